@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/base/mempool/v1beta1/query.proto
+
+package mempoolv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Service_Txs_FullMethodName       = "/cosmos.base.mempool.v1beta1.Service/Txs"
+	Service_SenderTxs_FullMethodName = "/cosmos.base.mempool.v1beta1.Service/SenderTxs"
+)
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceClient interface {
+	// Txs queries the txs pending in the mempool, in the mempool order.
+	Txs(ctx context.Context, in *TxsRequest, opts ...grpc.CallOption) (*TxsResponse, error)
+	// SenderTxs queries the txs of a sender pending in the mempool, in nonce order.
+	SenderTxs(ctx context.Context, in *SenderTxsRequest, opts ...grpc.CallOption) (*SenderTxsResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceClient(cc grpc.ClientConnInterface) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) Txs(ctx context.Context, in *TxsRequest, opts ...grpc.CallOption) (*TxsResponse, error) {
+	out := new(TxsResponse)
+	err := c.cc.Invoke(ctx, Service_Txs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SenderTxs(ctx context.Context, in *SenderTxsRequest, opts ...grpc.CallOption) (*SenderTxsResponse, error) {
+	out := new(SenderTxsResponse)
+	err := c.cc.Invoke(ctx, Service_SenderTxs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+// All implementations must embed UnimplementedServiceServer
+// for forward compatibility
+type ServiceServer interface {
+	// Txs queries the txs pending in the mempool, in the mempool order.
+	Txs(context.Context, *TxsRequest) (*TxsResponse, error)
+	// SenderTxs queries the txs of a sender pending in the mempool, in nonce order.
+	SenderTxs(context.Context, *SenderTxsRequest) (*SenderTxsResponse, error)
+	mustEmbedUnimplementedServiceServer()
+}
+
+// UnimplementedServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (UnimplementedServiceServer) Txs(context.Context, *TxsRequest) (*TxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Txs not implemented")
+}
+func (UnimplementedServiceServer) SenderTxs(context.Context, *SenderTxsRequest) (*SenderTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SenderTxs not implemented")
+}
+func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
+
+// UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceServer will
+// result in compilation errors.
+type UnsafeServiceServer interface {
+	mustEmbedUnimplementedServiceServer()
+}
+
+func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
+	s.RegisterService(&Service_ServiceDesc, srv)
+}
+
+func _Service_Txs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Txs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Txs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Txs(ctx, req.(*TxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SenderTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SenderTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SenderTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SenderTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SenderTxs(ctx, req.(*SenderTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Service_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.mempool.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Txs",
+			Handler:    _Service_Txs_Handler,
+		},
+		{
+			MethodName: "SenderTxs",
+			Handler:    _Service_SenderTxs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/mempool/v1beta1/query.proto",
+}
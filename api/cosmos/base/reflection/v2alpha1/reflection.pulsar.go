@@ -14,13 +14,14 @@ import (
 )
 
 var (
-	md_AppDescriptor                protoreflect.MessageDescriptor
-	fd_AppDescriptor_authn          protoreflect.FieldDescriptor
-	fd_AppDescriptor_chain          protoreflect.FieldDescriptor
-	fd_AppDescriptor_codec          protoreflect.FieldDescriptor
-	fd_AppDescriptor_configuration  protoreflect.FieldDescriptor
-	fd_AppDescriptor_query_services protoreflect.FieldDescriptor
-	fd_AppDescriptor_tx             protoreflect.FieldDescriptor
+	md_AppDescriptor                 protoreflect.MessageDescriptor
+	fd_AppDescriptor_authn           protoreflect.FieldDescriptor
+	fd_AppDescriptor_chain           protoreflect.FieldDescriptor
+	fd_AppDescriptor_codec           protoreflect.FieldDescriptor
+	fd_AppDescriptor_configuration   protoreflect.FieldDescriptor
+	fd_AppDescriptor_query_services  protoreflect.FieldDescriptor
+	fd_AppDescriptor_tx              protoreflect.FieldDescriptor
+	fd_AppDescriptor_genesis_schemas protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -32,6 +33,7 @@ func init() {
 	fd_AppDescriptor_configuration = md_AppDescriptor.Fields().ByName("configuration")
 	fd_AppDescriptor_query_services = md_AppDescriptor.Fields().ByName("query_services")
 	fd_AppDescriptor_tx = md_AppDescriptor.Fields().ByName("tx")
+	fd_AppDescriptor_genesis_schemas = md_AppDescriptor.Fields().ByName("genesis_schemas")
 }
 
 var _ protoreflect.Message = (*fastReflection_AppDescriptor)(nil)
@@ -135,6 +137,12 @@ func (x *fastReflection_AppDescriptor) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if x.GenesisSchemas != nil {
+		value := protoreflect.ValueOfMessage(x.GenesisSchemas.ProtoReflect())
+		if !f(fd_AppDescriptor_genesis_schemas, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -162,6 +170,8 @@ func (x *fastReflection_AppDescriptor) Has(fd protoreflect.FieldDescriptor) bool
 		return x.QueryServices != nil
 	case "cosmos.base.reflection.v2alpha1.AppDescriptor.tx":
 		return x.Tx != nil
+	case "cosmos.base.reflection.v2alpha1.AppDescriptor.genesis_schemas":
+		return x.GenesisSchemas != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.AppDescriptor"))
@@ -190,6 +200,8 @@ func (x *fastReflection_AppDescriptor) Clear(fd protoreflect.FieldDescriptor) {
 		x.QueryServices = nil
 	case "cosmos.base.reflection.v2alpha1.AppDescriptor.tx":
 		x.Tx = nil
+	case "cosmos.base.reflection.v2alpha1.AppDescriptor.genesis_schemas":
+		x.GenesisSchemas = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.AppDescriptor"))
@@ -224,6 +236,9 @@ func (x *fastReflection_AppDescriptor) Get(descriptor protoreflect.FieldDescript
 	case "cosmos.base.reflection.v2alpha1.AppDescriptor.tx":
 		value := x.Tx
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.base.reflection.v2alpha1.AppDescriptor.genesis_schemas":
+		value := x.GenesisSchemas
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.AppDescriptor"))
@@ -256,6 +271,8 @@ func (x *fastReflection_AppDescriptor) Set(fd protoreflect.FieldDescriptor, valu
 		x.QueryServices = value.Message().Interface().(*QueryServicesDescriptor)
 	case "cosmos.base.reflection.v2alpha1.AppDescriptor.tx":
 		x.Tx = value.Message().Interface().(*TxDescriptor)
+	case "cosmos.base.reflection.v2alpha1.AppDescriptor.genesis_schemas":
+		x.GenesisSchemas = value.Message().Interface().(*GenesisSchemasDescriptor)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.AppDescriptor"))
@@ -306,6 +323,11 @@ func (x *fastReflection_AppDescriptor) Mutable(fd protoreflect.FieldDescriptor)
 			x.Tx = new(TxDescriptor)
 		}
 		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+	case "cosmos.base.reflection.v2alpha1.AppDescriptor.genesis_schemas":
+		if x.GenesisSchemas == nil {
+			x.GenesisSchemas = new(GenesisSchemasDescriptor)
+		}
+		return protoreflect.ValueOfMessage(x.GenesisSchemas.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.AppDescriptor"))
@@ -337,6 +359,9 @@ func (x *fastReflection_AppDescriptor) NewField(fd protoreflect.FieldDescriptor)
 	case "cosmos.base.reflection.v2alpha1.AppDescriptor.tx":
 		m := new(TxDescriptor)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.base.reflection.v2alpha1.AppDescriptor.genesis_schemas":
+		m := new(GenesisSchemasDescriptor)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.AppDescriptor"))
@@ -430,6 +455,10 @@ func (x *fastReflection_AppDescriptor) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Tx)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.GenesisSchemas != nil {
+			l = options.Size(x.GenesisSchemas)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -459,6 +488,20 @@ func (x *fastReflection_AppDescriptor) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.GenesisSchemas != nil {
+			encoded, err := options.Marshal(x.GenesisSchemas)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if x.Tx != nil {
 			encoded, err := options.Marshal(x.Tx)
 			if err != nil {
@@ -808,6 +851,42 @@ func (x *fastReflection_AppDescriptor) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenesisSchemas", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GenesisSchemas == nil {
+					x.GenesisSchemas = &GenesisSchemasDescriptor{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GenesisSchemas); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5905,24 +5984,77 @@ func (x *fastReflection_MsgDescriptor) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_GenesisSchemasDescriptor_1_list)(nil)
+
+type _GenesisSchemasDescriptor_1_list struct {
+	list *[]*ModuleGenesisSchemaDescriptor
+}
+
+func (x *_GenesisSchemasDescriptor_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisSchemasDescriptor_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisSchemasDescriptor_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleGenesisSchemaDescriptor)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisSchemasDescriptor_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleGenesisSchemaDescriptor)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisSchemasDescriptor_1_list) AppendMutable() protoreflect.Value {
+	v := new(ModuleGenesisSchemaDescriptor)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisSchemasDescriptor_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisSchemasDescriptor_1_list) NewElement() protoreflect.Value {
+	v := new(ModuleGenesisSchemaDescriptor)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisSchemasDescriptor_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GetAuthnDescriptorRequest protoreflect.MessageDescriptor
+	md_GenesisSchemasDescriptor         protoreflect.MessageDescriptor
+	fd_GenesisSchemasDescriptor_modules protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
-	md_GetAuthnDescriptorRequest = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetAuthnDescriptorRequest")
+	md_GenesisSchemasDescriptor = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GenesisSchemasDescriptor")
+	fd_GenesisSchemasDescriptor_modules = md_GenesisSchemasDescriptor.Fields().ByName("modules")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetAuthnDescriptorRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_GenesisSchemasDescriptor)(nil)
 
-type fastReflection_GetAuthnDescriptorRequest GetAuthnDescriptorRequest
+type fastReflection_GenesisSchemasDescriptor GenesisSchemasDescriptor
 
-func (x *GetAuthnDescriptorRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetAuthnDescriptorRequest)(x)
+func (x *GenesisSchemasDescriptor) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisSchemasDescriptor)(x)
 }
 
-func (x *GetAuthnDescriptorRequest) slowProtoReflect() protoreflect.Message {
+func (x *GenesisSchemasDescriptor) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5934,43 +6066,43 @@ func (x *GetAuthnDescriptorRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetAuthnDescriptorRequest_messageType fastReflection_GetAuthnDescriptorRequest_messageType
-var _ protoreflect.MessageType = fastReflection_GetAuthnDescriptorRequest_messageType{}
+var _fastReflection_GenesisSchemasDescriptor_messageType fastReflection_GenesisSchemasDescriptor_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisSchemasDescriptor_messageType{}
 
-type fastReflection_GetAuthnDescriptorRequest_messageType struct{}
+type fastReflection_GenesisSchemasDescriptor_messageType struct{}
 
-func (x fastReflection_GetAuthnDescriptorRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetAuthnDescriptorRequest)(nil)
+func (x fastReflection_GenesisSchemasDescriptor_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisSchemasDescriptor)(nil)
 }
-func (x fastReflection_GetAuthnDescriptorRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetAuthnDescriptorRequest)
+func (x fastReflection_GenesisSchemasDescriptor_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisSchemasDescriptor)
 }
-func (x fastReflection_GetAuthnDescriptorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetAuthnDescriptorRequest
+func (x fastReflection_GenesisSchemasDescriptor_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisSchemasDescriptor
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetAuthnDescriptorRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetAuthnDescriptorRequest
+func (x *fastReflection_GenesisSchemasDescriptor) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisSchemasDescriptor
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetAuthnDescriptorRequest) Type() protoreflect.MessageType {
-	return _fastReflection_GetAuthnDescriptorRequest_messageType
+func (x *fastReflection_GenesisSchemasDescriptor) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisSchemasDescriptor_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetAuthnDescriptorRequest) New() protoreflect.Message {
-	return new(fastReflection_GetAuthnDescriptorRequest)
+func (x *fastReflection_GenesisSchemasDescriptor) New() protoreflect.Message {
+	return new(fastReflection_GenesisSchemasDescriptor)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetAuthnDescriptorRequest) Interface() protoreflect.ProtoMessage {
-	return (*GetAuthnDescriptorRequest)(x)
+func (x *fastReflection_GenesisSchemasDescriptor) Interface() protoreflect.ProtoMessage {
+	return (*GenesisSchemasDescriptor)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5978,7 +6110,13 @@ func (x *fastReflection_GetAuthnDescriptorRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetAuthnDescriptorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_GenesisSchemasDescriptor) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Modules) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisSchemasDescriptor_1_list{list: &x.Modules})
+		if !f(fd_GenesisSchemasDescriptor_modules, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -5992,13 +6130,15 @@ func (x *fastReflection_GetAuthnDescriptorRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetAuthnDescriptorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GenesisSchemasDescriptor) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor.modules":
+		return len(x.Modules) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6008,13 +6148,15 @@ func (x *fastReflection_GetAuthnDescriptorRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetAuthnDescriptorRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GenesisSchemasDescriptor) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor.modules":
+		x.Modules = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6024,13 +6166,19 @@ func (x *fastReflection_GetAuthnDescriptorRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetAuthnDescriptorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GenesisSchemasDescriptor) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor.modules":
+		if len(x.Modules) == 0 {
+			return protoreflect.ValueOfList(&_GenesisSchemasDescriptor_1_list{})
+		}
+		listValue := &_GenesisSchemasDescriptor_1_list{list: &x.Modules}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6044,13 +6192,17 @@ func (x *fastReflection_GetAuthnDescriptorRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetAuthnDescriptorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GenesisSchemasDescriptor) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor.modules":
+		lv := value.List()
+		clv := lv.(*_GenesisSchemasDescriptor_1_list)
+		x.Modules = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6064,36 +6216,45 @@ func (x *fastReflection_GetAuthnDescriptorRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetAuthnDescriptorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GenesisSchemasDescriptor) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor.modules":
+		if x.Modules == nil {
+			x.Modules = []*ModuleGenesisSchemaDescriptor{}
+		}
+		value := &_GenesisSchemasDescriptor_1_list{list: &x.Modules}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetAuthnDescriptorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GenesisSchemasDescriptor) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor.modules":
+		list := []*ModuleGenesisSchemaDescriptor{}
+		return protoreflect.ValueOfList(&_GenesisSchemasDescriptor_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetAuthnDescriptorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GenesisSchemasDescriptor) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6101,7 +6262,7 @@ func (x *fastReflection_GetAuthnDescriptorRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetAuthnDescriptorRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GenesisSchemasDescriptor) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6112,7 +6273,7 @@ func (x *fastReflection_GetAuthnDescriptorRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetAuthnDescriptorRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GenesisSchemasDescriptor) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6124,7 +6285,7 @@ func (x *fastReflection_GetAuthnDescriptorRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetAuthnDescriptorRequest) IsValid() bool {
+func (x *fastReflection_GenesisSchemasDescriptor) IsValid() bool {
 	return x != nil
 }
 
@@ -6134,9 +6295,9 @@ func (x *fastReflection_GetAuthnDescriptorRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetAuthnDescriptorRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GenesisSchemasDescriptor) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetAuthnDescriptorRequest)
+		x := input.Message.Interface().(*GenesisSchemasDescriptor)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6148,6 +6309,12 @@ func (x *fastReflection_GetAuthnDescriptorRequest) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
+		if len(x.Modules) > 0 {
+			for _, e := range x.Modules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6158,7 +6325,7 @@ func (x *fastReflection_GetAuthnDescriptorRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetAuthnDescriptorRequest)
+		x := input.Message.Interface().(*GenesisSchemasDescriptor)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6177,6 +6344,22 @@ func (x *fastReflection_GetAuthnDescriptorRequest) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Modules) > 0 {
+			for iNdEx := len(x.Modules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Modules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -6188,7 +6371,7 @@ func (x *fastReflection_GetAuthnDescriptorRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetAuthnDescriptorRequest)
+		x := input.Message.Interface().(*GenesisSchemasDescriptor)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6220,12 +6403,46 @@ func (x *fastReflection_GetAuthnDescriptorRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetAuthnDescriptorRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisSchemasDescriptor: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetAuthnDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisSchemasDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Modules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Modules = append(x.Modules, &ModuleGenesisSchemaDescriptor{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Modules[len(x.Modules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6262,25 +6479,27 @@ func (x *fastReflection_GetAuthnDescriptorRequest) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_GetAuthnDescriptorResponse       protoreflect.MessageDescriptor
-	fd_GetAuthnDescriptorResponse_authn protoreflect.FieldDescriptor
+	md_ModuleGenesisSchemaDescriptor             protoreflect.MessageDescriptor
+	fd_ModuleGenesisSchemaDescriptor_module      protoreflect.FieldDescriptor
+	fd_ModuleGenesisSchemaDescriptor_json_schema protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
-	md_GetAuthnDescriptorResponse = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetAuthnDescriptorResponse")
-	fd_GetAuthnDescriptorResponse_authn = md_GetAuthnDescriptorResponse.Fields().ByName("authn")
+	md_ModuleGenesisSchemaDescriptor = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("ModuleGenesisSchemaDescriptor")
+	fd_ModuleGenesisSchemaDescriptor_module = md_ModuleGenesisSchemaDescriptor.Fields().ByName("module")
+	fd_ModuleGenesisSchemaDescriptor_json_schema = md_ModuleGenesisSchemaDescriptor.Fields().ByName("json_schema")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetAuthnDescriptorResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_ModuleGenesisSchemaDescriptor)(nil)
 
-type fastReflection_GetAuthnDescriptorResponse GetAuthnDescriptorResponse
+type fastReflection_ModuleGenesisSchemaDescriptor ModuleGenesisSchemaDescriptor
 
-func (x *GetAuthnDescriptorResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetAuthnDescriptorResponse)(x)
+func (x *ModuleGenesisSchemaDescriptor) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ModuleGenesisSchemaDescriptor)(x)
 }
 
-func (x *GetAuthnDescriptorResponse) slowProtoReflect() protoreflect.Message {
+func (x *ModuleGenesisSchemaDescriptor) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6292,43 +6511,43 @@ func (x *GetAuthnDescriptorResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetAuthnDescriptorResponse_messageType fastReflection_GetAuthnDescriptorResponse_messageType
-var _ protoreflect.MessageType = fastReflection_GetAuthnDescriptorResponse_messageType{}
+var _fastReflection_ModuleGenesisSchemaDescriptor_messageType fastReflection_ModuleGenesisSchemaDescriptor_messageType
+var _ protoreflect.MessageType = fastReflection_ModuleGenesisSchemaDescriptor_messageType{}
 
-type fastReflection_GetAuthnDescriptorResponse_messageType struct{}
+type fastReflection_ModuleGenesisSchemaDescriptor_messageType struct{}
 
-func (x fastReflection_GetAuthnDescriptorResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetAuthnDescriptorResponse)(nil)
+func (x fastReflection_ModuleGenesisSchemaDescriptor_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ModuleGenesisSchemaDescriptor)(nil)
 }
-func (x fastReflection_GetAuthnDescriptorResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetAuthnDescriptorResponse)
+func (x fastReflection_ModuleGenesisSchemaDescriptor_messageType) New() protoreflect.Message {
+	return new(fastReflection_ModuleGenesisSchemaDescriptor)
 }
-func (x fastReflection_GetAuthnDescriptorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetAuthnDescriptorResponse
+func (x fastReflection_ModuleGenesisSchemaDescriptor_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleGenesisSchemaDescriptor
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetAuthnDescriptorResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetAuthnDescriptorResponse
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleGenesisSchemaDescriptor
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetAuthnDescriptorResponse) Type() protoreflect.MessageType {
-	return _fastReflection_GetAuthnDescriptorResponse_messageType
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) Type() protoreflect.MessageType {
+	return _fastReflection_ModuleGenesisSchemaDescriptor_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetAuthnDescriptorResponse) New() protoreflect.Message {
-	return new(fastReflection_GetAuthnDescriptorResponse)
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) New() protoreflect.Message {
+	return new(fastReflection_ModuleGenesisSchemaDescriptor)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetAuthnDescriptorResponse) Interface() protoreflect.ProtoMessage {
-	return (*GetAuthnDescriptorResponse)(x)
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) Interface() protoreflect.ProtoMessage {
+	return (*ModuleGenesisSchemaDescriptor)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6336,10 +6555,16 @@ func (x *fastReflection_GetAuthnDescriptorResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetAuthnDescriptorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Authn != nil {
-		value := protoreflect.ValueOfMessage(x.Authn.ProtoReflect())
-		if !f(fd_GetAuthnDescriptorResponse_authn, value) {
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Module != "" {
+		value := protoreflect.ValueOfString(x.Module)
+		if !f(fd_ModuleGenesisSchemaDescriptor_module, value) {
+			return
+		}
+	}
+	if x.JsonSchema != "" {
+		value := protoreflect.ValueOfString(x.JsonSchema)
+		if !f(fd_ModuleGenesisSchemaDescriptor_json_schema, value) {
 			return
 		}
 	}
@@ -6356,15 +6581,17 @@ func (x *fastReflection_GetAuthnDescriptorResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetAuthnDescriptorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse.authn":
-		return x.Authn != nil
+	case "cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor.module":
+		return x.Module != ""
+	case "cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor.json_schema":
+		return x.JsonSchema != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6374,15 +6601,17 @@ func (x *fastReflection_GetAuthnDescriptorResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetAuthnDescriptorResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse.authn":
-		x.Authn = nil
+	case "cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor.module":
+		x.Module = ""
+	case "cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor.json_schema":
+		x.JsonSchema = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6392,16 +6621,19 @@ func (x *fastReflection_GetAuthnDescriptorResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetAuthnDescriptorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse.authn":
-		value := x.Authn
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor.module":
+		value := x.Module
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor.json_schema":
+		value := x.JsonSchema
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6415,15 +6647,17 @@ func (x *fastReflection_GetAuthnDescriptorResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetAuthnDescriptorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse.authn":
-		x.Authn = value.Message().Interface().(*AuthnDescriptor)
+	case "cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor.module":
+		x.Module = value.Interface().(string)
+	case "cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor.json_schema":
+		x.JsonSchema = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6437,44 +6671,44 @@ func (x *fastReflection_GetAuthnDescriptorResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetAuthnDescriptorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse.authn":
-		if x.Authn == nil {
-			x.Authn = new(AuthnDescriptor)
-		}
-		return protoreflect.ValueOfMessage(x.Authn.ProtoReflect())
+	case "cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor.module":
+		panic(fmt.Errorf("field module of message cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor is not mutable"))
+	case "cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor.json_schema":
+		panic(fmt.Errorf("field json_schema of message cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetAuthnDescriptorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse.authn":
-		m := new(AuthnDescriptor)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor.module":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor.json_schema":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetAuthnDescriptorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6482,7 +6716,7 @@ func (x *fastReflection_GetAuthnDescriptorResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetAuthnDescriptorResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6493,7 +6727,7 @@ func (x *fastReflection_GetAuthnDescriptorResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetAuthnDescriptorResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6505,7 +6739,7 @@ func (x *fastReflection_GetAuthnDescriptorResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetAuthnDescriptorResponse) IsValid() bool {
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) IsValid() bool {
 	return x != nil
 }
 
@@ -6515,9 +6749,9 @@ func (x *fastReflection_GetAuthnDescriptorResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetAuthnDescriptorResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ModuleGenesisSchemaDescriptor) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetAuthnDescriptorResponse)
+		x := input.Message.Interface().(*ModuleGenesisSchemaDescriptor)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6529,8 +6763,12 @@ func (x *fastReflection_GetAuthnDescriptorResponse) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		if x.Authn != nil {
-			l = options.Size(x.Authn)
+		l = len(x.Module)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.JsonSchema)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6543,7 +6781,7 @@ func (x *fastReflection_GetAuthnDescriptorResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetAuthnDescriptorResponse)
+		x := input.Message.Interface().(*ModuleGenesisSchemaDescriptor)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6562,17 +6800,17 @@ func (x *fastReflection_GetAuthnDescriptorResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Authn != nil {
-			encoded, err := options.Marshal(x.Authn)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.JsonSchema) > 0 {
+			i -= len(x.JsonSchema)
+			copy(dAtA[i:], x.JsonSchema)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.JsonSchema)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Module) > 0 {
+			i -= len(x.Module)
+			copy(dAtA[i:], x.Module)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Module)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6587,7 +6825,7 @@ func (x *fastReflection_GetAuthnDescriptorResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetAuthnDescriptorResponse)
+		x := input.Message.Interface().(*ModuleGenesisSchemaDescriptor)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6619,17 +6857,17 @@ func (x *fastReflection_GetAuthnDescriptorResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetAuthnDescriptorResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleGenesisSchemaDescriptor: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetAuthnDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleGenesisSchemaDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authn", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6639,27 +6877,55 @@ func (x *fastReflection_GetAuthnDescriptorResponse) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Authn == nil {
-					x.Authn = &AuthnDescriptor{}
+				x.Module = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JsonSchema", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Authn); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.JsonSchema = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6697,23 +6963,23 @@ func (x *fastReflection_GetAuthnDescriptorResponse) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_GetChainDescriptorRequest protoreflect.MessageDescriptor
+	md_GetAuthnDescriptorRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
-	md_GetChainDescriptorRequest = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetChainDescriptorRequest")
+	md_GetAuthnDescriptorRequest = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetAuthnDescriptorRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetChainDescriptorRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_GetAuthnDescriptorRequest)(nil)
 
-type fastReflection_GetChainDescriptorRequest GetChainDescriptorRequest
+type fastReflection_GetAuthnDescriptorRequest GetAuthnDescriptorRequest
 
-func (x *GetChainDescriptorRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetChainDescriptorRequest)(x)
+func (x *GetAuthnDescriptorRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetAuthnDescriptorRequest)(x)
 }
 
-func (x *GetChainDescriptorRequest) slowProtoReflect() protoreflect.Message {
+func (x *GetAuthnDescriptorRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6725,43 +6991,43 @@ func (x *GetChainDescriptorRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetChainDescriptorRequest_messageType fastReflection_GetChainDescriptorRequest_messageType
-var _ protoreflect.MessageType = fastReflection_GetChainDescriptorRequest_messageType{}
+var _fastReflection_GetAuthnDescriptorRequest_messageType fastReflection_GetAuthnDescriptorRequest_messageType
+var _ protoreflect.MessageType = fastReflection_GetAuthnDescriptorRequest_messageType{}
 
-type fastReflection_GetChainDescriptorRequest_messageType struct{}
+type fastReflection_GetAuthnDescriptorRequest_messageType struct{}
 
-func (x fastReflection_GetChainDescriptorRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetChainDescriptorRequest)(nil)
+func (x fastReflection_GetAuthnDescriptorRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetAuthnDescriptorRequest)(nil)
 }
-func (x fastReflection_GetChainDescriptorRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetChainDescriptorRequest)
+func (x fastReflection_GetAuthnDescriptorRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetAuthnDescriptorRequest)
 }
-func (x fastReflection_GetChainDescriptorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetChainDescriptorRequest
+func (x fastReflection_GetAuthnDescriptorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetAuthnDescriptorRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetChainDescriptorRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetChainDescriptorRequest
+func (x *fastReflection_GetAuthnDescriptorRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetAuthnDescriptorRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetChainDescriptorRequest) Type() protoreflect.MessageType {
-	return _fastReflection_GetChainDescriptorRequest_messageType
+func (x *fastReflection_GetAuthnDescriptorRequest) Type() protoreflect.MessageType {
+	return _fastReflection_GetAuthnDescriptorRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetChainDescriptorRequest) New() protoreflect.Message {
-	return new(fastReflection_GetChainDescriptorRequest)
+func (x *fastReflection_GetAuthnDescriptorRequest) New() protoreflect.Message {
+	return new(fastReflection_GetAuthnDescriptorRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetChainDescriptorRequest) Interface() protoreflect.ProtoMessage {
-	return (*GetChainDescriptorRequest)(x)
+func (x *fastReflection_GetAuthnDescriptorRequest) Interface() protoreflect.ProtoMessage {
+	return (*GetAuthnDescriptorRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6769,7 +7035,7 @@ func (x *fastReflection_GetChainDescriptorRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetChainDescriptorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_GetAuthnDescriptorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -6783,13 +7049,13 @@ func (x *fastReflection_GetChainDescriptorRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetChainDescriptorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GetAuthnDescriptorRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6799,13 +7065,13 @@ func (x *fastReflection_GetChainDescriptorRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetChainDescriptorRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GetAuthnDescriptorRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6815,13 +7081,13 @@ func (x *fastReflection_GetChainDescriptorRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetChainDescriptorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetAuthnDescriptorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6835,13 +7101,13 @@ func (x *fastReflection_GetChainDescriptorRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetChainDescriptorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GetAuthnDescriptorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6855,36 +7121,36 @@ func (x *fastReflection_GetChainDescriptorRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetChainDescriptorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetAuthnDescriptorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetChainDescriptorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetAuthnDescriptorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetChainDescriptorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GetAuthnDescriptorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6892,7 +7158,7 @@ func (x *fastReflection_GetChainDescriptorRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetChainDescriptorRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GetAuthnDescriptorRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6903,7 +7169,7 @@ func (x *fastReflection_GetChainDescriptorRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetChainDescriptorRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GetAuthnDescriptorRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6915,7 +7181,7 @@ func (x *fastReflection_GetChainDescriptorRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetChainDescriptorRequest) IsValid() bool {
+func (x *fastReflection_GetAuthnDescriptorRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6925,9 +7191,9 @@ func (x *fastReflection_GetChainDescriptorRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetChainDescriptorRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GetAuthnDescriptorRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetChainDescriptorRequest)
+		x := input.Message.Interface().(*GetAuthnDescriptorRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6949,7 +7215,7 @@ func (x *fastReflection_GetChainDescriptorRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetChainDescriptorRequest)
+		x := input.Message.Interface().(*GetAuthnDescriptorRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6979,7 +7245,7 @@ func (x *fastReflection_GetChainDescriptorRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetChainDescriptorRequest)
+		x := input.Message.Interface().(*GetAuthnDescriptorRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7011,10 +7277,10 @@ func (x *fastReflection_GetChainDescriptorRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetChainDescriptorRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetAuthnDescriptorRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetChainDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetAuthnDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -7053,25 +7319,25 @@ func (x *fastReflection_GetChainDescriptorRequest) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_GetChainDescriptorResponse       protoreflect.MessageDescriptor
-	fd_GetChainDescriptorResponse_chain protoreflect.FieldDescriptor
+	md_GetAuthnDescriptorResponse       protoreflect.MessageDescriptor
+	fd_GetAuthnDescriptorResponse_authn protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
-	md_GetChainDescriptorResponse = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetChainDescriptorResponse")
-	fd_GetChainDescriptorResponse_chain = md_GetChainDescriptorResponse.Fields().ByName("chain")
+	md_GetAuthnDescriptorResponse = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetAuthnDescriptorResponse")
+	fd_GetAuthnDescriptorResponse_authn = md_GetAuthnDescriptorResponse.Fields().ByName("authn")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetChainDescriptorResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_GetAuthnDescriptorResponse)(nil)
 
-type fastReflection_GetChainDescriptorResponse GetChainDescriptorResponse
+type fastReflection_GetAuthnDescriptorResponse GetAuthnDescriptorResponse
 
-func (x *GetChainDescriptorResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetChainDescriptorResponse)(x)
+func (x *GetAuthnDescriptorResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetAuthnDescriptorResponse)(x)
 }
 
-func (x *GetChainDescriptorResponse) slowProtoReflect() protoreflect.Message {
+func (x *GetAuthnDescriptorResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7083,43 +7349,43 @@ func (x *GetChainDescriptorResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetChainDescriptorResponse_messageType fastReflection_GetChainDescriptorResponse_messageType
-var _ protoreflect.MessageType = fastReflection_GetChainDescriptorResponse_messageType{}
+var _fastReflection_GetAuthnDescriptorResponse_messageType fastReflection_GetAuthnDescriptorResponse_messageType
+var _ protoreflect.MessageType = fastReflection_GetAuthnDescriptorResponse_messageType{}
 
-type fastReflection_GetChainDescriptorResponse_messageType struct{}
+type fastReflection_GetAuthnDescriptorResponse_messageType struct{}
 
-func (x fastReflection_GetChainDescriptorResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetChainDescriptorResponse)(nil)
+func (x fastReflection_GetAuthnDescriptorResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetAuthnDescriptorResponse)(nil)
 }
-func (x fastReflection_GetChainDescriptorResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetChainDescriptorResponse)
+func (x fastReflection_GetAuthnDescriptorResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetAuthnDescriptorResponse)
 }
-func (x fastReflection_GetChainDescriptorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetChainDescriptorResponse
+func (x fastReflection_GetAuthnDescriptorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetAuthnDescriptorResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetChainDescriptorResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetChainDescriptorResponse
+func (x *fastReflection_GetAuthnDescriptorResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetAuthnDescriptorResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetChainDescriptorResponse) Type() protoreflect.MessageType {
-	return _fastReflection_GetChainDescriptorResponse_messageType
+func (x *fastReflection_GetAuthnDescriptorResponse) Type() protoreflect.MessageType {
+	return _fastReflection_GetAuthnDescriptorResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetChainDescriptorResponse) New() protoreflect.Message {
-	return new(fastReflection_GetChainDescriptorResponse)
+func (x *fastReflection_GetAuthnDescriptorResponse) New() protoreflect.Message {
+	return new(fastReflection_GetAuthnDescriptorResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetChainDescriptorResponse) Interface() protoreflect.ProtoMessage {
-	return (*GetChainDescriptorResponse)(x)
+func (x *fastReflection_GetAuthnDescriptorResponse) Interface() protoreflect.ProtoMessage {
+	return (*GetAuthnDescriptorResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7127,10 +7393,10 @@ func (x *fastReflection_GetChainDescriptorResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetChainDescriptorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Chain != nil {
-		value := protoreflect.ValueOfMessage(x.Chain.ProtoReflect())
-		if !f(fd_GetChainDescriptorResponse_chain, value) {
+func (x *fastReflection_GetAuthnDescriptorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authn != nil {
+		value := protoreflect.ValueOfMessage(x.Authn.ProtoReflect())
+		if !f(fd_GetAuthnDescriptorResponse_authn, value) {
 			return
 		}
 	}
@@ -7147,15 +7413,15 @@ func (x *fastReflection_GetChainDescriptorResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetChainDescriptorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GetAuthnDescriptorResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse.chain":
-		return x.Chain != nil
+	case "cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse.authn":
+		return x.Authn != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7165,15 +7431,15 @@ func (x *fastReflection_GetChainDescriptorResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetChainDescriptorResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GetAuthnDescriptorResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse.chain":
-		x.Chain = nil
+	case "cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse.authn":
+		x.Authn = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7183,16 +7449,16 @@ func (x *fastReflection_GetChainDescriptorResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetChainDescriptorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetAuthnDescriptorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse.chain":
-		value := x.Chain
+	case "cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse.authn":
+		value := x.Authn
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7206,15 +7472,15 @@ func (x *fastReflection_GetChainDescriptorResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetChainDescriptorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GetAuthnDescriptorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse.chain":
-		x.Chain = value.Message().Interface().(*ChainDescriptor)
+	case "cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse.authn":
+		x.Authn = value.Message().Interface().(*AuthnDescriptor)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7228,44 +7494,44 @@ func (x *fastReflection_GetChainDescriptorResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetChainDescriptorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetAuthnDescriptorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse.chain":
-		if x.Chain == nil {
-			x.Chain = new(ChainDescriptor)
+	case "cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse.authn":
+		if x.Authn == nil {
+			x.Authn = new(AuthnDescriptor)
 		}
-		return protoreflect.ValueOfMessage(x.Chain.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Authn.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetChainDescriptorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetAuthnDescriptorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse.chain":
-		m := new(ChainDescriptor)
+	case "cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse.authn":
+		m := new(AuthnDescriptor)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetChainDescriptorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GetAuthnDescriptorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7273,7 +7539,7 @@ func (x *fastReflection_GetChainDescriptorResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetChainDescriptorResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GetAuthnDescriptorResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7284,7 +7550,7 @@ func (x *fastReflection_GetChainDescriptorResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetChainDescriptorResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GetAuthnDescriptorResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7296,7 +7562,7 @@ func (x *fastReflection_GetChainDescriptorResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetChainDescriptorResponse) IsValid() bool {
+func (x *fastReflection_GetAuthnDescriptorResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7306,9 +7572,9 @@ func (x *fastReflection_GetChainDescriptorResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetChainDescriptorResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GetAuthnDescriptorResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetChainDescriptorResponse)
+		x := input.Message.Interface().(*GetAuthnDescriptorResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7320,8 +7586,8 @@ func (x *fastReflection_GetChainDescriptorResponse) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		if x.Chain != nil {
-			l = options.Size(x.Chain)
+		if x.Authn != nil {
+			l = options.Size(x.Authn)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7334,7 +7600,7 @@ func (x *fastReflection_GetChainDescriptorResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetChainDescriptorResponse)
+		x := input.Message.Interface().(*GetAuthnDescriptorResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7353,8 +7619,8 @@ func (x *fastReflection_GetChainDescriptorResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Chain != nil {
-			encoded, err := options.Marshal(x.Chain)
+		if x.Authn != nil {
+			encoded, err := options.Marshal(x.Authn)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7378,7 +7644,7 @@ func (x *fastReflection_GetChainDescriptorResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetChainDescriptorResponse)
+		x := input.Message.Interface().(*GetAuthnDescriptorResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7410,15 +7676,15 @@ func (x *fastReflection_GetChainDescriptorResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetChainDescriptorResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetAuthnDescriptorResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetChainDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetAuthnDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authn", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7445,10 +7711,10 @@ func (x *fastReflection_GetChainDescriptorResponse) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Chain == nil {
-					x.Chain = &ChainDescriptor{}
+				if x.Authn == nil {
+					x.Authn = &AuthnDescriptor{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Chain); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Authn); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -7488,23 +7754,23 @@ func (x *fastReflection_GetChainDescriptorResponse) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_GetCodecDescriptorRequest protoreflect.MessageDescriptor
+	md_GetChainDescriptorRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
-	md_GetCodecDescriptorRequest = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetCodecDescriptorRequest")
+	md_GetChainDescriptorRequest = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetChainDescriptorRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetCodecDescriptorRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_GetChainDescriptorRequest)(nil)
 
-type fastReflection_GetCodecDescriptorRequest GetCodecDescriptorRequest
+type fastReflection_GetChainDescriptorRequest GetChainDescriptorRequest
 
-func (x *GetCodecDescriptorRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetCodecDescriptorRequest)(x)
+func (x *GetChainDescriptorRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetChainDescriptorRequest)(x)
 }
 
-func (x *GetCodecDescriptorRequest) slowProtoReflect() protoreflect.Message {
+func (x *GetChainDescriptorRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7516,43 +7782,43 @@ func (x *GetCodecDescriptorRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetCodecDescriptorRequest_messageType fastReflection_GetCodecDescriptorRequest_messageType
-var _ protoreflect.MessageType = fastReflection_GetCodecDescriptorRequest_messageType{}
+var _fastReflection_GetChainDescriptorRequest_messageType fastReflection_GetChainDescriptorRequest_messageType
+var _ protoreflect.MessageType = fastReflection_GetChainDescriptorRequest_messageType{}
 
-type fastReflection_GetCodecDescriptorRequest_messageType struct{}
+type fastReflection_GetChainDescriptorRequest_messageType struct{}
 
-func (x fastReflection_GetCodecDescriptorRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetCodecDescriptorRequest)(nil)
+func (x fastReflection_GetChainDescriptorRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetChainDescriptorRequest)(nil)
 }
-func (x fastReflection_GetCodecDescriptorRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetCodecDescriptorRequest)
+func (x fastReflection_GetChainDescriptorRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetChainDescriptorRequest)
 }
-func (x fastReflection_GetCodecDescriptorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetCodecDescriptorRequest
+func (x fastReflection_GetChainDescriptorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetChainDescriptorRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetCodecDescriptorRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetCodecDescriptorRequest
+func (x *fastReflection_GetChainDescriptorRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetChainDescriptorRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetCodecDescriptorRequest) Type() protoreflect.MessageType {
-	return _fastReflection_GetCodecDescriptorRequest_messageType
+func (x *fastReflection_GetChainDescriptorRequest) Type() protoreflect.MessageType {
+	return _fastReflection_GetChainDescriptorRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetCodecDescriptorRequest) New() protoreflect.Message {
-	return new(fastReflection_GetCodecDescriptorRequest)
+func (x *fastReflection_GetChainDescriptorRequest) New() protoreflect.Message {
+	return new(fastReflection_GetChainDescriptorRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetCodecDescriptorRequest) Interface() protoreflect.ProtoMessage {
-	return (*GetCodecDescriptorRequest)(x)
+func (x *fastReflection_GetChainDescriptorRequest) Interface() protoreflect.ProtoMessage {
+	return (*GetChainDescriptorRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7560,7 +7826,7 @@ func (x *fastReflection_GetCodecDescriptorRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetCodecDescriptorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_GetChainDescriptorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -7574,13 +7840,13 @@ func (x *fastReflection_GetCodecDescriptorRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetCodecDescriptorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GetChainDescriptorRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7590,13 +7856,13 @@ func (x *fastReflection_GetCodecDescriptorRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetCodecDescriptorRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GetChainDescriptorRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7606,13 +7872,13 @@ func (x *fastReflection_GetCodecDescriptorRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetCodecDescriptorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetChainDescriptorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7626,13 +7892,13 @@ func (x *fastReflection_GetCodecDescriptorRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetCodecDescriptorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GetChainDescriptorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7646,36 +7912,36 @@ func (x *fastReflection_GetCodecDescriptorRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetCodecDescriptorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetChainDescriptorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetCodecDescriptorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetChainDescriptorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetCodecDescriptorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GetChainDescriptorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7683,7 +7949,7 @@ func (x *fastReflection_GetCodecDescriptorRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetCodecDescriptorRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GetChainDescriptorRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7694,7 +7960,7 @@ func (x *fastReflection_GetCodecDescriptorRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetCodecDescriptorRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GetChainDescriptorRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7706,7 +7972,7 @@ func (x *fastReflection_GetCodecDescriptorRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetCodecDescriptorRequest) IsValid() bool {
+func (x *fastReflection_GetChainDescriptorRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7716,9 +7982,9 @@ func (x *fastReflection_GetCodecDescriptorRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetCodecDescriptorRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GetChainDescriptorRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetCodecDescriptorRequest)
+		x := input.Message.Interface().(*GetChainDescriptorRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7740,7 +8006,7 @@ func (x *fastReflection_GetCodecDescriptorRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetCodecDescriptorRequest)
+		x := input.Message.Interface().(*GetChainDescriptorRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7770,7 +8036,7 @@ func (x *fastReflection_GetCodecDescriptorRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetCodecDescriptorRequest)
+		x := input.Message.Interface().(*GetChainDescriptorRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7802,10 +8068,10 @@ func (x *fastReflection_GetCodecDescriptorRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetCodecDescriptorRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetChainDescriptorRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetCodecDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetChainDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -7844,25 +8110,25 @@ func (x *fastReflection_GetCodecDescriptorRequest) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_GetCodecDescriptorResponse       protoreflect.MessageDescriptor
-	fd_GetCodecDescriptorResponse_codec protoreflect.FieldDescriptor
+	md_GetChainDescriptorResponse       protoreflect.MessageDescriptor
+	fd_GetChainDescriptorResponse_chain protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
-	md_GetCodecDescriptorResponse = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetCodecDescriptorResponse")
-	fd_GetCodecDescriptorResponse_codec = md_GetCodecDescriptorResponse.Fields().ByName("codec")
+	md_GetChainDescriptorResponse = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetChainDescriptorResponse")
+	fd_GetChainDescriptorResponse_chain = md_GetChainDescriptorResponse.Fields().ByName("chain")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetCodecDescriptorResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_GetChainDescriptorResponse)(nil)
 
-type fastReflection_GetCodecDescriptorResponse GetCodecDescriptorResponse
+type fastReflection_GetChainDescriptorResponse GetChainDescriptorResponse
 
-func (x *GetCodecDescriptorResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetCodecDescriptorResponse)(x)
+func (x *GetChainDescriptorResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetChainDescriptorResponse)(x)
 }
 
-func (x *GetCodecDescriptorResponse) slowProtoReflect() protoreflect.Message {
+func (x *GetChainDescriptorResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7874,43 +8140,43 @@ func (x *GetCodecDescriptorResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetCodecDescriptorResponse_messageType fastReflection_GetCodecDescriptorResponse_messageType
-var _ protoreflect.MessageType = fastReflection_GetCodecDescriptorResponse_messageType{}
+var _fastReflection_GetChainDescriptorResponse_messageType fastReflection_GetChainDescriptorResponse_messageType
+var _ protoreflect.MessageType = fastReflection_GetChainDescriptorResponse_messageType{}
 
-type fastReflection_GetCodecDescriptorResponse_messageType struct{}
+type fastReflection_GetChainDescriptorResponse_messageType struct{}
 
-func (x fastReflection_GetCodecDescriptorResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetCodecDescriptorResponse)(nil)
+func (x fastReflection_GetChainDescriptorResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetChainDescriptorResponse)(nil)
 }
-func (x fastReflection_GetCodecDescriptorResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetCodecDescriptorResponse)
+func (x fastReflection_GetChainDescriptorResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetChainDescriptorResponse)
 }
-func (x fastReflection_GetCodecDescriptorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetCodecDescriptorResponse
+func (x fastReflection_GetChainDescriptorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetChainDescriptorResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetCodecDescriptorResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetCodecDescriptorResponse
+func (x *fastReflection_GetChainDescriptorResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetChainDescriptorResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetCodecDescriptorResponse) Type() protoreflect.MessageType {
-	return _fastReflection_GetCodecDescriptorResponse_messageType
+func (x *fastReflection_GetChainDescriptorResponse) Type() protoreflect.MessageType {
+	return _fastReflection_GetChainDescriptorResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetCodecDescriptorResponse) New() protoreflect.Message {
-	return new(fastReflection_GetCodecDescriptorResponse)
+func (x *fastReflection_GetChainDescriptorResponse) New() protoreflect.Message {
+	return new(fastReflection_GetChainDescriptorResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetCodecDescriptorResponse) Interface() protoreflect.ProtoMessage {
-	return (*GetCodecDescriptorResponse)(x)
+func (x *fastReflection_GetChainDescriptorResponse) Interface() protoreflect.ProtoMessage {
+	return (*GetChainDescriptorResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7918,10 +8184,10 @@ func (x *fastReflection_GetCodecDescriptorResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetCodecDescriptorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Codec != nil {
-		value := protoreflect.ValueOfMessage(x.Codec.ProtoReflect())
-		if !f(fd_GetCodecDescriptorResponse_codec, value) {
+func (x *fastReflection_GetChainDescriptorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Chain != nil {
+		value := protoreflect.ValueOfMessage(x.Chain.ProtoReflect())
+		if !f(fd_GetChainDescriptorResponse_chain, value) {
 			return
 		}
 	}
@@ -7938,15 +8204,15 @@ func (x *fastReflection_GetCodecDescriptorResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetCodecDescriptorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GetChainDescriptorResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse.codec":
-		return x.Codec != nil
+	case "cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse.chain":
+		return x.Chain != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7956,15 +8222,15 @@ func (x *fastReflection_GetCodecDescriptorResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetCodecDescriptorResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GetChainDescriptorResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse.codec":
-		x.Codec = nil
+	case "cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse.chain":
+		x.Chain = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7974,16 +8240,16 @@ func (x *fastReflection_GetCodecDescriptorResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetCodecDescriptorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetChainDescriptorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse.codec":
-		value := x.Codec
+	case "cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse.chain":
+		value := x.Chain
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7997,15 +8263,15 @@ func (x *fastReflection_GetCodecDescriptorResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetCodecDescriptorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GetChainDescriptorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse.codec":
-		x.Codec = value.Message().Interface().(*CodecDescriptor)
+	case "cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse.chain":
+		x.Chain = value.Message().Interface().(*ChainDescriptor)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8019,44 +8285,44 @@ func (x *fastReflection_GetCodecDescriptorResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetCodecDescriptorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetChainDescriptorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse.codec":
-		if x.Codec == nil {
-			x.Codec = new(CodecDescriptor)
+	case "cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse.chain":
+		if x.Chain == nil {
+			x.Chain = new(ChainDescriptor)
 		}
-		return protoreflect.ValueOfMessage(x.Codec.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Chain.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetCodecDescriptorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetChainDescriptorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse.codec":
-		m := new(CodecDescriptor)
+	case "cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse.chain":
+		m := new(ChainDescriptor)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetCodecDescriptorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GetChainDescriptorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8064,7 +8330,7 @@ func (x *fastReflection_GetCodecDescriptorResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetCodecDescriptorResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GetChainDescriptorResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8075,7 +8341,7 @@ func (x *fastReflection_GetCodecDescriptorResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetCodecDescriptorResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GetChainDescriptorResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8087,7 +8353,7 @@ func (x *fastReflection_GetCodecDescriptorResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetCodecDescriptorResponse) IsValid() bool {
+func (x *fastReflection_GetChainDescriptorResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8097,9 +8363,9 @@ func (x *fastReflection_GetCodecDescriptorResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetCodecDescriptorResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GetChainDescriptorResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetCodecDescriptorResponse)
+		x := input.Message.Interface().(*GetChainDescriptorResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8111,8 +8377,8 @@ func (x *fastReflection_GetCodecDescriptorResponse) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		if x.Codec != nil {
-			l = options.Size(x.Codec)
+		if x.Chain != nil {
+			l = options.Size(x.Chain)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8125,7 +8391,7 @@ func (x *fastReflection_GetCodecDescriptorResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetCodecDescriptorResponse)
+		x := input.Message.Interface().(*GetChainDescriptorResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8144,8 +8410,8 @@ func (x *fastReflection_GetCodecDescriptorResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Codec != nil {
-			encoded, err := options.Marshal(x.Codec)
+		if x.Chain != nil {
+			encoded, err := options.Marshal(x.Chain)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8169,7 +8435,7 @@ func (x *fastReflection_GetCodecDescriptorResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetCodecDescriptorResponse)
+		x := input.Message.Interface().(*GetChainDescriptorResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8201,15 +8467,15 @@ func (x *fastReflection_GetCodecDescriptorResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetCodecDescriptorResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetChainDescriptorResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetCodecDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetChainDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -8236,10 +8502,10 @@ func (x *fastReflection_GetCodecDescriptorResponse) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Codec == nil {
-					x.Codec = &CodecDescriptor{}
+				if x.Chain == nil {
+					x.Chain = &ChainDescriptor{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Codec); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Chain); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -8279,23 +8545,23 @@ func (x *fastReflection_GetCodecDescriptorResponse) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_GetConfigurationDescriptorRequest protoreflect.MessageDescriptor
+	md_GetCodecDescriptorRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
-	md_GetConfigurationDescriptorRequest = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetConfigurationDescriptorRequest")
+	md_GetCodecDescriptorRequest = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetCodecDescriptorRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetConfigurationDescriptorRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_GetCodecDescriptorRequest)(nil)
 
-type fastReflection_GetConfigurationDescriptorRequest GetConfigurationDescriptorRequest
+type fastReflection_GetCodecDescriptorRequest GetCodecDescriptorRequest
 
-func (x *GetConfigurationDescriptorRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetConfigurationDescriptorRequest)(x)
+func (x *GetCodecDescriptorRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetCodecDescriptorRequest)(x)
 }
 
-func (x *GetConfigurationDescriptorRequest) slowProtoReflect() protoreflect.Message {
+func (x *GetCodecDescriptorRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8307,43 +8573,43 @@ func (x *GetConfigurationDescriptorRequest) slowProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetConfigurationDescriptorRequest_messageType fastReflection_GetConfigurationDescriptorRequest_messageType
-var _ protoreflect.MessageType = fastReflection_GetConfigurationDescriptorRequest_messageType{}
+var _fastReflection_GetCodecDescriptorRequest_messageType fastReflection_GetCodecDescriptorRequest_messageType
+var _ protoreflect.MessageType = fastReflection_GetCodecDescriptorRequest_messageType{}
 
-type fastReflection_GetConfigurationDescriptorRequest_messageType struct{}
+type fastReflection_GetCodecDescriptorRequest_messageType struct{}
 
-func (x fastReflection_GetConfigurationDescriptorRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetConfigurationDescriptorRequest)(nil)
+func (x fastReflection_GetCodecDescriptorRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetCodecDescriptorRequest)(nil)
 }
-func (x fastReflection_GetConfigurationDescriptorRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetConfigurationDescriptorRequest)
+func (x fastReflection_GetCodecDescriptorRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetCodecDescriptorRequest)
 }
-func (x fastReflection_GetConfigurationDescriptorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetConfigurationDescriptorRequest
+func (x fastReflection_GetCodecDescriptorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetCodecDescriptorRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetConfigurationDescriptorRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetConfigurationDescriptorRequest
+func (x *fastReflection_GetCodecDescriptorRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetCodecDescriptorRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetConfigurationDescriptorRequest) Type() protoreflect.MessageType {
-	return _fastReflection_GetConfigurationDescriptorRequest_messageType
+func (x *fastReflection_GetCodecDescriptorRequest) Type() protoreflect.MessageType {
+	return _fastReflection_GetCodecDescriptorRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetConfigurationDescriptorRequest) New() protoreflect.Message {
-	return new(fastReflection_GetConfigurationDescriptorRequest)
+func (x *fastReflection_GetCodecDescriptorRequest) New() protoreflect.Message {
+	return new(fastReflection_GetCodecDescriptorRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetConfigurationDescriptorRequest) Interface() protoreflect.ProtoMessage {
-	return (*GetConfigurationDescriptorRequest)(x)
+func (x *fastReflection_GetCodecDescriptorRequest) Interface() protoreflect.ProtoMessage {
+	return (*GetCodecDescriptorRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8351,7 +8617,7 @@ func (x *fastReflection_GetConfigurationDescriptorRequest) Interface() protorefl
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetConfigurationDescriptorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_GetCodecDescriptorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -8365,13 +8631,13 @@ func (x *fastReflection_GetConfigurationDescriptorRequest) Range(f func(protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetConfigurationDescriptorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GetCodecDescriptorRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8381,13 +8647,13 @@ func (x *fastReflection_GetConfigurationDescriptorRequest) Has(fd protoreflect.F
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetConfigurationDescriptorRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GetCodecDescriptorRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8397,13 +8663,13 @@ func (x *fastReflection_GetConfigurationDescriptorRequest) Clear(fd protoreflect
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetConfigurationDescriptorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetCodecDescriptorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8417,13 +8683,13 @@ func (x *fastReflection_GetConfigurationDescriptorRequest) Get(descriptor protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetConfigurationDescriptorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GetCodecDescriptorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8437,36 +8703,36 @@ func (x *fastReflection_GetConfigurationDescriptorRequest) Set(fd protoreflect.F
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetConfigurationDescriptorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetCodecDescriptorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetConfigurationDescriptorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetCodecDescriptorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetConfigurationDescriptorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GetCodecDescriptorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8474,7 +8740,7 @@ func (x *fastReflection_GetConfigurationDescriptorRequest) WhichOneof(d protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetConfigurationDescriptorRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GetCodecDescriptorRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8485,7 +8751,7 @@ func (x *fastReflection_GetConfigurationDescriptorRequest) GetUnknown() protoref
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetConfigurationDescriptorRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GetCodecDescriptorRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8497,7 +8763,7 @@ func (x *fastReflection_GetConfigurationDescriptorRequest) SetUnknown(fields pro
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetConfigurationDescriptorRequest) IsValid() bool {
+func (x *fastReflection_GetCodecDescriptorRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8507,9 +8773,9 @@ func (x *fastReflection_GetConfigurationDescriptorRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetConfigurationDescriptorRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GetCodecDescriptorRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetConfigurationDescriptorRequest)
+		x := input.Message.Interface().(*GetCodecDescriptorRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8531,7 +8797,7 @@ func (x *fastReflection_GetConfigurationDescriptorRequest) ProtoMethods() *proto
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetConfigurationDescriptorRequest)
+		x := input.Message.Interface().(*GetCodecDescriptorRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8561,7 +8827,7 @@ func (x *fastReflection_GetConfigurationDescriptorRequest) ProtoMethods() *proto
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetConfigurationDescriptorRequest)
+		x := input.Message.Interface().(*GetCodecDescriptorRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8593,10 +8859,10 @@ func (x *fastReflection_GetConfigurationDescriptorRequest) ProtoMethods() *proto
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetConfigurationDescriptorRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetCodecDescriptorRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetConfigurationDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetCodecDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -8635,25 +8901,25 @@ func (x *fastReflection_GetConfigurationDescriptorRequest) ProtoMethods() *proto
 }
 
 var (
-	md_GetConfigurationDescriptorResponse        protoreflect.MessageDescriptor
-	fd_GetConfigurationDescriptorResponse_config protoreflect.FieldDescriptor
+	md_GetCodecDescriptorResponse       protoreflect.MessageDescriptor
+	fd_GetCodecDescriptorResponse_codec protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
-	md_GetConfigurationDescriptorResponse = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetConfigurationDescriptorResponse")
-	fd_GetConfigurationDescriptorResponse_config = md_GetConfigurationDescriptorResponse.Fields().ByName("config")
+	md_GetCodecDescriptorResponse = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetCodecDescriptorResponse")
+	fd_GetCodecDescriptorResponse_codec = md_GetCodecDescriptorResponse.Fields().ByName("codec")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetConfigurationDescriptorResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_GetCodecDescriptorResponse)(nil)
 
-type fastReflection_GetConfigurationDescriptorResponse GetConfigurationDescriptorResponse
+type fastReflection_GetCodecDescriptorResponse GetCodecDescriptorResponse
 
-func (x *GetConfigurationDescriptorResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetConfigurationDescriptorResponse)(x)
+func (x *GetCodecDescriptorResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetCodecDescriptorResponse)(x)
 }
 
-func (x *GetConfigurationDescriptorResponse) slowProtoReflect() protoreflect.Message {
+func (x *GetCodecDescriptorResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8665,43 +8931,1625 @@ func (x *GetConfigurationDescriptorResponse) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetConfigurationDescriptorResponse_messageType fastReflection_GetConfigurationDescriptorResponse_messageType
-var _ protoreflect.MessageType = fastReflection_GetConfigurationDescriptorResponse_messageType{}
+var _fastReflection_GetCodecDescriptorResponse_messageType fastReflection_GetCodecDescriptorResponse_messageType
+var _ protoreflect.MessageType = fastReflection_GetCodecDescriptorResponse_messageType{}
 
-type fastReflection_GetConfigurationDescriptorResponse_messageType struct{}
+type fastReflection_GetCodecDescriptorResponse_messageType struct{}
 
-func (x fastReflection_GetConfigurationDescriptorResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetConfigurationDescriptorResponse)(nil)
-}
-func (x fastReflection_GetConfigurationDescriptorResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetConfigurationDescriptorResponse)
+func (x fastReflection_GetCodecDescriptorResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetCodecDescriptorResponse)(nil)
 }
-func (x fastReflection_GetConfigurationDescriptorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetConfigurationDescriptorResponse
+func (x fastReflection_GetCodecDescriptorResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetCodecDescriptorResponse)
+}
+func (x fastReflection_GetCodecDescriptorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetCodecDescriptorResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GetCodecDescriptorResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetCodecDescriptorResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GetCodecDescriptorResponse) Type() protoreflect.MessageType {
+	return _fastReflection_GetCodecDescriptorResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GetCodecDescriptorResponse) New() protoreflect.Message {
+	return new(fastReflection_GetCodecDescriptorResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GetCodecDescriptorResponse) Interface() protoreflect.ProtoMessage {
+	return (*GetCodecDescriptorResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GetCodecDescriptorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Codec != nil {
+		value := protoreflect.ValueOfMessage(x.Codec.ProtoReflect())
+		if !f(fd_GetCodecDescriptorResponse_codec, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GetCodecDescriptorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse.codec":
+		return x.Codec != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetCodecDescriptorResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse.codec":
+		x.Codec = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GetCodecDescriptorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse.codec":
+		value := x.Codec
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetCodecDescriptorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse.codec":
+		x.Codec = value.Message().Interface().(*CodecDescriptor)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetCodecDescriptorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse.codec":
+		if x.Codec == nil {
+			x.Codec = new(CodecDescriptor)
+		}
+		return protoreflect.ValueOfMessage(x.Codec.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GetCodecDescriptorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse.codec":
+		m := new(CodecDescriptor)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GetCodecDescriptorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GetCodecDescriptorResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetCodecDescriptorResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GetCodecDescriptorResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GetCodecDescriptorResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GetCodecDescriptorResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Codec != nil {
+			l = options.Size(x.Codec)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GetCodecDescriptorResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Codec != nil {
+			encoded, err := options.Marshal(x.Codec)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GetCodecDescriptorResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetCodecDescriptorResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetCodecDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Codec == nil {
+					x.Codec = &CodecDescriptor{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Codec); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GetConfigurationDescriptorRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
+	md_GetConfigurationDescriptorRequest = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetConfigurationDescriptorRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_GetConfigurationDescriptorRequest)(nil)
+
+type fastReflection_GetConfigurationDescriptorRequest GetConfigurationDescriptorRequest
+
+func (x *GetConfigurationDescriptorRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetConfigurationDescriptorRequest)(x)
+}
+
+func (x *GetConfigurationDescriptorRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GetConfigurationDescriptorRequest_messageType fastReflection_GetConfigurationDescriptorRequest_messageType
+var _ protoreflect.MessageType = fastReflection_GetConfigurationDescriptorRequest_messageType{}
+
+type fastReflection_GetConfigurationDescriptorRequest_messageType struct{}
+
+func (x fastReflection_GetConfigurationDescriptorRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetConfigurationDescriptorRequest)(nil)
+}
+func (x fastReflection_GetConfigurationDescriptorRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetConfigurationDescriptorRequest)
+}
+func (x fastReflection_GetConfigurationDescriptorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetConfigurationDescriptorRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GetConfigurationDescriptorRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetConfigurationDescriptorRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GetConfigurationDescriptorRequest) Type() protoreflect.MessageType {
+	return _fastReflection_GetConfigurationDescriptorRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GetConfigurationDescriptorRequest) New() protoreflect.Message {
+	return new(fastReflection_GetConfigurationDescriptorRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GetConfigurationDescriptorRequest) Interface() protoreflect.ProtoMessage {
+	return (*GetConfigurationDescriptorRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GetConfigurationDescriptorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GetConfigurationDescriptorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetConfigurationDescriptorRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GetConfigurationDescriptorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetConfigurationDescriptorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetConfigurationDescriptorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GetConfigurationDescriptorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GetConfigurationDescriptorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GetConfigurationDescriptorRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetConfigurationDescriptorRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GetConfigurationDescriptorRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GetConfigurationDescriptorRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GetConfigurationDescriptorRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GetConfigurationDescriptorRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GetConfigurationDescriptorRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetConfigurationDescriptorRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetConfigurationDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GetConfigurationDescriptorResponse        protoreflect.MessageDescriptor
+	fd_GetConfigurationDescriptorResponse_config protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
+	md_GetConfigurationDescriptorResponse = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetConfigurationDescriptorResponse")
+	fd_GetConfigurationDescriptorResponse_config = md_GetConfigurationDescriptorResponse.Fields().ByName("config")
+}
+
+var _ protoreflect.Message = (*fastReflection_GetConfigurationDescriptorResponse)(nil)
+
+type fastReflection_GetConfigurationDescriptorResponse GetConfigurationDescriptorResponse
+
+func (x *GetConfigurationDescriptorResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetConfigurationDescriptorResponse)(x)
+}
+
+func (x *GetConfigurationDescriptorResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GetConfigurationDescriptorResponse_messageType fastReflection_GetConfigurationDescriptorResponse_messageType
+var _ protoreflect.MessageType = fastReflection_GetConfigurationDescriptorResponse_messageType{}
+
+type fastReflection_GetConfigurationDescriptorResponse_messageType struct{}
+
+func (x fastReflection_GetConfigurationDescriptorResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetConfigurationDescriptorResponse)(nil)
+}
+func (x fastReflection_GetConfigurationDescriptorResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetConfigurationDescriptorResponse)
+}
+func (x fastReflection_GetConfigurationDescriptorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetConfigurationDescriptorResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GetConfigurationDescriptorResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetConfigurationDescriptorResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GetConfigurationDescriptorResponse) Type() protoreflect.MessageType {
+	return _fastReflection_GetConfigurationDescriptorResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GetConfigurationDescriptorResponse) New() protoreflect.Message {
+	return new(fastReflection_GetConfigurationDescriptorResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GetConfigurationDescriptorResponse) Interface() protoreflect.ProtoMessage {
+	return (*GetConfigurationDescriptorResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GetConfigurationDescriptorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Config != nil {
+		value := protoreflect.ValueOfMessage(x.Config.ProtoReflect())
+		if !f(fd_GetConfigurationDescriptorResponse_config, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GetConfigurationDescriptorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse.config":
+		return x.Config != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetConfigurationDescriptorResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse.config":
+		x.Config = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GetConfigurationDescriptorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse.config":
+		value := x.Config
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetConfigurationDescriptorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse.config":
+		x.Config = value.Message().Interface().(*ConfigurationDescriptor)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetConfigurationDescriptorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse.config":
+		if x.Config == nil {
+			x.Config = new(ConfigurationDescriptor)
+		}
+		return protoreflect.ValueOfMessage(x.Config.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GetConfigurationDescriptorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse.config":
+		m := new(ConfigurationDescriptor)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GetConfigurationDescriptorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GetConfigurationDescriptorResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetConfigurationDescriptorResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GetConfigurationDescriptorResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GetConfigurationDescriptorResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GetConfigurationDescriptorResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Config != nil {
+			l = options.Size(x.Config)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GetConfigurationDescriptorResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Config != nil {
+			encoded, err := options.Marshal(x.Config)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GetConfigurationDescriptorResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetConfigurationDescriptorResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetConfigurationDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Config == nil {
+					x.Config = &ConfigurationDescriptor{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Config); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GetQueryServicesDescriptorRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
+	md_GetQueryServicesDescriptorRequest = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetQueryServicesDescriptorRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_GetQueryServicesDescriptorRequest)(nil)
+
+type fastReflection_GetQueryServicesDescriptorRequest GetQueryServicesDescriptorRequest
+
+func (x *GetQueryServicesDescriptorRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetQueryServicesDescriptorRequest)(x)
+}
+
+func (x *GetQueryServicesDescriptorRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GetQueryServicesDescriptorRequest_messageType fastReflection_GetQueryServicesDescriptorRequest_messageType
+var _ protoreflect.MessageType = fastReflection_GetQueryServicesDescriptorRequest_messageType{}
+
+type fastReflection_GetQueryServicesDescriptorRequest_messageType struct{}
+
+func (x fastReflection_GetQueryServicesDescriptorRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetQueryServicesDescriptorRequest)(nil)
+}
+func (x fastReflection_GetQueryServicesDescriptorRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetQueryServicesDescriptorRequest)
+}
+func (x fastReflection_GetQueryServicesDescriptorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetQueryServicesDescriptorRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetQueryServicesDescriptorRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) Type() protoreflect.MessageType {
+	return _fastReflection_GetQueryServicesDescriptorRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) New() protoreflect.Message {
+	return new(fastReflection_GetQueryServicesDescriptorRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) Interface() protoreflect.ProtoMessage {
+	return (*GetQueryServicesDescriptorRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GetQueryServicesDescriptorRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GetQueryServicesDescriptorRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GetQueryServicesDescriptorRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GetQueryServicesDescriptorRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetQueryServicesDescriptorRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetQueryServicesDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GetQueryServicesDescriptorResponse         protoreflect.MessageDescriptor
+	fd_GetQueryServicesDescriptorResponse_queries protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
+	md_GetQueryServicesDescriptorResponse = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetQueryServicesDescriptorResponse")
+	fd_GetQueryServicesDescriptorResponse_queries = md_GetQueryServicesDescriptorResponse.Fields().ByName("queries")
+}
+
+var _ protoreflect.Message = (*fastReflection_GetQueryServicesDescriptorResponse)(nil)
+
+type fastReflection_GetQueryServicesDescriptorResponse GetQueryServicesDescriptorResponse
+
+func (x *GetQueryServicesDescriptorResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetQueryServicesDescriptorResponse)(x)
+}
+
+func (x *GetQueryServicesDescriptorResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GetQueryServicesDescriptorResponse_messageType fastReflection_GetQueryServicesDescriptorResponse_messageType
+var _ protoreflect.MessageType = fastReflection_GetQueryServicesDescriptorResponse_messageType{}
+
+type fastReflection_GetQueryServicesDescriptorResponse_messageType struct{}
+
+func (x fastReflection_GetQueryServicesDescriptorResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetQueryServicesDescriptorResponse)(nil)
+}
+func (x fastReflection_GetQueryServicesDescriptorResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetQueryServicesDescriptorResponse)
+}
+func (x fastReflection_GetQueryServicesDescriptorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetQueryServicesDescriptorResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetConfigurationDescriptorResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetConfigurationDescriptorResponse
+func (x *fastReflection_GetQueryServicesDescriptorResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetQueryServicesDescriptorResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetConfigurationDescriptorResponse) Type() protoreflect.MessageType {
-	return _fastReflection_GetConfigurationDescriptorResponse_messageType
+func (x *fastReflection_GetQueryServicesDescriptorResponse) Type() protoreflect.MessageType {
+	return _fastReflection_GetQueryServicesDescriptorResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetConfigurationDescriptorResponse) New() protoreflect.Message {
-	return new(fastReflection_GetConfigurationDescriptorResponse)
+func (x *fastReflection_GetQueryServicesDescriptorResponse) New() protoreflect.Message {
+	return new(fastReflection_GetQueryServicesDescriptorResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetConfigurationDescriptorResponse) Interface() protoreflect.ProtoMessage {
-	return (*GetConfigurationDescriptorResponse)(x)
+func (x *fastReflection_GetQueryServicesDescriptorResponse) Interface() protoreflect.ProtoMessage {
+	return (*GetQueryServicesDescriptorResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8709,10 +10557,10 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetConfigurationDescriptorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Config != nil {
-		value := protoreflect.ValueOfMessage(x.Config.ProtoReflect())
-		if !f(fd_GetConfigurationDescriptorResponse_config, value) {
+func (x *fastReflection_GetQueryServicesDescriptorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Queries != nil {
+		value := protoreflect.ValueOfMessage(x.Queries.ProtoReflect())
+		if !f(fd_GetQueryServicesDescriptorResponse_queries, value) {
 			return
 		}
 	}
@@ -8729,15 +10577,15 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetConfigurationDescriptorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GetQueryServicesDescriptorResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse.config":
-		return x.Config != nil
+	case "cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse.queries":
+		return x.Queries != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8747,15 +10595,15 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetConfigurationDescriptorResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GetQueryServicesDescriptorResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse.config":
-		x.Config = nil
+	case "cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse.queries":
+		x.Queries = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8765,16 +10613,16 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetConfigurationDescriptorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetQueryServicesDescriptorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse.config":
-		value := x.Config
+	case "cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse.queries":
+		value := x.Queries
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8788,15 +10636,15 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetConfigurationDescriptorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GetQueryServicesDescriptorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse.config":
-		x.Config = value.Message().Interface().(*ConfigurationDescriptor)
+	case "cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse.queries":
+		x.Queries = value.Message().Interface().(*QueryServicesDescriptor)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8810,44 +10658,44 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetConfigurationDescriptorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetQueryServicesDescriptorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse.config":
-		if x.Config == nil {
-			x.Config = new(ConfigurationDescriptor)
+	case "cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse.queries":
+		if x.Queries == nil {
+			x.Queries = new(QueryServicesDescriptor)
 		}
-		return protoreflect.ValueOfMessage(x.Config.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Queries.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetConfigurationDescriptorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetQueryServicesDescriptorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse.config":
-		m := new(ConfigurationDescriptor)
+	case "cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse.queries":
+		m := new(QueryServicesDescriptor)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetConfigurationDescriptorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GetQueryServicesDescriptorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8855,7 +10703,7 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetConfigurationDescriptorResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GetQueryServicesDescriptorResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8866,7 +10714,7 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetConfigurationDescriptorResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GetQueryServicesDescriptorResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8878,7 +10726,7 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetConfigurationDescriptorResponse) IsValid() bool {
+func (x *fastReflection_GetQueryServicesDescriptorResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8888,9 +10736,9 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetConfigurationDescriptorResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GetQueryServicesDescriptorResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetConfigurationDescriptorResponse)
+		x := input.Message.Interface().(*GetQueryServicesDescriptorResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8902,8 +10750,8 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		if x.Config != nil {
-			l = options.Size(x.Config)
+		if x.Queries != nil {
+			l = options.Size(x.Queries)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8916,7 +10764,7 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetConfigurationDescriptorResponse)
+		x := input.Message.Interface().(*GetQueryServicesDescriptorResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8935,8 +10783,8 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Config != nil {
-			encoded, err := options.Marshal(x.Config)
+		if x.Queries != nil {
+			encoded, err := options.Marshal(x.Queries)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8960,7 +10808,7 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetConfigurationDescriptorResponse)
+		x := input.Message.Interface().(*GetQueryServicesDescriptorResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8992,15 +10840,15 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetConfigurationDescriptorResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetQueryServicesDescriptorResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetConfigurationDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetQueryServicesDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9027,10 +10875,10 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) ProtoMethods() *prot
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Config == nil {
-					x.Config = &ConfigurationDescriptor{}
+				if x.Queries == nil {
+					x.Queries = &QueryServicesDescriptor{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Config); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Queries); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -9070,24 +10918,24 @@ func (x *fastReflection_GetConfigurationDescriptorResponse) ProtoMethods() *prot
 }
 
 var (
-	md_GetQueryServicesDescriptorRequest protoreflect.MessageDescriptor
+	md_GetTxDescriptorRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
-	md_GetQueryServicesDescriptorRequest = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetQueryServicesDescriptorRequest")
+	md_GetTxDescriptorRequest = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetTxDescriptorRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetQueryServicesDescriptorRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_GetTxDescriptorRequest)(nil)
 
-type fastReflection_GetQueryServicesDescriptorRequest GetQueryServicesDescriptorRequest
+type fastReflection_GetTxDescriptorRequest GetTxDescriptorRequest
 
-func (x *GetQueryServicesDescriptorRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetQueryServicesDescriptorRequest)(x)
+func (x *GetTxDescriptorRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetTxDescriptorRequest)(x)
 }
 
-func (x *GetQueryServicesDescriptorRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[19]
+func (x *GetTxDescriptorRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9098,43 +10946,43 @@ func (x *GetQueryServicesDescriptorRequest) slowProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetQueryServicesDescriptorRequest_messageType fastReflection_GetQueryServicesDescriptorRequest_messageType
-var _ protoreflect.MessageType = fastReflection_GetQueryServicesDescriptorRequest_messageType{}
+var _fastReflection_GetTxDescriptorRequest_messageType fastReflection_GetTxDescriptorRequest_messageType
+var _ protoreflect.MessageType = fastReflection_GetTxDescriptorRequest_messageType{}
 
-type fastReflection_GetQueryServicesDescriptorRequest_messageType struct{}
+type fastReflection_GetTxDescriptorRequest_messageType struct{}
 
-func (x fastReflection_GetQueryServicesDescriptorRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetQueryServicesDescriptorRequest)(nil)
+func (x fastReflection_GetTxDescriptorRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetTxDescriptorRequest)(nil)
 }
-func (x fastReflection_GetQueryServicesDescriptorRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetQueryServicesDescriptorRequest)
+func (x fastReflection_GetTxDescriptorRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetTxDescriptorRequest)
 }
-func (x fastReflection_GetQueryServicesDescriptorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetQueryServicesDescriptorRequest
+func (x fastReflection_GetTxDescriptorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetTxDescriptorRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetQueryServicesDescriptorRequest
+func (x *fastReflection_GetTxDescriptorRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetTxDescriptorRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) Type() protoreflect.MessageType {
-	return _fastReflection_GetQueryServicesDescriptorRequest_messageType
+func (x *fastReflection_GetTxDescriptorRequest) Type() protoreflect.MessageType {
+	return _fastReflection_GetTxDescriptorRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) New() protoreflect.Message {
-	return new(fastReflection_GetQueryServicesDescriptorRequest)
+func (x *fastReflection_GetTxDescriptorRequest) New() protoreflect.Message {
+	return new(fastReflection_GetTxDescriptorRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) Interface() protoreflect.ProtoMessage {
-	return (*GetQueryServicesDescriptorRequest)(x)
+func (x *fastReflection_GetTxDescriptorRequest) Interface() protoreflect.ProtoMessage {
+	return (*GetTxDescriptorRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9142,7 +10990,7 @@ func (x *fastReflection_GetQueryServicesDescriptorRequest) Interface() protorefl
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_GetTxDescriptorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -9156,13 +11004,13 @@ func (x *fastReflection_GetQueryServicesDescriptorRequest) Range(f func(protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GetTxDescriptorRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9172,13 +11020,13 @@ func (x *fastReflection_GetQueryServicesDescriptorRequest) Has(fd protoreflect.F
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GetTxDescriptorRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9188,13 +11036,13 @@ func (x *fastReflection_GetQueryServicesDescriptorRequest) Clear(fd protoreflect
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetTxDescriptorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9208,13 +11056,13 @@ func (x *fastReflection_GetQueryServicesDescriptorRequest) Get(descriptor protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GetTxDescriptorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9228,36 +11076,36 @@ func (x *fastReflection_GetQueryServicesDescriptorRequest) Set(fd protoreflect.F
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetTxDescriptorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetTxDescriptorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GetTxDescriptorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9265,7 +11113,7 @@ func (x *fastReflection_GetQueryServicesDescriptorRequest) WhichOneof(d protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GetTxDescriptorRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9276,7 +11124,7 @@ func (x *fastReflection_GetQueryServicesDescriptorRequest) GetUnknown() protoref
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GetTxDescriptorRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9288,7 +11136,7 @@ func (x *fastReflection_GetQueryServicesDescriptorRequest) SetUnknown(fields pro
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) IsValid() bool {
+func (x *fastReflection_GetTxDescriptorRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -9298,9 +11146,9 @@ func (x *fastReflection_GetQueryServicesDescriptorRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetQueryServicesDescriptorRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GetTxDescriptorRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetQueryServicesDescriptorRequest)
+		x := input.Message.Interface().(*GetTxDescriptorRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9322,7 +11170,7 @@ func (x *fastReflection_GetQueryServicesDescriptorRequest) ProtoMethods() *proto
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetQueryServicesDescriptorRequest)
+		x := input.Message.Interface().(*GetTxDescriptorRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9352,7 +11200,7 @@ func (x *fastReflection_GetQueryServicesDescriptorRequest) ProtoMethods() *proto
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetQueryServicesDescriptorRequest)
+		x := input.Message.Interface().(*GetTxDescriptorRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9384,10 +11232,10 @@ func (x *fastReflection_GetQueryServicesDescriptorRequest) ProtoMethods() *proto
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetQueryServicesDescriptorRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxDescriptorRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetQueryServicesDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -9426,26 +11274,26 @@ func (x *fastReflection_GetQueryServicesDescriptorRequest) ProtoMethods() *proto
 }
 
 var (
-	md_GetQueryServicesDescriptorResponse         protoreflect.MessageDescriptor
-	fd_GetQueryServicesDescriptorResponse_queries protoreflect.FieldDescriptor
+	md_GetTxDescriptorResponse    protoreflect.MessageDescriptor
+	fd_GetTxDescriptorResponse_tx protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
-	md_GetQueryServicesDescriptorResponse = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetQueryServicesDescriptorResponse")
-	fd_GetQueryServicesDescriptorResponse_queries = md_GetQueryServicesDescriptorResponse.Fields().ByName("queries")
+	md_GetTxDescriptorResponse = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetTxDescriptorResponse")
+	fd_GetTxDescriptorResponse_tx = md_GetTxDescriptorResponse.Fields().ByName("tx")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetQueryServicesDescriptorResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_GetTxDescriptorResponse)(nil)
 
-type fastReflection_GetQueryServicesDescriptorResponse GetQueryServicesDescriptorResponse
+type fastReflection_GetTxDescriptorResponse GetTxDescriptorResponse
 
-func (x *GetQueryServicesDescriptorResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetQueryServicesDescriptorResponse)(x)
+func (x *GetTxDescriptorResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetTxDescriptorResponse)(x)
 }
 
-func (x *GetQueryServicesDescriptorResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[20]
+func (x *GetTxDescriptorResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9456,43 +11304,43 @@ func (x *GetQueryServicesDescriptorResponse) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetQueryServicesDescriptorResponse_messageType fastReflection_GetQueryServicesDescriptorResponse_messageType
-var _ protoreflect.MessageType = fastReflection_GetQueryServicesDescriptorResponse_messageType{}
+var _fastReflection_GetTxDescriptorResponse_messageType fastReflection_GetTxDescriptorResponse_messageType
+var _ protoreflect.MessageType = fastReflection_GetTxDescriptorResponse_messageType{}
 
-type fastReflection_GetQueryServicesDescriptorResponse_messageType struct{}
+type fastReflection_GetTxDescriptorResponse_messageType struct{}
 
-func (x fastReflection_GetQueryServicesDescriptorResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetQueryServicesDescriptorResponse)(nil)
+func (x fastReflection_GetTxDescriptorResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetTxDescriptorResponse)(nil)
 }
-func (x fastReflection_GetQueryServicesDescriptorResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetQueryServicesDescriptorResponse)
+func (x fastReflection_GetTxDescriptorResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetTxDescriptorResponse)
 }
-func (x fastReflection_GetQueryServicesDescriptorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetQueryServicesDescriptorResponse
+func (x fastReflection_GetTxDescriptorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetTxDescriptorResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetQueryServicesDescriptorResponse
+func (x *fastReflection_GetTxDescriptorResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetTxDescriptorResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) Type() protoreflect.MessageType {
-	return _fastReflection_GetQueryServicesDescriptorResponse_messageType
+func (x *fastReflection_GetTxDescriptorResponse) Type() protoreflect.MessageType {
+	return _fastReflection_GetTxDescriptorResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) New() protoreflect.Message {
-	return new(fastReflection_GetQueryServicesDescriptorResponse)
+func (x *fastReflection_GetTxDescriptorResponse) New() protoreflect.Message {
+	return new(fastReflection_GetTxDescriptorResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) Interface() protoreflect.ProtoMessage {
-	return (*GetQueryServicesDescriptorResponse)(x)
+func (x *fastReflection_GetTxDescriptorResponse) Interface() protoreflect.ProtoMessage {
+	return (*GetTxDescriptorResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9500,10 +11348,10 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Queries != nil {
-		value := protoreflect.ValueOfMessage(x.Queries.ProtoReflect())
-		if !f(fd_GetQueryServicesDescriptorResponse_queries, value) {
+func (x *fastReflection_GetTxDescriptorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tx != nil {
+		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+		if !f(fd_GetTxDescriptorResponse_tx, value) {
 			return
 		}
 	}
@@ -9520,15 +11368,15 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GetTxDescriptorResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse.queries":
-		return x.Queries != nil
+	case "cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse.tx":
+		return x.Tx != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9538,15 +11386,15 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GetTxDescriptorResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse.queries":
-		x.Queries = nil
+	case "cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse.tx":
+		x.Tx = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9556,16 +11404,16 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetTxDescriptorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse.queries":
-		value := x.Queries
+	case "cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse.tx":
+		value := x.Tx
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9579,15 +11427,15 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GetTxDescriptorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse.queries":
-		x.Queries = value.Message().Interface().(*QueryServicesDescriptor)
+	case "cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse.tx":
+		x.Tx = value.Message().Interface().(*TxDescriptor)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9601,44 +11449,44 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetTxDescriptorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse.queries":
-		if x.Queries == nil {
-			x.Queries = new(QueryServicesDescriptor)
+	case "cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse.tx":
+		if x.Tx == nil {
+			x.Tx = new(TxDescriptor)
 		}
-		return protoreflect.ValueOfMessage(x.Queries.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetTxDescriptorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse.queries":
-		m := new(QueryServicesDescriptor)
+	case "cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse.tx":
+		m := new(TxDescriptor)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GetTxDescriptorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9646,7 +11494,7 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GetTxDescriptorResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9657,7 +11505,7 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GetTxDescriptorResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9669,7 +11517,7 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) IsValid() bool {
+func (x *fastReflection_GetTxDescriptorResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9679,9 +11527,9 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetQueryServicesDescriptorResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GetTxDescriptorResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetQueryServicesDescriptorResponse)
+		x := input.Message.Interface().(*GetTxDescriptorResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9693,8 +11541,8 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		if x.Queries != nil {
-			l = options.Size(x.Queries)
+		if x.Tx != nil {
+			l = options.Size(x.Tx)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -9707,7 +11555,7 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetQueryServicesDescriptorResponse)
+		x := input.Message.Interface().(*GetTxDescriptorResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9726,8 +11574,8 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Queries != nil {
-			encoded, err := options.Marshal(x.Queries)
+		if x.Tx != nil {
+			encoded, err := options.Marshal(x.Tx)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9751,7 +11599,7 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetQueryServicesDescriptorResponse)
+		x := input.Message.Interface().(*GetTxDescriptorResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9783,15 +11631,15 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetQueryServicesDescriptorResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxDescriptorResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetQueryServicesDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9818,10 +11666,10 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) ProtoMethods() *prot
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Queries == nil {
-					x.Queries = &QueryServicesDescriptor{}
+				if x.Tx == nil {
+					x.Tx = &TxDescriptor{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Queries); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -9861,24 +11709,24 @@ func (x *fastReflection_GetQueryServicesDescriptorResponse) ProtoMethods() *prot
 }
 
 var (
-	md_GetTxDescriptorRequest protoreflect.MessageDescriptor
+	md_GetGenesisSchemasDescriptorRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
-	md_GetTxDescriptorRequest = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetTxDescriptorRequest")
+	md_GetGenesisSchemasDescriptorRequest = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetGenesisSchemasDescriptorRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetTxDescriptorRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_GetGenesisSchemasDescriptorRequest)(nil)
 
-type fastReflection_GetTxDescriptorRequest GetTxDescriptorRequest
+type fastReflection_GetGenesisSchemasDescriptorRequest GetGenesisSchemasDescriptorRequest
 
-func (x *GetTxDescriptorRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetTxDescriptorRequest)(x)
+func (x *GetGenesisSchemasDescriptorRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetGenesisSchemasDescriptorRequest)(x)
 }
 
-func (x *GetTxDescriptorRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[21]
+func (x *GetGenesisSchemasDescriptorRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9889,43 +11737,43 @@ func (x *GetTxDescriptorRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetTxDescriptorRequest_messageType fastReflection_GetTxDescriptorRequest_messageType
-var _ protoreflect.MessageType = fastReflection_GetTxDescriptorRequest_messageType{}
+var _fastReflection_GetGenesisSchemasDescriptorRequest_messageType fastReflection_GetGenesisSchemasDescriptorRequest_messageType
+var _ protoreflect.MessageType = fastReflection_GetGenesisSchemasDescriptorRequest_messageType{}
 
-type fastReflection_GetTxDescriptorRequest_messageType struct{}
+type fastReflection_GetGenesisSchemasDescriptorRequest_messageType struct{}
 
-func (x fastReflection_GetTxDescriptorRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetTxDescriptorRequest)(nil)
+func (x fastReflection_GetGenesisSchemasDescriptorRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetGenesisSchemasDescriptorRequest)(nil)
 }
-func (x fastReflection_GetTxDescriptorRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetTxDescriptorRequest)
+func (x fastReflection_GetGenesisSchemasDescriptorRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetGenesisSchemasDescriptorRequest)
 }
-func (x fastReflection_GetTxDescriptorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetTxDescriptorRequest
+func (x fastReflection_GetGenesisSchemasDescriptorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetGenesisSchemasDescriptorRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetTxDescriptorRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetTxDescriptorRequest
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetGenesisSchemasDescriptorRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetTxDescriptorRequest) Type() protoreflect.MessageType {
-	return _fastReflection_GetTxDescriptorRequest_messageType
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) Type() protoreflect.MessageType {
+	return _fastReflection_GetGenesisSchemasDescriptorRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetTxDescriptorRequest) New() protoreflect.Message {
-	return new(fastReflection_GetTxDescriptorRequest)
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) New() protoreflect.Message {
+	return new(fastReflection_GetGenesisSchemasDescriptorRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetTxDescriptorRequest) Interface() protoreflect.ProtoMessage {
-	return (*GetTxDescriptorRequest)(x)
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) Interface() protoreflect.ProtoMessage {
+	return (*GetGenesisSchemasDescriptorRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9933,7 +11781,7 @@ func (x *fastReflection_GetTxDescriptorRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetTxDescriptorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -9947,13 +11795,13 @@ func (x *fastReflection_GetTxDescriptorRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetTxDescriptorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9963,13 +11811,13 @@ func (x *fastReflection_GetTxDescriptorRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxDescriptorRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9979,13 +11827,13 @@ func (x *fastReflection_GetTxDescriptorRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetTxDescriptorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9999,13 +11847,13 @@ func (x *fastReflection_GetTxDescriptorRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxDescriptorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10019,36 +11867,36 @@ func (x *fastReflection_GetTxDescriptorRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxDescriptorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetTxDescriptorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetTxDescriptorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10056,7 +11904,7 @@ func (x *fastReflection_GetTxDescriptorRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetTxDescriptorRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10067,7 +11915,7 @@ func (x *fastReflection_GetTxDescriptorRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxDescriptorRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10079,7 +11927,7 @@ func (x *fastReflection_GetTxDescriptorRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetTxDescriptorRequest) IsValid() bool {
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -10089,9 +11937,9 @@ func (x *fastReflection_GetTxDescriptorRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetTxDescriptorRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GetGenesisSchemasDescriptorRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetTxDescriptorRequest)
+		x := input.Message.Interface().(*GetGenesisSchemasDescriptorRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10113,7 +11961,7 @@ func (x *fastReflection_GetTxDescriptorRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetTxDescriptorRequest)
+		x := input.Message.Interface().(*GetGenesisSchemasDescriptorRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10143,7 +11991,7 @@ func (x *fastReflection_GetTxDescriptorRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetTxDescriptorRequest)
+		x := input.Message.Interface().(*GetGenesisSchemasDescriptorRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10175,10 +12023,10 @@ func (x *fastReflection_GetTxDescriptorRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxDescriptorRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetGenesisSchemasDescriptorRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetGenesisSchemasDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -10217,26 +12065,26 @@ func (x *fastReflection_GetTxDescriptorRequest) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_GetTxDescriptorResponse    protoreflect.MessageDescriptor
-	fd_GetTxDescriptorResponse_tx protoreflect.FieldDescriptor
+	md_GetGenesisSchemasDescriptorResponse                 protoreflect.MessageDescriptor
+	fd_GetGenesisSchemasDescriptorResponse_genesis_schemas protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_reflection_v2alpha1_reflection_proto_init()
-	md_GetTxDescriptorResponse = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetTxDescriptorResponse")
-	fd_GetTxDescriptorResponse_tx = md_GetTxDescriptorResponse.Fields().ByName("tx")
+	md_GetGenesisSchemasDescriptorResponse = File_cosmos_base_reflection_v2alpha1_reflection_proto.Messages().ByName("GetGenesisSchemasDescriptorResponse")
+	fd_GetGenesisSchemasDescriptorResponse_genesis_schemas = md_GetGenesisSchemasDescriptorResponse.Fields().ByName("genesis_schemas")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetTxDescriptorResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_GetGenesisSchemasDescriptorResponse)(nil)
 
-type fastReflection_GetTxDescriptorResponse GetTxDescriptorResponse
+type fastReflection_GetGenesisSchemasDescriptorResponse GetGenesisSchemasDescriptorResponse
 
-func (x *GetTxDescriptorResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetTxDescriptorResponse)(x)
+func (x *GetGenesisSchemasDescriptorResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetGenesisSchemasDescriptorResponse)(x)
 }
 
-func (x *GetTxDescriptorResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[22]
+func (x *GetGenesisSchemasDescriptorResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10247,43 +12095,43 @@ func (x *GetTxDescriptorResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetTxDescriptorResponse_messageType fastReflection_GetTxDescriptorResponse_messageType
-var _ protoreflect.MessageType = fastReflection_GetTxDescriptorResponse_messageType{}
+var _fastReflection_GetGenesisSchemasDescriptorResponse_messageType fastReflection_GetGenesisSchemasDescriptorResponse_messageType
+var _ protoreflect.MessageType = fastReflection_GetGenesisSchemasDescriptorResponse_messageType{}
 
-type fastReflection_GetTxDescriptorResponse_messageType struct{}
+type fastReflection_GetGenesisSchemasDescriptorResponse_messageType struct{}
 
-func (x fastReflection_GetTxDescriptorResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetTxDescriptorResponse)(nil)
+func (x fastReflection_GetGenesisSchemasDescriptorResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetGenesisSchemasDescriptorResponse)(nil)
 }
-func (x fastReflection_GetTxDescriptorResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetTxDescriptorResponse)
+func (x fastReflection_GetGenesisSchemasDescriptorResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetGenesisSchemasDescriptorResponse)
 }
-func (x fastReflection_GetTxDescriptorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetTxDescriptorResponse
+func (x fastReflection_GetGenesisSchemasDescriptorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetGenesisSchemasDescriptorResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetTxDescriptorResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetTxDescriptorResponse
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetGenesisSchemasDescriptorResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetTxDescriptorResponse) Type() protoreflect.MessageType {
-	return _fastReflection_GetTxDescriptorResponse_messageType
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) Type() protoreflect.MessageType {
+	return _fastReflection_GetGenesisSchemasDescriptorResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetTxDescriptorResponse) New() protoreflect.Message {
-	return new(fastReflection_GetTxDescriptorResponse)
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) New() protoreflect.Message {
+	return new(fastReflection_GetGenesisSchemasDescriptorResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetTxDescriptorResponse) Interface() protoreflect.ProtoMessage {
-	return (*GetTxDescriptorResponse)(x)
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) Interface() protoreflect.ProtoMessage {
+	return (*GetGenesisSchemasDescriptorResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10291,10 +12139,10 @@ func (x *fastReflection_GetTxDescriptorResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetTxDescriptorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Tx != nil {
-		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
-		if !f(fd_GetTxDescriptorResponse_tx, value) {
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GenesisSchemas != nil {
+		value := protoreflect.ValueOfMessage(x.GenesisSchemas.ProtoReflect())
+		if !f(fd_GetGenesisSchemasDescriptorResponse_genesis_schemas, value) {
 			return
 		}
 	}
@@ -10311,15 +12159,15 @@ func (x *fastReflection_GetTxDescriptorResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetTxDescriptorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse.tx":
-		return x.Tx != nil
+	case "cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse.genesis_schemas":
+		return x.GenesisSchemas != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10329,15 +12177,15 @@ func (x *fastReflection_GetTxDescriptorResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxDescriptorResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse.tx":
-		x.Tx = nil
+	case "cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse.genesis_schemas":
+		x.GenesisSchemas = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10347,16 +12195,16 @@ func (x *fastReflection_GetTxDescriptorResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetTxDescriptorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse.tx":
-		value := x.Tx
+	case "cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse.genesis_schemas":
+		value := x.GenesisSchemas
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10370,15 +12218,15 @@ func (x *fastReflection_GetTxDescriptorResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxDescriptorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse.tx":
-		x.Tx = value.Message().Interface().(*TxDescriptor)
+	case "cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse.genesis_schemas":
+		x.GenesisSchemas = value.Message().Interface().(*GenesisSchemasDescriptor)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10392,44 +12240,44 @@ func (x *fastReflection_GetTxDescriptorResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxDescriptorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse.tx":
-		if x.Tx == nil {
-			x.Tx = new(TxDescriptor)
+	case "cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse.genesis_schemas":
+		if x.GenesisSchemas == nil {
+			x.GenesisSchemas = new(GenesisSchemasDescriptor)
 		}
-		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.GenesisSchemas.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetTxDescriptorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse.tx":
-		m := new(TxDescriptor)
+	case "cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse.genesis_schemas":
+		m := new(GenesisSchemasDescriptor)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetTxDescriptorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10437,7 +12285,7 @@ func (x *fastReflection_GetTxDescriptorResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetTxDescriptorResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10448,7 +12296,7 @@ func (x *fastReflection_GetTxDescriptorResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxDescriptorResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10460,7 +12308,7 @@ func (x *fastReflection_GetTxDescriptorResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetTxDescriptorResponse) IsValid() bool {
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10470,9 +12318,9 @@ func (x *fastReflection_GetTxDescriptorResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetTxDescriptorResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GetGenesisSchemasDescriptorResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetTxDescriptorResponse)
+		x := input.Message.Interface().(*GetGenesisSchemasDescriptorResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10484,8 +12332,8 @@ func (x *fastReflection_GetTxDescriptorResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.Tx != nil {
-			l = options.Size(x.Tx)
+		if x.GenesisSchemas != nil {
+			l = options.Size(x.GenesisSchemas)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -10498,7 +12346,7 @@ func (x *fastReflection_GetTxDescriptorResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetTxDescriptorResponse)
+		x := input.Message.Interface().(*GetGenesisSchemasDescriptorResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10517,8 +12365,8 @@ func (x *fastReflection_GetTxDescriptorResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Tx != nil {
-			encoded, err := options.Marshal(x.Tx)
+		if x.GenesisSchemas != nil {
+			encoded, err := options.Marshal(x.GenesisSchemas)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10542,7 +12390,7 @@ func (x *fastReflection_GetTxDescriptorResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetTxDescriptorResponse)
+		x := input.Message.Interface().(*GetGenesisSchemasDescriptorResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10574,15 +12422,15 @@ func (x *fastReflection_GetTxDescriptorResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxDescriptorResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetGenesisSchemasDescriptorResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetGenesisSchemasDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenesisSchemas", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10609,10 +12457,10 @@ func (x *fastReflection_GetTxDescriptorResponse) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Tx == nil {
-					x.Tx = &TxDescriptor{}
+				if x.GenesisSchemas == nil {
+					x.GenesisSchemas = &GenesisSchemasDescriptor{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GenesisSchemas); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -10722,7 +12570,7 @@ func (x *QueryServicesDescriptor) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryServicesDescriptor) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[23]
+	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11220,7 +13068,7 @@ func (x *QueryServiceDescriptor) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryServiceDescriptor) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[24]
+	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11779,7 +13627,7 @@ func (x *QueryMethodDescriptor) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryMethodDescriptor) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[25]
+	mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12275,6 +14123,8 @@ type AppDescriptor struct {
 	QueryServices *QueryServicesDescriptor `protobuf:"bytes,5,opt,name=query_services,json=queryServices,proto3" json:"query_services,omitempty"`
 	// tx provides metadata information regarding how to send transactions to the given application
 	Tx *TxDescriptor `protobuf:"bytes,6,opt,name=tx,proto3" json:"tx,omitempty"`
+	// genesis_schemas provides the JSON schemas of the collections genesis of the application modules
+	GenesisSchemas *GenesisSchemasDescriptor `protobuf:"bytes,7,opt,name=genesis_schemas,json=genesisSchemas,proto3" json:"genesis_schemas,omitempty"`
 }
 
 func (x *AppDescriptor) Reset() {
@@ -12339,6 +14189,13 @@ func (x *AppDescriptor) GetTx() *TxDescriptor {
 	return nil
 }
 
+func (x *AppDescriptor) GetGenesisSchemas() *GenesisSchemasDescriptor {
+	if x != nil {
+		return x.GenesisSchemas
+	}
+	return nil
+}
+
 // TxDescriptor describes the accepted transaction type
 type TxDescriptor struct {
 	state         protoimpl.MessageState
@@ -12742,46 +14599,130 @@ func (*ConfigurationDescriptor) Descriptor() ([]byte, []int) {
 	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ConfigurationDescriptor) GetBech32AccountAddressPrefix() string {
+func (x *ConfigurationDescriptor) GetBech32AccountAddressPrefix() string {
+	if x != nil {
+		return x.Bech32AccountAddressPrefix
+	}
+	return ""
+}
+
+// MsgDescriptor describes a cosmos-sdk message that can be delivered with a transaction
+type MsgDescriptor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// msg_type_url contains the TypeURL of a sdk.Msg.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (x *MsgDescriptor) Reset() {
+	*x = MsgDescriptor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDescriptor) ProtoMessage() {}
+
+// Deprecated: Use MsgDescriptor.ProtoReflect.Descriptor instead.
+func (*MsgDescriptor) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgDescriptor) GetMsgTypeUrl() string {
+	if x != nil {
+		return x.MsgTypeUrl
+	}
+	return ""
+}
+
+// GenesisSchemasDescriptor contains the JSON schemas of the collections genesis of the modules
+type GenesisSchemasDescriptor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// modules is a list of ModuleGenesisSchemaDescriptor, sorted by module name
+	Modules []*ModuleGenesisSchemaDescriptor `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+}
+
+func (x *GenesisSchemasDescriptor) Reset() {
+	*x = GenesisSchemasDescriptor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisSchemasDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisSchemasDescriptor) ProtoMessage() {}
+
+// Deprecated: Use GenesisSchemasDescriptor.ProtoReflect.Descriptor instead.
+func (*GenesisSchemasDescriptor) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GenesisSchemasDescriptor) GetModules() []*ModuleGenesisSchemaDescriptor {
 	if x != nil {
-		return x.Bech32AccountAddressPrefix
+		return x.Modules
 	}
-	return ""
+	return nil
 }
 
-// MsgDescriptor describes a cosmos-sdk message that can be delivered with a transaction
-type MsgDescriptor struct {
+// ModuleGenesisSchemaDescriptor describes the collections genesis of a module
+type ModuleGenesisSchemaDescriptor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// msg_type_url contains the TypeURL of a sdk.Msg.
-	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// module is the name of the module store
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// json_schema is the JSON schema document of the genesis of the module collections,
+	// as exported by collections.Schema
+	JsonSchema string `protobuf:"bytes,2,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
 }
 
-func (x *MsgDescriptor) Reset() {
-	*x = MsgDescriptor{}
+func (x *ModuleGenesisSchemaDescriptor) Reset() {
+	*x = ModuleGenesisSchemaDescriptor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[10]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgDescriptor) String() string {
+func (x *ModuleGenesisSchemaDescriptor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgDescriptor) ProtoMessage() {}
+func (*ModuleGenesisSchemaDescriptor) ProtoMessage() {}
 
-// Deprecated: Use MsgDescriptor.ProtoReflect.Descriptor instead.
-func (*MsgDescriptor) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ModuleGenesisSchemaDescriptor.ProtoReflect.Descriptor instead.
+func (*ModuleGenesisSchemaDescriptor) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MsgDescriptor) GetMsgTypeUrl() string {
+func (x *ModuleGenesisSchemaDescriptor) GetModule() string {
 	if x != nil {
-		return x.MsgTypeUrl
+		return x.Module
+	}
+	return ""
+}
+
+func (x *ModuleGenesisSchemaDescriptor) GetJsonSchema() string {
+	if x != nil {
+		return x.JsonSchema
 	}
 	return ""
 }
@@ -12796,7 +14737,7 @@ type GetAuthnDescriptorRequest struct {
 func (x *GetAuthnDescriptorRequest) Reset() {
 	*x = GetAuthnDescriptorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[11]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12810,7 +14751,7 @@ func (*GetAuthnDescriptorRequest) ProtoMessage() {}
 
 // Deprecated: Use GetAuthnDescriptorRequest.ProtoReflect.Descriptor instead.
 func (*GetAuthnDescriptorRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{11}
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{13}
 }
 
 // GetAuthnDescriptorResponse is the response returned by the GetAuthnDescriptor RPC
@@ -12826,7 +14767,7 @@ type GetAuthnDescriptorResponse struct {
 func (x *GetAuthnDescriptorResponse) Reset() {
 	*x = GetAuthnDescriptorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[12]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12840,7 +14781,7 @@ func (*GetAuthnDescriptorResponse) ProtoMessage() {}
 
 // Deprecated: Use GetAuthnDescriptorResponse.ProtoReflect.Descriptor instead.
 func (*GetAuthnDescriptorResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{12}
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetAuthnDescriptorResponse) GetAuthn() *AuthnDescriptor {
@@ -12860,7 +14801,7 @@ type GetChainDescriptorRequest struct {
 func (x *GetChainDescriptorRequest) Reset() {
 	*x = GetChainDescriptorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[13]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12874,7 +14815,7 @@ func (*GetChainDescriptorRequest) ProtoMessage() {}
 
 // Deprecated: Use GetChainDescriptorRequest.ProtoReflect.Descriptor instead.
 func (*GetChainDescriptorRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{13}
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{15}
 }
 
 // GetChainDescriptorResponse is the response returned by the GetChainDescriptor RPC
@@ -12890,7 +14831,7 @@ type GetChainDescriptorResponse struct {
 func (x *GetChainDescriptorResponse) Reset() {
 	*x = GetChainDescriptorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[14]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12904,7 +14845,7 @@ func (*GetChainDescriptorResponse) ProtoMessage() {}
 
 // Deprecated: Use GetChainDescriptorResponse.ProtoReflect.Descriptor instead.
 func (*GetChainDescriptorResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{14}
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetChainDescriptorResponse) GetChain() *ChainDescriptor {
@@ -12924,7 +14865,7 @@ type GetCodecDescriptorRequest struct {
 func (x *GetCodecDescriptorRequest) Reset() {
 	*x = GetCodecDescriptorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[15]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12938,7 +14879,7 @@ func (*GetCodecDescriptorRequest) ProtoMessage() {}
 
 // Deprecated: Use GetCodecDescriptorRequest.ProtoReflect.Descriptor instead.
 func (*GetCodecDescriptorRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{15}
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{17}
 }
 
 // GetCodecDescriptorResponse is the response returned by the GetCodecDescriptor RPC
@@ -12954,7 +14895,7 @@ type GetCodecDescriptorResponse struct {
 func (x *GetCodecDescriptorResponse) Reset() {
 	*x = GetCodecDescriptorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[16]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12968,7 +14909,7 @@ func (*GetCodecDescriptorResponse) ProtoMessage() {}
 
 // Deprecated: Use GetCodecDescriptorResponse.ProtoReflect.Descriptor instead.
 func (*GetCodecDescriptorResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{16}
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetCodecDescriptorResponse) GetCodec() *CodecDescriptor {
@@ -12988,7 +14929,7 @@ type GetConfigurationDescriptorRequest struct {
 func (x *GetConfigurationDescriptorRequest) Reset() {
 	*x = GetConfigurationDescriptorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[17]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13002,7 +14943,7 @@ func (*GetConfigurationDescriptorRequest) ProtoMessage() {}
 
 // Deprecated: Use GetConfigurationDescriptorRequest.ProtoReflect.Descriptor instead.
 func (*GetConfigurationDescriptorRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{17}
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{19}
 }
 
 // GetConfigurationDescriptorResponse is the response returned by the GetConfigurationDescriptor RPC
@@ -13018,7 +14959,7 @@ type GetConfigurationDescriptorResponse struct {
 func (x *GetConfigurationDescriptorResponse) Reset() {
 	*x = GetConfigurationDescriptorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[18]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13032,7 +14973,7 @@ func (*GetConfigurationDescriptorResponse) ProtoMessage() {}
 
 // Deprecated: Use GetConfigurationDescriptorResponse.ProtoReflect.Descriptor instead.
 func (*GetConfigurationDescriptorResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{18}
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetConfigurationDescriptorResponse) GetConfig() *ConfigurationDescriptor {
@@ -13052,7 +14993,7 @@ type GetQueryServicesDescriptorRequest struct {
 func (x *GetQueryServicesDescriptorRequest) Reset() {
 	*x = GetQueryServicesDescriptorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[19]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13066,7 +15007,7 @@ func (*GetQueryServicesDescriptorRequest) ProtoMessage() {}
 
 // Deprecated: Use GetQueryServicesDescriptorRequest.ProtoReflect.Descriptor instead.
 func (*GetQueryServicesDescriptorRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{19}
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{21}
 }
 
 // GetQueryServicesDescriptorResponse is the response returned by the GetQueryServicesDescriptor RPC
@@ -13082,7 +15023,7 @@ type GetQueryServicesDescriptorResponse struct {
 func (x *GetQueryServicesDescriptorResponse) Reset() {
 	*x = GetQueryServicesDescriptorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[20]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13096,7 +15037,7 @@ func (*GetQueryServicesDescriptorResponse) ProtoMessage() {}
 
 // Deprecated: Use GetQueryServicesDescriptorResponse.ProtoReflect.Descriptor instead.
 func (*GetQueryServicesDescriptorResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{20}
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetQueryServicesDescriptorResponse) GetQueries() *QueryServicesDescriptor {
@@ -13116,7 +15057,7 @@ type GetTxDescriptorRequest struct {
 func (x *GetTxDescriptorRequest) Reset() {
 	*x = GetTxDescriptorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[21]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13130,7 +15071,7 @@ func (*GetTxDescriptorRequest) ProtoMessage() {}
 
 // Deprecated: Use GetTxDescriptorRequest.ProtoReflect.Descriptor instead.
 func (*GetTxDescriptorRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{21}
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{23}
 }
 
 // GetTxDescriptorResponse is the response returned by the GetTxDescriptor RPC
@@ -13147,7 +15088,7 @@ type GetTxDescriptorResponse struct {
 func (x *GetTxDescriptorResponse) Reset() {
 	*x = GetTxDescriptorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[22]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13161,7 +15102,7 @@ func (*GetTxDescriptorResponse) ProtoMessage() {}
 
 // Deprecated: Use GetTxDescriptorResponse.ProtoReflect.Descriptor instead.
 func (*GetTxDescriptorResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{22}
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetTxDescriptorResponse) GetTx() *TxDescriptor {
@@ -13171,6 +15112,70 @@ func (x *GetTxDescriptorResponse) GetTx() *TxDescriptor {
 	return nil
 }
 
+// GetGenesisSchemasDescriptorRequest is the request used for the GetGenesisSchemasDescriptor RPC
+type GetGenesisSchemasDescriptorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetGenesisSchemasDescriptorRequest) Reset() {
+	*x = GetGenesisSchemasDescriptorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGenesisSchemasDescriptorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGenesisSchemasDescriptorRequest) ProtoMessage() {}
+
+// Deprecated: Use GetGenesisSchemasDescriptorRequest.ProtoReflect.Descriptor instead.
+func (*GetGenesisSchemasDescriptorRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{25}
+}
+
+// GetGenesisSchemasDescriptorResponse is the response returned by the GetGenesisSchemasDescriptor RPC
+type GetGenesisSchemasDescriptorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// genesis_schemas provides the JSON schemas of the collections genesis of the modules
+	GenesisSchemas *GenesisSchemasDescriptor `protobuf:"bytes,1,opt,name=genesis_schemas,json=genesisSchemas,proto3" json:"genesis_schemas,omitempty"`
+}
+
+func (x *GetGenesisSchemasDescriptorResponse) Reset() {
+	*x = GetGenesisSchemasDescriptorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGenesisSchemasDescriptorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGenesisSchemasDescriptorResponse) ProtoMessage() {}
+
+// Deprecated: Use GetGenesisSchemasDescriptorResponse.ProtoReflect.Descriptor instead.
+func (*GetGenesisSchemasDescriptorResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetGenesisSchemasDescriptorResponse) GetGenesisSchemas() *GenesisSchemasDescriptor {
+	if x != nil {
+		return x.GenesisSchemas
+	}
+	return nil
+}
+
 // QueryServicesDescriptor contains the list of cosmos-sdk queriable services
 type QueryServicesDescriptor struct {
 	state         protoimpl.MessageState
@@ -13184,7 +15189,7 @@ type QueryServicesDescriptor struct {
 func (x *QueryServicesDescriptor) Reset() {
 	*x = QueryServicesDescriptor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[23]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13198,7 +15203,7 @@ func (*QueryServicesDescriptor) ProtoMessage() {}
 
 // Deprecated: Use QueryServicesDescriptor.ProtoReflect.Descriptor instead.
 func (*QueryServicesDescriptor) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{23}
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *QueryServicesDescriptor) GetQueryServices() []*QueryServiceDescriptor {
@@ -13225,7 +15230,7 @@ type QueryServiceDescriptor struct {
 func (x *QueryServiceDescriptor) Reset() {
 	*x = QueryServiceDescriptor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[24]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13239,7 +15244,7 @@ func (*QueryServiceDescriptor) ProtoMessage() {}
 
 // Deprecated: Use QueryServiceDescriptor.ProtoReflect.Descriptor instead.
 func (*QueryServiceDescriptor) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{24}
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *QueryServiceDescriptor) GetFullname() string {
@@ -13281,7 +15286,7 @@ type QueryMethodDescriptor struct {
 func (x *QueryMethodDescriptor) Reset() {
 	*x = QueryMethodDescriptor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[25]
+		mi := &file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13295,7 +15300,7 @@ func (*QueryMethodDescriptor) ProtoMessage() {}
 
 // Deprecated: Use QueryMethodDescriptor.ProtoReflect.Descriptor instead.
 func (*QueryMethodDescriptor) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{25}
+	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *QueryMethodDescriptor) GetName() string {
@@ -13322,7 +15327,7 @@ var file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDesc = []byte{
 	0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
 	0x68, 0x61, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
 	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xe7, 0x03, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x6f, 0x22, 0xcb, 0x04, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
 	0x74, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x05, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
 	0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c,
@@ -13352,256 +15357,302 @@ var file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDesc = []byte{
 	0x74, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
 	0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x02, 0x74, 0x78, 0x22, 0x6e, 0x0a, 0x0c, 0x54,
-	0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x66,
-	0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
-	0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x02, 0x74, 0x78, 0x12, 0x62, 0x0a, 0x0f, 0x67,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52,
+	0x0e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x22,
+	0x6e, 0x0a, 0x0c, 0x54, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x04, 0x6d,
+	0x73, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x22,
+	0x68, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x12, 0x55, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x4d, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x53, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x4c, 0x0a, 0x23, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x66, 0x75,
+	0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x46, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x21, 0x0a,
+	0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x67, 0x0a, 0x0f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x12, 0x54, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x13, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x86, 0x01,
+	0x0a, 0x1c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x1a, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x76, 0x0a, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x15, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0x57,
+	0x0a, 0x1e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x77, 0x0a, 0x23, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x22, 0x5c, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x1d, 0x62,
+	0x65, 0x63, 0x68, 0x33, 0x32, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x1a, 0x62, 0x65, 0x63, 0x68, 0x33, 0x32, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x31,
+	0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12,
+	0x20, 0x0a, 0x0c, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72,
+	0x6c, 0x22, 0x74, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x58, 0x0a,
+	0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x07,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x58, 0x0a, 0x1d, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x64,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x05,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x64, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x46, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x63, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x64, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x63,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x46, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x22, 0x23, 0x0a, 0x21, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x76, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
 	0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x22, 0x68, 0x0a, 0x0f, 0x41,
-	0x75, 0x74, 0x68, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x55,
-	0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x4d, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
-	0x67, 0x4d, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x23, 0x61,
-	0x75, 0x74, 0x68, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x46, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x21, 0x0a, 0x0f, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x67, 0x0a, 0x0f,
-	0x43, 0x6f, 0x64, 0x65, 0x63, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12,
-	0x54, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x23, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x78, 0x0a, 0x22,
+	0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x52, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
 	0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x13, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a,
-	0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x1c, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6e,
-	0x67, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x44, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72,
-	0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65,
-	0x70, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x1a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x12, 0x76, 0x0a, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f,
-	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x6d,
-	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x6f, 0x72, 0x52, 0x15, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x6d,
-	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0x57, 0x0a, 0x1e, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x65, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x79, 0x70, 0x65,
-	0x55, 0x72, 0x6c, 0x22, 0x77, 0x0a, 0x23, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75,
-	0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75,
-	0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x17,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x1d, 0x62, 0x65, 0x63, 0x68, 0x33,
-	0x32, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a,
-	0x62, 0x65, 0x63, 0x68, 0x33, 0x32, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x31, 0x0a, 0x0d, 0x4d, 0x73,
-	0x67, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x6d,
-	0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x1b, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x64, 0x0a, 0x1a, 0x47, 0x65,
-	0x74, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x05, 0x61, 0x75, 0x74, 0x68,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x71,
+	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x78, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x58, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x02, 0x74,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x61, 0x75, 0x74, 0x68, 0x6e,
-	0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x64, 0x0a,
-	0x1a, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x05, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x44,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x02, 0x74, 0x78, 0x22, 0x24, 0x0a, 0x22, 0x47, 0x65,
+	0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x44,
 	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x64, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46,
-	0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x43, 0x6f, 0x64, 0x65, 0x63, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52,
-	0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x22, 0x23, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x76, 0x0a, 0x22, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x50, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x38, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x22, 0x89, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x67, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x39, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
 	0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x22, 0x23, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x78, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
-	0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x38, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x0e, 0x67, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x22, 0x79, 0x0a, 0x17,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x5e, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x37, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65,
 	0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69,
-	0x65, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x54, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x6f, 0x72, 0x52, 0x02, 0x74, 0x78, 0x22, 0x79, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
-	0x72, 0x12, 0x5e, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72,
 	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x6f, 0x72, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x22, 0xa3, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x07,
-	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x22, 0x53, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66,
-	0x75, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x32, 0xa7, 0x0a, 0x0a,
-	0x11, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0xcb, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x22, 0x53, 0x0a,
+	0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x75,
+	0x6c, 0x6c, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x75, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
+	0x74, 0x68, 0x32, 0x9a, 0x0c, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xcb, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12,
+	0x3a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65,
+	0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36,
+	0x12, 0x34, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x72,
+	0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x2f, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x12, 0xcb, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x75, 0x74, 0x68, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6e,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x5f,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6e,
-	0x12, 0xcb, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0xcb,
-	0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x63,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x3b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x62, 0x61, 0x73, 0x65, 0x2f, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x12, 0xeb, 0x01, 0x0a,
-	0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x42, 0x2e, 0x63, 0x6f,
+	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x72, 0x65, 0x66,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x61, 0x70, 0x70, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x12, 0xcb, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x63, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x2e, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x43, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65,
-	0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x63,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x63, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x72, 0x65, 0x66, 0x6c, 0x65,
 	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x70,
-	0x70, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xec, 0x01, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x42, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12, 0x3d, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x5f,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0xca, 0x01, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x54, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x37, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2f, 0x74, 0x78, 0x5f, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x42, 0x9a, 0x02, 0x0a, 0x23, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
+	0x70, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2f, 0x63, 0x6f, 0x64,
+	0x65, 0x63, 0x12, 0xeb, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x12, 0x42, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65,
+	0x2f, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0xec, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12,
+	0x42, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65,
+	0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f,
+	0x12, 0x3d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x72,
+	0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0xca, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x12, 0x37, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x72, 0x65, 0x66,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x61, 0x70, 0x70, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2f, 0x74,
+	0x78, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0xf0, 0x01, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x43, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x0f,
-	0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x43, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
-	0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x3b, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x32,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x42, 0x52, 0xaa, 0x02, 0x1f, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02,
-	0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x52, 0x65, 0x66,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0xe2, 0x02, 0x2b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x52,
-	0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x32, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x52,
-	0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x32, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x44, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x12,
+	0x3e, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x72, 0x65,
+	0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2f,
+	0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x42,
+	0x9a, 0x02, 0x0a, 0x23, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x0f, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x72, 0x65, 0x66,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2,
+	0x02, 0x03, 0x43, 0x42, 0x52, 0xaa, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x42,
+	0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5c, 0x56, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x2b, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5c, 0x56, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x3a, 0x3a, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -13616,7 +15667,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescGZIP() []byte
 	return file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDescData
 }
 
-var file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_cosmos_base_reflection_v2alpha1_reflection_proto_goTypes = []interface{}{
 	(*AppDescriptor)(nil),                       // 0: cosmos.base.reflection.v2alpha1.AppDescriptor
 	(*TxDescriptor)(nil),                        // 1: cosmos.base.reflection.v2alpha1.TxDescriptor
@@ -13629,59 +15680,68 @@ var file_cosmos_base_reflection_v2alpha1_reflection_proto_goTypes = []interface{
 	(*InterfaceAcceptingMessageDescriptor)(nil), // 8: cosmos.base.reflection.v2alpha1.InterfaceAcceptingMessageDescriptor
 	(*ConfigurationDescriptor)(nil),             // 9: cosmos.base.reflection.v2alpha1.ConfigurationDescriptor
 	(*MsgDescriptor)(nil),                       // 10: cosmos.base.reflection.v2alpha1.MsgDescriptor
-	(*GetAuthnDescriptorRequest)(nil),           // 11: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest
-	(*GetAuthnDescriptorResponse)(nil),          // 12: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse
-	(*GetChainDescriptorRequest)(nil),           // 13: cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest
-	(*GetChainDescriptorResponse)(nil),          // 14: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse
-	(*GetCodecDescriptorRequest)(nil),           // 15: cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest
-	(*GetCodecDescriptorResponse)(nil),          // 16: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse
-	(*GetConfigurationDescriptorRequest)(nil),   // 17: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest
-	(*GetConfigurationDescriptorResponse)(nil),  // 18: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse
-	(*GetQueryServicesDescriptorRequest)(nil),   // 19: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest
-	(*GetQueryServicesDescriptorResponse)(nil),  // 20: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse
-	(*GetTxDescriptorRequest)(nil),              // 21: cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest
-	(*GetTxDescriptorResponse)(nil),             // 22: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse
-	(*QueryServicesDescriptor)(nil),             // 23: cosmos.base.reflection.v2alpha1.QueryServicesDescriptor
-	(*QueryServiceDescriptor)(nil),              // 24: cosmos.base.reflection.v2alpha1.QueryServiceDescriptor
-	(*QueryMethodDescriptor)(nil),               // 25: cosmos.base.reflection.v2alpha1.QueryMethodDescriptor
+	(*GenesisSchemasDescriptor)(nil),            // 11: cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor
+	(*ModuleGenesisSchemaDescriptor)(nil),       // 12: cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor
+	(*GetAuthnDescriptorRequest)(nil),           // 13: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest
+	(*GetAuthnDescriptorResponse)(nil),          // 14: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse
+	(*GetChainDescriptorRequest)(nil),           // 15: cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest
+	(*GetChainDescriptorResponse)(nil),          // 16: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse
+	(*GetCodecDescriptorRequest)(nil),           // 17: cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest
+	(*GetCodecDescriptorResponse)(nil),          // 18: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse
+	(*GetConfigurationDescriptorRequest)(nil),   // 19: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest
+	(*GetConfigurationDescriptorResponse)(nil),  // 20: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse
+	(*GetQueryServicesDescriptorRequest)(nil),   // 21: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest
+	(*GetQueryServicesDescriptorResponse)(nil),  // 22: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse
+	(*GetTxDescriptorRequest)(nil),              // 23: cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest
+	(*GetTxDescriptorResponse)(nil),             // 24: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse
+	(*GetGenesisSchemasDescriptorRequest)(nil),  // 25: cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest
+	(*GetGenesisSchemasDescriptorResponse)(nil), // 26: cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse
+	(*QueryServicesDescriptor)(nil),             // 27: cosmos.base.reflection.v2alpha1.QueryServicesDescriptor
+	(*QueryServiceDescriptor)(nil),              // 28: cosmos.base.reflection.v2alpha1.QueryServiceDescriptor
+	(*QueryMethodDescriptor)(nil),               // 29: cosmos.base.reflection.v2alpha1.QueryMethodDescriptor
 }
 var file_cosmos_base_reflection_v2alpha1_reflection_proto_depIdxs = []int32{
 	2,  // 0: cosmos.base.reflection.v2alpha1.AppDescriptor.authn:type_name -> cosmos.base.reflection.v2alpha1.AuthnDescriptor
 	4,  // 1: cosmos.base.reflection.v2alpha1.AppDescriptor.chain:type_name -> cosmos.base.reflection.v2alpha1.ChainDescriptor
 	5,  // 2: cosmos.base.reflection.v2alpha1.AppDescriptor.codec:type_name -> cosmos.base.reflection.v2alpha1.CodecDescriptor
 	9,  // 3: cosmos.base.reflection.v2alpha1.AppDescriptor.configuration:type_name -> cosmos.base.reflection.v2alpha1.ConfigurationDescriptor
-	23, // 4: cosmos.base.reflection.v2alpha1.AppDescriptor.query_services:type_name -> cosmos.base.reflection.v2alpha1.QueryServicesDescriptor
+	27, // 4: cosmos.base.reflection.v2alpha1.AppDescriptor.query_services:type_name -> cosmos.base.reflection.v2alpha1.QueryServicesDescriptor
 	1,  // 5: cosmos.base.reflection.v2alpha1.AppDescriptor.tx:type_name -> cosmos.base.reflection.v2alpha1.TxDescriptor
-	10, // 6: cosmos.base.reflection.v2alpha1.TxDescriptor.msgs:type_name -> cosmos.base.reflection.v2alpha1.MsgDescriptor
-	3,  // 7: cosmos.base.reflection.v2alpha1.AuthnDescriptor.sign_modes:type_name -> cosmos.base.reflection.v2alpha1.SigningModeDescriptor
-	6,  // 8: cosmos.base.reflection.v2alpha1.CodecDescriptor.interfaces:type_name -> cosmos.base.reflection.v2alpha1.InterfaceDescriptor
-	8,  // 9: cosmos.base.reflection.v2alpha1.InterfaceDescriptor.interface_accepting_messages:type_name -> cosmos.base.reflection.v2alpha1.InterfaceAcceptingMessageDescriptor
-	7,  // 10: cosmos.base.reflection.v2alpha1.InterfaceDescriptor.interface_implementers:type_name -> cosmos.base.reflection.v2alpha1.InterfaceImplementerDescriptor
-	2,  // 11: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse.authn:type_name -> cosmos.base.reflection.v2alpha1.AuthnDescriptor
-	4,  // 12: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse.chain:type_name -> cosmos.base.reflection.v2alpha1.ChainDescriptor
-	5,  // 13: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse.codec:type_name -> cosmos.base.reflection.v2alpha1.CodecDescriptor
-	9,  // 14: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse.config:type_name -> cosmos.base.reflection.v2alpha1.ConfigurationDescriptor
-	23, // 15: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse.queries:type_name -> cosmos.base.reflection.v2alpha1.QueryServicesDescriptor
-	1,  // 16: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse.tx:type_name -> cosmos.base.reflection.v2alpha1.TxDescriptor
-	24, // 17: cosmos.base.reflection.v2alpha1.QueryServicesDescriptor.query_services:type_name -> cosmos.base.reflection.v2alpha1.QueryServiceDescriptor
-	25, // 18: cosmos.base.reflection.v2alpha1.QueryServiceDescriptor.methods:type_name -> cosmos.base.reflection.v2alpha1.QueryMethodDescriptor
-	11, // 19: cosmos.base.reflection.v2alpha1.ReflectionService.GetAuthnDescriptor:input_type -> cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest
-	13, // 20: cosmos.base.reflection.v2alpha1.ReflectionService.GetChainDescriptor:input_type -> cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest
-	15, // 21: cosmos.base.reflection.v2alpha1.ReflectionService.GetCodecDescriptor:input_type -> cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest
-	17, // 22: cosmos.base.reflection.v2alpha1.ReflectionService.GetConfigurationDescriptor:input_type -> cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest
-	19, // 23: cosmos.base.reflection.v2alpha1.ReflectionService.GetQueryServicesDescriptor:input_type -> cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest
-	21, // 24: cosmos.base.reflection.v2alpha1.ReflectionService.GetTxDescriptor:input_type -> cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest
-	12, // 25: cosmos.base.reflection.v2alpha1.ReflectionService.GetAuthnDescriptor:output_type -> cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse
-	14, // 26: cosmos.base.reflection.v2alpha1.ReflectionService.GetChainDescriptor:output_type -> cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse
-	16, // 27: cosmos.base.reflection.v2alpha1.ReflectionService.GetCodecDescriptor:output_type -> cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse
-	18, // 28: cosmos.base.reflection.v2alpha1.ReflectionService.GetConfigurationDescriptor:output_type -> cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse
-	20, // 29: cosmos.base.reflection.v2alpha1.ReflectionService.GetQueryServicesDescriptor:output_type -> cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse
-	22, // 30: cosmos.base.reflection.v2alpha1.ReflectionService.GetTxDescriptor:output_type -> cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse
-	25, // [25:31] is the sub-list for method output_type
-	19, // [19:25] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	11, // 6: cosmos.base.reflection.v2alpha1.AppDescriptor.genesis_schemas:type_name -> cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor
+	10, // 7: cosmos.base.reflection.v2alpha1.TxDescriptor.msgs:type_name -> cosmos.base.reflection.v2alpha1.MsgDescriptor
+	3,  // 8: cosmos.base.reflection.v2alpha1.AuthnDescriptor.sign_modes:type_name -> cosmos.base.reflection.v2alpha1.SigningModeDescriptor
+	6,  // 9: cosmos.base.reflection.v2alpha1.CodecDescriptor.interfaces:type_name -> cosmos.base.reflection.v2alpha1.InterfaceDescriptor
+	8,  // 10: cosmos.base.reflection.v2alpha1.InterfaceDescriptor.interface_accepting_messages:type_name -> cosmos.base.reflection.v2alpha1.InterfaceAcceptingMessageDescriptor
+	7,  // 11: cosmos.base.reflection.v2alpha1.InterfaceDescriptor.interface_implementers:type_name -> cosmos.base.reflection.v2alpha1.InterfaceImplementerDescriptor
+	12, // 12: cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor.modules:type_name -> cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor
+	2,  // 13: cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse.authn:type_name -> cosmos.base.reflection.v2alpha1.AuthnDescriptor
+	4,  // 14: cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse.chain:type_name -> cosmos.base.reflection.v2alpha1.ChainDescriptor
+	5,  // 15: cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse.codec:type_name -> cosmos.base.reflection.v2alpha1.CodecDescriptor
+	9,  // 16: cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse.config:type_name -> cosmos.base.reflection.v2alpha1.ConfigurationDescriptor
+	27, // 17: cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse.queries:type_name -> cosmos.base.reflection.v2alpha1.QueryServicesDescriptor
+	1,  // 18: cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse.tx:type_name -> cosmos.base.reflection.v2alpha1.TxDescriptor
+	11, // 19: cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse.genesis_schemas:type_name -> cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor
+	28, // 20: cosmos.base.reflection.v2alpha1.QueryServicesDescriptor.query_services:type_name -> cosmos.base.reflection.v2alpha1.QueryServiceDescriptor
+	29, // 21: cosmos.base.reflection.v2alpha1.QueryServiceDescriptor.methods:type_name -> cosmos.base.reflection.v2alpha1.QueryMethodDescriptor
+	13, // 22: cosmos.base.reflection.v2alpha1.ReflectionService.GetAuthnDescriptor:input_type -> cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest
+	15, // 23: cosmos.base.reflection.v2alpha1.ReflectionService.GetChainDescriptor:input_type -> cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest
+	17, // 24: cosmos.base.reflection.v2alpha1.ReflectionService.GetCodecDescriptor:input_type -> cosmos.base.reflection.v2alpha1.GetCodecDescriptorRequest
+	19, // 25: cosmos.base.reflection.v2alpha1.ReflectionService.GetConfigurationDescriptor:input_type -> cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorRequest
+	21, // 26: cosmos.base.reflection.v2alpha1.ReflectionService.GetQueryServicesDescriptor:input_type -> cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorRequest
+	23, // 27: cosmos.base.reflection.v2alpha1.ReflectionService.GetTxDescriptor:input_type -> cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest
+	25, // 28: cosmos.base.reflection.v2alpha1.ReflectionService.GetGenesisSchemasDescriptor:input_type -> cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest
+	14, // 29: cosmos.base.reflection.v2alpha1.ReflectionService.GetAuthnDescriptor:output_type -> cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse
+	16, // 30: cosmos.base.reflection.v2alpha1.ReflectionService.GetChainDescriptor:output_type -> cosmos.base.reflection.v2alpha1.GetChainDescriptorResponse
+	18, // 31: cosmos.base.reflection.v2alpha1.ReflectionService.GetCodecDescriptor:output_type -> cosmos.base.reflection.v2alpha1.GetCodecDescriptorResponse
+	20, // 32: cosmos.base.reflection.v2alpha1.ReflectionService.GetConfigurationDescriptor:output_type -> cosmos.base.reflection.v2alpha1.GetConfigurationDescriptorResponse
+	22, // 33: cosmos.base.reflection.v2alpha1.ReflectionService.GetQueryServicesDescriptor:output_type -> cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse
+	24, // 34: cosmos.base.reflection.v2alpha1.ReflectionService.GetTxDescriptor:output_type -> cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse
+	26, // 35: cosmos.base.reflection.v2alpha1.ReflectionService.GetGenesisSchemasDescriptor:output_type -> cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse
+	29, // [29:36] is the sub-list for method output_type
+	22, // [22:29] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_base_reflection_v2alpha1_reflection_proto_init() }
@@ -13823,7 +15883,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			}
 		}
 		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAuthnDescriptorRequest); i {
+			switch v := v.(*GenesisSchemasDescriptor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13835,7 +15895,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			}
 		}
 		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAuthnDescriptorResponse); i {
+			switch v := v.(*ModuleGenesisSchemaDescriptor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13847,7 +15907,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			}
 		}
 		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetChainDescriptorRequest); i {
+			switch v := v.(*GetAuthnDescriptorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13859,7 +15919,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			}
 		}
 		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetChainDescriptorResponse); i {
+			switch v := v.(*GetAuthnDescriptorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13871,7 +15931,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			}
 		}
 		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCodecDescriptorRequest); i {
+			switch v := v.(*GetChainDescriptorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13883,7 +15943,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			}
 		}
 		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCodecDescriptorResponse); i {
+			switch v := v.(*GetChainDescriptorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13895,7 +15955,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			}
 		}
 		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigurationDescriptorRequest); i {
+			switch v := v.(*GetCodecDescriptorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13907,7 +15967,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			}
 		}
 		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigurationDescriptorResponse); i {
+			switch v := v.(*GetCodecDescriptorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13919,7 +15979,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			}
 		}
 		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetQueryServicesDescriptorRequest); i {
+			switch v := v.(*GetConfigurationDescriptorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13931,7 +15991,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			}
 		}
 		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetQueryServicesDescriptorResponse); i {
+			switch v := v.(*GetConfigurationDescriptorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13943,7 +16003,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			}
 		}
 		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxDescriptorRequest); i {
+			switch v := v.(*GetQueryServicesDescriptorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13955,7 +16015,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			}
 		}
 		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxDescriptorResponse); i {
+			switch v := v.(*GetQueryServicesDescriptorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13967,7 +16027,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			}
 		}
 		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryServicesDescriptor); i {
+			switch v := v.(*GetTxDescriptorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13979,7 +16039,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			}
 		}
 		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryServiceDescriptor); i {
+			switch v := v.(*GetTxDescriptorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13991,6 +16051,54 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			}
 		}
 		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGenesisSchemasDescriptorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGenesisSchemasDescriptorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryServicesDescriptor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryServiceDescriptor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_reflection_v2alpha1_reflection_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryMethodDescriptor); i {
 			case 0:
 				return &v.state
@@ -14009,7 +16117,7 @@ func file_cosmos_base_reflection_v2alpha1_reflection_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_base_reflection_v2alpha1_reflection_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
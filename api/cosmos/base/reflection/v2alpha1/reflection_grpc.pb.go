@@ -21,12 +21,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ReflectionService_GetAuthnDescriptor_FullMethodName         = "/cosmos.base.reflection.v2alpha1.ReflectionService/GetAuthnDescriptor"
-	ReflectionService_GetChainDescriptor_FullMethodName         = "/cosmos.base.reflection.v2alpha1.ReflectionService/GetChainDescriptor"
-	ReflectionService_GetCodecDescriptor_FullMethodName         = "/cosmos.base.reflection.v2alpha1.ReflectionService/GetCodecDescriptor"
-	ReflectionService_GetConfigurationDescriptor_FullMethodName = "/cosmos.base.reflection.v2alpha1.ReflectionService/GetConfigurationDescriptor"
-	ReflectionService_GetQueryServicesDescriptor_FullMethodName = "/cosmos.base.reflection.v2alpha1.ReflectionService/GetQueryServicesDescriptor"
-	ReflectionService_GetTxDescriptor_FullMethodName            = "/cosmos.base.reflection.v2alpha1.ReflectionService/GetTxDescriptor"
+	ReflectionService_GetAuthnDescriptor_FullMethodName          = "/cosmos.base.reflection.v2alpha1.ReflectionService/GetAuthnDescriptor"
+	ReflectionService_GetChainDescriptor_FullMethodName          = "/cosmos.base.reflection.v2alpha1.ReflectionService/GetChainDescriptor"
+	ReflectionService_GetCodecDescriptor_FullMethodName          = "/cosmos.base.reflection.v2alpha1.ReflectionService/GetCodecDescriptor"
+	ReflectionService_GetConfigurationDescriptor_FullMethodName  = "/cosmos.base.reflection.v2alpha1.ReflectionService/GetConfigurationDescriptor"
+	ReflectionService_GetQueryServicesDescriptor_FullMethodName  = "/cosmos.base.reflection.v2alpha1.ReflectionService/GetQueryServicesDescriptor"
+	ReflectionService_GetTxDescriptor_FullMethodName             = "/cosmos.base.reflection.v2alpha1.ReflectionService/GetTxDescriptor"
+	ReflectionService_GetGenesisSchemasDescriptor_FullMethodName = "/cosmos.base.reflection.v2alpha1.ReflectionService/GetGenesisSchemasDescriptor"
 )
 
 // ReflectionServiceClient is the client API for ReflectionService service.
@@ -47,6 +48,8 @@ type ReflectionServiceClient interface {
 	GetQueryServicesDescriptor(ctx context.Context, in *GetQueryServicesDescriptorRequest, opts ...grpc.CallOption) (*GetQueryServicesDescriptorResponse, error)
 	// GetTxDescriptor returns information on the used transaction object and available msgs that can be used
 	GetTxDescriptor(ctx context.Context, in *GetTxDescriptorRequest, opts ...grpc.CallOption) (*GetTxDescriptorResponse, error)
+	// GetGenesisSchemasDescriptor returns the JSON schemas of the collections genesis of the application modules
+	GetGenesisSchemasDescriptor(ctx context.Context, in *GetGenesisSchemasDescriptorRequest, opts ...grpc.CallOption) (*GetGenesisSchemasDescriptorResponse, error)
 }
 
 type reflectionServiceClient struct {
@@ -111,6 +114,15 @@ func (c *reflectionServiceClient) GetTxDescriptor(ctx context.Context, in *GetTx
 	return out, nil
 }
 
+func (c *reflectionServiceClient) GetGenesisSchemasDescriptor(ctx context.Context, in *GetGenesisSchemasDescriptorRequest, opts ...grpc.CallOption) (*GetGenesisSchemasDescriptorResponse, error) {
+	out := new(GetGenesisSchemasDescriptorResponse)
+	err := c.cc.Invoke(ctx, ReflectionService_GetGenesisSchemasDescriptor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReflectionServiceServer is the server API for ReflectionService service.
 // All implementations must embed UnimplementedReflectionServiceServer
 // for forward compatibility
@@ -129,6 +141,8 @@ type ReflectionServiceServer interface {
 	GetQueryServicesDescriptor(context.Context, *GetQueryServicesDescriptorRequest) (*GetQueryServicesDescriptorResponse, error)
 	// GetTxDescriptor returns information on the used transaction object and available msgs that can be used
 	GetTxDescriptor(context.Context, *GetTxDescriptorRequest) (*GetTxDescriptorResponse, error)
+	// GetGenesisSchemasDescriptor returns the JSON schemas of the collections genesis of the application modules
+	GetGenesisSchemasDescriptor(context.Context, *GetGenesisSchemasDescriptorRequest) (*GetGenesisSchemasDescriptorResponse, error)
 	mustEmbedUnimplementedReflectionServiceServer()
 }
 
@@ -154,6 +168,9 @@ func (UnimplementedReflectionServiceServer) GetQueryServicesDescriptor(context.C
 func (UnimplementedReflectionServiceServer) GetTxDescriptor(context.Context, *GetTxDescriptorRequest) (*GetTxDescriptorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTxDescriptor not implemented")
 }
+func (UnimplementedReflectionServiceServer) GetGenesisSchemasDescriptor(context.Context, *GetGenesisSchemasDescriptorRequest) (*GetGenesisSchemasDescriptorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGenesisSchemasDescriptor not implemented")
+}
 func (UnimplementedReflectionServiceServer) mustEmbedUnimplementedReflectionServiceServer() {}
 
 // UnsafeReflectionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -275,6 +292,24 @@ func _ReflectionService_GetTxDescriptor_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReflectionService_GetGenesisSchemasDescriptor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGenesisSchemasDescriptorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReflectionServiceServer).GetGenesisSchemasDescriptor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReflectionService_GetGenesisSchemasDescriptor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReflectionServiceServer).GetGenesisSchemasDescriptor(ctx, req.(*GetGenesisSchemasDescriptorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReflectionService_ServiceDesc is the grpc.ServiceDesc for ReflectionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -306,6 +341,10 @@ var ReflectionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxDescriptor",
 			Handler:    _ReflectionService_GetTxDescriptor_Handler,
 		},
+		{
+			MethodName: "GetGenesisSchemasDescriptor",
+			Handler:    _ReflectionService_GetGenesisSchemasDescriptor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/reflection/v2alpha1/reflection.proto",
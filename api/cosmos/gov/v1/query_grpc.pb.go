@@ -21,15 +21,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Constitution_FullMethodName = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName     = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName    = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName         = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName        = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName       = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName      = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName     = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName  = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Constitution_FullMethodName        = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName            = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName           = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName               = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName              = "/cosmos.gov.v1.Query/Params"
+	Query_AllowedProposalMsgs_FullMethodName = "/cosmos.gov.v1.Query/AllowedProposalMsgs"
+	Query_Deposit_FullMethodName             = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName            = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName         = "/cosmos.gov.v1.Query/TallyResult"
 )
 
 // QueryClient is the client API for Query service.
@@ -48,6 +49,9 @@ type QueryClient interface {
 	Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
 	// Params queries all parameters of the gov module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AllowedProposalMsgs queries the type URLs of the messages and legacy contents
+	// which can be submitted within a proposal.
+	AllowedProposalMsgs(ctx context.Context, in *QueryAllowedProposalMsgsRequest, opts ...grpc.CallOption) (*QueryAllowedProposalMsgsResponse, error)
 	// Deposit queries single deposit information based on proposalID, depositAddr.
 	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
 	// Deposits queries all deposits of a single proposal.
@@ -118,6 +122,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) AllowedProposalMsgs(ctx context.Context, in *QueryAllowedProposalMsgsRequest, opts ...grpc.CallOption) (*QueryAllowedProposalMsgsResponse, error) {
+	out := new(QueryAllowedProposalMsgsResponse)
+	err := c.cc.Invoke(ctx, Query_AllowedProposalMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
 	out := new(QueryDepositResponse)
 	err := c.cc.Invoke(ctx, Query_Deposit_FullMethodName, in, out, opts...)
@@ -161,6 +174,9 @@ type QueryServer interface {
 	Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
 	// Params queries all parameters of the gov module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AllowedProposalMsgs queries the type URLs of the messages and legacy contents
+	// which can be submitted within a proposal.
+	AllowedProposalMsgs(context.Context, *QueryAllowedProposalMsgsRequest) (*QueryAllowedProposalMsgsResponse, error)
 	// Deposit queries single deposit information based on proposalID, depositAddr.
 	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
 	// Deposits queries all deposits of a single proposal.
@@ -192,6 +208,9 @@ func (UnimplementedQueryServer) Votes(context.Context, *QueryVotesRequest) (*Que
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) AllowedProposalMsgs(context.Context, *QueryAllowedProposalMsgsRequest) (*QueryAllowedProposalMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedProposalMsgs not implemented")
+}
 func (UnimplementedQueryServer) Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -322,6 +341,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllowedProposalMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedProposalMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedProposalMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllowedProposalMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedProposalMsgs(ctx, req.(*QueryAllowedProposalMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDepositRequest)
 	if err := dec(in); err != nil {
@@ -407,6 +444,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "AllowedProposalMsgs",
+			Handler:    _Query_AllowedProposalMsgs_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _Query_Deposit_Handler,
@@ -704,15 +704,70 @@ func (x *_Metadata_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Metadata_2_list)(nil)
+
+type _Metadata_2_list struct {
+	list *[]*SnapshotSection
+}
+
+func (x *_Metadata_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Metadata_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Metadata_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SnapshotSection)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Metadata_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SnapshotSection)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Metadata_2_list) AppendMutable() protoreflect.Value {
+	v := new(SnapshotSection)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Metadata_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Metadata_2_list) NewElement() protoreflect.Value {
+	v := new(SnapshotSection)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Metadata_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Metadata              protoreflect.MessageDescriptor
 	fd_Metadata_chunk_hashes protoreflect.FieldDescriptor
+	fd_Metadata_sections     protoreflect.FieldDescriptor
+	fd_Metadata_base_height  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_store_snapshots_v1_snapshot_proto_init()
 	md_Metadata = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("Metadata")
 	fd_Metadata_chunk_hashes = md_Metadata.Fields().ByName("chunk_hashes")
+	fd_Metadata_sections = md_Metadata.Fields().ByName("sections")
+	fd_Metadata_base_height = md_Metadata.Fields().ByName("base_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
@@ -786,6 +841,18 @@ func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if len(x.Sections) != 0 {
+		value := protoreflect.ValueOfList(&_Metadata_2_list{list: &x.Sections})
+		if !f(fd_Metadata_sections, value) {
+			return
+		}
+	}
+	if x.BaseHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseHeight)
+		if !f(fd_Metadata_base_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -803,6 +870,10 @@ func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "cosmos.store.snapshots.v1.Metadata.chunk_hashes":
 		return len(x.ChunkHashes) != 0
+	case "cosmos.store.snapshots.v1.Metadata.sections":
+		return len(x.Sections) != 0
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		return x.BaseHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -821,6 +892,10 @@ func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "cosmos.store.snapshots.v1.Metadata.chunk_hashes":
 		x.ChunkHashes = nil
+	case "cosmos.store.snapshots.v1.Metadata.sections":
+		x.Sections = nil
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		x.BaseHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -843,6 +918,15 @@ func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) p
 		}
 		listValue := &_Metadata_1_list{list: &x.ChunkHashes}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.store.snapshots.v1.Metadata.sections":
+		if len(x.Sections) == 0 {
+			return protoreflect.ValueOfList(&_Metadata_2_list{})
+		}
+		listValue := &_Metadata_2_list{list: &x.Sections}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		value := x.BaseHeight
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -867,6 +951,12 @@ func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value pro
 		lv := value.List()
 		clv := lv.(*_Metadata_1_list)
 		x.ChunkHashes = *clv.list
+	case "cosmos.store.snapshots.v1.Metadata.sections":
+		lv := value.List()
+		clv := lv.(*_Metadata_2_list)
+		x.Sections = *clv.list
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		x.BaseHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -893,6 +983,14 @@ func (x *fastReflection_Metadata) Mutable(fd protoreflect.FieldDescriptor) proto
 		}
 		value := &_Metadata_1_list{list: &x.ChunkHashes}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.store.snapshots.v1.Metadata.sections":
+		if x.Sections == nil {
+			x.Sections = []*SnapshotSection{}
+		}
+		value := &_Metadata_2_list{list: &x.Sections}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		panic(fmt.Errorf("field base_height of message cosmos.store.snapshots.v1.Metadata is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -909,6 +1007,11 @@ func (x *fastReflection_Metadata) NewField(fd protoreflect.FieldDescriptor) prot
 	case "cosmos.store.snapshots.v1.Metadata.chunk_hashes":
 		list := [][]byte{}
 		return protoreflect.ValueOfList(&_Metadata_1_list{list: &list})
+	case "cosmos.store.snapshots.v1.Metadata.sections":
+		list := []*SnapshotSection{}
+		return protoreflect.ValueOfList(&_Metadata_2_list{list: &list})
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -984,6 +1087,15 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.Sections) > 0 {
+			for _, e := range x.Sections {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BaseHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1013,6 +1125,27 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.BaseHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Sections) > 0 {
+			for iNdEx := len(x.Sections) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Sections[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if len(x.ChunkHashes) > 0 {
 			for iNdEx := len(x.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.ChunkHashes[iNdEx])
@@ -1103,6 +1236,59 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				x.ChunkHashes = append(x.ChunkHashes, make([]byte, postIndex-iNdEx))
 				copy(x.ChunkHashes[len(x.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sections", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sections = append(x.Sections, &SnapshotSection{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Sections[len(x.Sections)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+				}
+				x.BaseHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1139,31 +1325,29 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SnapshotItem                   protoreflect.MessageDescriptor
-	fd_SnapshotItem_store             protoreflect.FieldDescriptor
-	fd_SnapshotItem_iavl              protoreflect.FieldDescriptor
-	fd_SnapshotItem_extension         protoreflect.FieldDescriptor
-	fd_SnapshotItem_extension_payload protoreflect.FieldDescriptor
+	md_SnapshotSection        protoreflect.MessageDescriptor
+	fd_SnapshotSection_name   protoreflect.FieldDescriptor
+	fd_SnapshotSection_chunks protoreflect.FieldDescriptor
+	fd_SnapshotSection_hash   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_store_snapshots_v1_snapshot_proto_init()
-	md_SnapshotItem = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotItem")
-	fd_SnapshotItem_store = md_SnapshotItem.Fields().ByName("store")
-	fd_SnapshotItem_iavl = md_SnapshotItem.Fields().ByName("iavl")
-	fd_SnapshotItem_extension = md_SnapshotItem.Fields().ByName("extension")
-	fd_SnapshotItem_extension_payload = md_SnapshotItem.Fields().ByName("extension_payload")
+	md_SnapshotSection = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotSection")
+	fd_SnapshotSection_name = md_SnapshotSection.Fields().ByName("name")
+	fd_SnapshotSection_chunks = md_SnapshotSection.Fields().ByName("chunks")
+	fd_SnapshotSection_hash = md_SnapshotSection.Fields().ByName("hash")
 }
 
-var _ protoreflect.Message = (*fastReflection_SnapshotItem)(nil)
+var _ protoreflect.Message = (*fastReflection_SnapshotSection)(nil)
 
-type fastReflection_SnapshotItem SnapshotItem
+type fastReflection_SnapshotSection SnapshotSection
 
-func (x *SnapshotItem) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SnapshotItem)(x)
+func (x *SnapshotSection) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotSection)(x)
 }
 
-func (x *SnapshotItem) slowProtoReflect() protoreflect.Message {
+func (x *SnapshotSection) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1175,43 +1359,43 @@ func (x *SnapshotItem) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SnapshotItem_messageType fastReflection_SnapshotItem_messageType
-var _ protoreflect.MessageType = fastReflection_SnapshotItem_messageType{}
+var _fastReflection_SnapshotSection_messageType fastReflection_SnapshotSection_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotSection_messageType{}
 
-type fastReflection_SnapshotItem_messageType struct{}
+type fastReflection_SnapshotSection_messageType struct{}
 
-func (x fastReflection_SnapshotItem_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SnapshotItem)(nil)
+func (x fastReflection_SnapshotSection_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotSection)(nil)
 }
-func (x fastReflection_SnapshotItem_messageType) New() protoreflect.Message {
-	return new(fastReflection_SnapshotItem)
+func (x fastReflection_SnapshotSection_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotSection)
 }
-func (x fastReflection_SnapshotItem_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotItem
+func (x fastReflection_SnapshotSection_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotSection
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SnapshotItem) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotItem
+func (x *fastReflection_SnapshotSection) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotSection
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SnapshotItem) Type() protoreflect.MessageType {
-	return _fastReflection_SnapshotItem_messageType
+func (x *fastReflection_SnapshotSection) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotSection_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SnapshotItem) New() protoreflect.Message {
-	return new(fastReflection_SnapshotItem)
+func (x *fastReflection_SnapshotSection) New() protoreflect.Message {
+	return new(fastReflection_SnapshotSection)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SnapshotItem) Interface() protoreflect.ProtoMessage {
-	return (*SnapshotItem)(x)
+func (x *fastReflection_SnapshotSection) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotSection)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1219,33 +1403,23 @@ func (x *fastReflection_SnapshotItem) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SnapshotItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Item != nil {
-		switch o := x.Item.(type) {
-		case *SnapshotItem_Store:
-			v := o.Store
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_SnapshotItem_store, value) {
-				return
-			}
-		case *SnapshotItem_Iavl:
-			v := o.Iavl
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_SnapshotItem_iavl, value) {
-				return
-			}
-		case *SnapshotItem_Extension:
-			v := o.Extension
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_SnapshotItem_extension, value) {
-				return
-			}
-		case *SnapshotItem_ExtensionPayload:
-			v := o.ExtensionPayload
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_SnapshotItem_extension_payload, value) {
-				return
-			}
+func (x *fastReflection_SnapshotSection) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_SnapshotSection_name, value) {
+			return
+		}
+	}
+	if x.Chunks != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Chunks)
+		if !f(fd_SnapshotSection_chunks, value) {
+			return
+		}
+	}
+	if len(x.Hash) != 0 {
+		value := protoreflect.ValueOfBytes(x.Hash)
+		if !f(fd_SnapshotSection_hash, value) {
+			return
 		}
 	}
 }
@@ -1261,45 +1435,19 @@ func (x *fastReflection_SnapshotItem) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SnapshotItem) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SnapshotSection) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotItem.store":
-		if x.Item == nil {
-			return false
-		} else if _, ok := x.Item.(*SnapshotItem_Store); ok {
-			return true
-		} else {
-			return false
-		}
-	case "cosmos.store.snapshots.v1.SnapshotItem.iavl":
-		if x.Item == nil {
-			return false
-		} else if _, ok := x.Item.(*SnapshotItem_Iavl); ok {
-			return true
-		} else {
-			return false
-		}
-	case "cosmos.store.snapshots.v1.SnapshotItem.extension":
-		if x.Item == nil {
-			return false
-		} else if _, ok := x.Item.(*SnapshotItem_Extension); ok {
-			return true
-		} else {
-			return false
-		}
-	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
-		if x.Item == nil {
-			return false
-		} else if _, ok := x.Item.(*SnapshotItem_ExtensionPayload); ok {
-			return true
-		} else {
-			return false
-		}
+	case "cosmos.store.snapshots.v1.SnapshotSection.name":
+		return x.Name != ""
+	case "cosmos.store.snapshots.v1.SnapshotSection.chunks":
+		return x.Chunks != uint32(0)
+	case "cosmos.store.snapshots.v1.SnapshotSection.hash":
+		return len(x.Hash) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotSection"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotSection does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1309,21 +1457,19 @@ func (x *fastReflection_SnapshotItem) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotItem) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SnapshotSection) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotItem.store":
-		x.Item = nil
-	case "cosmos.store.snapshots.v1.SnapshotItem.iavl":
-		x.Item = nil
-	case "cosmos.store.snapshots.v1.SnapshotItem.extension":
-		x.Item = nil
-	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
-		x.Item = nil
+	case "cosmos.store.snapshots.v1.SnapshotSection.name":
+		x.Name = ""
+	case "cosmos.store.snapshots.v1.SnapshotSection.chunks":
+		x.Chunks = uint32(0)
+	case "cosmos.store.snapshots.v1.SnapshotSection.hash":
+		x.Hash = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotSection"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotSection does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1333,45 +1479,22 @@ func (x *fastReflection_SnapshotItem) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SnapshotItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotSection) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotItem.store":
-		if x.Item == nil {
-			return protoreflect.ValueOfMessage((*SnapshotStoreItem)(nil).ProtoReflect())
-		} else if v, ok := x.Item.(*SnapshotItem_Store); ok {
-			return protoreflect.ValueOfMessage(v.Store.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*SnapshotStoreItem)(nil).ProtoReflect())
-		}
-	case "cosmos.store.snapshots.v1.SnapshotItem.iavl":
-		if x.Item == nil {
-			return protoreflect.ValueOfMessage((*SnapshotIAVLItem)(nil).ProtoReflect())
-		} else if v, ok := x.Item.(*SnapshotItem_Iavl); ok {
-			return protoreflect.ValueOfMessage(v.Iavl.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*SnapshotIAVLItem)(nil).ProtoReflect())
-		}
-	case "cosmos.store.snapshots.v1.SnapshotItem.extension":
-		if x.Item == nil {
-			return protoreflect.ValueOfMessage((*SnapshotExtensionMeta)(nil).ProtoReflect())
-		} else if v, ok := x.Item.(*SnapshotItem_Extension); ok {
-			return protoreflect.ValueOfMessage(v.Extension.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*SnapshotExtensionMeta)(nil).ProtoReflect())
+	case "cosmos.store.snapshots.v1.SnapshotSection.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cosmos.store.snapshots.v1.SnapshotSection.chunks":
+		value := x.Chunks
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.store.snapshots.v1.SnapshotSection.hash":
+		value := x.Hash
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotSection"))
 		}
-	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
-		if x.Item == nil {
-			return protoreflect.ValueOfMessage((*SnapshotExtensionPayload)(nil).ProtoReflect())
-		} else if v, ok := x.Item.(*SnapshotItem_ExtensionPayload); ok {
-			return protoreflect.ValueOfMessage(v.ExtensionPayload.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*SnapshotExtensionPayload)(nil).ProtoReflect())
-		}
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
-		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotItem does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotSection does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1385,25 +1508,19 @@ func (x *fastReflection_SnapshotItem) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SnapshotSection) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotItem.store":
-		cv := value.Message().Interface().(*SnapshotStoreItem)
-		x.Item = &SnapshotItem_Store{Store: cv}
-	case "cosmos.store.snapshots.v1.SnapshotItem.iavl":
-		cv := value.Message().Interface().(*SnapshotIAVLItem)
-		x.Item = &SnapshotItem_Iavl{Iavl: cv}
-	case "cosmos.store.snapshots.v1.SnapshotItem.extension":
-		cv := value.Message().Interface().(*SnapshotExtensionMeta)
-		x.Item = &SnapshotItem_Extension{Extension: cv}
-	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
-		cv := value.Message().Interface().(*SnapshotExtensionPayload)
-		x.Item = &SnapshotItem_ExtensionPayload{ExtensionPayload: cv}
+	case "cosmos.store.snapshots.v1.SnapshotSection.name":
+		x.Name = value.Interface().(string)
+	case "cosmos.store.snapshots.v1.SnapshotSection.chunks":
+		x.Chunks = uint32(value.Uint())
+	case "cosmos.store.snapshots.v1.SnapshotSection.hash":
+		x.Hash = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotSection"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotSection does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1417,126 +1534,48 @@ func (x *fastReflection_SnapshotItem) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotSection) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotItem.store":
-		if x.Item == nil {
-			value := &SnapshotStoreItem{}
-			oneofValue := &SnapshotItem_Store{Store: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.Item.(type) {
-		case *SnapshotItem_Store:
-			return protoreflect.ValueOfMessage(m.Store.ProtoReflect())
-		default:
-			value := &SnapshotStoreItem{}
-			oneofValue := &SnapshotItem_Store{Store: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	case "cosmos.store.snapshots.v1.SnapshotItem.iavl":
-		if x.Item == nil {
-			value := &SnapshotIAVLItem{}
-			oneofValue := &SnapshotItem_Iavl{Iavl: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.Item.(type) {
-		case *SnapshotItem_Iavl:
-			return protoreflect.ValueOfMessage(m.Iavl.ProtoReflect())
-		default:
-			value := &SnapshotIAVLItem{}
-			oneofValue := &SnapshotItem_Iavl{Iavl: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	case "cosmos.store.snapshots.v1.SnapshotItem.extension":
-		if x.Item == nil {
-			value := &SnapshotExtensionMeta{}
-			oneofValue := &SnapshotItem_Extension{Extension: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.Item.(type) {
-		case *SnapshotItem_Extension:
-			return protoreflect.ValueOfMessage(m.Extension.ProtoReflect())
-		default:
-			value := &SnapshotExtensionMeta{}
-			oneofValue := &SnapshotItem_Extension{Extension: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
-		if x.Item == nil {
-			value := &SnapshotExtensionPayload{}
-			oneofValue := &SnapshotItem_ExtensionPayload{ExtensionPayload: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.Item.(type) {
-		case *SnapshotItem_ExtensionPayload:
-			return protoreflect.ValueOfMessage(m.ExtensionPayload.ProtoReflect())
-		default:
-			value := &SnapshotExtensionPayload{}
-			oneofValue := &SnapshotItem_ExtensionPayload{ExtensionPayload: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
+	case "cosmos.store.snapshots.v1.SnapshotSection.name":
+		panic(fmt.Errorf("field name of message cosmos.store.snapshots.v1.SnapshotSection is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotSection.chunks":
+		panic(fmt.Errorf("field chunks of message cosmos.store.snapshots.v1.SnapshotSection is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotSection.hash":
+		panic(fmt.Errorf("field hash of message cosmos.store.snapshots.v1.SnapshotSection is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotSection"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotSection does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SnapshotItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotSection) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotItem.store":
-		value := &SnapshotStoreItem{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.store.snapshots.v1.SnapshotItem.iavl":
-		value := &SnapshotIAVLItem{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.store.snapshots.v1.SnapshotItem.extension":
-		value := &SnapshotExtensionMeta{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
-		value := &SnapshotExtensionPayload{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.snapshots.v1.SnapshotSection.name":
+		return protoreflect.ValueOfString("")
+	case "cosmos.store.snapshots.v1.SnapshotSection.chunks":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.store.snapshots.v1.SnapshotSection.hash":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotSection"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotSection does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SnapshotItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SnapshotSection) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotItem.item":
-		if x.Item == nil {
-			return nil
-		}
-		switch x.Item.(type) {
-		case *SnapshotItem_Store:
-			return x.Descriptor().Fields().ByName("store")
-		case *SnapshotItem_Iavl:
-			return x.Descriptor().Fields().ByName("iavl")
-		case *SnapshotItem_Extension:
-			return x.Descriptor().Fields().ByName("extension")
-		case *SnapshotItem_ExtensionPayload:
-			return x.Descriptor().Fields().ByName("extension_payload")
-		}
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotItem", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotSection", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1544,7 +1583,7 @@ func (x *fastReflection_SnapshotItem) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SnapshotItem) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SnapshotSection) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1555,7 +1594,7 @@ func (x *fastReflection_SnapshotItem) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotItem) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SnapshotSection) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1567,7 +1606,7 @@ func (x *fastReflection_SnapshotItem) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SnapshotItem) IsValid() bool {
+func (x *fastReflection_SnapshotSection) IsValid() bool {
 	return x != nil
 }
 
@@ -1577,9 +1616,9 @@ func (x *fastReflection_SnapshotItem) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SnapshotSection) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SnapshotItem)
+		x := input.Message.Interface().(*SnapshotSection)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1591,30 +1630,15 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		switch x := x.Item.(type) {
-		case *SnapshotItem_Store:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.Store)
-			n += 1 + l + runtime.Sov(uint64(l))
-		case *SnapshotItem_Iavl:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.Iavl)
-			n += 1 + l + runtime.Sov(uint64(l))
-		case *SnapshotItem_Extension:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.Extension)
+		l = len(x.Name)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
-		case *SnapshotItem_ExtensionPayload:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.ExtensionPayload)
+		}
+		if x.Chunks != 0 {
+			n += 1 + runtime.Sov(uint64(x.Chunks))
+		}
+		l = len(x.Hash)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -1627,7 +1651,7 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotItem)
+		x := input.Message.Interface().(*SnapshotSection)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1646,59 +1670,24 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		switch x := x.Item.(type) {
-		case *SnapshotItem_Store:
-			encoded, err := options.Marshal(x.Store)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		case *SnapshotItem_Iavl:
-			encoded, err := options.Marshal(x.Iavl)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		case *SnapshotItem_Extension:
-			encoded, err := options.Marshal(x.Extension)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
 			i--
 			dAtA[i] = 0x1a
-		case *SnapshotItem_ExtensionPayload:
-			encoded, err := options.Marshal(x.ExtensionPayload)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if x.Chunks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Chunks))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x10
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1711,7 +1700,7 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotItem)
+		x := input.Message.Interface().(*SnapshotSection)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1743,17 +1732,17 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotItem: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotSection: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotItem: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotSection: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1763,31 +1752,1040 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v := &SnapshotStoreItem{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.Item = &SnapshotItem_Store{v}
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Iavl", wireType)
-				}
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+				}
+				x.Chunks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Chunks |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = append(x.Hash[:0], dAtA[iNdEx:postIndex]...)
+				if x.Hash == nil {
+					x.Hash = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SnapshotItem                   protoreflect.MessageDescriptor
+	fd_SnapshotItem_store             protoreflect.FieldDescriptor
+	fd_SnapshotItem_iavl              protoreflect.FieldDescriptor
+	fd_SnapshotItem_extension         protoreflect.FieldDescriptor
+	fd_SnapshotItem_extension_payload protoreflect.FieldDescriptor
+	fd_SnapshotItem_change            protoreflect.FieldDescriptor
+	fd_SnapshotItem_store_hash        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_store_snapshots_v1_snapshot_proto_init()
+	md_SnapshotItem = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotItem")
+	fd_SnapshotItem_store = md_SnapshotItem.Fields().ByName("store")
+	fd_SnapshotItem_iavl = md_SnapshotItem.Fields().ByName("iavl")
+	fd_SnapshotItem_extension = md_SnapshotItem.Fields().ByName("extension")
+	fd_SnapshotItem_extension_payload = md_SnapshotItem.Fields().ByName("extension_payload")
+	fd_SnapshotItem_change = md_SnapshotItem.Fields().ByName("change")
+	fd_SnapshotItem_store_hash = md_SnapshotItem.Fields().ByName("store_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_SnapshotItem)(nil)
+
+type fastReflection_SnapshotItem SnapshotItem
+
+func (x *SnapshotItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotItem)(x)
+}
+
+func (x *SnapshotItem) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SnapshotItem_messageType fastReflection_SnapshotItem_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotItem_messageType{}
+
+type fastReflection_SnapshotItem_messageType struct{}
+
+func (x fastReflection_SnapshotItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotItem)(nil)
+}
+func (x fastReflection_SnapshotItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotItem)
+}
+func (x fastReflection_SnapshotItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotItem
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SnapshotItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotItem
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SnapshotItem) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotItem_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SnapshotItem) New() protoreflect.Message {
+	return new(fastReflection_SnapshotItem)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SnapshotItem) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotItem)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SnapshotItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Item != nil {
+		switch o := x.Item.(type) {
+		case *SnapshotItem_Store:
+			v := o.Store
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_store, value) {
+				return
+			}
+		case *SnapshotItem_Iavl:
+			v := o.Iavl
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_iavl, value) {
+				return
+			}
+		case *SnapshotItem_Extension:
+			v := o.Extension
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_extension, value) {
+				return
+			}
+		case *SnapshotItem_ExtensionPayload:
+			v := o.ExtensionPayload
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_extension_payload, value) {
+				return
+			}
+		case *SnapshotItem_Change:
+			v := o.Change
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_change, value) {
+				return
+			}
+		case *SnapshotItem_StoreHash:
+			v := o.StoreHash
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_store_hash, value) {
+				return
+			}
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SnapshotItem) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotItem.store":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_Store); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_Iavl); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.extension":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_Extension); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_ExtensionPayload); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.change":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_Change); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.store_hash":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_StoreHash); ok {
+			return true
+		} else {
+			return false
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotItem) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotItem.store":
+		x.Item = nil
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl":
+		x.Item = nil
+	case "cosmos.store.snapshots.v1.SnapshotItem.extension":
+		x.Item = nil
+	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
+		x.Item = nil
+	case "cosmos.store.snapshots.v1.SnapshotItem.change":
+		x.Item = nil
+	case "cosmos.store.snapshots.v1.SnapshotItem.store_hash":
+		x.Item = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SnapshotItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotItem.store":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotStoreItem)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_Store); ok {
+			return protoreflect.ValueOfMessage(v.Store.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotStoreItem)(nil).ProtoReflect())
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotIAVLItem)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_Iavl); ok {
+			return protoreflect.ValueOfMessage(v.Iavl.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotIAVLItem)(nil).ProtoReflect())
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.extension":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotExtensionMeta)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_Extension); ok {
+			return protoreflect.ValueOfMessage(v.Extension.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotExtensionMeta)(nil).ProtoReflect())
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotExtensionPayload)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_ExtensionPayload); ok {
+			return protoreflect.ValueOfMessage(v.ExtensionPayload.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotExtensionPayload)(nil).ProtoReflect())
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.change":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotChangeItem)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_Change); ok {
+			return protoreflect.ValueOfMessage(v.Change.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotChangeItem)(nil).ProtoReflect())
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.store_hash":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotStoreHashItem)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_StoreHash); ok {
+			return protoreflect.ValueOfMessage(v.StoreHash.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotStoreHashItem)(nil).ProtoReflect())
+		}
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotItem does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotItem.store":
+		cv := value.Message().Interface().(*SnapshotStoreItem)
+		x.Item = &SnapshotItem_Store{Store: cv}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl":
+		cv := value.Message().Interface().(*SnapshotIAVLItem)
+		x.Item = &SnapshotItem_Iavl{Iavl: cv}
+	case "cosmos.store.snapshots.v1.SnapshotItem.extension":
+		cv := value.Message().Interface().(*SnapshotExtensionMeta)
+		x.Item = &SnapshotItem_Extension{Extension: cv}
+	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
+		cv := value.Message().Interface().(*SnapshotExtensionPayload)
+		x.Item = &SnapshotItem_ExtensionPayload{ExtensionPayload: cv}
+	case "cosmos.store.snapshots.v1.SnapshotItem.change":
+		cv := value.Message().Interface().(*SnapshotChangeItem)
+		x.Item = &SnapshotItem_Change{Change: cv}
+	case "cosmos.store.snapshots.v1.SnapshotItem.store_hash":
+		cv := value.Message().Interface().(*SnapshotStoreHashItem)
+		x.Item = &SnapshotItem_StoreHash{StoreHash: cv}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotItem.store":
+		if x.Item == nil {
+			value := &SnapshotStoreItem{}
+			oneofValue := &SnapshotItem_Store{Store: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_Store:
+			return protoreflect.ValueOfMessage(m.Store.ProtoReflect())
+		default:
+			value := &SnapshotStoreItem{}
+			oneofValue := &SnapshotItem_Store{Store: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl":
+		if x.Item == nil {
+			value := &SnapshotIAVLItem{}
+			oneofValue := &SnapshotItem_Iavl{Iavl: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_Iavl:
+			return protoreflect.ValueOfMessage(m.Iavl.ProtoReflect())
+		default:
+			value := &SnapshotIAVLItem{}
+			oneofValue := &SnapshotItem_Iavl{Iavl: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.extension":
+		if x.Item == nil {
+			value := &SnapshotExtensionMeta{}
+			oneofValue := &SnapshotItem_Extension{Extension: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_Extension:
+			return protoreflect.ValueOfMessage(m.Extension.ProtoReflect())
+		default:
+			value := &SnapshotExtensionMeta{}
+			oneofValue := &SnapshotItem_Extension{Extension: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
+		if x.Item == nil {
+			value := &SnapshotExtensionPayload{}
+			oneofValue := &SnapshotItem_ExtensionPayload{ExtensionPayload: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_ExtensionPayload:
+			return protoreflect.ValueOfMessage(m.ExtensionPayload.ProtoReflect())
+		default:
+			value := &SnapshotExtensionPayload{}
+			oneofValue := &SnapshotItem_ExtensionPayload{ExtensionPayload: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.change":
+		if x.Item == nil {
+			value := &SnapshotChangeItem{}
+			oneofValue := &SnapshotItem_Change{Change: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_Change:
+			return protoreflect.ValueOfMessage(m.Change.ProtoReflect())
+		default:
+			value := &SnapshotChangeItem{}
+			oneofValue := &SnapshotItem_Change{Change: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.store_hash":
+		if x.Item == nil {
+			value := &SnapshotStoreHashItem{}
+			oneofValue := &SnapshotItem_StoreHash{StoreHash: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_StoreHash:
+			return protoreflect.ValueOfMessage(m.StoreHash.ProtoReflect())
+		default:
+			value := &SnapshotStoreHashItem{}
+			oneofValue := &SnapshotItem_StoreHash{StoreHash: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SnapshotItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotItem.store":
+		value := &SnapshotStoreItem{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl":
+		value := &SnapshotIAVLItem{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.snapshots.v1.SnapshotItem.extension":
+		value := &SnapshotExtensionMeta{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
+		value := &SnapshotExtensionPayload{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.snapshots.v1.SnapshotItem.change":
+		value := &SnapshotChangeItem{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.snapshots.v1.SnapshotItem.store_hash":
+		value := &SnapshotStoreHashItem{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SnapshotItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotItem.item":
+		if x.Item == nil {
+			return nil
+		}
+		switch x.Item.(type) {
+		case *SnapshotItem_Store:
+			return x.Descriptor().Fields().ByName("store")
+		case *SnapshotItem_Iavl:
+			return x.Descriptor().Fields().ByName("iavl")
+		case *SnapshotItem_Extension:
+			return x.Descriptor().Fields().ByName("extension")
+		case *SnapshotItem_ExtensionPayload:
+			return x.Descriptor().Fields().ByName("extension_payload")
+		case *SnapshotItem_Change:
+			return x.Descriptor().Fields().ByName("change")
+		case *SnapshotItem_StoreHash:
+			return x.Descriptor().Fields().ByName("store_hash")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotItem", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SnapshotItem) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotItem) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SnapshotItem) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SnapshotItem)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		switch x := x.Item.(type) {
+		case *SnapshotItem_Store:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Store)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_Iavl:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Iavl)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_Extension:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Extension)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_ExtensionPayload:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.ExtensionPayload)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_Change:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Change)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_StoreHash:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.StoreHash)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotItem)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.Item.(type) {
+		case *SnapshotItem_Store:
+			encoded, err := options.Marshal(x.Store)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		case *SnapshotItem_Iavl:
+			encoded, err := options.Marshal(x.Iavl)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		case *SnapshotItem_Extension:
+			encoded, err := options.Marshal(x.Extension)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		case *SnapshotItem_ExtensionPayload:
+			encoded, err := options.Marshal(x.ExtensionPayload)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		case *SnapshotItem_Change:
+			encoded, err := options.Marshal(x.Change)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		case *SnapshotItem_StoreHash:
+			encoded, err := options.Marshal(x.StoreHash)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotItem)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotItem: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotStoreItem{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_Store{v}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Iavl", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotIAVLItem{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_Iavl{v}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotExtensionMeta{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_Extension{v}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtensionPayload", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotExtensionPayload{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_ExtensionPayload{v}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotChangeItem{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_Change{v}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StoreHash", wireType)
+				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
@@ -1798,32 +2796,998 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotStoreHashItem{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_StoreHash{v}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SnapshotStoreItem      protoreflect.MessageDescriptor
+	fd_SnapshotStoreItem_name protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_store_snapshots_v1_snapshot_proto_init()
+	md_SnapshotStoreItem = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotStoreItem")
+	fd_SnapshotStoreItem_name = md_SnapshotStoreItem.Fields().ByName("name")
+}
+
+var _ protoreflect.Message = (*fastReflection_SnapshotStoreItem)(nil)
+
+type fastReflection_SnapshotStoreItem SnapshotStoreItem
+
+func (x *SnapshotStoreItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotStoreItem)(x)
+}
+
+func (x *SnapshotStoreItem) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SnapshotStoreItem_messageType fastReflection_SnapshotStoreItem_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotStoreItem_messageType{}
+
+type fastReflection_SnapshotStoreItem_messageType struct{}
+
+func (x fastReflection_SnapshotStoreItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotStoreItem)(nil)
+}
+func (x fastReflection_SnapshotStoreItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotStoreItem)
+}
+func (x fastReflection_SnapshotStoreItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotStoreItem
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SnapshotStoreItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotStoreItem
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SnapshotStoreItem) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotStoreItem_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SnapshotStoreItem) New() protoreflect.Message {
+	return new(fastReflection_SnapshotStoreItem)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SnapshotStoreItem) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotStoreItem)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SnapshotStoreItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_SnapshotStoreItem_name, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SnapshotStoreItem) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotStoreItem.name":
+		return x.Name != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotStoreItem) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotStoreItem.name":
+		x.Name = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SnapshotStoreItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotStoreItem.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreItem does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotStoreItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotStoreItem.name":
+		x.Name = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotStoreItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotStoreItem.name":
+		panic(fmt.Errorf("field name of message cosmos.store.snapshots.v1.SnapshotStoreItem is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SnapshotStoreItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotStoreItem.name":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SnapshotStoreItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotStoreItem", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SnapshotStoreItem) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotStoreItem) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SnapshotStoreItem) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SnapshotStoreItem)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotStoreItem)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotStoreItem)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreItem: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SnapshotIAVLItem         protoreflect.MessageDescriptor
+	fd_SnapshotIAVLItem_key     protoreflect.FieldDescriptor
+	fd_SnapshotIAVLItem_value   protoreflect.FieldDescriptor
+	fd_SnapshotIAVLItem_version protoreflect.FieldDescriptor
+	fd_SnapshotIAVLItem_height  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_store_snapshots_v1_snapshot_proto_init()
+	md_SnapshotIAVLItem = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotIAVLItem")
+	fd_SnapshotIAVLItem_key = md_SnapshotIAVLItem.Fields().ByName("key")
+	fd_SnapshotIAVLItem_value = md_SnapshotIAVLItem.Fields().ByName("value")
+	fd_SnapshotIAVLItem_version = md_SnapshotIAVLItem.Fields().ByName("version")
+	fd_SnapshotIAVLItem_height = md_SnapshotIAVLItem.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_SnapshotIAVLItem)(nil)
+
+type fastReflection_SnapshotIAVLItem SnapshotIAVLItem
+
+func (x *SnapshotIAVLItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotIAVLItem)(x)
+}
+
+func (x *SnapshotIAVLItem) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SnapshotIAVLItem_messageType fastReflection_SnapshotIAVLItem_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotIAVLItem_messageType{}
+
+type fastReflection_SnapshotIAVLItem_messageType struct{}
+
+func (x fastReflection_SnapshotIAVLItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotIAVLItem)(nil)
+}
+func (x fastReflection_SnapshotIAVLItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotIAVLItem)
+}
+func (x fastReflection_SnapshotIAVLItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotIAVLItem
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SnapshotIAVLItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotIAVLItem
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SnapshotIAVLItem) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotIAVLItem_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SnapshotIAVLItem) New() protoreflect.Message {
+	return new(fastReflection_SnapshotIAVLItem)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SnapshotIAVLItem) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotIAVLItem)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SnapshotIAVLItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Key) != 0 {
+		value := protoreflect.ValueOfBytes(x.Key)
+		if !f(fd_SnapshotIAVLItem_key, value) {
+			return
+		}
+	}
+	if len(x.Value) != 0 {
+		value := protoreflect.ValueOfBytes(x.Value)
+		if !f(fd_SnapshotIAVLItem_value, value) {
+			return
+		}
+	}
+	if x.Version != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Version)
+		if !f(fd_SnapshotIAVLItem_version, value) {
+			return
+		}
+	}
+	if x.Height != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Height)
+		if !f(fd_SnapshotIAVLItem_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SnapshotIAVLItem) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.key":
+		return len(x.Key) != 0
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.value":
+		return len(x.Value) != 0
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.version":
+		return x.Version != int64(0)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.height":
+		return x.Height != int32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotIAVLItem) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.key":
+		x.Key = nil
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.value":
+		x.Value = nil
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.version":
+		x.Version = int64(0)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.height":
+		x.Height = int32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SnapshotIAVLItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.key":
+		value := x.Key
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.value":
+		value := x.Value
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.version":
+		value := x.Version
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.height":
+		value := x.Height
+		return protoreflect.ValueOfInt32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLItem does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotIAVLItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.key":
+		x.Key = value.Bytes()
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.value":
+		x.Value = value.Bytes()
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.version":
+		x.Version = value.Int()
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.height":
+		x.Height = int32(value.Int())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotIAVLItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.key":
+		panic(fmt.Errorf("field key of message cosmos.store.snapshots.v1.SnapshotIAVLItem is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.value":
+		panic(fmt.Errorf("field value of message cosmos.store.snapshots.v1.SnapshotIAVLItem is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.version":
+		panic(fmt.Errorf("field version of message cosmos.store.snapshots.v1.SnapshotIAVLItem is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.height":
+		panic(fmt.Errorf("field height of message cosmos.store.snapshots.v1.SnapshotIAVLItem is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SnapshotIAVLItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.key":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.value":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.version":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.height":
+		return protoreflect.ValueOfInt32(int32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SnapshotIAVLItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotIAVLItem", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SnapshotIAVLItem) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotIAVLItem) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SnapshotIAVLItem) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SnapshotIAVLItem)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Value)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotIAVLItem)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotIAVLItem)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLItem: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Key = append(x.Key[:0], dAtA[iNdEx:postIndex]...)
+				if x.Key == nil {
+					x.Key = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v := &SnapshotIAVLItem{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Value = append(x.Value[:0], dAtA[iNdEx:postIndex]...)
+				if x.Value == nil {
+					x.Value = []byte{}
 				}
-				x.Item = &SnapshotItem_Iavl{v}
 				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 				}
-				var msglen int
+				x.Version = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1833,32 +3797,16 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Version |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				v := &SnapshotExtensionMeta{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.Item = &SnapshotItem_Extension{v}
-				iNdEx = postIndex
 			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtensionPayload", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				var msglen int
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1868,27 +3816,11 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Height |= int32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				v := &SnapshotExtensionPayload{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.Item = &SnapshotItem_ExtensionPayload{v}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1925,26 +3857,32 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SnapshotStoreItem      protoreflect.MessageDescriptor
-	fd_SnapshotStoreItem_name protoreflect.FieldDescriptor
+	md_SnapshotChangeItem         protoreflect.MessageDescriptor
+	fd_SnapshotChangeItem_version protoreflect.FieldDescriptor
+	fd_SnapshotChangeItem_key     protoreflect.FieldDescriptor
+	fd_SnapshotChangeItem_value   protoreflect.FieldDescriptor
+	fd_SnapshotChangeItem_delete  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_store_snapshots_v1_snapshot_proto_init()
-	md_SnapshotStoreItem = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotStoreItem")
-	fd_SnapshotStoreItem_name = md_SnapshotStoreItem.Fields().ByName("name")
+	md_SnapshotChangeItem = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotChangeItem")
+	fd_SnapshotChangeItem_version = md_SnapshotChangeItem.Fields().ByName("version")
+	fd_SnapshotChangeItem_key = md_SnapshotChangeItem.Fields().ByName("key")
+	fd_SnapshotChangeItem_value = md_SnapshotChangeItem.Fields().ByName("value")
+	fd_SnapshotChangeItem_delete = md_SnapshotChangeItem.Fields().ByName("delete")
 }
 
-var _ protoreflect.Message = (*fastReflection_SnapshotStoreItem)(nil)
+var _ protoreflect.Message = (*fastReflection_SnapshotChangeItem)(nil)
 
-type fastReflection_SnapshotStoreItem SnapshotStoreItem
+type fastReflection_SnapshotChangeItem SnapshotChangeItem
 
-func (x *SnapshotStoreItem) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SnapshotStoreItem)(x)
+func (x *SnapshotChangeItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotChangeItem)(x)
 }
 
-func (x *SnapshotStoreItem) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[3]
+func (x *SnapshotChangeItem) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1955,43 +3893,43 @@ func (x *SnapshotStoreItem) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SnapshotStoreItem_messageType fastReflection_SnapshotStoreItem_messageType
-var _ protoreflect.MessageType = fastReflection_SnapshotStoreItem_messageType{}
+var _fastReflection_SnapshotChangeItem_messageType fastReflection_SnapshotChangeItem_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotChangeItem_messageType{}
 
-type fastReflection_SnapshotStoreItem_messageType struct{}
+type fastReflection_SnapshotChangeItem_messageType struct{}
 
-func (x fastReflection_SnapshotStoreItem_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SnapshotStoreItem)(nil)
+func (x fastReflection_SnapshotChangeItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotChangeItem)(nil)
 }
-func (x fastReflection_SnapshotStoreItem_messageType) New() protoreflect.Message {
-	return new(fastReflection_SnapshotStoreItem)
+func (x fastReflection_SnapshotChangeItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotChangeItem)
 }
-func (x fastReflection_SnapshotStoreItem_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotStoreItem
+func (x fastReflection_SnapshotChangeItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotChangeItem
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SnapshotStoreItem) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotStoreItem
+func (x *fastReflection_SnapshotChangeItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotChangeItem
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SnapshotStoreItem) Type() protoreflect.MessageType {
-	return _fastReflection_SnapshotStoreItem_messageType
+func (x *fastReflection_SnapshotChangeItem) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotChangeItem_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SnapshotStoreItem) New() protoreflect.Message {
-	return new(fastReflection_SnapshotStoreItem)
+func (x *fastReflection_SnapshotChangeItem) New() protoreflect.Message {
+	return new(fastReflection_SnapshotChangeItem)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SnapshotStoreItem) Interface() protoreflect.ProtoMessage {
-	return (*SnapshotStoreItem)(x)
+func (x *fastReflection_SnapshotChangeItem) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotChangeItem)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1999,10 +3937,28 @@ func (x *fastReflection_SnapshotStoreItem) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SnapshotStoreItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_SnapshotStoreItem_name, value) {
+func (x *fastReflection_SnapshotChangeItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Version != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Version)
+		if !f(fd_SnapshotChangeItem_version, value) {
+			return
+		}
+	}
+	if len(x.Key) != 0 {
+		value := protoreflect.ValueOfBytes(x.Key)
+		if !f(fd_SnapshotChangeItem_key, value) {
+			return
+		}
+	}
+	if len(x.Value) != 0 {
+		value := protoreflect.ValueOfBytes(x.Value)
+		if !f(fd_SnapshotChangeItem_value, value) {
+			return
+		}
+	}
+	if x.Delete != false {
+		value := protoreflect.ValueOfBool(x.Delete)
+		if !f(fd_SnapshotChangeItem_delete, value) {
 			return
 		}
 	}
@@ -2019,15 +3975,21 @@ func (x *fastReflection_SnapshotStoreItem) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SnapshotStoreItem) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SnapshotChangeItem) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotStoreItem.name":
-		return x.Name != ""
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.version":
+		return x.Version != int64(0)
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.key":
+		return len(x.Key) != 0
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.value":
+		return len(x.Value) != 0
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.delete":
+		return x.Delete != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotChangeItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotChangeItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2037,15 +3999,21 @@ func (x *fastReflection_SnapshotStoreItem) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotStoreItem) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SnapshotChangeItem) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotStoreItem.name":
-		x.Name = ""
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.version":
+		x.Version = int64(0)
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.key":
+		x.Key = nil
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.value":
+		x.Value = nil
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.delete":
+		x.Delete = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotChangeItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotChangeItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2055,16 +4023,25 @@ func (x *fastReflection_SnapshotStoreItem) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SnapshotStoreItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotChangeItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotStoreItem.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.version":
+		value := x.Version
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.key":
+		value := x.Key
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.value":
+		value := x.Value
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.delete":
+		value := x.Delete
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotChangeItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreItem does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotChangeItem does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2078,15 +4055,21 @@ func (x *fastReflection_SnapshotStoreItem) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotStoreItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SnapshotChangeItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotStoreItem.name":
-		x.Name = value.Interface().(string)
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.version":
+		x.Version = value.Int()
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.key":
+		x.Key = value.Bytes()
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.value":
+		x.Value = value.Bytes()
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.delete":
+		x.Delete = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotChangeItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotChangeItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2100,40 +4083,52 @@ func (x *fastReflection_SnapshotStoreItem) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotStoreItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotChangeItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotStoreItem.name":
-		panic(fmt.Errorf("field name of message cosmos.store.snapshots.v1.SnapshotStoreItem is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.version":
+		panic(fmt.Errorf("field version of message cosmos.store.snapshots.v1.SnapshotChangeItem is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.key":
+		panic(fmt.Errorf("field key of message cosmos.store.snapshots.v1.SnapshotChangeItem is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.value":
+		panic(fmt.Errorf("field value of message cosmos.store.snapshots.v1.SnapshotChangeItem is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.delete":
+		panic(fmt.Errorf("field delete of message cosmos.store.snapshots.v1.SnapshotChangeItem is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotChangeItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotChangeItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SnapshotStoreItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotChangeItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotStoreItem.name":
-		return protoreflect.ValueOfString("")
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.version":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.key":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.value":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.store.snapshots.v1.SnapshotChangeItem.delete":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotChangeItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotChangeItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SnapshotStoreItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SnapshotChangeItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotStoreItem", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotChangeItem", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2141,7 +4136,7 @@ func (x *fastReflection_SnapshotStoreItem) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SnapshotStoreItem) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SnapshotChangeItem) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2152,7 +4147,7 @@ func (x *fastReflection_SnapshotStoreItem) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotStoreItem) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SnapshotChangeItem) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2164,7 +4159,7 @@ func (x *fastReflection_SnapshotStoreItem) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SnapshotStoreItem) IsValid() bool {
+func (x *fastReflection_SnapshotChangeItem) IsValid() bool {
 	return x != nil
 }
 
@@ -2174,9 +4169,9 @@ func (x *fastReflection_SnapshotStoreItem) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SnapshotChangeItem) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SnapshotStoreItem)
+		x := input.Message.Interface().(*SnapshotChangeItem)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2188,10 +4183,20 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Name)
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Value)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Delete {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2202,7 +4207,7 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotStoreItem)
+		x := input.Message.Interface().(*SnapshotChangeItem)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2221,12 +4226,34 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		if x.Delete {
 			i--
-			dAtA[i] = 0xa
+			if x.Delete {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2239,7 +4266,7 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotStoreItem)
+		x := input.Message.Interface().(*SnapshotChangeItem)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2271,17 +4298,70 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreItem: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotChangeItem: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreItem: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotChangeItem: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Key = append(x.Key[:0], dAtA[iNdEx:postIndex]...)
+				if x.Key == nil {
+					x.Key = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2291,24 +4371,46 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Name = string(dAtA[iNdEx:postIndex])
+				x.Value = append(x.Value[:0], dAtA[iNdEx:postIndex]...)
+				if x.Value == nil {
+					x.Value = []byte{}
+				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Delete = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2345,32 +4447,26 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SnapshotIAVLItem         protoreflect.MessageDescriptor
-	fd_SnapshotIAVLItem_key     protoreflect.FieldDescriptor
-	fd_SnapshotIAVLItem_value   protoreflect.FieldDescriptor
-	fd_SnapshotIAVLItem_version protoreflect.FieldDescriptor
-	fd_SnapshotIAVLItem_height  protoreflect.FieldDescriptor
+	md_SnapshotStoreHashItem      protoreflect.MessageDescriptor
+	fd_SnapshotStoreHashItem_hash protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_store_snapshots_v1_snapshot_proto_init()
-	md_SnapshotIAVLItem = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotIAVLItem")
-	fd_SnapshotIAVLItem_key = md_SnapshotIAVLItem.Fields().ByName("key")
-	fd_SnapshotIAVLItem_value = md_SnapshotIAVLItem.Fields().ByName("value")
-	fd_SnapshotIAVLItem_version = md_SnapshotIAVLItem.Fields().ByName("version")
-	fd_SnapshotIAVLItem_height = md_SnapshotIAVLItem.Fields().ByName("height")
+	md_SnapshotStoreHashItem = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotStoreHashItem")
+	fd_SnapshotStoreHashItem_hash = md_SnapshotStoreHashItem.Fields().ByName("hash")
 }
 
-var _ protoreflect.Message = (*fastReflection_SnapshotIAVLItem)(nil)
+var _ protoreflect.Message = (*fastReflection_SnapshotStoreHashItem)(nil)
 
-type fastReflection_SnapshotIAVLItem SnapshotIAVLItem
+type fastReflection_SnapshotStoreHashItem SnapshotStoreHashItem
 
-func (x *SnapshotIAVLItem) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SnapshotIAVLItem)(x)
+func (x *SnapshotStoreHashItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotStoreHashItem)(x)
 }
 
-func (x *SnapshotIAVLItem) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[4]
+func (x *SnapshotStoreHashItem) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2381,43 +4477,43 @@ func (x *SnapshotIAVLItem) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SnapshotIAVLItem_messageType fastReflection_SnapshotIAVLItem_messageType
-var _ protoreflect.MessageType = fastReflection_SnapshotIAVLItem_messageType{}
+var _fastReflection_SnapshotStoreHashItem_messageType fastReflection_SnapshotStoreHashItem_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotStoreHashItem_messageType{}
 
-type fastReflection_SnapshotIAVLItem_messageType struct{}
+type fastReflection_SnapshotStoreHashItem_messageType struct{}
 
-func (x fastReflection_SnapshotIAVLItem_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SnapshotIAVLItem)(nil)
+func (x fastReflection_SnapshotStoreHashItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotStoreHashItem)(nil)
 }
-func (x fastReflection_SnapshotIAVLItem_messageType) New() protoreflect.Message {
-	return new(fastReflection_SnapshotIAVLItem)
+func (x fastReflection_SnapshotStoreHashItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotStoreHashItem)
 }
-func (x fastReflection_SnapshotIAVLItem_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotIAVLItem
+func (x fastReflection_SnapshotStoreHashItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotStoreHashItem
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SnapshotIAVLItem) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotIAVLItem
+func (x *fastReflection_SnapshotStoreHashItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotStoreHashItem
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SnapshotIAVLItem) Type() protoreflect.MessageType {
-	return _fastReflection_SnapshotIAVLItem_messageType
+func (x *fastReflection_SnapshotStoreHashItem) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotStoreHashItem_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SnapshotIAVLItem) New() protoreflect.Message {
-	return new(fastReflection_SnapshotIAVLItem)
+func (x *fastReflection_SnapshotStoreHashItem) New() protoreflect.Message {
+	return new(fastReflection_SnapshotStoreHashItem)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SnapshotIAVLItem) Interface() protoreflect.ProtoMessage {
-	return (*SnapshotIAVLItem)(x)
+func (x *fastReflection_SnapshotStoreHashItem) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotStoreHashItem)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2425,28 +4521,10 @@ func (x *fastReflection_SnapshotIAVLItem) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SnapshotIAVLItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Key) != 0 {
-		value := protoreflect.ValueOfBytes(x.Key)
-		if !f(fd_SnapshotIAVLItem_key, value) {
-			return
-		}
-	}
-	if len(x.Value) != 0 {
-		value := protoreflect.ValueOfBytes(x.Value)
-		if !f(fd_SnapshotIAVLItem_value, value) {
-			return
-		}
-	}
-	if x.Version != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Version)
-		if !f(fd_SnapshotIAVLItem_version, value) {
-			return
-		}
-	}
-	if x.Height != int32(0) {
-		value := protoreflect.ValueOfInt32(x.Height)
-		if !f(fd_SnapshotIAVLItem_height, value) {
+func (x *fastReflection_SnapshotStoreHashItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Hash) != 0 {
+		value := protoreflect.ValueOfBytes(x.Hash)
+		if !f(fd_SnapshotStoreHashItem_hash, value) {
 			return
 		}
 	}
@@ -2463,21 +4541,15 @@ func (x *fastReflection_SnapshotIAVLItem) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SnapshotIAVLItem) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SnapshotStoreHashItem) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.key":
-		return len(x.Key) != 0
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.value":
-		return len(x.Value) != 0
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.version":
-		return x.Version != int64(0)
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.height":
-		return x.Height != int32(0)
+	case "cosmos.store.snapshots.v1.SnapshotStoreHashItem.hash":
+		return len(x.Hash) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreHashItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreHashItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2487,21 +4559,15 @@ func (x *fastReflection_SnapshotIAVLItem) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotIAVLItem) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SnapshotStoreHashItem) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.key":
-		x.Key = nil
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.value":
-		x.Value = nil
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.version":
-		x.Version = int64(0)
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.height":
-		x.Height = int32(0)
+	case "cosmos.store.snapshots.v1.SnapshotStoreHashItem.hash":
+		x.Hash = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreHashItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreHashItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2511,25 +4577,16 @@ func (x *fastReflection_SnapshotIAVLItem) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SnapshotIAVLItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotStoreHashItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.key":
-		value := x.Key
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.value":
-		value := x.Value
+	case "cosmos.store.snapshots.v1.SnapshotStoreHashItem.hash":
+		value := x.Hash
 		return protoreflect.ValueOfBytes(value)
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.version":
-		value := x.Version
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.height":
-		value := x.Height
-		return protoreflect.ValueOfInt32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreHashItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLItem does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreHashItem does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2543,21 +4600,15 @@ func (x *fastReflection_SnapshotIAVLItem) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotIAVLItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SnapshotStoreHashItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.key":
-		x.Key = value.Bytes()
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.value":
-		x.Value = value.Bytes()
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.version":
-		x.Version = value.Int()
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.height":
-		x.Height = int32(value.Int())
+	case "cosmos.store.snapshots.v1.SnapshotStoreHashItem.hash":
+		x.Hash = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreHashItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreHashItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2571,52 +4622,40 @@ func (x *fastReflection_SnapshotIAVLItem) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotIAVLItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotStoreHashItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.key":
-		panic(fmt.Errorf("field key of message cosmos.store.snapshots.v1.SnapshotIAVLItem is not mutable"))
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.value":
-		panic(fmt.Errorf("field value of message cosmos.store.snapshots.v1.SnapshotIAVLItem is not mutable"))
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.version":
-		panic(fmt.Errorf("field version of message cosmos.store.snapshots.v1.SnapshotIAVLItem is not mutable"))
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.height":
-		panic(fmt.Errorf("field height of message cosmos.store.snapshots.v1.SnapshotIAVLItem is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotStoreHashItem.hash":
+		panic(fmt.Errorf("field hash of message cosmos.store.snapshots.v1.SnapshotStoreHashItem is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreHashItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreHashItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SnapshotIAVLItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotStoreHashItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.key":
+	case "cosmos.store.snapshots.v1.SnapshotStoreHashItem.hash":
 		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.value":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.version":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.store.snapshots.v1.SnapshotIAVLItem.height":
-		return protoreflect.ValueOfInt32(int32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotStoreHashItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotStoreHashItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SnapshotIAVLItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SnapshotStoreHashItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotIAVLItem", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotStoreHashItem", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2624,7 +4663,7 @@ func (x *fastReflection_SnapshotIAVLItem) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SnapshotIAVLItem) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SnapshotStoreHashItem) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2635,7 +4674,7 @@ func (x *fastReflection_SnapshotIAVLItem) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotIAVLItem) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SnapshotStoreHashItem) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2647,7 +4686,7 @@ func (x *fastReflection_SnapshotIAVLItem) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SnapshotIAVLItem) IsValid() bool {
+func (x *fastReflection_SnapshotStoreHashItem) IsValid() bool {
 	return x != nil
 }
 
@@ -2657,9 +4696,9 @@ func (x *fastReflection_SnapshotIAVLItem) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SnapshotStoreHashItem) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SnapshotIAVLItem)
+		x := input.Message.Interface().(*SnapshotStoreHashItem)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2671,20 +4710,10 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Key)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Value)
+		l = len(x.Hash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Version != 0 {
-			n += 1 + runtime.Sov(uint64(x.Version))
-		}
-		if x.Height != 0 {
-			n += 1 + runtime.Sov(uint64(x.Height))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2695,7 +4724,7 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotIAVLItem)
+		x := input.Message.Interface().(*SnapshotStoreHashItem)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2714,27 +4743,10 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Height != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.Version != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Value) > 0 {
-			i -= len(x.Value)
-			copy(dAtA[i:], x.Value)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Key) > 0 {
-			i -= len(x.Key)
-			copy(dAtA[i:], x.Key)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2749,7 +4761,7 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotIAVLItem)
+		x := input.Message.Interface().(*SnapshotStoreHashItem)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2768,62 +4780,28 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLItem: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLItem: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Key = append(x.Key[:0], dAtA[iNdEx:postIndex]...)
-				if x.Key == nil {
-					x.Key = []byte{}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 2:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreHashItem: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreHashItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -2850,49 +4828,11 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Value = append(x.Value[:0], dAtA[iNdEx:postIndex]...)
-				if x.Value == nil {
-					x.Value = []byte{}
+				x.Hash = append(x.Hash[:0], dAtA[iNdEx:postIndex]...)
+				if x.Hash == nil {
+					x.Hash = []byte{}
 				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-				}
-				x.Version = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Version |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-				}
-				x.Height = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Height |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2950,7 +4890,7 @@ func (x *SnapshotExtensionMeta) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotExtensionMeta) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5]
+	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3416,7 +5356,7 @@ func (x *SnapshotExtensionPayload) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotExtensionPayload) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
+	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3906,6 +5846,12 @@ type Metadata struct {
 	unknownFields protoimpl.UnknownFields
 
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"` // SHA-256 chunk hashes
+	// sections are the sections of the chunks, in order, with the snapshot
+	// formats made of independently restorable sections.
+	Sections []*SnapshotSection `protobuf:"bytes,2,rep,name=sections,proto3" json:"sections,omitempty"`
+	// base_height is the height of the snapshot an incremental snapshot applies
+	// to, the incremental snapshots holding only the changes since this height.
+	BaseHeight uint64 `protobuf:"varint,3,opt,name=base_height,json=baseHeight,proto3" json:"base_height,omitempty"`
 }
 
 func (x *Metadata) Reset() {
@@ -3935,6 +5881,78 @@ func (x *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (x *Metadata) GetSections() []*SnapshotSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *Metadata) GetBaseHeight() uint64 {
+	if x != nil {
+		return x.BaseHeight
+	}
+	return 0
+}
+
+// SnapshotSection describes a section of a snapshot, made of consecutive chunks
+// holding an independent stream of snapshot items.
+type SnapshotSection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the store of the section, or empty for the section of
+	// the extension snapshotters.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// chunks is the number of chunks of the section.
+	Chunks uint32 `protobuf:"varint,2,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	// hash is the SHA-256 hash of the concatenated SHA-256 hashes of the chunks
+	// of the section.
+	Hash []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *SnapshotSection) Reset() {
+	*x = SnapshotSection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotSection) ProtoMessage() {}
+
+// Deprecated: Use SnapshotSection.ProtoReflect.Descriptor instead.
+func (*SnapshotSection) Descriptor() ([]byte, []int) {
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SnapshotSection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SnapshotSection) GetChunks() uint32 {
+	if x != nil {
+		return x.Chunks
+	}
+	return 0
+}
+
+func (x *SnapshotSection) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 //
 // Since: cosmos-sdk 0.46
@@ -3946,18 +5964,19 @@ type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are assignable to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_Iavl
 	//	*SnapshotItem_Extension
 	//	*SnapshotItem_ExtensionPayload
+	//	*SnapshotItem_Change
+	//	*SnapshotItem_StoreHash
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
 func (x *SnapshotItem) Reset() {
 	*x = SnapshotItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[2]
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3971,7 +5990,7 @@ func (*SnapshotItem) ProtoMessage() {}
 
 // Deprecated: Use SnapshotItem.ProtoReflect.Descriptor instead.
 func (*SnapshotItem) Descriptor() ([]byte, []int) {
-	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SnapshotItem) GetItem() isSnapshotItem_Item {
@@ -4009,6 +6028,20 @@ func (x *SnapshotItem) GetExtensionPayload() *SnapshotExtensionPayload {
 	return nil
 }
 
+func (x *SnapshotItem) GetChange() *SnapshotChangeItem {
+	if x, ok := x.GetItem().(*SnapshotItem_Change); ok {
+		return x.Change
+	}
+	return nil
+}
+
+func (x *SnapshotItem) GetStoreHash() *SnapshotStoreHashItem {
+	if x, ok := x.GetItem().(*SnapshotItem_StoreHash); ok {
+		return x.StoreHash
+	}
+	return nil
+}
+
 type isSnapshotItem_Item interface {
 	isSnapshotItem_Item()
 }
@@ -4029,6 +6062,14 @@ type SnapshotItem_ExtensionPayload struct {
 	ExtensionPayload *SnapshotExtensionPayload `protobuf:"bytes,4,opt,name=extension_payload,json=extensionPayload,proto3,oneof"`
 }
 
+type SnapshotItem_Change struct {
+	Change *SnapshotChangeItem `protobuf:"bytes,7,opt,name=change,proto3,oneof"`
+}
+
+type SnapshotItem_StoreHash struct {
+	StoreHash *SnapshotStoreHashItem `protobuf:"bytes,8,opt,name=store_hash,json=storeHash,proto3,oneof"`
+}
+
 func (*SnapshotItem_Store) isSnapshotItem_Item() {}
 
 func (*SnapshotItem_Iavl) isSnapshotItem_Item() {}
@@ -4037,6 +6078,10 @@ func (*SnapshotItem_Extension) isSnapshotItem_Item() {}
 
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
 
+func (*SnapshotItem_Change) isSnapshotItem_Item() {}
+
+func (*SnapshotItem_StoreHash) isSnapshotItem_Item() {}
+
 // SnapshotStoreItem contains metadata about a snapshotted store.
 //
 // Since: cosmos-sdk 0.46
@@ -4051,7 +6096,7 @@ type SnapshotStoreItem struct {
 func (x *SnapshotStoreItem) Reset() {
 	*x = SnapshotStoreItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[3]
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4065,7 +6110,7 @@ func (*SnapshotStoreItem) ProtoMessage() {}
 
 // Deprecated: Use SnapshotStoreItem.ProtoReflect.Descriptor instead.
 func (*SnapshotStoreItem) Descriptor() ([]byte, []int) {
-	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{3}
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SnapshotStoreItem) GetName() string {
@@ -4094,7 +6139,7 @@ type SnapshotIAVLItem struct {
 func (x *SnapshotIAVLItem) Reset() {
 	*x = SnapshotIAVLItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[4]
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4108,7 +6153,7 @@ func (*SnapshotIAVLItem) ProtoMessage() {}
 
 // Deprecated: Use SnapshotIAVLItem.ProtoReflect.Descriptor instead.
 func (*SnapshotIAVLItem) Descriptor() ([]byte, []int) {
-	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SnapshotIAVLItem) GetKey() []byte {
@@ -4139,6 +6184,104 @@ func (x *SnapshotIAVLItem) GetHeight() int32 {
 	return 0
 }
 
+// SnapshotChangeItem is a change of a store at a version, in the incremental
+// snapshots.
+type SnapshotChangeItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version int64  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Key     []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value   []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Delete  bool   `protobuf:"varint,4,opt,name=delete,proto3" json:"delete,omitempty"`
+}
+
+func (x *SnapshotChangeItem) Reset() {
+	*x = SnapshotChangeItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotChangeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotChangeItem) ProtoMessage() {}
+
+// Deprecated: Use SnapshotChangeItem.ProtoReflect.Descriptor instead.
+func (*SnapshotChangeItem) Descriptor() ([]byte, []int) {
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SnapshotChangeItem) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SnapshotChangeItem) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *SnapshotChangeItem) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SnapshotChangeItem) GetDelete() bool {
+	if x != nil {
+		return x.Delete
+	}
+	return false
+}
+
+// SnapshotStoreHashItem ends the changes of a store in the incremental
+// snapshots, with the hash of the store at the snapshot height.
+type SnapshotStoreHashItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *SnapshotStoreHashItem) Reset() {
+	*x = SnapshotStoreHashItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotStoreHashItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotStoreHashItem) ProtoMessage() {}
+
+// Deprecated: Use SnapshotStoreHashItem.ProtoReflect.Descriptor instead.
+func (*SnapshotStoreHashItem) Descriptor() ([]byte, []int) {
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SnapshotStoreHashItem) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
 // SnapshotExtensionMeta contains metadata about an external snapshotter.
 //
 // Since: cosmos-sdk 0.46
@@ -4154,7 +6297,7 @@ type SnapshotExtensionMeta struct {
 func (x *SnapshotExtensionMeta) Reset() {
 	*x = SnapshotExtensionMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5]
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4168,7 +6311,7 @@ func (*SnapshotExtensionMeta) ProtoMessage() {}
 
 // Deprecated: Use SnapshotExtensionMeta.ProtoReflect.Descriptor instead.
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SnapshotExtensionMeta) GetName() string {
@@ -4199,7 +6342,7 @@ type SnapshotExtensionPayload struct {
 func (x *SnapshotExtensionPayload) Reset() {
 	*x = SnapshotExtensionPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4213,7 +6356,7 @@ func (*SnapshotExtensionPayload) ProtoMessage() {}
 
 // Deprecated: Use SnapshotExtensionPayload.ProtoReflect.Descriptor instead.
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SnapshotExtensionPayload) GetPayload() []byte {
@@ -4242,65 +6385,97 @@ var file_cosmos_store_snapshots_v1_snapshot_proto_rawDesc = []byte{
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e,
 	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2d, 0x0a, 0x08, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0xdf, 0x02, 0x0a, 0x0c, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x44, 0x0a, 0x05, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x12, 0x4b, 0x0a, 0x04, 0x69, 0x61, 0x76, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x08, 0xe2, 0xde,
-	0x1f, 0x04, 0x49, 0x41, 0x56, 0x4c, 0x48, 0x00, 0x52, 0x04, 0x69, 0x61, 0x76, 0x6c, 0x12, 0x50,
-	0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d,
-	0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x62, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x27, 0x0a, 0x11,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x22, 0x43, 0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x34, 0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0xed,
-	0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74,
+	0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9c, 0x01, 0x0a, 0x08,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x08, 0x73,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x62, 0x61, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x51, 0x0a, 0x0f, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0xfb, 0x03,
+	0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x44,
+	0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x05, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x12, 0x4b, 0x0a, 0x04, 0x69, 0x61, 0x76, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x42,
+	0x08, 0xe2, 0xde, 0x1f, 0x04, 0x49, 0x41, 0x56, 0x4c, 0x48, 0x00, 0x52, 0x04, 0x69, 0x61, 0x76,
+	0x6c, 0x12, 0x50, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74,
 	0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x42, 0x0d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x53, 0xaa,
-	0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x51, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x48, 0x61,
+	0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x48,
+	0x61, 0x73, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x27, 0x0a, 0x11, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x6e, 0x0a, 0x12, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x48, 0x61, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22,
+	0x43, 0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x22, 0x34, 0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0xed, 0x01, 0x0a, 0x1d, 0x63,
+	0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x53, 0xaa, 0x02, 0x19, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x3a,
-	0x3a, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -4315,27 +6490,33 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP() []byte {
 	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescData
 }
 
-var file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_cosmos_store_snapshots_v1_snapshot_proto_goTypes = []interface{}{
 	(*Snapshot)(nil),                 // 0: cosmos.store.snapshots.v1.Snapshot
 	(*Metadata)(nil),                 // 1: cosmos.store.snapshots.v1.Metadata
-	(*SnapshotItem)(nil),             // 2: cosmos.store.snapshots.v1.SnapshotItem
-	(*SnapshotStoreItem)(nil),        // 3: cosmos.store.snapshots.v1.SnapshotStoreItem
-	(*SnapshotIAVLItem)(nil),         // 4: cosmos.store.snapshots.v1.SnapshotIAVLItem
-	(*SnapshotExtensionMeta)(nil),    // 5: cosmos.store.snapshots.v1.SnapshotExtensionMeta
-	(*SnapshotExtensionPayload)(nil), // 6: cosmos.store.snapshots.v1.SnapshotExtensionPayload
+	(*SnapshotSection)(nil),          // 2: cosmos.store.snapshots.v1.SnapshotSection
+	(*SnapshotItem)(nil),             // 3: cosmos.store.snapshots.v1.SnapshotItem
+	(*SnapshotStoreItem)(nil),        // 4: cosmos.store.snapshots.v1.SnapshotStoreItem
+	(*SnapshotIAVLItem)(nil),         // 5: cosmos.store.snapshots.v1.SnapshotIAVLItem
+	(*SnapshotChangeItem)(nil),       // 6: cosmos.store.snapshots.v1.SnapshotChangeItem
+	(*SnapshotStoreHashItem)(nil),    // 7: cosmos.store.snapshots.v1.SnapshotStoreHashItem
+	(*SnapshotExtensionMeta)(nil),    // 8: cosmos.store.snapshots.v1.SnapshotExtensionMeta
+	(*SnapshotExtensionPayload)(nil), // 9: cosmos.store.snapshots.v1.SnapshotExtensionPayload
 }
 var file_cosmos_store_snapshots_v1_snapshot_proto_depIdxs = []int32{
 	1, // 0: cosmos.store.snapshots.v1.Snapshot.metadata:type_name -> cosmos.store.snapshots.v1.Metadata
-	3, // 1: cosmos.store.snapshots.v1.SnapshotItem.store:type_name -> cosmos.store.snapshots.v1.SnapshotStoreItem
-	4, // 2: cosmos.store.snapshots.v1.SnapshotItem.iavl:type_name -> cosmos.store.snapshots.v1.SnapshotIAVLItem
-	5, // 3: cosmos.store.snapshots.v1.SnapshotItem.extension:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionMeta
-	6, // 4: cosmos.store.snapshots.v1.SnapshotItem.extension_payload:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionPayload
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	2, // 1: cosmos.store.snapshots.v1.Metadata.sections:type_name -> cosmos.store.snapshots.v1.SnapshotSection
+	4, // 2: cosmos.store.snapshots.v1.SnapshotItem.store:type_name -> cosmos.store.snapshots.v1.SnapshotStoreItem
+	5, // 3: cosmos.store.snapshots.v1.SnapshotItem.iavl:type_name -> cosmos.store.snapshots.v1.SnapshotIAVLItem
+	8, // 4: cosmos.store.snapshots.v1.SnapshotItem.extension:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionMeta
+	9, // 5: cosmos.store.snapshots.v1.SnapshotItem.extension_payload:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionPayload
+	6, // 6: cosmos.store.snapshots.v1.SnapshotItem.change:type_name -> cosmos.store.snapshots.v1.SnapshotChangeItem
+	7, // 7: cosmos.store.snapshots.v1.SnapshotItem.store_hash:type_name -> cosmos.store.snapshots.v1.SnapshotStoreHashItem
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_store_snapshots_v1_snapshot_proto_init() }
@@ -4369,7 +6550,7 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotItem); i {
+			switch v := v.(*SnapshotSection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4381,7 +6562,7 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotStoreItem); i {
+			switch v := v.(*SnapshotItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4393,7 +6574,7 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotIAVLItem); i {
+			switch v := v.(*SnapshotStoreItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4405,7 +6586,7 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotExtensionMeta); i {
+			switch v := v.(*SnapshotIAVLItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4417,6 +6598,42 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotChangeItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotStoreHashItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotExtensionMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SnapshotExtensionPayload); i {
 			case 0:
 				return &v.state
@@ -4429,11 +6646,13 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			}
 		}
 	}
-	file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*SnapshotItem_Store)(nil),
 		(*SnapshotItem_Iavl)(nil),
 		(*SnapshotItem_Extension)(nil),
 		(*SnapshotItem_ExtensionPayload)(nil),
+		(*SnapshotItem_Change)(nil),
+		(*SnapshotItem_StoreHash)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4441,7 +6660,7 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_store_snapshots_v1_snapshot_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
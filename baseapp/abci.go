@@ -53,6 +53,12 @@ func (app *BaseApp) InitChain(req *abci.RequestInitChain) (*abci.ResponseInitCha
 		app.initialHeight = 1
 	}
 
+	if app.versionedKV != nil {
+		if err := app.versionedKV.Start(app.initialHeight); err != nil {
+			return nil, err
+		}
+	}
+
 	// if req.InitialHeight is > 1, then we set the initial version on all stores
 	if req.InitialHeight > 1 {
 		initHeader.Height = req.InitialHeight
@@ -214,6 +220,12 @@ func (app *BaseApp) ListSnapshots(_ *abci.RequestListSnapshots) (*abci.ResponseL
 	}
 
 	for _, snapshot := range snapshots {
+		// incremental snapshots are only restored locally, on top of their base
+		// snapshot, they are not offered to state sync
+		if snapshot.Format == snapshottypes.FormatIncremental {
+			continue
+		}
+
 		abciSnapshot, err := snapshot.ToABCI()
 		if err != nil {
 			app.logger.Error("failed to convert ABCI snapshots", "err", err)
@@ -785,8 +797,18 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 	//
 	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
 	// vote extensions, so skip those.
+	//
+	// The txs are executed in parallel when enabled, in which case the loop
+	// below has nothing left to execute.
 	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
-	for _, rawTx := range req.Txs {
+	if app.canExecuteTxsInParallel(req.Txs) {
+		txResults, err = app.executeTxsInParallel(ctx, req.Txs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, rawTx := range req.Txs[len(txResults):] {
 		var response *abci.ExecTxResult
 
 		if _, err := app.txDecoder(rawTx); err == nil {
@@ -855,10 +877,19 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 // where they adhere to the sdk.Tx interface.
 func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.ResponseFinalizeBlock, err error) {
 	defer func() {
+		if res == nil {
+			return
+		}
+
 		// call the streaming service hooks with the FinalizeBlock messages
 		for _, streamingListener := range app.streamingManager.ABCIListeners {
-			if err := streamingListener.ListenFinalizeBlock(app.finalizeBlockState.Context(), *req, *res); err != nil {
-				app.logger.Error("ListenFinalizeBlock listening hook failed", "height", req.Height, "err", err)
+			if listenErr := streamingListener.ListenFinalizeBlock(app.finalizeBlockState.Context(), *req, *res); listenErr != nil {
+				app.logger.Error("ListenFinalizeBlock listening hook failed", "height", req.Height, "err", listenErr)
+
+				if app.streamingManager.StopNodeOnErr {
+					res, err = nil, fmt.Errorf("ListenFinalizeBlock listening hook failed at height %d: %w", req.Height, listenErr)
+					return
+				}
 			}
 		}
 	}()
@@ -945,6 +976,10 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 		for _, abciListener := range abciListeners {
 			if err := abciListener.ListenCommit(ctx, *resp, changeSet); err != nil {
 				app.logger.Error("Commit listening hook failed", "height", blockHeight, "err", err)
+
+				if app.streamingManager.StopNodeOnErr {
+					return nil, fmt.Errorf("commit listening hook failed at height %d: %w", blockHeight, err)
+				}
 			}
 		}
 	}
@@ -1220,7 +1255,19 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 			)
 	}
 
-	cacheMS, err := qms.CacheMultiStoreWithVersion(height)
+	var (
+		cacheMS storetypes.CacheMultiStore
+		err     error
+	)
+
+	// serve the historical queries from the versioned KV index if possible,
+	// which avoids loading the IAVL trees of past heights
+	if !prove && height != lastBlockHeight && app.qms == nil &&
+		app.versionedKV != nil && app.versionedKV.CanServe(height) {
+		cacheMS, err = app.versionedKVCacheMultiStore(height)
+	} else {
+		cacheMS, err = qms.CacheMultiStoreWithVersion(height)
+	}
 	if err != nil {
 		return sdk.Context{},
 			errorsmod.Wrapf(
@@ -280,86 +280,11 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 		}
 
 		selectedTxsSignersSeqs := make(map[string]uint64)
-		var (
-			resError        error
-			selectedTxsNums int
-			invalidTxs      []sdk.Tx // invalid txs to be removed out of the loop to avoid dead lock
-		)
-		mempool.SelectBy(ctx, h.mempool, req.Txs, func(memTx sdk.Tx) bool {
-			signerData, err := h.signerExtAdapter.GetSigners(memTx)
-			if err != nil {
-				// propagate the error to the caller
-				resError = err
-				return false
-			}
-
-			// If the signers aren't in selectedTxsSignersSeqs then we haven't seen them before
-			// so we add them and continue given that we don't need to check the sequence.
-			shouldAdd := true
-			txSignersSeqs := make(map[string]uint64)
-			for _, signer := range signerData {
-				seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
-				if !ok {
-					txSignersSeqs[signer.Signer.String()] = signer.Sequence
-					continue
-				}
-
-				// If we have seen this signer before in this block, we must make
-				// sure that the current sequence is seq+1; otherwise is invalid
-				// and we skip it.
-				if seq+1 != signer.Sequence {
-					shouldAdd = false
-					break
-				}
-				txSignersSeqs[signer.Signer.String()] = signer.Sequence
-			}
-			if !shouldAdd {
-				return true
-			}
-
-			// NOTE: Since transaction verification was already executed in CheckTx,
-			// which calls mempool.Insert, in theory everything in the pool should be
-			// valid. But some mempool implementations may insert invalid txs, so we
-			// check again.
-			txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
-			if err != nil {
-				invalidTxs = append(invalidTxs, memTx)
-			} else {
-				stop := h.txSelector.SelectTxForProposal(ctx, uint64(req.MaxTxBytes), maxBlockGas, memTx, txBz)
-				if stop {
-					return false
-				}
-
-				txsLen := len(h.txSelector.SelectedTxs(ctx))
-				for sender, seq := range txSignersSeqs {
-					// If txsLen != selectedTxsNums is true, it means that we've
-					// added a new tx to the selected txs, so we need to update
-					// the sequence of the sender.
-					if txsLen != selectedTxsNums {
-						selectedTxsSignersSeqs[sender] = seq
-					} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
-						// The transaction hasn't been added but it passed the
-						// verification, so we know that the sequence is correct.
-						// So we set this sender's sequence to seq-1, in order
-						// to avoid unnecessary calls to PrepareProposalVerifyTx.
-						selectedTxsSignersSeqs[sender] = seq - 1
-					}
-				}
-				selectedTxsNums = txsLen
-			}
-
-			return true
-		})
-
-		if resError != nil {
-			return nil, resError
-		}
-
-		for _, tx := range invalidTxs {
-			err := h.mempool.Remove(tx)
-			if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
-				return nil, err
-			}
+		if err := selectMempoolTxs(
+			ctx, h.mempool, req.Txs, h.txVerifier, h.signerExtAdapter, h.txSelector,
+			uint64(req.MaxTxBytes), maxBlockGas, selectedTxsSignersSeqs,
+		); err != nil {
+			return nil, err
 		}
 
 		return &abci.ResponsePrepareProposal{Txs: h.txSelector.SelectedTxs(ctx)}, nil
@@ -415,6 +340,105 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 	}
 }
 
+// selectMempoolTxs selects the valid txs of the mempool for a proposal with the
+// TxSelector, skipping the txs with unexpected signer sequences given the ones
+// in selectedTxsSignersSeqs, which is updated with the selected txs. The invalid
+// txs are removed from the mempool.
+func selectMempoolTxs(
+	ctx sdk.Context,
+	mp mempool.Mempool,
+	txs [][]byte,
+	txVerifier ProposalTxVerifier,
+	signerExtAdapter mempool.SignerExtractionAdapter,
+	txSelector TxSelector,
+	maxTxBytes, maxBlockGas uint64,
+	selectedTxsSignersSeqs map[string]uint64,
+) error {
+	var (
+		resError        error
+		selectedTxsNums int
+		invalidTxs      []sdk.Tx // invalid txs to be removed out of the loop to avoid dead lock
+	)
+	mempool.SelectBy(ctx, mp, txs, func(memTx sdk.Tx) bool {
+		signerData, err := signerExtAdapter.GetSigners(memTx)
+		if err != nil {
+			// propagate the error to the caller
+			resError = err
+			return false
+		}
+
+		// If the signers aren't in selectedTxsSignersSeqs then we haven't seen them before
+		// so we add them and continue given that we don't need to check the sequence.
+		shouldAdd := true
+		txSignersSeqs := make(map[string]uint64)
+		for _, signer := range signerData {
+			seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
+			if !ok {
+				txSignersSeqs[signer.Signer.String()] = signer.Sequence
+				continue
+			}
+
+			// If we have seen this signer before in this block, we must make
+			// sure that the current sequence is seq+1; otherwise is invalid
+			// and we skip it.
+			if seq+1 != signer.Sequence {
+				shouldAdd = false
+				break
+			}
+			txSignersSeqs[signer.Signer.String()] = signer.Sequence
+		}
+		if !shouldAdd {
+			return true
+		}
+
+		// NOTE: Since transaction verification was already executed in CheckTx,
+		// which calls mempool.Insert, in theory everything in the pool should be
+		// valid. But some mempool implementations may insert invalid txs, so we
+		// check again.
+		txBz, err := txVerifier.PrepareProposalVerifyTx(memTx)
+		if err != nil {
+			invalidTxs = append(invalidTxs, memTx)
+		} else {
+			stop := txSelector.SelectTxForProposal(ctx, maxTxBytes, maxBlockGas, memTx, txBz)
+			if stop {
+				return false
+			}
+
+			txsLen := len(txSelector.SelectedTxs(ctx))
+			for sender, seq := range txSignersSeqs {
+				// If txsLen != selectedTxsNums is true, it means that we've
+				// added a new tx to the selected txs, so we need to update
+				// the sequence of the sender.
+				if txsLen != selectedTxsNums {
+					selectedTxsSignersSeqs[sender] = seq
+				} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
+					// The transaction hasn't been added but it passed the
+					// verification, so we know that the sequence is correct.
+					// So we set this sender's sequence to seq-1, in order
+					// to avoid unnecessary calls to PrepareProposalVerifyTx.
+					selectedTxsSignersSeqs[sender] = seq - 1
+				}
+			}
+			selectedTxsNums = txsLen
+		}
+
+		return true
+	})
+
+	if resError != nil {
+		return resError
+	}
+
+	for _, tx := range invalidTxs {
+		err := mp.Remove(tx)
+		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // NoOpPrepareProposal defines a no-op PrepareProposal handler. It will always
 // return the transactions sent by the client's request.
 func NoOpPrepareProposal() sdk.PrepareProposalHandler {
@@ -3,6 +3,7 @@ package baseapp
 import (
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"sort"
 	"strconv"
@@ -25,6 +26,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp/oe"
+	"github.com/cosmos/cosmos-sdk/baseapp/versionedkv"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -194,6 +196,18 @@ type BaseApp struct {
 	//
 	// SAFETY: it's safe to do if validators validate the total gas wanted in the `ProcessProposal`, which is the case in the default handler.
 	disableBlockGasMeter bool
+
+	// parallelExecWorkers is the number of workers executing the txs of a block
+	// in parallel in FinalizeBlock, txs are executed sequentially if it is zero.
+	parallelExecWorkers int
+
+	// parallelExecMergers are the mergers of the keys which don't make the txs
+	// executed in parallel conflict, per store key.
+	parallelExecMergers map[storetypes.StoreKey]ParallelExecMerger
+
+	// versionedKV is the optional versioned KV index serving the historical
+	// queries which do not request proofs.
+	versionedKV *versionedkv.Index
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -432,6 +446,10 @@ func (app *BaseApp) Init() error {
 		return errors.New("commit multi-store must not be nil")
 	}
 
+	if err := app.initVersionedKVIndex(); err != nil {
+		return err
+	}
+
 	return app.cms.GetPruning().Validate()
 }
 
@@ -664,7 +682,14 @@ func (app *BaseApp) getContextForTx(mode execMode, txBytes []byte) sdk.Context {
 	if modeState == nil {
 		panic(fmt.Sprintf("state is nil for mode %v", mode))
 	}
-	ctx := modeState.Context().
+
+	return app.prepareContextForTx(modeState.Context(), mode, txBytes)
+}
+
+// prepareContextForTx returns the context for a tx execution, based off of the
+// provided mode state context.
+func (app *BaseApp) prepareContextForTx(ctx sdk.Context, mode execMode, txBytes []byte) sdk.Context {
+	ctx = ctx.
 		WithTxBytes(txBytes).
 		WithGasMeter(storetypes.NewInfiniteGasMeter())
 	// WithVoteInfos(app.voteInfos) // TODO: identify if this is needed
@@ -753,40 +778,46 @@ func (app *BaseApp) beginBlock(_ *abci.RequestFinalizeBlock) (sdk.BeginBlock, er
 }
 
 func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
-	gInfo := sdk.GasInfo{}
-	resultStr := "successful"
+	resp := app.deliverTxWithContext(app.getContextForTx(execModeFinalize, tx), tx, app.mempool)
+	emitTxTelemetry(resp)
 
-	var resp *abci.ExecTxResult
-
-	defer func() {
-		telemetry.IncrCounter(1, "tx", "count")
-		telemetry.IncrCounter(1, "tx", resultStr)
-		telemetry.SetGauge(float32(gInfo.GasUsed), "tx", "gas", "used")
-		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
-	}()
+	return resp
+}
 
-	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
+// deliverTxWithContext executes a tx in finalize mode with the provided context
+// and mempool, and returns its execution result.
+func (app *BaseApp) deliverTxWithContext(ctx sdk.Context, tx []byte, mp mempool.Mempool) *abci.ExecTxResult {
+	gInfo, result, anteEvents, err := app.runTxWithContext(ctx, execModeFinalize, tx, mp)
 	if err != nil {
-		resultStr = "failed"
-		resp = sdkerrors.ResponseExecTxResultWithEvents(
+		return sdkerrors.ResponseExecTxResultWithEvents(
 			err,
 			gInfo.GasWanted,
 			gInfo.GasUsed,
 			sdk.MarkEventsToIndex(anteEvents, app.indexEvents),
 			app.trace,
 		)
-		return resp
 	}
 
-	resp = &abci.ExecTxResult{
+	return &abci.ExecTxResult{
 		GasWanted: int64(gInfo.GasWanted),
 		GasUsed:   int64(gInfo.GasUsed),
 		Log:       result.Log,
 		Data:      result.Data,
 		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
 	}
+}
 
-	return resp
+// emitTxTelemetry emits the telemetry of a delivered tx.
+func emitTxTelemetry(resp *abci.ExecTxResult) {
+	resultStr := "successful"
+	if !resp.IsOK() {
+		resultStr = "failed"
+	}
+
+	telemetry.IncrCounter(1, "tx", "count")
+	telemetry.IncrCounter(1, "tx", resultStr)
+	telemetry.SetGauge(float32(resp.GasUsed), "tx", "gas", "used")
+	telemetry.SetGauge(float32(resp.GasWanted), "tx", "gas", "wanted")
 }
 
 // endBlock is an application-defined function that is called after transactions
@@ -823,12 +854,17 @@ func (app *BaseApp) endBlock(_ context.Context) (sdk.EndBlock, error) {
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, app.mempool)
+}
+
+// runTxWithContext is runTx over the provided tx context, inserting and removing
+// txs in the provided mempool.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode execMode, txBytes []byte, mp mempool.Mempool) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -922,7 +958,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		if err != nil {
 			if mode == execModeReCheck {
 				// if the ante handler fails on recheck, we want to remove the tx from the mempool
-				if mempoolErr := app.mempool.Remove(tx); mempoolErr != nil {
+				if mempoolErr := mp.Remove(tx); mempoolErr != nil {
 					return gInfo, nil, anteEvents, errors.Join(err, mempoolErr)
 				}
 			}
@@ -934,12 +970,12 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 	}
 
 	if mode == execModeCheck {
-		err = app.mempool.Insert(ctx, tx)
+		err = mp.Insert(ctx, tx)
 		if err != nil {
 			return gInfo, nil, anteEvents, err
 		}
 	} else if mode == execModeFinalize {
-		err = app.mempool.Remove(tx)
+		err = mp.Remove(tx)
 		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 			return gInfo, nil, anteEvents,
 				fmt.Errorf("failed to remove tx from mempool: %w", err)
@@ -1169,5 +1205,14 @@ func (app *BaseApp) Close() error {
 		}
 	}
 
+	// Close the streaming listeners writing to local resources, e.g. files
+	for _, abciListener := range app.streamingManager.ABCIListeners {
+		if closer, ok := abciListener.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
 	return errors.Join(errs...)
 }
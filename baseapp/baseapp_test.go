@@ -57,7 +57,10 @@ type (
 		blockTxs           int
 		snapshotInterval   uint64
 		snapshotKeepRecent uint32
-		pruningOpts        pruningtypes.PruningOptions
+		// snapshotIncrementalInterval is the interval of the incremental
+		// snapshots, which are not awaited
+		snapshotIncrementalInterval uint64
+		pruningOpts                 pruningtypes.PruningOptions
 	}
 )
 
@@ -116,11 +119,14 @@ func NewBaseAppSuiteWithSnapshots(t *testing.T, cfg SnapshotsConfig, opts ...fun
 	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), testutil.GetTempDir(t))
 	require.NoError(t, err)
 
+	snapshotOpts := snapshottypes.NewSnapshotOptions(cfg.snapshotInterval, cfg.snapshotKeepRecent)
+	snapshotOpts.IncrementalInterval = cfg.snapshotIncrementalInterval
+
 	suite := NewBaseAppSuite(
 		t,
 		append(
 			opts,
-			baseapp.SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(cfg.snapshotInterval, cfg.snapshotKeepRecent)),
+			baseapp.SetSnapshot(snapshotStore, snapshotOpts),
 			baseapp.SetPruning(cfg.pruningOpts),
 		)...,
 	)
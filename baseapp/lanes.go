@@ -0,0 +1,312 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ mempool.ExtMempool = (*laneMempool)(nil)
+
+type (
+	// Lane defines a share of the block space reserved to the transactions
+	// matched by the lane, e.g. system transactions which must be included ahead
+	// of the user transactions.
+	Lane struct {
+		// Name identifies the lane.
+		Name string
+
+		// Match returns whether the transaction belongs to the lane. A nil Match
+		// matches every transaction, which is typically used by the last lane.
+		Match func(sdk.Tx) bool
+
+		// Mempool holds the transactions of the lane.
+		Mempool mempool.Mempool
+
+		// MaxBlockShare is the maximum share, in (0, 1], of the block bytes and
+		// gas that the transactions of the lane can use.
+		MaxBlockShare math.LegacyDec
+	}
+
+	// LaneProposalHandler defines ABCI PrepareProposal and ProcessProposal
+	// handlers filling blocks from an ordered list of lanes. The transactions
+	// of a lane are placed before the ones of the following lanes, and each
+	// lane is limited to its share of the block.
+	//
+	// The handler routes the transactions to the mempools of their lanes
+	// through the mempool returned by Mempool, which must be set as the
+	// application mempool:
+	//
+	//	h, err := baseapp.NewLaneProposalHandler(lanes, app)
+	//	app.SetMempool(h.Mempool())
+	//	app.SetPrepareProposal(h.PrepareProposalHandler())
+	//	app.SetProcessProposal(h.ProcessProposalHandler())
+	LaneProposalHandler struct {
+		lanes            []Lane
+		mempool          *laneMempool
+		txVerifier       ProposalTxVerifier
+		signerExtAdapter mempool.SignerExtractionAdapter
+	}
+)
+
+// NewLaneProposalHandler returns a LaneProposalHandler for the given lanes, in
+// priority order. A transaction belongs to the first lane matching it.
+func NewLaneProposalHandler(lanes []Lane, txVerifier ProposalTxVerifier) (*LaneProposalHandler, error) {
+	if len(lanes) == 0 {
+		return nil, errors.New("at least one lane is required")
+	}
+
+	names := make(map[string]struct{}, len(lanes))
+	for _, lane := range lanes {
+		if lane.Name == "" {
+			return nil, errors.New("lane name cannot be empty")
+		}
+		if _, ok := names[lane.Name]; ok {
+			return nil, fmt.Errorf("duplicate lane %s", lane.Name)
+		}
+		names[lane.Name] = struct{}{}
+
+		if lane.Mempool == nil {
+			return nil, fmt.Errorf("lane %s has no mempool", lane.Name)
+		}
+		if lane.MaxBlockShare.IsNil() || !lane.MaxBlockShare.IsPositive() || lane.MaxBlockShare.GT(math.LegacyOneDec()) {
+			return nil, fmt.Errorf("lane %s max block share must be in (0, 1], got %s", lane.Name, lane.MaxBlockShare)
+		}
+	}
+
+	return &LaneProposalHandler{
+		lanes:            lanes,
+		mempool:          &laneMempool{lanes: lanes},
+		txVerifier:       txVerifier,
+		signerExtAdapter: mempool.NewDefaultSignerExtractionAdapter(),
+	}, nil
+}
+
+// Mempool returns the application mempool, inserting and removing the
+// transactions in the mempools of their lanes, and iterating over the lanes in
+// order.
+func (h *LaneProposalHandler) Mempool() mempool.Mempool {
+	return h.mempool
+}
+
+// PrepareProposalHandler returns a PrepareProposal handler selecting the
+// transactions of the lanes in order, as DefaultProposalHandler does for a
+// single mempool. The transactions of a lane are limited to its share of
+// RequestPrepareProposal.MaxTxBytes and of the block gas, and to the space left
+// by the previous lanes.
+func (h *LaneProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		var maxBlockGas uint64
+		if b := ctx.ConsensusParams().Block; b != nil {
+			maxBlockGas = uint64(b.MaxGas)
+		}
+		maxTxBytes := uint64(req.MaxTxBytes)
+
+		var (
+			txs                    [][]byte
+			totalTxBytes           uint64
+			totalTxGas             uint64
+			selectedTxsSignersSeqs = make(map[string]uint64)
+		)
+		for _, lane := range h.lanes {
+			laneMaxTxBytes := min(laneLimit(lane.MaxBlockShare, maxTxBytes), maxTxBytes-totalTxBytes)
+			var laneMaxGas uint64
+			if maxBlockGas > 0 {
+				laneMaxGas = min(laneLimit(lane.MaxBlockShare, maxBlockGas), maxBlockGas-totalTxGas)
+				if laneMaxGas == 0 {
+					continue
+				}
+			}
+			if laneMaxTxBytes == 0 {
+				continue
+			}
+
+			selector := &defaultTxSelector{}
+			if err := selectMempoolTxs(
+				ctx, lane.Mempool, req.Txs, h.txVerifier, h.signerExtAdapter, selector,
+				laneMaxTxBytes, laneMaxGas, selectedTxsSignersSeqs,
+			); err != nil {
+				return nil, err
+			}
+
+			txs = append(txs, selector.selectedTxs...)
+			totalTxBytes += selector.totalTxBytes
+			totalTxGas += selector.totalTxGas
+		}
+
+		return &abci.ResponsePrepareProposal{Txs: txs}, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposal handler verifying the
+// transactions as DefaultProposalHandler does, and that they are ordered by
+// lane, every transaction belonging to a lane. The gas of the transactions of a
+// lane must not exceed its share of the block gas.
+//
+// The bytes of the transactions of a lane are checked against its share of the
+// block max bytes, when set, as the max bytes of the transactions requested
+// from the proposer are not known. This is looser than the limit enforced in
+// PrepareProposal.
+func (h *LaneProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		var maxBlockGas, maxBlockBytes int64
+		if b := ctx.ConsensusParams().Block; b != nil {
+			maxBlockGas = b.MaxGas
+			maxBlockBytes = b.MaxBytes
+		}
+
+		var (
+			totalTxGas   uint64
+			laneIdx      int
+			laneTxGas    uint64
+			laneTxBytes  uint64
+			reject       = &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+			laneExceeded = func(used, limit uint64) bool {
+				return used > laneLimit(h.lanes[laneIdx].MaxBlockShare, limit)
+			}
+		)
+		for _, txBytes := range req.Txs {
+			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
+			if err != nil {
+				return reject, nil
+			}
+
+			idx := h.mempool.laneIndex(tx)
+			if idx < laneIdx {
+				return reject, nil
+			}
+			if idx > laneIdx {
+				laneIdx, laneTxGas, laneTxBytes = idx, 0, 0
+			}
+
+			laneTxBytes += uint64(len(txBytes))
+			if maxBlockBytes > 0 && laneExceeded(laneTxBytes, uint64(maxBlockBytes)) {
+				return reject, nil
+			}
+
+			if maxBlockGas > 0 {
+				if gasTx, ok := tx.(GasTx); ok {
+					totalTxGas += gasTx.GetGas()
+					laneTxGas += gasTx.GetGas()
+				}
+
+				if totalTxGas > uint64(maxBlockGas) || laneExceeded(laneTxGas, uint64(maxBlockGas)) {
+					return reject, nil
+				}
+			}
+		}
+
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+}
+
+// laneLimit returns the share of the given limit.
+func laneLimit(share math.LegacyDec, limit uint64) uint64 {
+	return share.MulInt(math.NewIntFromUint64(limit)).TruncateInt().Uint64()
+}
+
+// laneMempool is a mempool routing the transactions to the mempools of their
+// lanes.
+type laneMempool struct {
+	lanes []Lane
+}
+
+// laneIndex returns the index of the first lane matching the transaction, or
+// -1 if there is none.
+func (mp *laneMempool) laneIndex(tx sdk.Tx) int {
+	for i, lane := range mp.lanes {
+		if lane.Match == nil || lane.Match(tx) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Insert inserts the transaction in the mempool of its lane, returning an error
+// if no lane matches it.
+func (mp *laneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	idx := mp.laneIndex(tx)
+	if idx < 0 {
+		return errors.New("no lane matches the tx")
+	}
+	return mp.lanes[idx].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the mempools of the lanes, in order.
+func (mp *laneMempool) Select(ctx context.Context, txs [][]byte) mempool.Iterator {
+	return newLaneIterator(ctx, mp.lanes, txs)
+}
+
+// SelectBy iterates over the mempools of the lanes in order, until callback
+// returns false.
+func (mp *laneMempool) SelectBy(ctx context.Context, txs [][]byte, callback func(sdk.Tx) bool) {
+	for _, lane := range mp.lanes {
+		stopped := false
+		mempool.SelectBy(ctx, lane.Mempool, txs, func(tx sdk.Tx) bool {
+			if !callback(tx) {
+				stopped = true
+				return false
+			}
+			return true
+		})
+		if stopped {
+			return
+		}
+	}
+}
+
+// CountTx returns the number of transactions in the mempools of the lanes.
+func (mp *laneMempool) CountTx() int {
+	var count int
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+	return count
+}
+
+// Remove removes the transaction from the mempool of its lane.
+func (mp *laneMempool) Remove(tx sdk.Tx) error {
+	idx := mp.laneIndex(tx)
+	if idx < 0 {
+		return mempool.ErrTxNotFound
+	}
+	return mp.lanes[idx].Mempool.Remove(tx)
+}
+
+// laneIterator iterates over the mempools of lanes, in order.
+type laneIterator struct {
+	ctx   context.Context
+	lanes []Lane
+	txs   [][]byte
+	iter  mempool.Iterator
+}
+
+// newLaneIterator returns an iterator positioned on the first transaction of
+// the lanes, or nil if they are empty.
+func newLaneIterator(ctx context.Context, lanes []Lane, txs [][]byte) mempool.Iterator {
+	for i, lane := range lanes {
+		if iter := lane.Mempool.Select(ctx, txs); iter != nil {
+			return &laneIterator{ctx: ctx, lanes: lanes[i+1:], txs: txs, iter: iter}
+		}
+	}
+	return nil
+}
+
+func (it *laneIterator) Next() mempool.Iterator {
+	if next := it.iter.Next(); next != nil {
+		it.iter = next
+		return it
+	}
+	return newLaneIterator(it.ctx, it.lanes, it.txs)
+}
+
+func (it *laneIterator) Tx() sdk.Tx {
+	return it.iter.Tx()
+}
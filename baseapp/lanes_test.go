@@ -0,0 +1,229 @@
+package baseapp_test
+
+import (
+	"bytes"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// valuePrefixMatch matches the txs of a MsgKeyValue with a value starting with
+// the given prefix.
+func valuePrefixMatch(prefix string) func(sdk.Tx) bool {
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+		msg, ok := msgs[0].(*baseapptestutil.MsgKeyValue)
+		return ok && bytes.HasPrefix(msg.Value, []byte(prefix))
+	}
+}
+
+func newTestLanes() []baseapp.Lane {
+	newMempool := func() mempool.Mempool {
+		return mempool.NewPriorityMempool(mempool.DefaultPriorityNonceMempoolConfig())
+	}
+
+	return []baseapp.Lane{
+		{
+			Name:          "system",
+			Match:         valuePrefixMatch("sys"),
+			Mempool:       newMempool(),
+			MaxBlockShare: math.LegacyNewDecWithPrec(5, 1),
+		},
+		{
+			Name:          "user",
+			Match:         valuePrefixMatch("usr"),
+			Mempool:       newMempool(),
+			MaxBlockShare: math.LegacyOneDec(),
+		},
+	}
+}
+
+func (s *ABCIUtilsTestSuite) TestNewLaneProposalHandler() {
+	lanes := newTestLanes()
+	_, err := baseapp.NewLaneProposalHandler(lanes, nil)
+	s.Require().NoError(err)
+
+	_, err = baseapp.NewLaneProposalHandler(nil, nil)
+	s.Require().Error(err)
+
+	_, err = baseapp.NewLaneProposalHandler([]baseapp.Lane{lanes[0], lanes[0]}, nil)
+	s.Require().ErrorContains(err, "duplicate lane")
+
+	lanes = newTestLanes()
+	lanes[0].Mempool = nil
+	_, err = baseapp.NewLaneProposalHandler(lanes, nil)
+	s.Require().ErrorContains(err, "no mempool")
+
+	for _, share := range []math.LegacyDec{{}, math.LegacyZeroDec(), math.LegacyNewDec(2)} {
+		lanes = newTestLanes()
+		lanes[0].MaxBlockShare = share
+		_, err = baseapp.NewLaneProposalHandler(lanes, nil)
+		s.Require().ErrorContains(err, "max block share")
+	}
+}
+
+func (s *ABCIUtilsTestSuite) TestLaneProposalHandler() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	type testTx struct {
+		tx       sdk.Tx
+		priority int64
+		bz       []byte
+	}
+
+	testTxs := []testTx{
+		{tx: buildMsg(s.T(), txConfig, []byte(`usr1`), [][]byte{[]byte("secret1")}, []uint64{1}), priority: 10},
+		{tx: buildMsg(s.T(), txConfig, []byte(`usr2`), [][]byte{[]byte("secret2")}, []uint64{1}), priority: 5},
+		{tx: buildMsg(s.T(), txConfig, []byte(`sys1`), [][]byte{[]byte("secret3")}, []uint64{1}), priority: 2},
+		{tx: buildMsg(s.T(), txConfig, []byte(`sys2`), [][]byte{[]byte("secret4")}, []uint64{1}), priority: 1},
+		{tx: buildMsg(s.T(), txConfig, []byte(`oth1`), [][]byte{[]byte("secret5")}, []uint64{1}), priority: 1},
+	}
+	for i := range testTxs {
+		bz, err := txConfig.TxEncoder()(testTxs[i].tx)
+		s.Require().NoError(err)
+		testTxs[i].bz = bz
+	}
+	size := int64(len(testTxs[0].bz))
+	for _, tx := range testTxs {
+		s.Require().Len(tx.bz, int(size))
+	}
+
+	newHandler := func() (*baseapp.LaneProposalHandler, *mock.MockProposalTxVerifier) {
+		ctrl := gomock.NewController(s.T())
+		app := mock.NewMockProposalTxVerifier(ctrl)
+		for _, v := range testTxs {
+			app.EXPECT().PrepareProposalVerifyTx(v.tx).Return(v.bz, nil).AnyTimes()
+			app.EXPECT().ProcessProposalVerifyTx(v.bz).Return(v.tx, nil).AnyTimes()
+		}
+
+		h, err := baseapp.NewLaneProposalHandler(newTestLanes(), app)
+		s.Require().NoError(err)
+		return h, app
+	}
+
+	txIndexes := func(txs [][]byte) []int {
+		indexes := []int{}
+		for _, tx := range txs {
+			for i, v := range testTxs {
+				if bytes.Equal(tx, v.bz) {
+					indexes = append(indexes, i)
+				}
+			}
+		}
+		return indexes
+	}
+
+	s.Run("mempool", func() {
+		h, _ := newHandler()
+		mp := h.Mempool()
+		for _, v := range testTxs[:4] {
+			s.Require().NoError(mp.Insert(s.ctx.WithPriority(v.priority), v.tx))
+		}
+		s.Require().Error(mp.Insert(s.ctx, testTxs[4].tx))
+		s.Require().Equal(4, mp.CountTx())
+
+		var txs [][]byte
+		for iter := mp.Select(s.ctx, nil); iter != nil; iter = iter.Next() {
+			bz, err := txConfig.TxEncoder()(iter.Tx())
+			s.Require().NoError(err)
+			txs = append(txs, bz)
+		}
+		s.Require().Equal([]int{2, 3, 0, 1}, txIndexes(txs))
+
+		s.Require().NoError(mp.Remove(testTxs[2].tx))
+		s.Require().ErrorIs(mp.Remove(testTxs[4].tx), mempool.ErrTxNotFound)
+		s.Require().Equal(3, mp.CountTx())
+	})
+
+	prepareCases := map[string]struct {
+		maxTxBytes  int64
+		expectedTxs []int
+	}{
+		"system lane first": {
+			maxTxBytes:  10 * size,
+			expectedTxs: []int{2, 3, 0, 1},
+		},
+		"system lane limited to its share": {
+			maxTxBytes:  3 * size,
+			expectedTxs: []int{2, 0, 1},
+		},
+		"user lane limited to the space left": {
+			maxTxBytes:  2 * size,
+			expectedTxs: []int{2, 0},
+		},
+	}
+
+	for name, tc := range prepareCases {
+		s.Run(name, func() {
+			h, _ := newHandler()
+			for _, v := range testTxs[:4] {
+				s.Require().NoError(h.Mempool().Insert(s.ctx.WithPriority(v.priority), v.tx))
+			}
+
+			resp, err := h.PrepareProposalHandler()(s.ctx, &abci.RequestPrepareProposal{MaxTxBytes: tc.maxTxBytes})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedTxs, txIndexes(resp.Txs))
+		})
+	}
+
+	processCases := map[string]struct {
+		txs    []int
+		status abci.ResponseProcessProposal_ProposalStatus
+	}{
+		"ordered lanes": {
+			txs:    []int{2, 0, 1},
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"only the user lane": {
+			txs:    []int{0, 1},
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"system tx after a user tx": {
+			txs:    []int{2, 0, 3},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"system lane exceeding its share": {
+			txs:    []int{2, 3},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"tx matching no lane": {
+			txs:    []int{2, 4},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+	}
+
+	ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{
+			MaxBytes: 3 * size,
+		},
+	})
+	for name, tc := range processCases {
+		s.Run(name, func() {
+			h, _ := newHandler()
+			req := &abci.RequestProcessProposal{}
+			for _, i := range tc.txs {
+				req.Txs = append(req.Txs, testTxs[i].bz)
+			}
+
+			resp, err := h.ProcessProposalHandler()(ctx, req)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.status, resp.Status)
+		})
+	}
+}
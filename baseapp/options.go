@@ -14,6 +14,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp/oe"
+	"github.com/cosmos/cosmos-sdk/baseapp/versionedkv"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -117,6 +118,24 @@ func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp
 	}
 }
 
+// SetParallelExecution sets the number of workers executing the txs of a block
+// in parallel in FinalizeBlock. Txs are executed sequentially if it is zero.
+func SetParallelExecution(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetParallelExecutionWorkers(workers) }
+}
+
+// SetParallelExecMerger sets the merger of the keys of the given store which
+// don't make the txs executed in parallel conflict.
+func SetParallelExecMerger(key storetypes.StoreKey, merger ParallelExecMerger) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetParallelExecMerger(key, merger) }
+}
+
+// SetVersionedKVIndex sets the versioned KV index serving the historical
+// queries which do not request proofs.
+func SetVersionedKVIndex(idx *versionedkv.Index) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetVersionedKVIndex(idx) }
+}
+
 // DisableBlockGasMeter disables the block gas meter.
 func DisableBlockGasMeter() func(*BaseApp) {
 	return func(app *BaseApp) { app.SetDisableBlockGasMeter(true) }
@@ -378,6 +397,35 @@ func (app *BaseApp) SetDisableBlockGasMeter(disableBlockGasMeter bool) {
 	app.disableBlockGasMeter = disableBlockGasMeter
 }
 
+// SetParallelExecutionWorkers sets the number of workers executing the txs of
+// a block in parallel in FinalizeBlock.
+func (app *BaseApp) SetParallelExecutionWorkers(workers int) {
+	app.parallelExecWorkers = workers
+}
+
+// SetParallelExecMerger sets the merger of the keys of the given store which
+// don't make the txs executed in parallel conflict, see ParallelExecMerger.
+func (app *BaseApp) SetParallelExecMerger(key storetypes.StoreKey, merger ParallelExecMerger) {
+	if app.sealed {
+		panic("SetParallelExecMerger() on sealed BaseApp")
+	}
+
+	if app.parallelExecMergers == nil {
+		app.parallelExecMergers = make(map[storetypes.StoreKey]ParallelExecMerger)
+	}
+	app.parallelExecMergers[key] = merger
+}
+
+// SetVersionedKVIndex sets the versioned KV index of the BaseApp, which is
+// maintained from the changes of the IAVL stores committed by the BaseApp.
+func (app *BaseApp) SetVersionedKVIndex(idx *versionedkv.Index) {
+	if app.sealed {
+		panic("SetVersionedKVIndex() on sealed BaseApp")
+	}
+
+	app.versionedKV = idx
+}
+
 // SetMsgServiceRouter sets the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) SetMsgServiceRouter(msgServiceRouter *MsgServiceRouter) {
 	app.msgServiceRouter = msgServiceRouter
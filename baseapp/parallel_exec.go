@@ -0,0 +1,547 @@
+package baseapp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// Parallel execution runs the txs of a block optimistically, in the spirit of
+// Block-STM:
+//
+//  1. Every tx is executed concurrently on its own cachemulti branch of the
+//     block state, as it was before any tx of the block was executed. The keys
+//     and iterated ranges read from the block state, and the keys written, are
+//     recorded per store key.
+//  2. The txs are then committed in block order. A speculative execution is
+//     valid if none of the keys and ranges it read were written by a previously
+//     committed tx, in which case its writes are applied to the block state.
+//     Otherwise the tx is re-executed on top of the committed txs.
+//
+// A speculative execution which observes the block gas meter beyond the out of
+// gas check of runTx, or which would not fit in the remaining block gas, is
+// also re-executed. This way the results, the events and the app hash are
+// identical to the ones of a sequential execution, as long as the ante
+// handler, the post handler and the msg handlers only keep their state in the
+// KV stores of the app.
+//
+// The keys which every tx of a block adds an amount to, such as the balance of
+// the fee collector, would make every tx conflict with the previous ones. The
+// writes of a tx to the keys of a ParallelExecMerger are instead rebased on the
+// block state when the tx is committed.
+
+// ParallelExecMerger merges the writes of the txs executed in parallel to the
+// keys which the txs only add an amount to, such as the balance of the fee
+// collector.
+//
+// CONTRACT: the txs must only read a mergeable key to add an amount to it.
+type ParallelExecMerger interface {
+	// Mergeable reports whether the key holds an amount merged by the merger.
+	Mergeable(key []byte) bool
+
+	// Merge adds the amount added by a tx to a key, from the base value it read
+	// to the value it wrote, to the committed value of the key. It fails if the
+	// tx did not add to the amount. Nil values are keys which are not set.
+	Merge(base, written, committed []byte) ([]byte, error)
+}
+
+// storeKeysByNamer is implemented by the commit multi-stores that expose their
+// mounted store keys, e.g. rootmulti.Store.
+type storeKeysByNamer interface {
+	StoreKeysByName() map[string]storetypes.StoreKey
+}
+
+// canExecuteTxsInParallel reports whether the txs of the current block can be
+// executed in parallel.
+func (app *BaseApp) canExecuteTxsInParallel(txs [][]byte) bool {
+	if app.parallelExecWorkers <= 0 || len(txs) < 2 {
+		return false
+	}
+
+	// tracing relies on the order of the store operations
+	if app.finalizeBlockState.ms.TracingEnabled() {
+		return false
+	}
+
+	_, ok := app.cms.(storeKeysByNamer)
+	return ok
+}
+
+// executeTxsInParallel executes the txs of the current block in parallel and
+// returns their execution results, in the order of the txs.
+func (app *BaseApp) executeTxsInParallel(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	keys := app.cms.(storeKeysByNamer).StoreKeysByName()
+	db := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	decoded := make([]bool, len(txs))
+	for i, rawTx := range txs {
+		_, err := app.txDecoder(rawTx)
+		decoded[i] = err == nil
+	}
+
+	execs := make([]*speculativeTx, len(txs))
+	indexes := make(chan int, len(txs))
+	for i := range txs {
+		if decoded[i] {
+			indexes <- i
+		}
+	}
+	close(indexes)
+
+	var wg sync.WaitGroup
+	for w := 0; w < app.parallelExecWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if ctx.Err() != nil {
+					return
+				}
+				execs[i] = app.executeTxSpeculatively(keys, db, txs[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	written := newWriteSet()
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for i, rawTx := range txs {
+		var response *abci.ExecTxResult
+
+		switch {
+		case !decoded[i]:
+			// In the case where a transaction included in a block proposal is malformed,
+			// we still want to return a default response to comet. This is because comet
+			// expects a response for each transaction included in a block proposal.
+			response = sdkerrors.ResponseExecTxResultWithEvents(
+				sdkerrors.ErrTxDecode,
+				0,
+				0,
+				nil,
+				false,
+			)
+
+		case execs[i] != nil && app.commitSpeculativeTx(execs[i], written):
+			response = execs[i].response
+			emitTxTelemetry(response)
+
+		default:
+			response = app.executeTxTracked(keys, db, rawTx, written)
+			emitTxTelemetry(response)
+		}
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+
+		txResults = append(txResults, response)
+	}
+
+	return txResults, nil
+}
+
+// speculativeTx is the outcome of a tx executed on a branch of the block state.
+type speculativeTx struct {
+	store         storetypes.CacheMultiStore
+	trackers      map[storetypes.StoreKey]*trackedKVStore
+	blockGasMeter *speculativeGasMeter
+	mempool       *recordingMempool
+	response      *abci.ExecTxResult
+	aborted       bool
+}
+
+// executeTxSpeculatively executes a tx on a branch of the block state without
+// writing to it. It is safe to call concurrently.
+func (app *BaseApp) executeTxSpeculatively(keys map[string]storetypes.StoreKey, db storetypes.KVStore, txBytes []byte) (exec *speculativeTx) {
+	store, trackers := app.newTrackedTxStore(keys, db)
+	exec = &speculativeTx{
+		store:         store,
+		trackers:      trackers,
+		blockGasMeter: &speculativeGasMeter{GasMeter: storetypes.NewInfiniteGasMeter()},
+		mempool:       &recordingMempool{},
+	}
+
+	// runTx recovers from the panics of the tx execution, anything else leaves
+	// the tx to be re-executed
+	defer func() {
+		if r := recover(); r != nil {
+			exec.aborted = true
+		}
+	}()
+
+	// NOTE: the block state event manager is not used by the tx executions,
+	// each execution gets its own so that they don't share it concurrently.
+	ctx := app.finalizeBlockState.Context().
+		WithMultiStore(store).
+		WithBlockGasMeter(exec.blockGasMeter).
+		WithEventManager(sdk.NewEventManager())
+	ctx = app.prepareContextForTx(ctx, execModeFinalize, txBytes)
+
+	exec.response = app.deliverTxWithContext(ctx, txBytes, exec.mempool)
+
+	return exec
+}
+
+// commitSpeculativeTx applies the outcome of a speculative execution to the
+// block state if it is the same as the one of a sequential execution, and
+// reports whether it did.
+func (app *BaseApp) commitSpeculativeTx(exec *speculativeTx, written writeSet) bool {
+	if exec.aborted || exec.blockGasMeter.observed() {
+		return false
+	}
+
+	merged, extraGas, ok := app.mergeSpeculativeTx(exec)
+	if !ok || written.conflicts(exec.trackers, merged) {
+		return false
+	}
+
+	// the gas of the merged keys depends on the length of their values
+	if extraGas > 0 {
+		gasUsed := uint64(exec.response.GasUsed) + extraGas
+		if gasUsed < extraGas || gasUsed > uint64(exec.response.GasWanted) {
+			return false
+		}
+		exec.response.GasUsed = int64(gasUsed)
+	}
+
+	blockGasMeter := app.finalizeBlockState.Context().BlockGasMeter()
+	gasUsed := exec.blockGasMeter.GasMeter.GasConsumed() + extraGas
+	if blockGasMeter.IsOutOfGas() || !fitsBlockGas(blockGasMeter, gasUsed) {
+		return false
+	}
+
+	if err := exec.mempool.replay(app.mempool); err != nil {
+		return false
+	}
+
+	blockGasMeter.ConsumeGas(gasUsed, "block gas meter")
+	exec.store.Write()
+	written.add(exec.trackers)
+
+	return true
+}
+
+// mergeSpeculativeTx rebases the writes of a speculative execution to the
+// mergeable keys on the block state. It returns the keys merged per store key,
+// and the gas that a sequential execution would have additionally consumed
+// reading and writing them. It reports false if the writes can't be merged.
+func (app *BaseApp) mergeSpeculativeTx(exec *speculativeTx) (map[storetypes.StoreKey]map[string]struct{}, storetypes.Gas, bool) {
+	var (
+		merged   map[storetypes.StoreKey]map[string]struct{}
+		extraGas storetypes.Gas
+	)
+
+	gasConfig := app.finalizeBlockState.Context().KVGasConfig()
+	for key, tracker := range exec.trackers {
+		if len(tracker.bases) == 0 {
+			continue
+		}
+
+		store := exec.store.GetKVStore(key)
+		for k, base := range tracker.bases {
+			// the keys were read, so their values are in the cache of the tx
+			value := store.Get([]byte(k))
+			if bytes.Equal(value, base) {
+				continue
+			}
+
+			committed := tracker.KVStore.Get([]byte(k))
+			if bytes.Equal(committed, base) {
+				continue
+			}
+
+			mergedValue, err := tracker.merger.Merge(base, value, committed)
+			if err != nil || len(committed) < len(base) || len(mergedValue) < len(value) {
+				return nil, 0, false
+			}
+
+			if mergedValue == nil {
+				store.Delete([]byte(k))
+			} else {
+				store.Set([]byte(k), mergedValue)
+			}
+
+			extraGas += gasConfig.ReadCostPerByte*storetypes.Gas(len(committed)-len(base)) +
+				gasConfig.WriteCostPerByte*storetypes.Gas(len(mergedValue)-len(value))
+
+			if merged == nil {
+				merged = make(map[storetypes.StoreKey]map[string]struct{})
+			}
+			if merged[key] == nil {
+				merged[key] = make(map[string]struct{})
+			}
+			merged[key][k] = struct{}{}
+		}
+	}
+
+	return merged, extraGas, true
+}
+
+// executeTxTracked executes a tx on top of the block state, recording its
+// writes.
+func (app *BaseApp) executeTxTracked(keys map[string]storetypes.StoreKey, db storetypes.KVStore, txBytes []byte, written writeSet) *abci.ExecTxResult {
+	store, trackers := app.newTrackedTxStore(keys, db)
+
+	ctx := app.finalizeBlockState.Context().
+		WithMultiStore(store).
+		WithEventManager(sdk.NewEventManager())
+	ctx = app.prepareContextForTx(ctx, execModeFinalize, txBytes)
+
+	response := app.deliverTxWithContext(ctx, txBytes, app.mempool)
+
+	store.Write()
+	written.add(trackers)
+
+	return response
+}
+
+// newTrackedTxStore returns a branch of the block state which records the
+// operations reaching the block state.
+func (app *BaseApp) newTrackedTxStore(keys map[string]storetypes.StoreKey, db storetypes.KVStore) (storetypes.CacheMultiStore, map[storetypes.StoreKey]*trackedKVStore) {
+	trackers := make(map[storetypes.StoreKey]*trackedKVStore, len(keys))
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	for _, key := range keys {
+		tracker := newTrackedKVStore(app.finalizeBlockState.ms.GetKVStore(key), app.parallelExecMergers[key])
+		trackers[key] = tracker
+		stores[key] = tracker
+	}
+
+	return cachemulti.NewFromKVStore(db, stores, keys, nil, nil), trackers
+}
+
+// fitsBlockGas reports whether the given gas can be consumed on the block gas
+// meter without running out of gas.
+func fitsBlockGas(meter storetypes.GasMeter, gas storetypes.Gas) bool {
+	if _, ok := meter.(noopGasMeter); ok {
+		return true
+	}
+
+	consumed := meter.GasConsumed()
+	return consumed+gas >= consumed && consumed+gas <= meter.Limit()
+}
+
+// keyRange is an iterated range, nil bounds are unbounded.
+type keyRange struct {
+	start, end []byte
+}
+
+// trackedKVStore is a KVStore recording the keys read, the ranges iterated and
+// the keys written through it, and the values read of the mergeable keys.
+type trackedKVStore struct {
+	storetypes.KVStore
+
+	merger ParallelExecMerger
+	reads  map[string]struct{}
+	bases  map[string][]byte
+	ranges []keyRange
+	writes map[string]struct{}
+}
+
+var _ storetypes.KVStore = (*trackedKVStore)(nil)
+
+func newTrackedKVStore(parent storetypes.KVStore, merger ParallelExecMerger) *trackedKVStore {
+	return &trackedKVStore{
+		KVStore: parent,
+		merger:  merger,
+		reads:   make(map[string]struct{}),
+		bases:   make(map[string][]byte),
+		writes:  make(map[string]struct{}),
+	}
+}
+
+// Get implements KVStore.
+func (s *trackedKVStore) Get(key []byte) []byte {
+	s.reads[string(key)] = struct{}{}
+	value := s.KVStore.Get(key)
+
+	if s.merger != nil && s.merger.Mergeable(key) {
+		if _, ok := s.bases[string(key)]; !ok {
+			s.bases[string(key)] = value
+		}
+	}
+
+	return value
+}
+
+// Has implements KVStore.
+func (s *trackedKVStore) Has(key []byte) bool {
+	s.reads[string(key)] = struct{}{}
+	return s.KVStore.Has(key)
+}
+
+// Set implements KVStore.
+func (s *trackedKVStore) Set(key, value []byte) {
+	s.writes[string(key)] = struct{}{}
+	s.KVStore.Set(key, value)
+}
+
+// Delete implements KVStore.
+func (s *trackedKVStore) Delete(key []byte) {
+	s.writes[string(key)] = struct{}{}
+	s.KVStore.Delete(key)
+}
+
+// Iterator implements KVStore.
+func (s *trackedKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: start, end: end})
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements KVStore.
+func (s *trackedKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: start, end: end})
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// writeSet holds the sorted keys written by the committed txs of a block, per
+// store key.
+type writeSet map[storetypes.StoreKey][]string
+
+func newWriteSet() writeSet {
+	return make(writeSet)
+}
+
+// add adds the keys written through the trackers to the write set.
+func (ws writeSet) add(trackers map[storetypes.StoreKey]*trackedKVStore) {
+	for key, tracker := range trackers {
+		if len(tracker.writes) == 0 {
+			continue
+		}
+
+		written := ws[key]
+		for k := range tracker.writes {
+			i := sort.SearchStrings(written, k)
+			if i < len(written) && written[i] == k {
+				continue
+			}
+
+			written = append(written, "")
+			copy(written[i+1:], written[i:])
+			written[i] = k
+		}
+		ws[key] = written
+	}
+}
+
+// conflicts reports whether any key read or range iterated through the
+// trackers is in the write set, the merged keys read aside.
+func (ws writeSet) conflicts(trackers map[storetypes.StoreKey]*trackedKVStore, merged map[storetypes.StoreKey]map[string]struct{}) bool {
+	for key, tracker := range trackers {
+		written := ws[key]
+		if len(written) == 0 {
+			continue
+		}
+
+		for k := range tracker.reads {
+			if _, ok := merged[key][k]; ok {
+				continue
+			}
+
+			i := sort.SearchStrings(written, k)
+			if i < len(written) && written[i] == k {
+				return true
+			}
+		}
+
+		for _, r := range tracker.ranges {
+			i := sort.SearchStrings(written, string(r.start))
+			if i < len(written) && (r.end == nil || written[i] < string(r.end)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// speculativeGasMeter is the block gas meter of a speculative execution. It
+// counts the reads of the meter, as the value read differs from the one of a
+// sequential execution.
+type speculativeGasMeter struct {
+	storetypes.GasMeter
+
+	reads int
+}
+
+var _ storetypes.GasMeter = (*speculativeGasMeter)(nil)
+
+// observed reports whether the meter was read beyond the out of gas check done
+// by runTx before executing the tx.
+func (m *speculativeGasMeter) observed() bool {
+	return m.reads > 1
+}
+
+func (m *speculativeGasMeter) GasConsumed() storetypes.Gas {
+	m.reads++
+	return m.GasMeter.GasConsumed()
+}
+
+func (m *speculativeGasMeter) GasConsumedToLimit() storetypes.Gas {
+	m.reads++
+	return m.GasMeter.GasConsumedToLimit()
+}
+
+func (m *speculativeGasMeter) GasRemaining() storetypes.Gas {
+	m.reads++
+	return m.GasMeter.GasRemaining()
+}
+
+func (m *speculativeGasMeter) Limit() storetypes.Gas {
+	m.reads++
+	return m.GasMeter.Limit()
+}
+
+func (m *speculativeGasMeter) IsPastLimit() bool {
+	m.reads++
+	return m.GasMeter.IsPastLimit()
+}
+
+func (m *speculativeGasMeter) IsOutOfGas() bool {
+	m.reads++
+	return m.GasMeter.IsOutOfGas()
+}
+
+// recordingMempool is the mempool of a speculative execution. It records the
+// txs removed, to remove them from the app mempool once the execution is
+// committed.
+type recordingMempool struct {
+	mempool.NoOpMempool
+
+	removed []sdk.Tx
+}
+
+var _ mempool.Mempool = (*recordingMempool)(nil)
+
+// Remove implements Mempool.
+func (mp *recordingMempool) Remove(tx sdk.Tx) error {
+	mp.removed = append(mp.removed, tx)
+	return nil
+}
+
+// replay removes the recorded txs from the given mempool.
+func (mp *recordingMempool) replay(to mempool.Mempool) error {
+	for _, tx := range mp.removed {
+		if err := to.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,440 @@
+package baseapp_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const parallelTestGasLimit = uint64(1_000_000)
+
+// parallelTestFeesKey is the key of capKey1 every tx of the determinism harness
+// deposits a fee to.
+var parallelTestFeesKey = []byte("fees")
+
+// parallelTestOp is a MsgKeyValue executed by parallelKeyValueImpl, the prefix
+// of the key selects the operation.
+type parallelTestOp struct {
+	key   string
+	value []byte
+}
+
+// parallelTestTx is a tx of the determinism harness.
+type parallelTestTx struct {
+	sender     string
+	failOnAnte bool
+	ops        []parallelTestOp
+	// undecodable txs are included as garbage bytes in the block
+	undecodable bool
+}
+
+// parallelKeyValueImpl executes the ops of the determinism harness on capKey2:
+//   - set/<k>: sets the key to the value
+//   - inc/<k>: increments the counter stored at the key
+//   - sum/<k>: sets the key to the sum of the inc/ counters
+//   - rsum/<k>: same as sum/ with a reverse iterator
+//   - del/<k>: deletes the key given as value
+//   - gas/<k>: consumes the gas given as value
+//   - fail/<k>: sets the key and fails
+type parallelKeyValueImpl struct{}
+
+func (parallelKeyValueImpl) Set(ctx context.Context, msg *baseapptestutil.MsgKeyValue) (*baseapptestutil.MsgCreateKeyValueResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(capKey2)
+
+	key := string(msg.Key)
+	var result int64
+
+	switch {
+	case strings.HasPrefix(key, "set/"):
+		store.Set(msg.Key, msg.Value)
+
+	case strings.HasPrefix(key, "inc/"):
+		result = readParallelTestInt(store, msg.Key) + 1
+		writeParallelTestInt(store, msg.Key, result)
+
+	case strings.HasPrefix(key, "sum/"), strings.HasPrefix(key, "rsum/"):
+		var it storetypes.Iterator
+		if strings.HasPrefix(key, "sum/") {
+			it = storetypes.KVStorePrefixIterator(store, []byte("inc/"))
+		} else {
+			it = storetypes.KVStoreReversePrefixIterator(store, []byte("inc/"))
+		}
+		for ; it.Valid(); it.Next() {
+			i, err := binary.ReadVarint(bytes.NewBuffer(it.Value()))
+			if err != nil {
+				return nil, err
+			}
+			result += i
+		}
+		if err := it.Close(); err != nil {
+			return nil, err
+		}
+		writeParallelTestInt(store, msg.Key, result)
+
+	case strings.HasPrefix(key, "del/"):
+		store.Delete(msg.Value)
+
+	case strings.HasPrefix(key, "gas/"):
+		result = int64(binary.BigEndian.Uint64(msg.Value))
+		sdkCtx.GasMeter().ConsumeGas(uint64(result), "test")
+
+	case strings.HasPrefix(key, "fail/"):
+		store.Set(msg.Key, msg.Value)
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"key_value",
+		sdk.NewAttribute("key", key),
+		sdk.NewAttribute("result", strconv.FormatInt(result, 10)),
+	))
+
+	return &baseapptestutil.MsgCreateKeyValueResponse{}, nil
+}
+
+// parallelTestAnteHandler sets the tx gas meter, increments the nonce of the tx
+// sender and deposits a fee on capKey1.
+func parallelTestAnteHandler(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(feeTx.GetGas()))
+
+	vals, err := url.ParseQuery(tx.(sdk.TxWithMemo).GetMemo())
+	if err != nil {
+		return ctx, err
+	}
+
+	if vals.Get("failOnAnte") == "true" {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+	}
+
+	store := ctx.KVStore(capKey1)
+	nonceKey := []byte("nonce/" + vals.Get("sender"))
+	nonce := readParallelTestInt(store, nonceKey) + 1
+	writeParallelTestInt(store, nonceKey, nonce)
+	writeParallelTestInt(store, parallelTestFeesKey, readParallelTestInt(store, parallelTestFeesKey)+1)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		"ante_handler",
+		sdk.NewAttribute("nonce", strconv.FormatInt(nonce, 10)),
+	))
+
+	return ctx, nil
+}
+
+// parallelTestFeesMerger merges the fees deposited by parallelTestAnteHandler.
+type parallelTestFeesMerger struct{}
+
+func (parallelTestFeesMerger) Mergeable(key []byte) bool {
+	return bytes.Equal(key, parallelTestFeesKey)
+}
+
+func (parallelTestFeesMerger) Merge(base, written, committed []byte) ([]byte, error) {
+	deposit := decodeParallelTestInt(written) - decodeParallelTestInt(base)
+	if deposit < 0 {
+		return nil, errors.New("fees withdrawn")
+	}
+
+	return encodeParallelTestInt(decodeParallelTestInt(committed) + deposit), nil
+}
+
+func readParallelTestInt(store storetypes.KVStore, key []byte) int64 {
+	return decodeParallelTestInt(store.Get(key))
+}
+
+func writeParallelTestInt(store storetypes.KVStore, key []byte, i int64) {
+	store.Set(key, encodeParallelTestInt(i))
+}
+
+func decodeParallelTestInt(bz []byte) int64 {
+	i, err := binary.ReadVarint(bytes.NewBuffer(bz))
+	if err != nil {
+		return 0
+	}
+
+	return i
+}
+
+func encodeParallelTestInt(i int64) []byte {
+	bz := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutVarint(bz, i)
+	return bz[:n]
+}
+
+func encodeParallelTestTx(t *testing.T, cfg client.TxConfig, tx parallelTestTx) []byte {
+	t.Helper()
+
+	if tx.undecodable {
+		return []byte("undecodable")
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	msgs := make([]sdk.Msg, 0, len(tx.ops))
+	for _, op := range tx.ops {
+		// MsgKeyValue requires a value
+		value := op.value
+		if value == nil {
+			value = []byte("value")
+		}
+
+		msgs = append(msgs, &baseapptestutil.MsgKeyValue{Key: []byte(op.key), Value: value, Signer: addr.String()})
+	}
+
+	builder := cfg.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetMemo(url.Values{
+		"sender":     []string{tx.sender},
+		"failOnAnte": []string{strconv.FormatBool(tx.failOnAnte)},
+	}.Encode())
+	builder.SetGasLimit(parallelTestGasLimit)
+	setTxSignature(t, builder, 0)
+
+	bz, err := cfg.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	return bz
+}
+
+func gasOp(gas uint64) parallelTestOp {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, gas)
+	return parallelTestOp{key: "gas/", value: bz}
+}
+
+// requireParallelExecutionDeterminism executes the blocks sequentially and in
+// parallel, and requires the results and the app hashes to be identical. It
+// returns the number of tx executions of the parallel execution.
+func requireParallelExecutionDeterminism(t *testing.T, maxBlockGas int64, blocks [][]parallelTestTx) int64 {
+	t.Helper()
+
+	var executions atomic.Int64
+	newApp := func(anteHandler sdk.AnteHandler, opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
+		opts = append(opts, func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandler) })
+		suite := NewBaseAppSuite(t, opts...)
+		baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), parallelKeyValueImpl{})
+
+		_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: maxBlockGas}},
+		})
+		require.NoError(t, err)
+
+		return suite
+	}
+
+	sequential := newApp(parallelTestAnteHandler)
+	parallel := newApp(
+		func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			executions.Add(1)
+			return parallelTestAnteHandler(ctx, tx, simulate)
+		},
+		baseapp.SetParallelExecution(4),
+		baseapp.SetParallelExecMerger(capKey1, parallelTestFeesMerger{}),
+	)
+
+	succeeded := 0
+
+	for i, block := range blocks {
+		txs := make([][]byte, 0, len(block))
+		for _, tx := range block {
+			txs = append(txs, encodeParallelTestTx(t, sequential.txConfig, tx))
+		}
+
+		req := &abci.RequestFinalizeBlock{Height: int64(i + 1), Txs: txs}
+
+		expected, err := sequential.baseApp.FinalizeBlock(req)
+		require.NoError(t, err)
+
+		res, err := parallel.baseApp.FinalizeBlock(req)
+		require.NoError(t, err)
+
+		require.Equal(t, expected.TxResults, res.TxResults, "block %d", i+1)
+		for _, txResult := range expected.TxResults {
+			if txResult.IsOK() {
+				succeeded++
+			}
+		}
+		require.Equal(t, expected.AppHash, res.AppHash, "block %d", i+1)
+
+		_, err = sequential.baseApp.Commit()
+		require.NoError(t, err)
+
+		_, err = parallel.baseApp.Commit()
+		require.NoError(t, err)
+
+		require.Equal(t, sequential.baseApp.LastCommitID(), parallel.baseApp.LastCommitID(), "block %d", i+1)
+	}
+
+	// make sure the blocks are not made of failing txs only
+	require.NotZero(t, succeeded)
+
+	return executions.Load()
+}
+
+func TestParallelExecution_IndependentTxs(t *testing.T) {
+	block := make([]parallelTestTx, 0, 50)
+	for i := 0; i < 50; i++ {
+		block = append(block, parallelTestTx{
+			sender: fmt.Sprintf("sender%d", i),
+			ops: []parallelTestOp{
+				{key: fmt.Sprintf("set/%d", i), value: []byte("value")},
+				{key: fmt.Sprintf("inc/%d", i)},
+			},
+		})
+	}
+
+	requireParallelExecutionDeterminism(t, 0, [][]parallelTestTx{block, block})
+}
+
+func TestParallelExecution_FeePayingTxs(t *testing.T) {
+	block := make([]parallelTestTx, 0, 100)
+	for i := 0; i < 100; i++ {
+		block = append(block, parallelTestTx{
+			sender: fmt.Sprintf("sender%d", i),
+			ops:    []parallelTestOp{{key: fmt.Sprintf("inc/%d", i)}},
+		})
+	}
+
+	// the fee deposits are merged, none of the txs is re-executed
+	executions := requireParallelExecutionDeterminism(t, 0, [][]parallelTestTx{block, block})
+	require.Equal(t, int64(2*len(block)), executions)
+}
+
+func TestParallelExecution_ConflictingTxs(t *testing.T) {
+	block := make([]parallelTestTx, 0, 50)
+	for i := 0; i < 50; i++ {
+		// every tx increments the same counter, and half of them share a sender
+		block = append(block, parallelTestTx{
+			sender: fmt.Sprintf("sender%d", i%25),
+			ops:    []parallelTestOp{{key: "inc/shared"}},
+		})
+	}
+
+	requireParallelExecutionDeterminism(t, 0, [][]parallelTestTx{block, block})
+}
+
+func TestParallelExecution_Iterators(t *testing.T) {
+	block := make([]parallelTestTx, 0, 40)
+	for i := 0; i < 40; i++ {
+		op := parallelTestOp{key: fmt.Sprintf("inc/%d", i%7)}
+		switch i % 5 {
+		case 1:
+			op = parallelTestOp{key: fmt.Sprintf("sum/%d", i)}
+		case 3:
+			op = parallelTestOp{key: fmt.Sprintf("rsum/%d", i)}
+		case 4:
+			op = parallelTestOp{key: fmt.Sprintf("del/%d", i), value: []byte(fmt.Sprintf("inc/%d", i%7))}
+		}
+
+		block = append(block, parallelTestTx{sender: fmt.Sprintf("sender%d", i), ops: []parallelTestOp{op}})
+	}
+
+	requireParallelExecutionDeterminism(t, 0, [][]parallelTestTx{block, block})
+}
+
+func TestParallelExecution_FailingTxs(t *testing.T) {
+	block := make([]parallelTestTx, 0, 40)
+	for i := 0; i < 40; i++ {
+		tx := parallelTestTx{
+			sender: fmt.Sprintf("sender%d", i%3),
+			ops:    []parallelTestOp{{key: fmt.Sprintf("inc/%d", i%4)}},
+		}
+
+		switch i % 4 {
+		case 1:
+			tx.failOnAnte = true
+		case 2:
+			tx.ops = append(tx.ops, parallelTestOp{key: fmt.Sprintf("fail/%d", i), value: []byte("value")})
+		case 3:
+			tx.undecodable = true
+		}
+
+		block = append(block, tx)
+	}
+
+	requireParallelExecutionDeterminism(t, 0, [][]parallelTestTx{block, block})
+}
+
+func TestParallelExecution_BlockGasLimit(t *testing.T) {
+	block := make([]parallelTestTx, 0, 30)
+	for i := 0; i < 30; i++ {
+		block = append(block, parallelTestTx{
+			sender: fmt.Sprintf("sender%d", i),
+			ops: []parallelTestOp{
+				{key: fmt.Sprintf("inc/%d", i)},
+				gasOp(uint64(10_000 + 1_000*i)),
+			},
+		})
+	}
+
+	requireParallelExecutionDeterminism(t, 200_000, [][]parallelTestTx{block, block})
+}
+
+func TestParallelExecution_RandomTxs(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	randomOp := func() parallelTestOp {
+		k := r.Intn(10)
+		switch r.Intn(7) {
+		case 0:
+			return parallelTestOp{key: fmt.Sprintf("set/%d", k), value: []byte(strconv.Itoa(r.Int()))}
+		case 1:
+			return parallelTestOp{key: fmt.Sprintf("sum/%d", k)}
+		case 2:
+			return parallelTestOp{key: fmt.Sprintf("rsum/%d", k)}
+		case 3:
+			return parallelTestOp{key: fmt.Sprintf("del/%d", k), value: []byte(fmt.Sprintf("inc/%d", r.Intn(10)))}
+		case 4:
+			return parallelTestOp{key: fmt.Sprintf("fail/%d", k), value: []byte("value")}
+		case 5:
+			return gasOp(uint64(r.Intn(20_000)))
+		default:
+			return parallelTestOp{key: fmt.Sprintf("inc/%d", k)}
+		}
+	}
+
+	blocks := make([][]parallelTestTx, 0, 10)
+	for b := 0; b < 10; b++ {
+		block := make([]parallelTestTx, 0, 40)
+		for i := 0; i < 40; i++ {
+			tx := parallelTestTx{
+				sender:      fmt.Sprintf("sender%d", r.Intn(20)),
+				failOnAnte:  r.Intn(10) == 0,
+				undecodable: r.Intn(20) == 0,
+			}
+			for n := 1 + r.Intn(3); n > 0; n-- {
+				tx.ops = append(tx.ops, randomOp())
+			}
+
+			block = append(block, tx)
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	requireParallelExecutionDeterminism(t, 1_000_000, blocks)
+}
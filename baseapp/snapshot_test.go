@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/stretchr/testify/require"
@@ -34,11 +35,42 @@ func TestABCI_ListSnapshots(t *testing.T) {
 	}
 
 	require.Equal(t, &abci.ResponseListSnapshots{Snapshots: []*abci.Snapshot{
-		{Height: 4, Format: snapshottypes.CurrentFormat, Chunks: 2},
-		{Height: 2, Format: snapshottypes.CurrentFormat, Chunks: 1},
+		{Height: 4, Format: snapshottypes.CurrentFormat, Chunks: 3},
+		{Height: 2, Format: snapshottypes.CurrentFormat, Chunks: 2},
 	}}, resp)
 }
 
+func TestABCI_ListSnapshotsWithoutIncremental(t *testing.T) {
+	ssCfg := SnapshotsConfig{
+		blocks:                      4,
+		blockTxs:                    4,
+		snapshotInterval:            4,
+		snapshotKeepRecent:          2,
+		snapshotIncrementalInterval: 5,
+		pruningOpts:                 pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+	}
+
+	suite := NewBaseAppSuiteWithSnapshots(t, ssCfg)
+
+	_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 5})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		snapshots, err := suite.baseApp.SnapshotManager().List()
+		require.NoError(t, err)
+		return len(snapshots) == 2 && snapshots[0].Height == 5 && snapshots[0].Format == snapshottypes.FormatIncremental
+	}, time.Minute, 100*time.Millisecond)
+
+	// the incremental snapshots can't be restored by state sync
+	resp, err := suite.baseApp.ListSnapshots(&abci.RequestListSnapshots{})
+	require.NoError(t, err)
+	require.Len(t, resp.Snapshots, 1)
+	require.Equal(t, uint64(4), resp.Snapshots[0].Height)
+	require.Equal(t, snapshottypes.CurrentFormat, resp.Snapshots[0].Format)
+}
+
 func TestABCI_SnapshotWithPruning(t *testing.T) {
 	testCases := map[string]struct {
 		ssCfg             SnapshotsConfig
@@ -53,7 +85,7 @@ func TestABCI_SnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 6},
 			},
 		},
 		"prune everything with snapshot": {
@@ -65,7 +97,7 @@ func TestABCI_SnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningEverything),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 6},
 			},
 		},
 		"default pruning with snapshot": {
@@ -77,7 +109,7 @@ func TestABCI_SnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningDefault),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 6},
 			},
 		},
 		"custom": {
@@ -89,8 +121,8 @@ func TestABCI_SnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewCustomPruningOptions(12, 12),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 25, Format: snapshottypes.CurrentFormat, Chunks: 6},
-				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
+				{Height: 25, Format: snapshottypes.CurrentFormat, Chunks: 7},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 6},
 			},
 		},
 		"no snapshots": {
@@ -111,9 +143,9 @@ func TestABCI_SnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 9, Format: snapshottypes.CurrentFormat, Chunks: 2},
-				{Height: 6, Format: snapshottypes.CurrentFormat, Chunks: 2},
-				{Height: 3, Format: snapshottypes.CurrentFormat, Chunks: 1},
+				{Height: 9, Format: snapshottypes.CurrentFormat, Chunks: 3},
+				{Height: 6, Format: snapshottypes.CurrentFormat, Chunks: 3},
+				{Height: 3, Format: snapshottypes.CurrentFormat, Chunks: 2},
 			},
 		},
 	}
@@ -243,6 +275,9 @@ func TestABCI_OfferSnapshot_Errors(t *testing.T) {
 		"invalid metadata serialization": {&abci.Snapshot{
 			Height: 1, Format: snapshottypes.CurrentFormat, Chunks: 0, Hash: hash, Metadata: []byte{3, 1, 4},
 		}, abci.ResponseOfferSnapshot_REJECT},
+		"no sections": {&abci.Snapshot{
+			Height: 1, Format: snapshottypes.FormatSections, Chunks: 3, Hash: hash, Metadata: metadata,
+		}, abci.ResponseOfferSnapshot_REJECT},
 	}
 	for name, tc := range testCases {
 		tc := tc
@@ -256,7 +291,7 @@ func TestABCI_OfferSnapshot_Errors(t *testing.T) {
 	// Offering a snapshot after one has been accepted should error
 	resp, err := suite.baseApp.OfferSnapshot(&abci.RequestOfferSnapshot{Snapshot: &abci.Snapshot{
 		Height:   1,
-		Format:   snapshottypes.CurrentFormat,
+		Format:   snapshottypes.FormatStream,
 		Chunks:   3,
 		Hash:     []byte{1, 2, 3},
 		Metadata: metadata,
@@ -266,7 +301,7 @@ func TestABCI_OfferSnapshot_Errors(t *testing.T) {
 
 	resp, err = suite.baseApp.OfferSnapshot(&abci.RequestOfferSnapshot{Snapshot: &abci.Snapshot{
 		Height:   2,
-		Format:   snapshottypes.CurrentFormat,
+		Format:   snapshottypes.FormatStream,
 		Chunks:   3,
 		Hash:     []byte{1, 2, 3},
 		Metadata: metadata,
@@ -1,15 +1,23 @@
 package baseapp
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"path/filepath"
 	"sort"
 	"strings"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	"github.com/spf13/cast"
 
 	"cosmossdk.io/store/streaming"
 	storetypes "cosmossdk.io/store/types"
 
+	"cosmossdk.io/log"
+
+	filestreaming "github.com/cosmos/cosmos-sdk/baseapp/streaming/file"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
@@ -20,6 +28,14 @@ const (
 	StreamingABCIPluginTomlKey        = "plugin"
 	StreamingABCIKeysTomlKey          = "keys"
 	StreamingABCIStopNodeOnErrTomlKey = "stop-node-on-err"
+
+	StreamingFileTomlKey              = "file"
+	StreamingFileEnableTomlKey        = "enable"
+	StreamingFileKeysTomlKey          = "keys"
+	StreamingFileDirTomlKey           = "dir"
+	StreamingFileFormatTomlKey        = "format"
+	StreamingFileRotateBlocksTomlKey  = "rotate-blocks"
+	StreamingFileStopNodeOnErrTomlKey = "stop-node-on-err"
 )
 
 // RegisterStreamingServices registers streaming services with the BaseApp.
@@ -41,6 +57,53 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 		}
 	}
 
+	enableKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingFileTomlKey, StreamingFileEnableTomlKey)
+	if cast.ToBool(appOpts.Get(enableKey)) {
+		if err := app.registerFileListener(appOpts, keys); err != nil {
+			return fmt.Errorf("failed to register streaming file listener: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// registerFileListener registers the in-process listener writing the streamed
+// data to local files.
+func (app *BaseApp) registerFileListener(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) error {
+	fileOpt := func(opt string) interface{} {
+		return appOpts.Get(fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingFileTomlKey, opt))
+	}
+
+	dir := cast.ToString(fileOpt(StreamingFileDirTomlKey))
+	if dir == "" {
+		dir = filepath.Join("data", "streaming")
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), dir)
+	}
+
+	format := filestreaming.Format(cast.ToString(fileOpt(StreamingFileFormatTomlKey)))
+	if format == "" {
+		format = filestreaming.FormatJSONL
+	}
+
+	exposeKeysStr := cast.ToStringSlice(fileOpt(StreamingFileKeysTomlKey))
+	stopNodeOnErr := cast.ToBool(fileOpt(StreamingFileStopNodeOnErrTomlKey))
+
+	listener, err := filestreaming.NewListener(filestreaming.Config{
+		Dir:          dir,
+		Format:       format,
+		Keys:         exposeKeysStr,
+		RotateBlocks: cast.ToUint64(fileOpt(StreamingFileRotateBlocksTomlKey)),
+		// blocks are acknowledged once durably written when the node must stop
+		// on errors, so that no block is committed without being streamed
+		Fsync: stopNodeOnErr,
+	})
+	if err != nil {
+		return err
+	}
+
+	app.addABCIListener(listener, exposeStoreKeysSorted(exposeKeysStr, keys), stopNodeOnErr)
 	return nil
 }
 
@@ -70,15 +133,95 @@ func (app *BaseApp) registerABCIListenerPlugin(
 	keysKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingABCITomlKey, StreamingABCIKeysTomlKey)
 	exposeKeysStr := cast.ToStringSlice(appOpts.Get(keysKey))
 	exposedKeys := exposeStoreKeysSorted(exposeKeysStr, keys)
-	app.cms.AddListeners(exposedKeys)
+	app.addABCIListener(abciListener, exposedKeys, stopNodeOnErr)
+}
+
+// addABCIListener adds a listener to the streaming manager, listening to the
+// changes of the given stores. The errors of a listener which must not stop
+// the node are only logged.
+func (app *BaseApp) addABCIListener(abciListener storetypes.ABCIListener, storeKeys []storetypes.StoreKey, stopNodeOnErr bool) {
+	app.cms.AddListeners(storeKeys)
+
+	// the change sets hold the changes of the stores of all the listeners
+	storeNames := make(map[string]struct{}, len(storeKeys))
+	for _, key := range storeKeys {
+		storeNames[key.Name()] = struct{}{}
+	}
+	abciListener = storeKeysABCIListener{ABCIListener: abciListener, storeNames: storeNames}
+
+	if !stopNodeOnErr {
+		abciListener = loggingABCIListener{ABCIListener: abciListener, logger: app.logger}
+	}
+
 	app.SetStreamingManager(
 		storetypes.StreamingManager{
-			ABCIListeners: []storetypes.ABCIListener{abciListener},
-			StopNodeOnErr: stopNodeOnErr,
+			ABCIListeners: append(app.streamingManager.ABCIListeners, abciListener),
+			StopNodeOnErr: app.streamingManager.StopNodeOnErr || stopNodeOnErr,
 		},
 	)
 }
 
+// storeKeysABCIListener is an ABCIListener receiving the changes of the given
+// stores only.
+type storeKeysABCIListener struct {
+	storetypes.ABCIListener
+
+	storeNames map[string]struct{}
+}
+
+func (l storeKeysABCIListener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	pairs := make([]*storetypes.StoreKVPair, 0, len(changeSet))
+	for _, pair := range changeSet {
+		if _, ok := l.storeNames[pair.StoreKey]; ok {
+			pairs = append(pairs, pair)
+		}
+	}
+
+	return l.ABCIListener.ListenCommit(ctx, res, pairs)
+}
+
+// Close closes the wrapped listener if it is an io.Closer.
+func (l storeKeysABCIListener) Close() error {
+	if closer, ok := l.ABCIListener.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+// loggingABCIListener is an ABCIListener logging the errors of the wrapped
+// listener instead of returning them.
+type loggingABCIListener struct {
+	storetypes.ABCIListener
+
+	logger log.Logger
+}
+
+func (l loggingABCIListener) ListenFinalizeBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	if err := l.ABCIListener.ListenFinalizeBlock(ctx, req, res); err != nil {
+		l.logger.Error("ListenFinalizeBlock listening hook failed", "height", req.Height, "err", err)
+	}
+
+	return nil
+}
+
+func (l loggingABCIListener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	if err := l.ABCIListener.ListenCommit(ctx, res, changeSet); err != nil {
+		l.logger.Error("Commit listening hook failed", "err", err)
+	}
+
+	return nil
+}
+
+// Close closes the wrapped listener if it is an io.Closer.
+func (l loggingABCIListener) Close() error {
+	if closer, ok := l.ABCIListener.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
 func exposeAll(list []string) bool {
 	for _, ele := range list {
 		if ele == "*" {
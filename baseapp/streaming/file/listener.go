@@ -0,0 +1,336 @@
+// Package file implements an in-process ABCIListener writing the FinalizeBlock
+// messages, the Commit response and the state changes of every block to
+// rotating local files, which indexers running on the same host can tail.
+package file
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/cosmos/gogoproto/proto"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Format is the layout of the change set in the records written by the
+// listener.
+type Format string
+
+const (
+	// FormatJSONL writes the change set of a block as an array of store
+	// key-value pairs.
+	FormatJSONL Format = "jsonl"
+	// FormatJSONLColumns writes the change set of a block as one JSON array per
+	// field of the store key-value pairs, see ChangeSetColumns.
+	FormatJSONLColumns Format = "jsonl-columns"
+)
+
+// Config defines the configuration of the file listener.
+type Config struct {
+	// Dir is the directory the files are written to.
+	Dir string
+	// Format is the layout of the change sets.
+	Format Format
+	// Keys are the names of the stores whose changes are written, "*" writes
+	// the changes of all the stores.
+	Keys []string
+	// RotateBlocks is the number of blocks written to a file before starting a
+	// new one, files are never rotated if it is zero.
+	RotateBlocks uint64
+	// Fsync syncs the file to disk after every block, so that a block is only
+	// acknowledged once it is durably written.
+	Fsync bool
+}
+
+// Record is the line written to the files for every block.
+type Record struct {
+	Height int64 `json:"height"`
+	// FinalizeBlockRequest and FinalizeBlockResponse are the protobuf JSON
+	// encodings of the FinalizeBlock messages of the block.
+	FinalizeBlockRequest  json.RawMessage `json:"finalize_block_request"`
+	FinalizeBlockResponse json.RawMessage `json:"finalize_block_response"`
+	// CommitResponse is the protobuf JSON encoding of the Commit response.
+	CommitResponse json.RawMessage `json:"commit_response"`
+	// ChangeSet is a []*storetypes.StoreKVPair with FormatJSONL, and a
+	// ChangeSetColumns with FormatJSONLColumns.
+	ChangeSet json.RawMessage `json:"change_set"`
+}
+
+// ChangeSetColumns is the per-field layout of a change set, the i-th store
+// key-value pair of the change set is made of the i-th element of each column.
+type ChangeSetColumns struct {
+	StoreKey []string `json:"store_key"`
+	Delete   []bool   `json:"delete"`
+	Key      [][]byte `json:"key"`
+	Value    [][]byte `json:"value"`
+}
+
+var _ storetypes.ABCIListener = (*Listener)(nil)
+
+// Listener is an ABCIListener writing a Record per block to files named after
+// the height of their first block. Records are written on Commit, along with
+// the FinalizeBlock messages received for the same block.
+//
+// A block can be written twice when the node restarts after writing it but
+// before persisting its state, in which case the last record of a height wins.
+type Listener struct {
+	cfg  Config
+	keys map[string]struct{}
+
+	mtx       sync.Mutex
+	file      *os.File
+	fileStart int64
+
+	height                int64
+	finalizeBlockRequest  json.RawMessage
+	finalizeBlockResponse json.RawMessage
+}
+
+// NewListener returns a file listener writing to the configured directory,
+// which is created if it does not exist.
+func NewListener(cfg Config) (*Listener, error) {
+	switch cfg.Format {
+	case FormatJSONL, FormatJSONLColumns:
+	default:
+		return nil, fmt.Errorf("unknown streaming file format %q", cfg.Format)
+	}
+
+	if cfg.Dir == "" {
+		return nil, errors.New("streaming file directory cannot be empty")
+	}
+
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create streaming file directory: %w", err)
+	}
+
+	var keys map[string]struct{}
+	if !exposeAll(cfg.Keys) {
+		keys = make(map[string]struct{}, len(cfg.Keys))
+		for _, key := range cfg.Keys {
+			keys[key] = struct{}{}
+		}
+	}
+
+	return &Listener{cfg: cfg, keys: keys}, nil
+}
+
+// ListenFinalizeBlock implements ABCIListener. The messages are kept until the
+// Commit of the block.
+func (l *Listener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	reqBz, err := marshalJSON(&req)
+	if err != nil {
+		return err
+	}
+
+	resBz, err := marshalJSON(&res)
+	if err != nil {
+		return err
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.height = req.Height
+	l.finalizeBlockRequest = reqBz
+	l.finalizeBlockResponse = resBz
+
+	return nil
+}
+
+// ListenCommit implements ABCIListener. It writes the record of the committed
+// block and, if configured, syncs it to disk before returning.
+func (l *Listener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	resBz, err := marshalJSON(&res)
+	if err != nil {
+		return err
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	height := l.height
+	if height == 0 {
+		height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	}
+
+	changeSetBz, err := l.marshalChangeSet(changeSet)
+	if err != nil {
+		return err
+	}
+
+	record := Record{
+		Height:                height,
+		FinalizeBlockRequest:  l.finalizeBlockRequest,
+		FinalizeBlockResponse: l.finalizeBlockResponse,
+		CommitResponse:        resBz,
+		ChangeSet:             changeSetBz,
+	}
+
+	l.height = 0
+	l.finalizeBlockRequest = nil
+	l.finalizeBlockResponse = nil
+
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal streaming record: %w", err)
+	}
+
+	return l.write(height, append(bz, '\n'))
+}
+
+// Close closes the file being written.
+func (l *Listener) Close() error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+
+	err := l.file.Close()
+	l.file = nil
+
+	return err
+}
+
+// write writes the record of a block, rotating the file if needed.
+func (l *Listener) write(height int64, bz []byte) error {
+	if l.file != nil && l.cfg.RotateBlocks > 0 && height-l.fileStart >= int64(l.cfg.RotateBlocks) {
+		if err := l.file.Close(); err != nil {
+			return fmt.Errorf("failed to close streaming file: %w", err)
+		}
+		l.file = nil
+	}
+
+	if l.file == nil {
+		file, err := os.OpenFile(l.FileName(height), os.O_CREATE|os.O_APPEND|os.O_RDWR, 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to open streaming file: %w", err)
+		}
+
+		if err := terminateLastLine(file); err != nil {
+			_ = file.Close()
+			return fmt.Errorf("failed to open streaming file: %w", err)
+		}
+
+		l.file = file
+		l.fileStart = height
+	}
+
+	if _, err := l.file.Write(bz); err != nil {
+		return fmt.Errorf("failed to write streaming record at height %d: %w", height, err)
+	}
+
+	if l.cfg.Fsync {
+		if err := l.file.Sync(); err != nil {
+			return fmt.Errorf("failed to sync streaming record at height %d: %w", height, err)
+		}
+	}
+
+	return nil
+}
+
+// terminateLastLine ends the last line of a file opened for appending, in case
+// its write was interrupted.
+func terminateLastLine(file *os.File) error {
+	info, err := file.Stat()
+	if err != nil || info.Size() == 0 {
+		return err
+	}
+
+	last := make([]byte, 1)
+	if _, err := file.ReadAt(last, info.Size()-1); err != nil {
+		return err
+	}
+
+	if last[0] != '\n' {
+		_, err = file.Write([]byte{'\n'})
+	}
+
+	return err
+}
+
+// FileName returns the name of the file starting at the given height.
+func (l *Listener) FileName(height int64) string {
+	ext := "jsonl"
+	if l.cfg.Format == FormatJSONLColumns {
+		ext = "columns.jsonl"
+	}
+
+	return filepath.Join(l.cfg.Dir, fmt.Sprintf("blocks-%012d.%s", height, ext))
+}
+
+// marshalChangeSet encodes the changes of the streamed stores in the configured
+// format.
+func (l *Listener) marshalChangeSet(changeSet []*storetypes.StoreKVPair) (json.RawMessage, error) {
+	pairs := make([]*storetypes.StoreKVPair, 0, len(changeSet))
+	for _, pair := range changeSet {
+		if l.keys != nil {
+			if _, ok := l.keys[pair.StoreKey]; !ok {
+				continue
+			}
+		}
+
+		pairs = append(pairs, pair)
+	}
+
+	var (
+		bz  []byte
+		err error
+	)
+
+	if l.cfg.Format == FormatJSONLColumns {
+		columns := ChangeSetColumns{
+			StoreKey: make([]string, len(pairs)),
+			Delete:   make([]bool, len(pairs)),
+			Key:      make([][]byte, len(pairs)),
+			Value:    make([][]byte, len(pairs)),
+		}
+		for i, pair := range pairs {
+			columns.StoreKey[i] = pair.StoreKey
+			columns.Delete[i] = pair.Delete
+			columns.Key[i] = pair.Key
+			columns.Value[i] = pair.Value
+		}
+
+		bz, err = json.Marshal(columns)
+	} else {
+		bz, err = json.Marshal(pairs)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal change set: %w", err)
+	}
+
+	return bz, nil
+}
+
+// marshalJSON returns the protobuf JSON encoding of an ABCI message.
+func marshalJSON(msg proto.Message) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, msg); err != nil {
+		return nil, fmt.Errorf("failed to marshal %T: %w", msg, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func exposeAll(keys []string) bool {
+	for _, key := range keys {
+		if key == "*" {
+			return true
+		}
+	}
+
+	return false
+}
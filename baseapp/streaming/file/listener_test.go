@@ -0,0 +1,172 @@
+package file_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/streaming/file"
+)
+
+var changeSet = []*storetypes.StoreKVPair{
+	{StoreKey: "bank", Key: []byte{1}, Value: []byte{2}},
+	{StoreKey: "staking", Key: []byte{3}, Delete: true},
+	{StoreKey: "bank", Key: []byte{4}, Value: []byte{5}},
+}
+
+func listenBlock(t *testing.T, listener *file.Listener, height int64) {
+	t.Helper()
+
+	req := abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{{0xab}}}
+	res := abci.ResponseFinalizeBlock{AppHash: []byte{byte(height)}}
+	require.NoError(t, listener.ListenFinalizeBlock(context.Background(), req, res))
+	require.NoError(t, listener.ListenCommit(context.Background(), abci.ResponseCommit{RetainHeight: height}, changeSet))
+}
+
+func readRecords(t *testing.T, path string) []file.Record {
+	t.Helper()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var records []file.Record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record file.Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+
+	return records
+}
+
+func TestListenerJSONL(t *testing.T) {
+	dir := t.TempDir()
+	listener, err := file.NewListener(file.Config{Dir: dir, Format: file.FormatJSONL, Keys: []string{"bank"}, Fsync: true})
+	require.NoError(t, err)
+
+	listenBlock(t, listener, 1)
+	listenBlock(t, listener, 2)
+	require.NoError(t, listener.Close())
+
+	records := readRecords(t, listener.FileName(1))
+	require.Len(t, records, 2)
+
+	for i, record := range records {
+		height := int64(i + 1)
+		require.Equal(t, height, record.Height)
+
+		var req abci.RequestFinalizeBlock
+		require.NoError(t, jsonpb.UnmarshalString(string(record.FinalizeBlockRequest), &req))
+		require.Equal(t, height, req.Height)
+		require.Equal(t, [][]byte{{0xab}}, req.Txs)
+
+		var res abci.ResponseFinalizeBlock
+		require.NoError(t, jsonpb.UnmarshalString(string(record.FinalizeBlockResponse), &res))
+		require.Equal(t, []byte{byte(height)}, res.AppHash)
+
+		var commitRes abci.ResponseCommit
+		require.NoError(t, jsonpb.UnmarshalString(string(record.CommitResponse), &commitRes))
+		require.Equal(t, height, commitRes.RetainHeight)
+
+		// only the changes of the streamed stores are written
+		var pairs []*storetypes.StoreKVPair
+		require.NoError(t, json.Unmarshal(record.ChangeSet, &pairs))
+		require.Equal(t, []*storetypes.StoreKVPair{changeSet[0], changeSet[2]}, pairs)
+	}
+}
+
+func TestListenerJSONLColumns(t *testing.T) {
+	dir := t.TempDir()
+	listener, err := file.NewListener(file.Config{Dir: dir, Format: file.FormatJSONLColumns, Keys: []string{"*"}})
+	require.NoError(t, err)
+
+	listenBlock(t, listener, 1)
+	require.NoError(t, listener.Close())
+
+	records := readRecords(t, listener.FileName(1))
+	require.Len(t, records, 1)
+
+	var columns file.ChangeSetColumns
+	require.NoError(t, json.Unmarshal(records[0].ChangeSet, &columns))
+	require.Equal(t, file.ChangeSetColumns{
+		StoreKey: []string{"bank", "staking", "bank"},
+		Delete:   []bool{false, true, false},
+		Key:      [][]byte{{1}, {3}, {4}},
+		Value:    [][]byte{{2}, nil, {5}},
+	}, columns)
+}
+
+func TestListenerRotation(t *testing.T) {
+	dir := t.TempDir()
+	listener, err := file.NewListener(file.Config{Dir: dir, Format: file.FormatJSONL, RotateBlocks: 2})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 5; height++ {
+		listenBlock(t, listener, height)
+	}
+	require.NoError(t, listener.Close())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	for start, count := range map[int64]int{1: 2, 3: 2, 5: 1} {
+		records := readRecords(t, listener.FileName(start))
+		require.Len(t, records, count)
+		require.Equal(t, start, records[0].Height)
+
+		// no store is streamed
+		require.JSONEq(t, "[]", string(records[0].ChangeSet))
+	}
+
+	// a restarted listener appends to the file of its first block
+	listener, err = file.NewListener(file.Config{Dir: dir, Format: file.FormatJSONL, RotateBlocks: 2})
+	require.NoError(t, err)
+	listenBlock(t, listener, 5)
+	require.NoError(t, listener.Close())
+	require.Len(t, readRecords(t, filepath.Join(dir, "blocks-000000000005.jsonl")), 2)
+}
+
+func TestListenerInterruptedWrite(t *testing.T) {
+	dir := t.TempDir()
+	listener, err := file.NewListener(file.Config{Dir: dir, Format: file.FormatJSONL})
+	require.NoError(t, err)
+
+	// a record cut by a crash is terminated by the next write
+	require.NoError(t, os.WriteFile(listener.FileName(1), []byte(`{"height":1,"fin`), 0o600))
+
+	listenBlock(t, listener, 1)
+	require.NoError(t, listener.Close())
+
+	bz, err := os.ReadFile(listener.FileName(1))
+	require.NoError(t, err)
+
+	lines := bytes.Split(bytes.TrimSuffix(bz, []byte("\n")), []byte("\n"))
+	require.Len(t, lines, 2)
+	require.Equal(t, `{"height":1,"fin`, string(lines[0]))
+
+	var record file.Record
+	require.NoError(t, json.Unmarshal(lines[1], &record))
+	require.Equal(t, int64(1), record.Height)
+}
+
+func TestNewListenerErrors(t *testing.T) {
+	_, err := file.NewListener(file.Config{Dir: t.TempDir(), Format: "parquet"})
+	require.ErrorContains(t, err, "unknown streaming file format")
+
+	_, err = file.NewListener(file.Config{Format: file.FormatJSONL})
+	require.ErrorContains(t, err, "directory cannot be empty")
+}
@@ -2,7 +2,11 @@ package baseapp_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -12,7 +16,10 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	filestreaming "github.com/cosmos/cosmos-sdk/baseapp/streaming/file"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 )
 
 var _ storetypes.ABCIListener = (*MockABCIListener)(nil)
@@ -144,3 +151,90 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		suite.baseApp.Commit()
 	}
 }
+
+type failingABCIListener struct{}
+
+func (failingABCIListener) ListenFinalizeBlock(_ context.Context, _ abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	return errors.New("finalize block failure")
+}
+
+func (failingABCIListener) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	return errors.New("commit failure")
+}
+
+func TestABCI_StreamingStopNodeOnErr(t *testing.T) {
+	for _, stopNodeOnErr := range []bool{false, true} {
+		streamingManager := storetypes.StreamingManager{
+			ABCIListeners: []storetypes.ABCIListener{failingABCIListener{}},
+			StopNodeOnErr: stopNodeOnErr,
+		}
+		streamingManagerOpt := func(bapp *baseapp.BaseApp) { bapp.SetStreamingManager(streamingManager) }
+		suite := NewBaseAppSuite(t, streamingManagerOpt)
+
+		_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &tmproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+		_, commitErr := suite.baseApp.Commit()
+		if stopNodeOnErr {
+			require.ErrorContains(t, err, "finalize block failure")
+			require.ErrorContains(t, commitErr, "commit failure")
+		} else {
+			require.NoError(t, err)
+			require.NoError(t, commitErr)
+		}
+	}
+}
+
+func TestABCI_FileStreaming(t *testing.T) {
+	home := t.TempDir()
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	appOpts := simtestutil.AppOptionsMap{
+		flags.FlagHome:                    home,
+		"streaming.file.enable":           true,
+		"streaming.file.keys":             []string{"key1"},
+		"streaming.file.format":           "jsonl",
+		"streaming.file.stop-node-on-err": true,
+	}
+	keys := map[string]*storetypes.KVStoreKey{capKey1.Name(): capKey1, capKey2.Name(): capKey2}
+	require.NoError(t, suite.baseApp.RegisterStreamingServices(appOpts, keys))
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: [][]byte{txBytes}})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+	require.NoError(t, suite.baseApp.Close())
+
+	bz, err := os.ReadFile(filepath.Join(home, "data", "streaming", "blocks-000000000001.jsonl"))
+	require.NoError(t, err)
+
+	var record filestreaming.Record
+	require.NoError(t, json.Unmarshal(bz, &record))
+	require.Equal(t, int64(1), record.Height)
+
+	var pairs []*storetypes.StoreKVPair
+	require.NoError(t, json.Unmarshal(record.ChangeSet, &pairs))
+	require.Len(t, pairs, 2)
+	for _, pair := range pairs {
+		require.Equal(t, capKey1.Name(), pair.StoreKey)
+	}
+	require.Equal(t, anteKey, pairs[0].Key)
+	require.Equal(t, deliverKey, pairs[1].Key)
+}
@@ -0,0 +1,78 @@
+package baseapp
+
+import (
+	"errors"
+	"sort"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/versionedkv"
+)
+
+// initVersionedKVIndex catches the versioned KV index up with the IAVL stores
+// and listens to their changes to maintain it at Commit.
+func (app *BaseApp) initVersionedKVIndex() error {
+	if app.versionedKV == nil {
+		return nil
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return errors.New("the versioned KV index requires a rootmulti.Store")
+	}
+
+	var keys []storetypes.StoreKey
+	for _, key := range rms.StoreKeysByName() {
+		if rms.GetCommitKVStore(key).GetStoreType() == storetypes.StoreTypeIAVL {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+
+	switch {
+	case app.versionedKV.Earliest() > 0:
+		// index the blocks committed while the index was not listening, e.g.
+		// when the node stopped before the index was written
+		if err := versionedkv.Backfill(app.versionedKV, rms, app.logger); err != nil {
+			return err
+		}
+
+	case rms.LatestVersion() > 0:
+		app.logger.Info(
+			"the versioned KV index does not hold the history of the stores, historical queries are served from IAVL until it is backfilled",
+			"index_latest", app.versionedKV.Latest(), "latest", rms.LatestVersion(),
+		)
+	}
+
+	// errors are logged, a block missing from the index stops serving
+	// queries from it until it is backfilled
+	app.addABCIListener(app.versionedKV, keys, false)
+
+	return nil
+}
+
+// versionedKVCacheMultiStore returns a branch of the state at the given height
+// reading the IAVL stores from the versioned KV index.
+func (app *BaseApp) versionedKVCacheMultiStore(height int64) (storetypes.CacheMultiStore, error) {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return nil, errors.New("the versioned KV index requires a rootmulti.Store")
+	}
+
+	keys := rms.StoreKeysByName()
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	for name, key := range keys {
+		store := rms.GetCommitKVStore(key)
+		if store.GetStoreType() == storetypes.StoreTypeIAVL {
+			stores[key] = app.versionedKV.KVStore(name, height)
+		} else {
+			stores[key] = store
+		}
+	}
+
+	return cachemulti.NewStore(dbm.NewMemDB(), stores, keys, nil, nil), nil
+}
@@ -0,0 +1,105 @@
+package versionedkv
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/iavl"
+
+	"cosmossdk.io/log"
+	storeiavl "cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Backfill writes the IAVL history of the root multi-store to the index, up to
+// its latest version.
+//
+// When the index serves a range of heights, only the heights following it are
+// written. Otherwise, the whole history available in the IAVL stores is
+// written and the index serves it from the earliest version available in all
+// the stores.
+//
+// Backfill must not run while blocks are committed to the root multi-store.
+func Backfill(idx *Index, rms *rootmulti.Store, logger log.Logger) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	latest := rms.LatestVersion()
+	if latest == 0 {
+		return nil
+	}
+
+	stores, firstVersion := iavlStores(rms)
+
+	earliest, start := idx.earliest, idx.latest+1
+	if earliest == 0 || start < firstVersion {
+		earliest, start = firstVersion, firstVersion
+	}
+
+	if start > latest {
+		return nil
+	}
+
+	logger.Info("backfilling the versioned KV index", "from", start, "to", latest)
+
+	for _, name := range sortedNames(stores) {
+		err := stores[name].TraverseStateChanges(start, latest, func(version int64, changeSet *iavl.ChangeSet) error {
+			batch := idx.db.NewBatch()
+			defer batch.Close()
+
+			for _, pair := range changeSet.Pairs {
+				if err := batch.Set(entryKey(name, pair.Key, version), entryValue(pair.Delete, pair.Value)); err != nil {
+					return err
+				}
+			}
+
+			return batch.Write()
+		})
+		if err != nil {
+			return fmt.Errorf("failed to backfill the versioned KV index of store %s: %w", name, err)
+		}
+
+		logger.Debug("backfilled the versioned KV index", "store", name)
+	}
+
+	if err := idx.setHeights(earliest, latest); err != nil {
+		return err
+	}
+
+	logger.Info("backfilled the versioned KV index", "earliest", earliest, "latest", latest)
+	return nil
+}
+
+// iavlStores returns the IAVL stores of the root multi-store by name, and the
+// earliest version available in all of them.
+func iavlStores(rms *rootmulti.Store) (map[string]*storeiavl.Store, int64) {
+	stores := make(map[string]*storeiavl.Store)
+	var firstVersion int64 = 1
+
+	for name, key := range rms.StoreKeysByName() {
+		// GetCommitKVStore unwraps the inter-block cache
+		store, ok := rms.GetCommitKVStore(key).(*storeiavl.Store)
+		if !ok || store.GetStoreType() != storetypes.StoreTypeIAVL {
+			continue
+		}
+
+		stores[name] = store
+
+		if versions := store.GetAllVersions(); len(versions) > 0 && int64(versions[0]) > firstVersion {
+			firstVersion = int64(versions[0])
+		}
+	}
+
+	return stores, firstVersion
+}
+
+func sortedNames(stores map[string]*storeiavl.Store) []string {
+	names := make([]string, 0, len(stores))
+	for name := range stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
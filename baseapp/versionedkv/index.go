@@ -0,0 +1,273 @@
+// Package versionedkv implements a flat side index of the history of the
+// stores, mapping every key to the list of its (height, value) versions, which
+// serves historical reads without loading the IAVL trees of past heights.
+package versionedkv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// entryPrefix prefixes the versions of the keys, stored under
+	// entryPrefix | uvarint(len(store)) | store | escaped key | 0x00 0x00 | height.
+	entryPrefix byte = 0x00
+	// earliestKey and latestKey store the range of heights served by the index.
+	earliestKey byte = 0x01
+	latestKey   byte = 0x02
+
+	// valueDeleted and valueSet are the first byte of the versions of a key,
+	// followed by the value of the key when it is set.
+	valueDeleted byte = 0x00
+	valueSet     byte = 0x01
+)
+
+var _ storetypes.ABCIListener = (*Index)(nil)
+
+// Index is the versioned KV index of the stores. The versions of the keys are
+// written in order of the heights, from the change sets of the committed
+// blocks or from the IAVL history with Backfill.
+//
+// The index serves the heights in [Earliest, Latest]. The earliest height is
+// unset, and the index serves no height, until it holds the whole history
+// from a height, i.e. when it started indexing at the genesis or was
+// backfilled, and is unset again when a block is missing.
+type Index struct {
+	db dbm.DB
+
+	mtx      sync.RWMutex
+	earliest int64
+	latest   int64
+}
+
+// NewIndex returns the versioned KV index stored in the given database.
+func NewIndex(db dbm.DB) (*Index, error) {
+	earliest, err := getHeight(db, earliestKey)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := getHeight(db, latestKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Index{db: db, earliest: earliest, latest: latest}, nil
+}
+
+// Earliest returns the earliest height served by the index, or zero if the
+// index serves no height.
+func (idx *Index) Earliest() int64 {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	return idx.earliest
+}
+
+// Latest returns the latest height written to the index.
+func (idx *Index) Latest() int64 {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	return idx.latest
+}
+
+// CanServe returns true if the state at the given height can be read from the
+// index.
+func (idx *Index) CanServe(height int64) bool {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	return idx.earliest > 0 && idx.earliest <= height && height <= idx.latest
+}
+
+// Start starts indexing at the given height, which is the initial height of
+// a chain whose genesis has not been committed yet, so that the index holds
+// the whole history once the block is committed.
+func (idx *Index) Start(height int64) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if idx.latest > 0 {
+		return nil
+	}
+
+	return idx.setHeights(height, 0)
+}
+
+// Apply writes the changes made to the stores at the given height. A change
+// set following a missing height is written, but the index serves no height
+// until it is backfilled.
+func (idx *Index) Apply(height int64, changeSet []*storetypes.StoreKVPair) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	for _, pair := range changeSet {
+		if err := batch.Set(entryKey(pair.StoreKey, pair.Key, height), entryValue(pair.Delete, pair.Value)); err != nil {
+			return err
+		}
+	}
+
+	// a block replayed after a restart has already been indexed
+	if height <= idx.latest {
+		return batch.Write()
+	}
+
+	earliest := idx.earliest
+	switch {
+	case idx.latest == 0 && earliest != height:
+		// the index did not start at this height
+		earliest = 0
+	case idx.latest > 0 && height != idx.latest+1:
+		earliest = 0
+	}
+
+	if err := idx.batchHeights(batch, earliest, height); err != nil {
+		return err
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	idx.earliest, idx.latest = earliest, height
+	return nil
+}
+
+// ListenFinalizeBlock implements ABCIListener.
+func (idx *Index) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit implements ABCIListener. It writes the change set of the
+// committed block.
+func (idx *Index) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if err := idx.Apply(height, changeSet); err != nil {
+		return fmt.Errorf("failed to index the changes at height %d: %w", height, err)
+	}
+
+	return nil
+}
+
+// KVStore returns a read-only view of a store at the given height, which must
+// be served by the index.
+func (idx *Index) KVStore(storeName string, height int64) storetypes.KVStore {
+	return &Store{db: idx.db, prefix: storePrefix(storeName), height: height}
+}
+
+// Close closes the database of the index.
+func (idx *Index) Close() error {
+	return idx.db.Close()
+}
+
+// setHeights persists the range of heights served by the index.
+func (idx *Index) setHeights(earliest, latest int64) error {
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	if err := idx.batchHeights(batch, earliest, latest); err != nil {
+		return err
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	idx.earliest, idx.latest = earliest, latest
+	return nil
+}
+
+func (idx *Index) batchHeights(batch dbm.Batch, earliest, latest int64) error {
+	if err := batch.Set([]byte{earliestKey}, sdk.Uint64ToBigEndian(uint64(earliest))); err != nil {
+		return err
+	}
+
+	return batch.Set([]byte{latestKey}, sdk.Uint64ToBigEndian(uint64(latest)))
+}
+
+func getHeight(db dbm.DB, key byte) (int64, error) {
+	bz, err := db.Get([]byte{key})
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	if len(bz) != 8 {
+		return 0, errors.New("invalid versioned KV index height")
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), nil
+}
+
+// storePrefix returns the prefix of the entries of a store.
+func storePrefix(storeName string) []byte {
+	prefix := make([]byte, 0, 1+binary.MaxVarintLen64+len(storeName))
+	prefix = append(prefix, entryPrefix)
+	prefix = binary.AppendUvarint(prefix, uint64(len(storeName)))
+	return append(prefix, storeName...)
+}
+
+// entryKey returns the key of the version of a key at a height.
+func entryKey(storeName string, key []byte, height int64) []byte {
+	bz := appendEscaped(storePrefix(storeName), key)
+	bz = append(bz, 0x00, 0x00)
+	return binary.BigEndian.AppendUint64(bz, uint64(height))
+}
+
+func entryValue(deleted bool, value []byte) []byte {
+	if deleted {
+		return []byte{valueDeleted}
+	}
+
+	return append([]byte{valueSet}, value...)
+}
+
+// appendEscaped appends a key escaped such that the escaped keys have the
+// same order as the keys and contain no 0x00 0x00 sequence, which terminates
+// them in the entry keys: 0x00 is escaped as 0x00 0xFF.
+func appendEscaped(bz, key []byte) []byte {
+	for _, b := range key {
+		bz = append(bz, b)
+		if b == 0x00 {
+			bz = append(bz, 0xFF)
+		}
+	}
+
+	return bz
+}
+
+// splitEntryKey returns the escaped key and the height of an entry key,
+// without the store prefix.
+func splitEntryKey(bz []byte) (escaped []byte, height int64, err error) {
+	if len(bz) < 10 || !bytes.Equal(bz[len(bz)-10:len(bz)-8], []byte{0x00, 0x00}) {
+		return nil, 0, fmt.Errorf("invalid versioned KV index entry %X", bz)
+	}
+
+	return bz[:len(bz)-10], int64(binary.BigEndian.Uint64(bz[len(bz)-8:])), nil
+}
+
+func unescape(escaped []byte) []byte {
+	key := make([]byte, 0, len(escaped))
+	for i := 0; i < len(escaped); i++ {
+		key = append(key, escaped[i])
+		if escaped[i] == 0x00 {
+			i++
+		}
+	}
+
+	return key
+}
@@ -0,0 +1,133 @@
+package versionedkv
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+func TestIndexHeights(t *testing.T) {
+	db := dbm.NewMemDB()
+	idx, err := NewIndex(db)
+	require.NoError(t, err)
+	require.False(t, idx.CanServe(1))
+
+	require.NoError(t, idx.Start(3))
+	require.NoError(t, idx.Apply(3, nil))
+	require.NoError(t, idx.Apply(4, nil))
+	require.Equal(t, int64(3), idx.Earliest())
+	require.Equal(t, int64(4), idx.Latest())
+	require.False(t, idx.CanServe(2))
+	require.True(t, idx.CanServe(3))
+	require.True(t, idx.CanServe(4))
+	require.False(t, idx.CanServe(5))
+
+	// a replayed block does not change the heights
+	require.NoError(t, idx.Apply(4, nil))
+	require.True(t, idx.CanServe(3))
+
+	// the heights are persisted
+	idx, err = NewIndex(db)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), idx.Earliest())
+	require.Equal(t, int64(4), idx.Latest())
+
+	// a missing block stops serving the heights
+	require.NoError(t, idx.Apply(6, nil))
+	require.Equal(t, int64(0), idx.Earliest())
+	require.Equal(t, int64(6), idx.Latest())
+	require.False(t, idx.CanServe(3))
+	require.False(t, idx.CanServe(6))
+
+	// an index which did not start at the genesis serves no height
+	idx, err = NewIndex(dbm.NewMemDB())
+	require.NoError(t, err)
+	require.NoError(t, idx.Apply(10, nil))
+	require.False(t, idx.CanServe(10))
+}
+
+func TestStore(t *testing.T) {
+	idx, err := NewIndex(dbm.NewMemDB())
+	require.NoError(t, err)
+	require.NoError(t, idx.Start(1))
+
+	// the keys include 0x00 and 0xFF bytes and prefixes of other keys
+	keys := [][]byte{{0x00}, {0x00, 0x00}, {0x00, 0xFF}, {0x01}, {0x01, 0x00}, {0x01, 0x00, 0x01}, {0xFF}, {0xFF, 0x00}}
+
+	// expected holds the state of the store at every height
+	expected := []map[string][]byte{{}}
+	for height := int64(1); height <= 10; height++ {
+		state := make(map[string][]byte)
+		for key, value := range expected[height-1] {
+			state[key] = value
+		}
+
+		var changeSet []*storetypes.StoreKVPair
+		for i, key := range keys {
+			switch (int64(i) + height) % 4 {
+			case 0:
+				value := []byte{byte(height), byte(i)}
+				changeSet = append(changeSet, &storetypes.StoreKVPair{StoreKey: "a", Key: key, Value: value})
+				state[string(key)] = value
+			case 1:
+				changeSet = append(changeSet, &storetypes.StoreKVPair{StoreKey: "a", Key: key, Delete: true})
+				delete(state, string(key))
+			}
+		}
+
+		// the changes of another store whose name is a prefix are ignored
+		changeSet = append(changeSet, &storetypes.StoreKVPair{StoreKey: "ab", Key: []byte{0x01}, Value: []byte{0x01}})
+
+		require.NoError(t, idx.Apply(height, changeSet))
+		expected = append(expected, state)
+	}
+
+	for height := int64(1); height <= 10; height++ {
+		store := idx.KVStore("a", height)
+		state := expected[height]
+
+		for _, key := range keys {
+			require.Equal(t, state[string(key)], store.Get(key), "height %d key %X", height, key)
+			require.Equal(t, state[string(key)] != nil, store.Has(key))
+		}
+
+		for _, r := range [][2][]byte{{nil, nil}, {{0x00, 0x00}, {0x01, 0x00}}, {{0x00, 0x01}, nil}, {nil, {0xFF}}} {
+			start, end := r[0], r[1]
+
+			var want [][2][]byte
+			for key, value := range state {
+				if (start == nil || bytes.Compare([]byte(key), start) >= 0) && (end == nil || bytes.Compare([]byte(key), end) < 0) {
+					want = append(want, [2][]byte{[]byte(key), value})
+				}
+			}
+			sort.Slice(want, func(i, j int) bool { return bytes.Compare(want[i][0], want[j][0]) < 0 })
+
+			require.Equal(t, want, collect(t, store.Iterator(start, end)), "height %d range %X-%X", height, start, end)
+
+			for i, j := 0, len(want)-1; i < j; i, j = i+1, j-1 {
+				want[i], want[j] = want[j], want[i]
+			}
+			require.Equal(t, want, collect(t, store.ReverseIterator(start, end)), "height %d range %X-%X", height, start, end)
+		}
+	}
+
+	require.Panics(t, func() { idx.KVStore("a", 1).Set([]byte{0x01}, []byte{0x01}) })
+}
+
+func collect(t *testing.T, it storetypes.Iterator) [][2][]byte {
+	t.Helper()
+	defer it.Close()
+
+	var pairs [][2][]byte
+	for ; it.Valid(); it.Next() {
+		pairs = append(pairs, [2][]byte{it.Key(), it.Value()})
+	}
+	require.NoError(t, it.Error())
+
+	return pairs
+}
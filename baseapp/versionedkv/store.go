@@ -0,0 +1,228 @@
+package versionedkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var _ storetypes.KVStore = (*Store)(nil)
+
+// Store is a read-only view of a store at a height, reading the latest version
+// of the keys at or below the height.
+type Store struct {
+	db     dbm.DB
+	prefix []byte
+	height int64
+}
+
+// GetStoreType implements Store.
+func (s *Store) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeIAVL
+}
+
+// CacheWrap implements CacheWrapper.
+func (s *Store) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// Get implements KVStore.
+func (s *Store) Get(key []byte) []byte {
+	storetypes.AssertValidKey(key)
+
+	start := appendEscaped(bytes.Clone(s.prefix), key)
+	start = append(start, 0x00, 0x00)
+	end := binary.BigEndian.AppendUint64(bytes.Clone(start), uint64(s.height+1))
+
+	it, err := s.db.ReverseIterator(start, end)
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil
+	}
+
+	value := it.Value()
+	if value[0] == valueDeleted {
+		return nil
+	}
+
+	return bytes.Clone(value[1:])
+}
+
+// Has implements KVStore.
+func (s *Store) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements KVStore, it panics as the store is read-only.
+func (s *Store) Set(_, _ []byte) {
+	panic("cannot write to a versioned KV index store")
+}
+
+// Delete implements KVStore, it panics as the store is read-only.
+func (s *Store) Delete(_ []byte) {
+	panic("cannot write to a versioned KV index store")
+}
+
+// Iterator implements KVStore.
+func (s *Store) Iterator(start, end []byte) storetypes.Iterator {
+	return s.newIterator(start, end, true)
+}
+
+// ReverseIterator implements KVStore.
+func (s *Store) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.newIterator(start, end, false)
+}
+
+func (s *Store) newIterator(start, end []byte, ascending bool) storetypes.Iterator {
+	dbStart := appendEscaped(bytes.Clone(s.prefix), start)
+	dbEnd := storetypes.PrefixEndBytes(s.prefix)
+	if end != nil {
+		dbEnd = appendEscaped(bytes.Clone(s.prefix), end)
+	}
+
+	var (
+		parent dbm.Iterator
+		err    error
+	)
+	if ascending {
+		parent, err = s.db.Iterator(dbStart, dbEnd)
+	} else {
+		parent, err = s.db.ReverseIterator(dbStart, dbEnd)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	it := &iterator{
+		parent:    parent,
+		prefixLen: len(s.prefix),
+		height:    s.height,
+		ascending: ascending,
+		start:     start,
+		end:       end,
+	}
+	it.next()
+
+	return it
+}
+
+// iterator iterates over the keys set at a height, made of the consecutive
+// entries of the versions of each key.
+type iterator struct {
+	parent    dbm.Iterator
+	prefixLen int
+	height    int64
+	ascending bool
+	start     []byte
+	end       []byte
+
+	key   []byte
+	value []byte
+	err   error
+}
+
+var _ storetypes.Iterator = (*iterator)(nil)
+
+// next moves to the next key set at the height of the iterator.
+func (it *iterator) next() {
+	it.key, it.value = nil, nil
+
+	for it.err == nil && it.parent.Valid() {
+		escaped, _, err := splitEntryKey(it.parent.Key()[it.prefixLen:])
+		if err != nil {
+			it.err = err
+			return
+		}
+		escaped = bytes.Clone(escaped)
+
+		// the versions of a key are in ascending order of the heights with an
+		// ascending iterator, and in descending order otherwise
+		var value []byte
+		for ; it.parent.Valid(); it.parent.Next() {
+			current, height, err := splitEntryKey(it.parent.Key()[it.prefixLen:])
+			if err != nil {
+				it.err = err
+				return
+			}
+
+			if !bytes.Equal(current, escaped) {
+				break
+			}
+
+			if height <= it.height && (it.ascending || value == nil) {
+				value = bytes.Clone(it.parent.Value())
+			}
+		}
+
+		if value != nil && value[0] == valueSet {
+			it.key, it.value = unescape(escaped), value[1:]
+			return
+		}
+	}
+
+	if it.err == nil {
+		it.err = it.parent.Error()
+	}
+}
+
+// Domain implements Iterator.
+func (it *iterator) Domain() (start, end []byte) {
+	return it.start, it.end
+}
+
+// Valid implements Iterator.
+func (it *iterator) Valid() bool {
+	return it.key != nil
+}
+
+// Next implements Iterator.
+func (it *iterator) Next() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	it.next()
+}
+
+// Key implements Iterator.
+func (it *iterator) Key() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	return it.key
+}
+
+// Value implements Iterator.
+func (it *iterator) Value() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	return it.value
+}
+
+// Error implements Iterator.
+func (it *iterator) Error() error {
+	return it.err
+}
+
+// Close implements Iterator.
+func (it *iterator) Close() error {
+	return it.parent.Close()
+}
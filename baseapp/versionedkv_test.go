@@ -0,0 +1,96 @@
+package baseapp_test
+
+import (
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/versionedkv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestABCI_VersionedKVIndex(t *testing.T) {
+	db := dbm.NewMemDB()
+	idx, err := versionedkv.NewIndex(dbm.NewMemDB())
+	require.NoError(t, err)
+
+	app := baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), db, nil, baseapp.SetVersionedKVIndex(idx))
+	app.MountStores(capKey1, capKey2)
+
+	// every block sets a key and deletes another one of the first store
+	app.SetBeginBlocker(func(ctx sdk.Context) (sdk.BeginBlock, error) {
+		store := ctx.KVStore(capKey1)
+		store.Set([]byte(fmt.Sprintf("key-%d", ctx.BlockHeight()%5)), []byte(fmt.Sprintf("value-%d", ctx.BlockHeight())))
+		store.Delete([]byte(fmt.Sprintf("key-%d", (ctx.BlockHeight()+2)%5)))
+		return sdk.BeginBlock{}, nil
+	})
+	require.NoError(t, app.LoadLatestVersion())
+
+	_, err = app.InitChain(&abci.RequestInitChain{InitialHeight: 1})
+	require.NoError(t, err)
+
+	const blocks = 10
+	for height := int64(1); height <= blocks; height++ {
+		_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, int64(1), idx.Earliest())
+	require.Equal(t, int64(blocks), idx.Latest())
+
+	cms := app.CommitMultiStore()
+	for height := int64(1); height < blocks; height++ {
+		require.True(t, idx.CanServe(height))
+
+		ctx, err := app.CreateQueryContext(height, false)
+		require.NoError(t, err)
+
+		iavlStore, err := cms.CacheMultiStoreWithVersion(height)
+		require.NoError(t, err)
+
+		for _, key := range []storetypes.StoreKey{capKey1, capKey2} {
+			require.Equal(t,
+				iterateStore(iavlStore.GetKVStore(key)),
+				iterateStore(ctx.KVStore(key)),
+				"height %d store %s", height, key.Name(),
+			)
+		}
+	}
+
+	// the index built from the IAVL history is the same
+	backfilled, err := versionedkv.NewIndex(dbm.NewMemDB())
+	require.NoError(t, err)
+	require.NoError(t, versionedkv.Backfill(backfilled, cms.(*rootmulti.Store), log.NewNopLogger()))
+	require.Equal(t, int64(1), backfilled.Earliest())
+	require.Equal(t, int64(blocks), backfilled.Latest())
+
+	for height := int64(1); height <= blocks; height++ {
+		require.Equal(t,
+			iterateStore(idx.KVStore(capKey1.Name(), height)),
+			iterateStore(backfilled.KVStore(capKey1.Name(), height)),
+			"height %d", height,
+		)
+	}
+}
+
+func iterateStore(store storetypes.KVStore) map[string]string {
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	pairs := make(map[string]string)
+	for ; it.Valid(); it.Next() {
+		pairs[string(it.Key())] = string(it.Value())
+	}
+
+	return pairs
+}
@@ -0,0 +1,385 @@
+package baseapp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// VoteExtensionCodec encodes and decodes the vote extensions of a module.
+	VoteExtensionCodec[T any] interface {
+		Encode(T) ([]byte, error)
+		Decode([]byte) (T, error)
+	}
+
+	// VoteExtensionHandler defines the typed vote extension handlers of a
+	// module, registered on a VoteExtensionManager with RegisterVoteExtension.
+	VoteExtensionHandler[T any] struct {
+		// Codec encodes and decodes the vote extensions of the module.
+		Codec VoteExtensionCodec[T]
+
+		// Extend returns the vote extension of the module for the vote of the
+		// node. An error omits the extension of the module from the vote.
+		Extend func(sdk.Context, *abci.RequestExtendVote) (T, error)
+
+		// Verify verifies the vote extension of the module of another validator,
+		// an error rejects the vote. Extensions are accepted if Verify is nil.
+		Verify func(sdk.Context, *abci.RequestVerifyVoteExtension, T) error
+
+		// Aggregate receives in PreBlocker the vote extensions of the module
+		// from the verified extended commit of the previous height, an error
+		// fails the block.
+		Aggregate func(sdk.Context, []ValidatorVoteExtension[T]) error
+	}
+
+	// ValidatorVoteExtension is the vote extension of a validator.
+	ValidatorVoteExtension[T any] struct {
+		Validator abci.Validator
+		Extension T
+	}
+
+	// VoteExtensionManager multiplexes the vote extensions of the modules
+	// registered with RegisterVoteExtension into a single vote extension, and
+	// delivers the extensions agreed upon to the modules.
+	//
+	// The proposer injects the extended commit of the previous height as the
+	// first transaction of the proposal, which is verified in ProcessProposal
+	// and delivered to the modules in PreBlocker. This pseudo-transaction is
+	// not a valid transaction, so it fails to decode when the block is executed
+	// and is reported as such in the transaction results. The handlers are
+	// wired as:
+	//
+	//	m := baseapp.NewVoteExtensionManager(app.StakingKeeper)
+	//	baseapp.RegisterVoteExtension(m, "mymodule", handler)
+	//	app.SetExtendVoteHandler(m.ExtendVoteHandler())
+	//	app.SetVerifyVoteExtensionHandler(m.VerifyVoteExtensionHandler())
+	//	app.SetPrepareProposal(m.PrepareProposalHandler(proposalHandler.PrepareProposalHandler()))
+	//	app.SetProcessProposal(m.ProcessProposalHandler(proposalHandler.ProcessProposalHandler()))
+	//	app.SetPreBlocker(m.PreBlocker(app.PreBlocker))
+	VoteExtensionManager struct {
+		valStore ValidatorStore
+		handlers map[string]voteExtensionHandler
+	}
+
+	// voteExtensionHandler is the untyped VoteExtensionHandler of a module.
+	voteExtensionHandler interface {
+		extend(sdk.Context, *abci.RequestExtendVote) ([]byte, error)
+		verify(sdk.Context, *abci.RequestVerifyVoteExtension, []byte) error
+		aggregate(sdk.Context, []ValidatorVoteExtension[[]byte]) error
+	}
+)
+
+// NewVoteExtensionManager returns a VoteExtensionManager verifying the vote
+// extension signatures of the extended commits with the validator store.
+func NewVoteExtensionManager(valStore ValidatorStore) *VoteExtensionManager {
+	return &VoteExtensionManager{
+		valStore: valStore,
+		handlers: make(map[string]voteExtensionHandler),
+	}
+}
+
+// RegisterVoteExtension registers the vote extension handlers of a module.
+func RegisterVoteExtension[T any](m *VoteExtensionManager, module string, h VoteExtensionHandler[T]) error {
+	if module == "" {
+		return errors.New("module name cannot be empty")
+	}
+	if _, ok := m.handlers[module]; ok {
+		return fmt.Errorf("vote extension handler already registered for module %s", module)
+	}
+	if h.Codec == nil || h.Extend == nil || h.Aggregate == nil {
+		return fmt.Errorf("vote extension handler of module %s must have a codec, Extend and Aggregate", module)
+	}
+
+	m.handlers[module] = h
+	return nil
+}
+
+func (h VoteExtensionHandler[T]) extend(ctx sdk.Context, req *abci.RequestExtendVote) ([]byte, error) {
+	ext, err := h.Extend(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return h.Codec.Encode(ext)
+}
+
+func (h VoteExtensionHandler[T]) verify(ctx sdk.Context, req *abci.RequestVerifyVoteExtension, bz []byte) error {
+	ext, err := h.Codec.Decode(bz)
+	if err != nil {
+		return err
+	}
+	if h.Verify == nil {
+		return nil
+	}
+	return h.Verify(ctx, req, ext)
+}
+
+func (h VoteExtensionHandler[T]) aggregate(ctx sdk.Context, votes []ValidatorVoteExtension[[]byte]) error {
+	exts := make([]ValidatorVoteExtension[T], 0, len(votes))
+	for _, vote := range votes {
+		ext, err := h.Codec.Decode(vote.Extension)
+		if err != nil {
+			ctx.Logger().Error("failed to decode vote extension", "validator", fmt.Sprintf("%X", vote.Validator.Address), "err", err)
+			continue
+		}
+		exts = append(exts, ValidatorVoteExtension[T]{Validator: vote.Validator, Extension: ext})
+	}
+
+	return h.Aggregate(ctx, exts)
+}
+
+// modules returns the names of the registered modules, sorted.
+func (m *VoteExtensionManager) modules() []string {
+	modules := make([]string, 0, len(m.handlers))
+	for module := range m.handlers {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+	return modules
+}
+
+// ExtendVoteHandler returns an ExtendVote handler multiplexing the vote
+// extensions of the modules. The extension of a module failing to extend the
+// vote is omitted.
+func (m *VoteExtensionManager) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		exts := make(map[string][]byte, len(m.handlers))
+		for _, module := range m.modules() {
+			bz, err := m.handlers[module].extend(ctx, req)
+			if err != nil {
+				ctx.Logger().Error("failed to extend vote", "module", module, "height", req.Height, "err", err)
+				continue
+			}
+			exts[module] = bz
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: encodeVoteExtensions(exts)}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns a VerifyVoteExtension handler verifying
+// the vote extension of each module. A vote extension is rejected if it is not
+// properly multiplexed, or has an extension of an unknown module or rejected by
+// its module.
+func (m *VoteExtensionManager) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		reject := &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}
+
+		exts, err := decodeVoteExtensions(req.VoteExtension)
+		if err != nil {
+			ctx.Logger().Info("rejected malformed vote extension", "validator", fmt.Sprintf("%X", req.ValidatorAddress), "err", err)
+			return reject, nil
+		}
+
+		for module, bz := range exts {
+			h, ok := m.handlers[module]
+			if !ok {
+				ctx.Logger().Info("rejected vote extension of unknown module", "module", module, "validator", fmt.Sprintf("%X", req.ValidatorAddress))
+				return reject, nil
+			}
+
+			if err := h.verify(ctx, req, bz); err != nil {
+				ctx.Logger().Info("rejected vote extension", "module", module, "validator", fmt.Sprintf("%X", req.ValidatorAddress), "err", err)
+				return reject, nil
+			}
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// PrepareProposalHandler returns a PrepareProposal handler injecting the
+// extended commit of the previous height as the first transaction of the
+// proposal, when vote extensions are enabled, followed by the transactions
+// prepared by the given handler in the remaining space.
+func (m *VoteExtensionManager) PrepareProposalHandler(next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		if err := ValidateVoteExtensions(ctx, m.valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+			return nil, fmt.Errorf("invalid local last commit: %w", err)
+		}
+
+		extCommitBz, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode extended commit: %w", err)
+		}
+
+		extCommitSize := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{extCommitBz})
+		if extCommitSize > req.MaxTxBytes {
+			return nil, fmt.Errorf("extended commit size %d exceeds the max tx bytes %d", extCommitSize, req.MaxTxBytes)
+		}
+
+		nextReq := *req
+		nextReq.MaxTxBytes -= extCommitSize
+		resp, err := next(ctx, &nextReq)
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ResponsePrepareProposal{Txs: append([][]byte{extCommitBz}, resp.Txs...)}, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposal handler verifying, when vote
+// extensions are enabled, the extended commit injected as the first
+// transaction of the proposal, then processing the remaining transactions with
+// the given handler.
+func (m *VoteExtensionManager) ProcessProposalHandler(next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		reject := &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		if len(req.Txs) == 0 {
+			ctx.Logger().Info("rejected proposal without extended commit", "height", req.Height)
+			return reject, nil
+		}
+
+		var extCommit abci.ExtendedCommitInfo
+		if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+			ctx.Logger().Info("rejected proposal with malformed extended commit", "height", req.Height, "err", err)
+			return reject, nil
+		}
+
+		if err := ValidateVoteExtensions(ctx, m.valStore, req.Height, ctx.ChainID(), extCommit); err != nil {
+			ctx.Logger().Info("rejected proposal with invalid extended commit", "height", req.Height, "err", err)
+			return reject, nil
+		}
+
+		nextReq := *req
+		nextReq.Txs = req.Txs[1:]
+		return next(ctx, &nextReq)
+	}
+}
+
+// PreBlocker returns a PreBlocker delivering the vote extensions of the
+// extended commit injected in the block to the modules, before calling the
+// given PreBlocker, if not nil.
+//
+// The extended commit was verified in ProcessProposal. The vote extensions
+// which are not properly multiplexed or fail to decode are skipped.
+func (m *VoteExtensionManager) PreBlocker(next sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		if voteExtensionsEnabled(ctx, req.Height) && len(req.Txs) > 0 {
+			if err := m.deliverVoteExtensions(ctx, req.Txs[0]); err != nil {
+				return nil, err
+			}
+		}
+
+		if next == nil {
+			return &sdk.ResponsePreBlock{}, nil
+		}
+		return next(ctx, req)
+	}
+}
+
+// deliverVoteExtensions delivers the vote extensions of the encoded extended
+// commit to the modules.
+func (m *VoteExtensionManager) deliverVoteExtensions(ctx sdk.Context, extCommitBz []byte) error {
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(extCommitBz); err != nil {
+		return fmt.Errorf("failed to decode extended commit: %w", err)
+	}
+
+	votes := make(map[string][]ValidatorVoteExtension[[]byte], len(m.handlers))
+	for _, vote := range extCommit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+
+		exts, err := decodeVoteExtensions(vote.VoteExtension)
+		if err != nil {
+			ctx.Logger().Error("failed to decode vote extension", "validator", fmt.Sprintf("%X", vote.Validator.Address), "err", err)
+			continue
+		}
+		for module, ext := range exts {
+			votes[module] = append(votes[module], ValidatorVoteExtension[[]byte]{Validator: vote.Validator, Extension: ext})
+		}
+	}
+
+	for _, module := range m.modules() {
+		if err := m.handlers[module].aggregate(ctx, votes[module]); err != nil {
+			return fmt.Errorf("failed to aggregate vote extensions of module %s: %w", module, err)
+		}
+	}
+
+	return nil
+}
+
+// voteExtensionsEnabled returns whether vote extensions are available at the
+// given height, i.e. after the vote extensions enable height.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
+
+// encodeVoteExtensions encodes the vote extensions of the modules, as the
+// length-prefixed module names and extensions, ordered by module name.
+func encodeVoteExtensions(exts map[string][]byte) []byte {
+	modules := make([]string, 0, len(exts))
+	for module := range exts {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	var bz []byte
+	for _, module := range modules {
+		bz = binary.AppendUvarint(bz, uint64(len(module)))
+		bz = append(bz, module...)
+		bz = binary.AppendUvarint(bz, uint64(len(exts[module])))
+		bz = append(bz, exts[module]...)
+	}
+	return bz
+}
+
+// decodeVoteExtensions decodes the vote extensions encoded by
+// encodeVoteExtensions, rejecting non-canonical encodings.
+func decodeVoteExtensions(bz []byte) (map[string][]byte, error) {
+	exts := make(map[string][]byte)
+	var prev string
+	for len(bz) > 0 {
+		module, rest, err := readLengthPrefixed(bz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid module name: %w", err)
+		}
+		if len(module) == 0 || (len(exts) > 0 && string(module) <= prev) {
+			return nil, fmt.Errorf("module %q out of order", module)
+		}
+
+		ext, rest, err := readLengthPrefixed(rest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid vote extension of module %s: %w", module, err)
+		}
+
+		prev = string(module)
+		exts[prev] = ext
+		bz = rest
+	}
+
+	return exts, nil
+}
+
+// readLengthPrefixed reads a uvarint length-prefixed byte slice, returning it
+// and the remaining bytes.
+func readLengthPrefixed(bz []byte) ([]byte, []byte, error) {
+	n, read := binary.Uvarint(bz)
+	if read <= 0 {
+		return nil, nil, errors.New("invalid length prefix")
+	}
+	bz = bz[read:]
+	if n > uint64(len(bz)) {
+		return nil, nil, fmt.Errorf("length %d exceeds the remaining %d bytes", n, len(bz))
+	}
+	return bz[:n], bz[n:], nil
+}
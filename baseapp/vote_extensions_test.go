@@ -0,0 +1,218 @@
+package baseapp_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/core/header"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type stringVoteExtCodec struct{}
+
+func (stringVoteExtCodec) Encode(s string) ([]byte, error) { return []byte(s), nil }
+
+func (stringVoteExtCodec) Decode(bz []byte) (string, error) { return string(bz), nil }
+
+type uint64VoteExtCodec struct{}
+
+func (uint64VoteExtCodec) Encode(n uint64) ([]byte, error) {
+	return binary.BigEndian.AppendUint64(nil, n), nil
+}
+
+func (uint64VoteExtCodec) Decode(bz []byte) (uint64, error) {
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid length %d", len(bz))
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+func (s *ABCIUtilsTestSuite) newVoteExtensionManager(prices *[]string, heights *[]uint64) *baseapp.VoteExtensionManager {
+	m := baseapp.NewVoteExtensionManager(s.valStore)
+	s.Require().NoError(baseapp.RegisterVoteExtension(m, "prices", baseapp.VoteExtensionHandler[string]{
+		Codec: stringVoteExtCodec{},
+		Extend: func(_ sdk.Context, req *abci.RequestExtendVote) (string, error) {
+			return fmt.Sprintf("price-%d", req.Height), nil
+		},
+		Verify: func(_ sdk.Context, _ *abci.RequestVerifyVoteExtension, price string) error {
+			if price == "" {
+				return errors.New("empty price")
+			}
+			return nil
+		},
+		Aggregate: func(_ sdk.Context, exts []baseapp.ValidatorVoteExtension[string]) error {
+			for _, ext := range exts {
+				*prices = append(*prices, ext.Extension)
+			}
+			return nil
+		},
+	}))
+	s.Require().NoError(baseapp.RegisterVoteExtension(m, "checkpoint", baseapp.VoteExtensionHandler[uint64]{
+		Codec: uint64VoteExtCodec{},
+		Extend: func(_ sdk.Context, req *abci.RequestExtendVote) (uint64, error) {
+			return uint64(req.Height), nil
+		},
+		Aggregate: func(_ sdk.Context, exts []baseapp.ValidatorVoteExtension[uint64]) error {
+			for _, ext := range exts {
+				*heights = append(*heights, ext.Extension)
+			}
+			return nil
+		},
+	}))
+	return m
+}
+
+func (s *ABCIUtilsTestSuite) TestRegisterVoteExtension() {
+	m := baseapp.NewVoteExtensionManager(s.valStore)
+	h := baseapp.VoteExtensionHandler[string]{
+		Codec:     stringVoteExtCodec{},
+		Extend:    func(sdk.Context, *abci.RequestExtendVote) (string, error) { return "", nil },
+		Aggregate: func(sdk.Context, []baseapp.ValidatorVoteExtension[string]) error { return nil },
+	}
+
+	s.Require().NoError(baseapp.RegisterVoteExtension(m, "module", h))
+	s.Require().ErrorContains(baseapp.RegisterVoteExtension(m, "module", h), "already registered")
+	s.Require().Error(baseapp.RegisterVoteExtension(m, "", h))
+
+	h.Codec = nil
+	s.Require().Error(baseapp.RegisterVoteExtension(m, "other", h))
+}
+
+func (s *ABCIUtilsTestSuite) TestVoteExtensionManagerVerifyVoteExtension() {
+	var (
+		prices  []string
+		heights []uint64
+	)
+	m := s.newVoteExtensionManager(&prices, &heights)
+
+	resp, err := m.ExtendVoteHandler()(s.ctx, &abci.RequestExtendVote{Height: 2})
+	s.Require().NoError(err)
+
+	verify := func(ext []byte) abci.ResponseVerifyVoteExtension_VerifyStatus {
+		resp, err := m.VerifyVoteExtensionHandler()(s.ctx, &abci.RequestVerifyVoteExtension{Height: 2, VoteExtension: ext})
+		s.Require().NoError(err)
+		return resp.Status
+	}
+
+	// module extensions, length-prefixed and ordered by module name
+	encode := func(modules ...string) []byte {
+		var bz []byte
+		for i := 0; i < len(modules); i += 2 {
+			bz = binary.AppendUvarint(bz, uint64(len(modules[i])))
+			bz = append(bz, modules[i]...)
+			bz = binary.AppendUvarint(bz, uint64(len(modules[i+1])))
+			bz = append(bz, modules[i+1]...)
+		}
+		return bz
+	}
+	height := string(binary.BigEndian.AppendUint64(nil, 2))
+
+	s.Require().Equal(encode("checkpoint", height, "prices", "price-2"), resp.VoteExtension)
+	s.Require().Equal(abci.ResponseVerifyVoteExtension_ACCEPT, verify(resp.VoteExtension))
+	s.Require().Equal(abci.ResponseVerifyVoteExtension_ACCEPT, verify(nil))
+	s.Require().Equal(abci.ResponseVerifyVoteExtension_ACCEPT, verify(encode("prices", "price-2")))
+	s.Require().Equal(abci.ResponseVerifyVoteExtension_REJECT, verify([]byte("garbage")))
+	s.Require().Equal(abci.ResponseVerifyVoteExtension_REJECT, verify(encode("prices", "price-2", "checkpoint", height)))
+	s.Require().Equal(abci.ResponseVerifyVoteExtension_REJECT, verify(encode("unknown", "ext")))
+	s.Require().Equal(abci.ResponseVerifyVoteExtension_REJECT, verify(encode("prices", "")))
+	s.Require().Equal(abci.ResponseVerifyVoteExtension_REJECT, verify(encode("checkpoint", "short")))
+}
+
+func (s *ABCIUtilsTestSuite) TestVoteExtensionManagerProposal() {
+	var (
+		prices  []string
+		heights []uint64
+	)
+	m := s.newVoteExtensionManager(&prices, &heights)
+
+	llc := abci.ExtendedCommitInfo{Round: 0}
+	for i, val := range s.vals {
+		resp, err := m.ExtendVoteHandler()(s.ctx, &abci.RequestExtendVote{Height: 2})
+		s.Require().NoError(err)
+
+		bz, err := marshalDelimitedFn(&cmtproto.CanonicalVoteExtension{
+			Extension: resp.VoteExtension,
+			Height:    2,
+			Round:     0,
+			ChainId:   chainID,
+		})
+		s.Require().NoError(err)
+		sig, err := val.privKey.Sign(bz)
+		s.Require().NoError(err)
+
+		llc.Votes = append(llc.Votes, abci.ExtendedVoteInfo{
+			Validator:          val.toValidator(333 + int64(i)),
+			VoteExtension:      resp.VoteExtension,
+			ExtensionSignature: sig,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		})
+	}
+	llc, info := extendedCommitToLastCommit(llc)
+	ctx := s.ctx.WithBlockHeight(3).WithHeaderInfo(header.Info{Height: 3, ChainID: chainID}).WithCometInfo(info)
+
+	var nextTxs [][]byte
+	prepare := m.PrepareProposalHandler(func(_ sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		s.Require().Less(req.MaxTxBytes, int64(10000))
+		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+	})
+	process := m.ProcessProposalHandler(func(_ sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		nextTxs = req.Txs
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	})
+
+	prepareResp, err := prepare(ctx, &abci.RequestPrepareProposal{
+		Height:          3,
+		MaxTxBytes:      10000,
+		Txs:             [][]byte{[]byte("tx")},
+		LocalLastCommit: llc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(prepareResp.Txs, 2)
+	s.Require().Equal([]byte("tx"), prepareResp.Txs[1])
+
+	_, err = prepare(ctx, &abci.RequestPrepareProposal{Height: 3, MaxTxBytes: 10, LocalLastCommit: llc})
+	s.Require().ErrorContains(err, "exceeds the max tx bytes")
+
+	processResp, err := process(ctx, &abci.RequestProcessProposal{Height: 3, Txs: prepareResp.Txs})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseProcessProposal_ACCEPT, processResp.Status)
+	s.Require().Equal([][]byte{[]byte("tx")}, nextTxs)
+
+	for name, txs := range map[string][][]byte{
+		"no extended commit":        nil,
+		"malformed extended commit": {[]byte("tx")},
+		"unsigned extended commit":  {mustMarshalExtendedCommit(s, abci.ExtendedCommitInfo{Votes: llc.Votes[:1]})},
+	} {
+		processResp, err := process(ctx, &abci.RequestProcessProposal{Height: 3, Txs: txs})
+		s.Require().NoError(err, name)
+		s.Require().Equal(abci.ResponseProcessProposal_REJECT, processResp.Status, name)
+	}
+
+	// vote extensions are not enabled at the enable height
+	processResp, err = process(s.ctx.WithBlockHeight(2), &abci.RequestProcessProposal{Height: 2})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseProcessProposal_ACCEPT, processResp.Status)
+
+	nextCalled := false
+	preBlocker := m.PreBlocker(func(sdk.Context, *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		nextCalled = true
+		return &sdk.ResponsePreBlock{}, nil
+	})
+	_, err = preBlocker(ctx, &abci.RequestFinalizeBlock{Height: 3, Txs: prepareResp.Txs})
+	s.Require().NoError(err)
+	s.Require().True(nextCalled)
+	s.Require().Equal([]string{"price-2", "price-2", "price-2"}, prices)
+	s.Require().Equal([]uint64{2, 2, 2}, heights)
+}
+
+func mustMarshalExtendedCommit(s *ABCIUtilsTestSuite, extCommit abci.ExtendedCommitInfo) []byte {
+	bz, err := extCommit.Marshal()
+	s.Require().NoError(err)
+	return bz
+}
@@ -0,0 +1,178 @@
+package debug
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	FlagAppDBBackend = "app-db-backend"
+
+	flagStart = "start"
+	flagEnd   = "end"
+)
+
+// CollectionsSchemaProvider is implemented by the applications exposing the
+// collections schemas of their modules, keyed by the name of the module store.
+type CollectionsSchemaProvider interface {
+	CollectionsSchemas() map[string]collections.Schema
+}
+
+// StateCmd returns a command dumping the entries of a module collection from
+// the application state, the application must implement
+// CollectionsSchemaProvider.
+func StateCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state [store] [collection]",
+		Short: "Dump the entries of a module collection from the application state",
+		Long: `Dump the entries of a module collection from the application state, one JSON entry per line.
+Without a collection, the collections of the module store are listed with their prefix.
+
+The range of entries is given by the hex encoded --start (inclusive) and --end (exclusive) keys,
+stripped of the collection prefix. The state is read at the last committed height unless --height is set.
+
+The node must be stopped while the state is read.
+
+Note: When the --app-db-backend flag is not specified, the default backend type is 'goleveldb'.
+Supported app-db-backend types include 'goleveldb', 'rocksdb', 'pebbledb'.`,
+		Example: "state bank Balances --start 0a --limit 10",
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vp := viper.New()
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			home := vp.GetString(flags.FlagHome)
+			if home == "" {
+				home = defaultNodeHome
+				vp.Set(flags.FlagHome, home)
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(vp), filepath.Join(home, "data"))
+			if err != nil {
+				return fmt.Errorf("error opening DB, make sure daemon is not running when calling this command: %w", err)
+			}
+
+			app := appCreator(log.NewNopLogger(), db, nil, vp)
+			defer app.Close()
+
+			provider, ok := app.(CollectionsSchemaProvider)
+			if !ok {
+				return fmt.Errorf("application %T does not expose its collections schemas", app)
+			}
+			schema, ok := provider.CollectionsSchemas()[args[0]]
+			if !ok {
+				return fmt.Errorf("no collections schema for store %s", args[0])
+			}
+
+			if len(args) == 1 {
+				for _, coll := range schema.ListCollections() {
+					cmd.Printf("%s %X\n", coll.GetName(), coll.GetPrefix())
+				}
+				return nil
+			}
+
+			var coll collections.Collection
+			for _, c := range schema.ListCollections() {
+				if c.GetName() == args[1] {
+					coll = c
+				}
+			}
+			if coll == nil {
+				return fmt.Errorf("unknown collection %s in store %s", args[1], args[0])
+			}
+
+			rms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("currently only support the state of rootmulti.Store type")
+			}
+			storeKey, ok := rms.StoreKeysByName()[args[0]]
+			if !ok {
+				return fmt.Errorf("unknown store %s", args[0])
+			}
+
+			height := vp.GetInt64(flags.FlagHeight)
+			if height == 0 {
+				height = rms.LastCommitID().Version
+			}
+			cms, err := rms.CacheMultiStoreWithVersion(height)
+			if err != nil {
+				return err
+			}
+
+			start, end, err := stateRange(coll.GetPrefix(), vp.GetString(flagStart), vp.GetString(flagEnd))
+			if err != nil {
+				return err
+			}
+
+			kvStore := cms.GetKVStore(storeKey)
+			var iter storetypes.Iterator
+			if vp.GetBool(flags.FlagReverse) {
+				iter = kvStore.ReverseIterator(start, end)
+			} else {
+				iter = kvStore.Iterator(start, end)
+			}
+			defer iter.Close()
+
+			limit := vp.GetUint64(flags.FlagLimit)
+			for n := uint64(0); iter.Valid() && (limit == 0 || n < limit); iter.Next() {
+				bz, err := schema.DecodeEntry(coll.GetPrefix(), iter.Key()[len(coll.GetPrefix()):], iter.Value())
+				if err != nil {
+					return fmt.Errorf("key %X: %w", iter.Key(), err)
+				}
+				cmd.Println(string(bz))
+				n++
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for application")
+	cmd.Flags().Int64(flags.FlagHeight, 0, "The height to read the state at, the last committed height if zero")
+	cmd.Flags().String(flagStart, "", "The hex encoded key the entries start from, inclusive")
+	cmd.Flags().String(flagEnd, "", "The hex encoded key the entries end at, exclusive")
+	cmd.Flags().Uint64(flags.FlagLimit, 0, "The maximum number of entries, there is no limit if zero")
+	cmd.Flags().Bool(flags.FlagReverse, false, "Dump the entries in descending key order")
+
+	return cmd
+}
+
+// stateRange returns the raw store range of the entries of the collection with
+// the provided prefix between the hex encoded start and end keys.
+func stateRange(prefix []byte, startHex, endHex string) (start, end []byte, err error) {
+	start = prefix
+	if startHex != "" {
+		key, err := hex.DecodeString(startHex)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid start key: %w", err)
+		}
+		start = append(append([]byte{}, prefix...), key...)
+	}
+
+	end = storetypes.PrefixEndBytes(prefix)
+	if endHex != "" {
+		key, err := hex.DecodeString(endHex)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid end key: %w", err)
+		}
+		end = append(append([]byte{}, prefix...), key...)
+	}
+
+	return start, end, nil
+}
@@ -0,0 +1,57 @@
+package mempool
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// QueryMempoolCmd returns a command to query the txs pending in the app-side
+// mempool of a node.
+func QueryMempoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mempool [sender]",
+		Short: "Query the txs pending in the app-side mempool of a node",
+		Long: `Query the txs pending in the app-side mempool of a node, with their sender, nonce,
+priority and size. With a sender, only its txs are queried, in nonce order.
+
+The mempool service is only served by the node gRPC server, set with --grpc-addr.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.GRPCClient == nil {
+				return errors.New("the mempool is only served by the node gRPC server, set its address with --" + flags.FlagGRPC)
+			}
+
+			queryClient := NewServiceClient(clientCtx)
+			if len(args) > 0 {
+				res, err := queryClient.SenderTxs(cmd.Context(), &SenderTxsRequest{Sender: args[0]})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Txs(cmd.Context(), &TxsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mempool txs")
+
+	return cmd
+}
@@ -0,0 +1,156 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// RegisterMempoolService registers the mempool gRPC service on the provided
+// gRPC server, serving the txs of the given app-side mempool.
+func RegisterMempoolService(server gogogrpc.Server, mp mempool.Mempool, txEncoder sdk.TxEncoder) {
+	RegisterServiceServer(server, NewQueryServer(mp, txEncoder))
+}
+
+var _ ServiceServer = queryServer{}
+
+type queryServer struct {
+	mempool   mempool.Mempool
+	txEncoder sdk.TxEncoder
+}
+
+func NewQueryServer(mp mempool.Mempool, txEncoder sdk.TxEncoder) ServiceServer {
+	return queryServer{
+		mempool:   mp,
+		txEncoder: txEncoder,
+	}
+}
+
+func (s queryServer) Txs(ctx context.Context, req *TxsRequest) (*TxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Pagination != nil && req.Pagination.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "key based pagination is not supported")
+	}
+
+	txs, err := s.txs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, limit := uint64(0), uint64(query.DefaultLimit)
+	if req.Pagination != nil {
+		offset = req.Pagination.Offset
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+	start := min(offset, uint64(len(txs)))
+	end := start + min(limit, uint64(len(txs))-start)
+
+	res := &TxsResponse{Txs: make([]*MempoolTx, 0, end-start)}
+	for _, tx := range txs[start:end] {
+		mempoolTx, err := s.mempoolTx(tx)
+		if err != nil {
+			return nil, err
+		}
+		res.Txs = append(res.Txs, mempoolTx)
+	}
+	if req.Pagination != nil && req.Pagination.CountTotal {
+		res.Pagination = &query.PageResponse{Total: uint64(len(txs))}
+	}
+
+	return res, nil
+}
+
+func (s queryServer) SenderTxs(ctx context.Context, req *SenderTxsRequest) (*SenderTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Sender == "" {
+		return nil, status.Error(codes.InvalidArgument, "sender cannot be empty")
+	}
+
+	var txs []mempool.TxInfo
+	if mp, ok := s.mempool.(mempool.InspectableMempool); ok {
+		txs = mp.SenderTxs(req.Sender)
+	} else {
+		all, err := s.txs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range all {
+			if tx.Sender == req.Sender {
+				txs = append(txs, tx)
+			}
+		}
+	}
+
+	res := &SenderTxsResponse{Txs: make([]*MempoolTx, 0, len(txs))}
+	for _, tx := range txs {
+		mempoolTx, err := s.mempoolTx(tx)
+		if err != nil {
+			return nil, err
+		}
+		res.Txs = append(res.Txs, mempoolTx)
+	}
+
+	return res, nil
+}
+
+// txs returns the txs of the mempool. Mempools which are not inspectable are
+// iterated, without the tx priorities.
+func (s queryServer) txs(ctx context.Context) ([]mempool.TxInfo, error) {
+	if mp, ok := s.mempool.(mempool.InspectableMempool); ok {
+		return mp.Txs(), nil
+	}
+
+	var (
+		txs []mempool.TxInfo
+		err error
+	)
+	signerExtractor := mempool.NewDefaultSignerExtractionAdapter()
+	mempool.SelectBy(ctx, s.mempool, nil, func(tx sdk.Tx) bool {
+		var signers []mempool.SignerData
+		signers, err = signerExtractor.GetSigners(tx)
+		if err != nil {
+			return false
+		}
+		info := mempool.TxInfo{Tx: tx}
+		if len(signers) > 0 {
+			info.Sender = signers[0].Signer.String()
+			info.Nonce = signers[0].Sequence
+		}
+		txs = append(txs, info)
+		return true
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return txs, nil
+}
+
+func (s queryServer) mempoolTx(info mempool.TxInfo) (*MempoolTx, error) {
+	bz, err := s.txEncoder(info.Tx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode tx: %v", err)
+	}
+
+	return &MempoolTx{
+		Hash:     fmt.Sprintf("%X", cmttypes.Tx(bz).Hash()),
+		Sender:   info.Sender,
+		Nonce:    info.Nonce,
+		Priority: info.Priority,
+		Size_:    uint64(len(bz)),
+	}, nil
+}
@@ -0,0 +1,124 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// testTx is a tx signed by a single signer.
+type testTx struct {
+	pubKey cryptotypes.PubKey
+	nonce  uint64
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return nil }
+
+func (tx testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func (tx testTx) GetSigners() ([][]byte, error) { return [][]byte{tx.pubKey.Address()}, nil }
+
+func (tx testTx) GetPubKeys() ([]cryptotypes.PubKey, error) {
+	return []cryptotypes.PubKey{tx.pubKey}, nil
+}
+
+func (tx testTx) GetSignaturesV2() ([]txsigning.SignatureV2, error) {
+	return []txsigning.SignatureV2{{PubKey: tx.pubKey, Sequence: tx.nonce}}, nil
+}
+
+func testTxEncoder(tx sdk.Tx) ([]byte, error) {
+	t := tx.(testTx)
+	return []byte(fmt.Sprintf("%X/%d", t.pubKey.Address(), t.nonce)), nil
+}
+
+func TestServiceServer_Txs(t *testing.T) {
+	pkA := secp256k1.GenPrivKey().PubKey()
+	pkB := secp256k1.GenPrivKey().PubKey()
+	senderA := sdk.AccAddress(pkA.Address()).String()
+	senderB := sdk.AccAddress(pkB.Address()).String()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+
+	mp := mempool.DefaultPriorityMempool()
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{pubKey: pkA, nonce: 1}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(5), testTx{pubKey: pkA, nonce: 2}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(20), testTx{pubKey: pkB, nonce: 7}))
+
+	svr := NewQueryServer(mp, testTxEncoder)
+
+	res, err := svr.Txs(ctx, &TxsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	require.Len(t, res.Txs, 3)
+
+	bz, err := testTxEncoder(testTx{pubKey: pkB, nonce: 7})
+	require.NoError(t, err)
+	require.Equal(t, senderB, res.Txs[0].Sender)
+	require.Equal(t, uint64(7), res.Txs[0].Nonce)
+	require.Equal(t, "20", res.Txs[0].Priority)
+	require.Equal(t, uint64(len(bz)), res.Txs[0].Size_)
+	require.Len(t, res.Txs[0].Hash, 64)
+	require.Equal(t, "10", res.Txs[1].Priority)
+	require.Equal(t, "5", res.Txs[2].Priority)
+
+	res, err = svr.Txs(ctx, &TxsRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 1)
+	require.Equal(t, "10", res.Txs[0].Priority)
+	require.Nil(t, res.Pagination)
+
+	res, err = svr.Txs(ctx, &TxsRequest{Pagination: &query.PageRequest{Offset: 5}})
+	require.NoError(t, err)
+	require.Empty(t, res.Txs)
+
+	_, err = svr.Txs(ctx, &TxsRequest{Pagination: &query.PageRequest{Key: []byte("key")}})
+	require.Error(t, err)
+
+	senderRes, err := svr.SenderTxs(ctx, &SenderTxsRequest{Sender: senderA})
+	require.NoError(t, err)
+	require.Len(t, senderRes.Txs, 2)
+	require.Equal(t, uint64(1), senderRes.Txs[0].Nonce)
+	require.Equal(t, uint64(2), senderRes.Txs[1].Nonce)
+
+	senderRes, err = svr.SenderTxs(ctx, &SenderTxsRequest{Sender: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()})
+	require.NoError(t, err)
+	require.Empty(t, senderRes.Txs)
+
+	_, err = svr.SenderTxs(ctx, &SenderTxsRequest{})
+	require.Error(t, err)
+}
+
+// selectOnlyMempool hides the inspection methods of a mempool.
+type selectOnlyMempool struct {
+	mempool.Mempool
+}
+
+func TestServiceServer_NotInspectable(t *testing.T) {
+	pk := secp256k1.GenPrivKey().PubKey()
+	sender := sdk.AccAddress(pk.Address()).String()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+
+	mp := mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(0))
+	require.NoError(t, mp.Insert(ctx, testTx{pubKey: pk, nonce: 2}))
+	require.NoError(t, mp.Insert(ctx, testTx{pubKey: pk, nonce: 1}))
+
+	svr := NewQueryServer(selectOnlyMempool{mp}, testTxEncoder)
+	res, err := svr.SenderTxs(context.Background(), &SenderTxsRequest{Sender: sender})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 2)
+	require.Equal(t, uint64(1), res.Txs[0].Nonce)
+	require.Equal(t, uint64(2), res.Txs[1].Nonce)
+	require.Empty(t, res.Txs[0].Priority)
+}
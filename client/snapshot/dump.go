@@ -3,6 +3,7 @@ package snapshot
 import (
 	"archive/tar"
 	"compress/gzip"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -11,6 +12,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
@@ -108,6 +111,37 @@ func DumpArchiveCmd() *cobra.Command {
 				}
 			}
 
+			// the manifest follows the chunks, so the archive can still be loaded
+			// by readers unaware of it
+			switch snapshot.Format {
+			case snapshottypes.FormatStream, snapshottypes.FormatSections:
+				_, chunks, err := snapshotStore.Load(height, uint32(format))
+				if err != nil {
+					return err
+				}
+				manifest, err := readSnapshot(snapshot, chunks, false)
+				if err != nil {
+					return fmt.Errorf("failed to read snapshot: %w", err)
+				}
+				bz, err := json.MarshalIndent(manifest, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				if err := tarWriter.WriteHeader(&tar.Header{
+					Name: ManifestFileName,
+					Mode: 0o644,
+					Size: int64(len(bz)),
+				}); err != nil {
+					return fmt.Errorf("failed to write manifest header to tar: %w", err)
+				}
+				if _, err := tarWriter.Write(bz); err != nil {
+					return fmt.Errorf("failed to write manifest to tar: %w", err)
+				}
+			default:
+				// the snapshots of the other formats are dumped without a manifest
+			}
+
 			if err := tarWriter.Close(); err != nil {
 				return fmt.Errorf("failed to close tar writer: %w", err)
 			}
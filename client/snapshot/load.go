@@ -4,6 +4,8 @@ import (
 	"archive/tar"
 	"bytes"
 	"compress/gzip"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -32,36 +34,12 @@ func LoadArchiveCmd() *cobra.Command {
 				return err
 			}
 
-			path := args[0]
-			fp, err := os.Open(path)
+			archive, err := openArchive(args[0])
 			if err != nil {
-				return fmt.Errorf("failed to open archive file: %w", err)
-			}
-			reader, err := gzip.NewReader(fp)
-			if err != nil {
-				return fmt.Errorf("failed to create gzip reader: %w", err)
-			}
-
-			var snapshot snapshottypes.Snapshot
-			tr := tar.NewReader(reader)
-			if err != nil {
-				return fmt.Errorf("failed to create tar reader: %w", err)
-			}
-
-			hdr, err := tr.Next()
-			if err != nil {
-				return fmt.Errorf("failed to read snapshot file header: %w", err)
-			}
-			if hdr.Name != SnapshotFileName {
-				return fmt.Errorf("invalid archive, expect file: snapshot, got: %s", hdr.Name)
-			}
-			bz, err := io.ReadAll(tr)
-			if err != nil {
-				return fmt.Errorf("failed to read snapshot file: %w", err)
-			}
-			if err := snapshot.Unmarshal(bz); err != nil {
-				return fmt.Errorf("failed to unmarshal snapshot: %w", err)
+				return err
 			}
+			defer archive.Close()
+			snapshot := archive.snapshot
 
 			// make sure the channel is unbuffered, because the tar reader can't do concurrency
 			chunks := make(chan io.ReadCloser)
@@ -77,26 +55,11 @@ func LoadArchiveCmd() *cobra.Command {
 				quitChan <- savedSnapshot
 			}()
 
-			for i := uint32(0); i < snapshot.Chunks; i++ {
-				hdr, err = tr.Next()
-				if err != nil {
-					if err == io.EOF {
-						break
-					}
-					return err
-				}
-
-				if hdr.Name != strconv.FormatInt(int64(i), 10) {
-					return fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
-				}
-
-				bz, err := io.ReadAll(tr)
-				if err != nil {
-					return fmt.Errorf("failed to read chunk file: %w", err)
-				}
-				chunks <- io.NopCloser(bytes.NewReader(bz))
-			}
+			err = archive.readChunks(chunks)
 			close(chunks)
+			if err != nil {
+				return err
+			}
 
 			savedSnapshot := <-quitChan
 			if savedSnapshot == nil {
@@ -112,3 +75,95 @@ func LoadArchiveCmd() *cobra.Command {
 		},
 	}
 }
+
+// archiveReader reads a portable archive format snapshot.
+type archiveReader struct {
+	fp       *os.File
+	tr       *tar.Reader
+	snapshot snapshottypes.Snapshot
+}
+
+// openArchive opens a snapshot archive file, reading its snapshot metadata.
+func openArchive(path string) (*archiveReader, error) {
+	fp, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive file: %w", err)
+	}
+	reader, err := gzip.NewReader(fp)
+	if err != nil {
+		fp.Close()
+		return nil, fmt.Errorf("failed to create gzip reader: %w", err)
+	}
+
+	archive := &archiveReader{fp: fp, tr: tar.NewReader(reader)}
+	hdr, err := archive.tr.Next()
+	if err != nil {
+		fp.Close()
+		return nil, fmt.Errorf("failed to read snapshot file header: %w", err)
+	}
+	if hdr.Name != SnapshotFileName {
+		fp.Close()
+		return nil, fmt.Errorf("invalid archive, expect file: snapshot, got: %s", hdr.Name)
+	}
+	bz, err := io.ReadAll(archive.tr)
+	if err != nil {
+		fp.Close()
+		return nil, fmt.Errorf("failed to read snapshot file: %w", err)
+	}
+	if err := archive.snapshot.Unmarshal(bz); err != nil {
+		fp.Close()
+		return nil, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+
+	return archive, nil
+}
+
+// readChunks reads the chunks of the archive into the channel, which is not
+// closed.
+func (a *archiveReader) readChunks(chunks chan<- io.ReadCloser) error {
+	for i := uint32(0); i < a.snapshot.Chunks; i++ {
+		hdr, err := a.tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+
+		if hdr.Name != strconv.FormatInt(int64(i), 10) {
+			return fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
+		}
+
+		bz, err := io.ReadAll(a.tr)
+		if err != nil {
+			return fmt.Errorf("failed to read chunk file: %w", err)
+		}
+		chunks <- io.NopCloser(bytes.NewReader(bz))
+	}
+	return nil
+}
+
+// readManifest reads the manifest following the chunks of the archive, it
+// returns nil if the archive has none.
+func (a *archiveReader) readManifest() (*Manifest, error) {
+	hdr, err := a.tr.Next()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if hdr.Name != ManifestFileName {
+		return nil, fmt.Errorf("invalid archive, expect file: %s, got: %s", ManifestFileName, hdr.Name)
+	}
+
+	var manifest Manifest
+	if err := json.NewDecoder(a.tr).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	return &manifest, nil
+}
+
+// Close closes the archive file.
+func (a *archiveReader) Close() error {
+	return a.fp.Close()
+}
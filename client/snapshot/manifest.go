@@ -0,0 +1,333 @@
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+
+	dbm "github.com/cosmos/cosmos-db"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/iavl"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/store/wrapper"
+)
+
+// ManifestFileName is the name of the manifest in the snapshot archives,
+// following the chunks.
+const ManifestFileName = "_manifest.json"
+
+// Manifest describes the content of a snapshot.
+type Manifest struct {
+	Height     uint64              `json:"height"`
+	Format     uint32              `json:"format"`
+	Chunks     uint32              `json:"chunks"`
+	Stores     []StoreManifest     `json:"stores"`
+	Extensions []ExtensionManifest `json:"extensions"`
+	// AppHash is the hash of the commit info of the stores, only set when the
+	// stores are rebuilt.
+	AppHash string `json:"app_hash,omitempty"`
+}
+
+// StoreManifest describes a store of a snapshot.
+type StoreManifest struct {
+	Name string `json:"name"`
+	// Nodes is the number of IAVL nodes of the store, and Leaves the number of
+	// its keys.
+	Nodes  uint64 `json:"nodes"`
+	Leaves uint64 `json:"leaves"`
+	// Size is the size in bytes of the keys and values of the store.
+	Size uint64 `json:"size"`
+	// Hash is the hash of the store, only set when the store is rebuilt.
+	Hash string `json:"hash,omitempty"`
+}
+
+// ExtensionManifest describes an extension of a snapshot.
+type ExtensionManifest struct {
+	Name   string `json:"name"`
+	Format uint32 `json:"format"`
+	// Size is the size in bytes of the payloads of the extension.
+	Size uint64 `json:"size"`
+}
+
+// withoutHashes returns the manifest without the hashes set when the stores
+// are rebuilt.
+func (m Manifest) withoutHashes() Manifest {
+	m.AppHash = ""
+	stores := make([]StoreManifest, len(m.Stores))
+	for i, store := range m.Stores {
+		store.Hash = ""
+		stores[i] = store
+	}
+	m.Stores = stores
+
+	return m
+}
+
+// readSnapshot reads the chunks of a snapshot, checking them against its
+// metadata, and returns its manifest. When rebuild is set, the IAVL stores are
+// rebuilt in a temporary database to compute their hashes and the hash of
+// their commit info, which is the app hash of the snapshot height.
+// The chunks are drained.
+func readSnapshot(snapshot *snapshottypes.Snapshot, chunks <-chan io.ReadCloser, rebuild bool) (*Manifest, error) {
+	defer snapshots.DrainChunks(chunks)
+
+	switch snapshot.Format {
+	case snapshottypes.FormatStream, snapshottypes.FormatSections:
+	case snapshottypes.FormatIncremental:
+		return nil, fmt.Errorf("snapshot format %d is incremental, it only holds the changes since the snapshot at height %d and can't be read on its own",
+			snapshot.Format, snapshot.Metadata.BaseHeight)
+	default:
+		return nil, fmt.Errorf("unsupported snapshot format %d", snapshot.Format)
+	}
+	if snapshot.Height == 0 || snapshot.Height > math.MaxInt64 {
+		return nil, fmt.Errorf("invalid snapshot height %d", snapshot.Height)
+	}
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return nil, fmt.Errorf("snapshot has %d chunk hashes, but %d chunks", len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+
+	// the chunks are checked as they are read, the error stopping the stream
+	verified := make(chan io.ReadCloser)
+	verifyDone := make(chan error, 1)
+	go func() {
+		defer close(verified)
+		verifyDone <- verifyChunks(snapshot, chunks, verified)
+	}()
+
+	manifest, err := readItems(snapshot, verified, rebuild)
+	snapshots.DrainChunks(verified)
+	if verifyErr := <-verifyDone; verifyErr != nil {
+		return nil, verifyErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// verifyChunks checks the hashes of the chunks and of the snapshot, passing the
+// verified chunks to the channel.
+func verifyChunks(snapshot *snapshottypes.Snapshot, chunks <-chan io.ReadCloser, verified chan<- io.ReadCloser) error {
+	snapshotHasher := sha256.New()
+	index := uint32(0)
+	for chunk := range chunks {
+		bz, err := io.ReadAll(chunk)
+		_ = chunk.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read chunk %d: %w", index, err)
+		}
+		if index >= snapshot.Chunks {
+			return fmt.Errorf("snapshot has more than %d chunks", snapshot.Chunks)
+		}
+
+		hash := sha256.Sum256(bz)
+		if !bytes.Equal(hash[:], snapshot.Metadata.ChunkHashes[index]) {
+			return fmt.Errorf("invalid chunk %d: expected hash %X, got %X", index, snapshot.Metadata.ChunkHashes[index], hash)
+		}
+		snapshotHasher.Write(bz)
+
+		verified <- io.NopCloser(bytes.NewReader(bz))
+		index++
+	}
+
+	if index != snapshot.Chunks {
+		return fmt.Errorf("snapshot has %d chunks, expected %d", index, snapshot.Chunks)
+	}
+	if hash := snapshotHasher.Sum(nil); !bytes.Equal(hash, snapshot.Hash) {
+		return fmt.Errorf("invalid snapshot: expected hash %X, got %X", snapshot.Hash, hash)
+	}
+
+	return nil
+}
+
+// readItems reads the snapshot items of the chunks, building the manifest of
+// the snapshot.
+func readItems(snapshot *snapshottypes.Snapshot, chunks <-chan io.ReadCloser, rebuild bool) (*Manifest, error) {
+	manifest := &Manifest{
+		Height:     snapshot.Height,
+		Format:     snapshot.Format,
+		Chunks:     snapshot.Chunks,
+		Stores:     []StoreManifest{},
+		Extensions: []ExtensionManifest{},
+	}
+
+	var db dbm.DB
+	if rebuild {
+		dir, err := os.MkdirTemp("", "snapshot-verify")
+		if err != nil {
+			return nil, err
+		}
+		defer os.RemoveAll(dir)
+
+		db, err = dbm.NewGoLevelDB("verify", dir, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer db.Close()
+	}
+
+	version := int64(snapshot.Height)
+	commitInfo := storetypes.CommitInfo{Version: version}
+	var (
+		tree     *iavl.MutableTree
+		importer *iavl.Importer
+	)
+	// commitStore commits the import of the current store, setting its hash
+	commitStore := func() error {
+		if importer == nil {
+			return nil
+		}
+		store := &manifest.Stores[len(manifest.Stores)-1]
+		if err := importer.Commit(); err != nil {
+			return fmt.Errorf("failed to rebuild store %s: %w", store.Name, err)
+		}
+		importer = nil
+
+		hash := tree.Hash()
+		store.Hash = fmt.Sprintf("%X", hash)
+		commitInfo.StoreInfos = append(commitInfo.StoreInfos, storetypes.StoreInfo{
+			Name:     store.Name,
+			CommitId: storetypes.CommitID{Version: version, Hash: hash},
+		})
+		return nil
+	}
+	defer func() {
+		if importer != nil {
+			importer.Close()
+		}
+	}()
+
+	// readItem adds a snapshot item to the manifest
+	readItem := func(item snapshottypes.SnapshotItem) error {
+		switch item := item.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if len(manifest.Extensions) > 0 {
+				return fmt.Errorf("unexpected store %s after the extensions", item.Store.Name)
+			}
+			if err := commitStore(); err != nil {
+				return err
+			}
+			manifest.Stores = append(manifest.Stores, StoreManifest{Name: item.Store.Name})
+
+			if rebuild {
+				prefix := fmt.Sprintf("s/k:%s/", item.Store.Name)
+				tree = iavl.NewMutableTree(wrapper.NewDBWrapper(dbm.NewPrefixDB(db, []byte(prefix))), 0, true, log.NewNopLogger())
+				var err error
+				if importer, err = tree.Import(version); err != nil {
+					return err
+				}
+			}
+
+		case *snapshottypes.SnapshotItem_IAVL:
+			if len(manifest.Stores) == 0 || len(manifest.Extensions) > 0 {
+				return errors.New("unexpected IAVL node item outside of a store")
+			}
+			if item.IAVL.Height > math.MaxInt8 {
+				return fmt.Errorf("node height %d cannot exceed %d", item.IAVL.Height, math.MaxInt8)
+			}
+
+			store := &manifest.Stores[len(manifest.Stores)-1]
+			store.Nodes++
+			if item.IAVL.Height == 0 {
+				store.Leaves++
+				store.Size += uint64(len(item.IAVL.Key) + len(item.IAVL.Value))
+			}
+
+			if importer != nil {
+				node := &iavl.ExportNode{
+					Key:     item.IAVL.Key,
+					Value:   item.IAVL.Value,
+					Height:  int8(item.IAVL.Height),
+					Version: item.IAVL.Version,
+				}
+				// see rootmulti.Store.Restore, IAVL does not allow nil keys nor nil leaf values
+				if node.Key == nil {
+					node.Key = []byte{}
+				}
+				if node.Height == 0 && node.Value == nil {
+					node.Value = []byte{}
+				}
+				if err := importer.Add(node); err != nil {
+					return fmt.Errorf("failed to rebuild store %s: %w", store.Name, err)
+				}
+			}
+
+		case *snapshottypes.SnapshotItem_Extension:
+			if err := commitStore(); err != nil {
+				return err
+			}
+			manifest.Extensions = append(manifest.Extensions, ExtensionManifest{
+				Name:   item.Extension.Name,
+				Format: item.Extension.Format,
+			})
+
+		case *snapshottypes.SnapshotItem_ExtensionPayload:
+			if len(manifest.Extensions) == 0 {
+				return errors.New("unexpected extension payload item outside of an extension")
+			}
+			manifest.Extensions[len(manifest.Extensions)-1].Size += uint64(len(item.ExtensionPayload.Payload))
+
+		default:
+			return fmt.Errorf("unknown snapshot item %T", item)
+		}
+		return nil
+	}
+
+	// readStream reads the items of a stream, the whole snapshot or a section
+	readStream := func(protoReader protoio.Reader) error {
+		for {
+			var item snapshottypes.SnapshotItem
+			err := protoReader.ReadMsg(&item)
+			if errors.Is(err, io.EOF) {
+				return nil
+			} else if err != nil {
+				return fmt.Errorf("invalid snapshot item: %w", err)
+			}
+			if err := readItem(item); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch snapshot.Format {
+	case snapshottypes.FormatStream:
+		streamReader, err := snapshots.NewStreamReader(chunks)
+		if err != nil {
+			return nil, err
+		}
+		defer streamReader.Close()
+		if err := readStream(streamReader); err != nil {
+			return nil, err
+		}
+
+	case snapshottypes.FormatSections:
+		err := snapshots.ReadSections(snapshot, chunks, func(_ snapshottypes.SnapshotSection, protoReader protoio.Reader) error {
+			return readStream(protoReader)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported snapshot format %d", snapshot.Format)
+	}
+
+	if err := commitStore(); err != nil {
+		return nil, err
+	}
+
+	if rebuild {
+		manifest.AppHash = fmt.Sprintf("%X", commitInfo.Hash())
+	}
+
+	return manifest, nil
+}
@@ -0,0 +1,134 @@
+package snapshot
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// streamSnapshotter hides the section snapshots of a multistore, so that its
+// snapshots are taken in the FormatStream format.
+type streamSnapshotter struct {
+	snapshottypes.Snapshotter
+}
+
+func setupSnapshot(t *testing.T, format uint32) (*snapshots.Store, *snapshottypes.Snapshot, []byte) {
+	t.Helper()
+
+	multistore := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, name := range []string{"bank", "staking"} {
+		multistore.MountStoreWithDB(storetypes.NewKVStoreKey(name), storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, multistore.LoadLatestVersion())
+
+	for version := 0; version < 3; version++ {
+		for _, name := range []string{"bank", "staking"} {
+			store := multistore.GetStoreByName(name).(storetypes.KVStore)
+			for i := 0; i < 10; i++ {
+				store.Set([]byte(fmt.Sprintf("key%d-%d", version, i)), []byte(fmt.Sprintf("value%d", i)))
+			}
+		}
+		multistore.Commit()
+	}
+
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	var snapshotter snapshottypes.Snapshotter = multistore
+	if format == snapshottypes.FormatStream {
+		snapshotter = streamSnapshotter{multistore}
+	}
+	manager := snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(0, 0), snapshotter, nil, log.NewNopLogger())
+	snapshot, err := manager.Create(3)
+	require.NoError(t, err)
+	require.Equal(t, format, snapshot.Format)
+
+	return snapshotStore, snapshot, multistore.LastCommitID().Hash
+}
+
+func TestReadSnapshot(t *testing.T) {
+	for _, format := range []uint32{snapshottypes.FormatStream, snapshottypes.FormatSections} {
+		t.Run(fmt.Sprintf("format %d", format), func(t *testing.T) {
+			snapshotStore, snapshot, appHash := setupSnapshot(t, format)
+
+			_, chunks, err := snapshotStore.Load(snapshot.Height, snapshot.Format)
+			require.NoError(t, err)
+			manifest, err := readSnapshot(snapshot, chunks, true)
+			require.NoError(t, err)
+			require.Equal(t, fmt.Sprintf("%X", appHash), manifest.AppHash)
+			require.Equal(t, snapshot.Height, manifest.Height)
+			require.Len(t, manifest.Stores, 2)
+			require.Equal(t, "bank", manifest.Stores[0].Name)
+			require.Equal(t, "staking", manifest.Stores[1].Name)
+			for _, store := range manifest.Stores {
+				require.Equal(t, uint64(30), store.Leaves)
+				require.Equal(t, uint64(59), store.Nodes)
+				require.NotEmpty(t, store.Hash)
+			}
+			require.Empty(t, manifest.Extensions)
+
+			// without rebuilding the stores, the manifest has no hashes
+			_, chunks, err = snapshotStore.Load(snapshot.Height, snapshot.Format)
+			require.NoError(t, err)
+			unverified, err := readSnapshot(snapshot, chunks, false)
+			require.NoError(t, err)
+			require.Empty(t, unverified.AppHash)
+			require.Equal(t, manifest.withoutHashes(), *unverified)
+
+			require.NoError(t, checkAppHash(appHash, manifest.AppHash))
+			require.Error(t, checkAppHash([]byte{1, 2, 3}, manifest.AppHash))
+		})
+	}
+}
+
+func TestReadSnapshot_Invalid(t *testing.T) {
+	snapshotStore, snapshot, _ := setupSnapshot(t, snapshottypes.FormatSections)
+
+	loadChunks := func() <-chan io.ReadCloser {
+		_, chunks, err := snapshotStore.Load(snapshot.Height, snapshot.Format)
+		require.NoError(t, err)
+		return chunks
+	}
+
+	invalid := *snapshot
+	invalid.Metadata.ChunkHashes = append([][]byte{make([]byte, 32)}, snapshot.Metadata.ChunkHashes[1:]...)
+	_, err := readSnapshot(&invalid, loadChunks(), true)
+	require.ErrorContains(t, err, "invalid chunk 0")
+
+	invalid = *snapshot
+	invalid.Hash = make([]byte, 32)
+	_, err = readSnapshot(&invalid, loadChunks(), true)
+	require.ErrorContains(t, err, "invalid snapshot")
+
+	// the chunks of a section must belong to the section
+	invalid = *snapshot
+	invalid.Metadata.Sections = append([]snapshottypes.SnapshotSection{}, snapshot.Metadata.Sections...)
+	invalid.Metadata.Sections[0].Name = "staking"
+	invalid.Metadata.Sections[1].Name = "bank"
+	_, err = readSnapshot(&invalid, loadChunks(), true)
+	require.ErrorContains(t, err, "chunk 0 of store staking is chunk 0 of store bank")
+
+	invalid = *snapshot
+	invalid.Metadata.Sections = snapshot.Metadata.Sections[1:]
+	_, err = readSnapshot(&invalid, loadChunks(), true)
+	require.ErrorContains(t, err, "chunk hash verification failed")
+
+	invalid = *snapshot
+	invalid.Format = snapshottypes.FormatIncremental
+	_, err = readSnapshot(&invalid, loadChunks(), true)
+	require.ErrorContains(t, err, "snapshot format 5 is incremental")
+
+	invalid = *snapshot
+	invalid.Format = 1
+	_, err = readSnapshot(&invalid, loadChunks(), true)
+	require.ErrorContains(t, err, "unsupported snapshot format")
+}
@@ -1,6 +1,9 @@
 package snapshot
 
 import (
+	"bytes"
+	"fmt"
+	"io"
 	"path/filepath"
 	"strconv"
 
@@ -8,6 +11,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -16,21 +21,39 @@ import (
 // RestoreSnapshotCmd returns a command to restore a snapshot
 func RestoreSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "restore <height> <format>",
+		Use:   "restore <height> [format]",
 		Short: "Restore app state from local snapshot",
-		Long:  "Restore app state from local snapshot",
-		Args:  cobra.ExactArgs(2),
+		Long: `Restore app state from local snapshot, of the latest format available at the height by default.
+An incremental snapshot (format 5) is restored on top of its base snapshots, it
+is only picked by default when there is no full snapshot at the height.
+
+With --trusted-app-hash, the stores of the snapshot are rebuilt before restoring
+it, and the snapshot is refused if their app hash doesn't match the trusted one,
+the app hash in the header of the block following the snapshot height. Incremental
+snapshots can't be verified this way.`,
+		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := server.GetServerContextFromCmd(cmd)
 
-			height, err := strconv.ParseUint(args[0], 10, 64)
+			trustedAppHash, err := trustedAppHashFromFlags(cmd)
 			if err != nil {
 				return err
 			}
-			format, err := strconv.ParseUint(args[1], 10, 32)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
 				return err
 			}
+			if err := checkTrustedHeight(cmd, height); err != nil {
+				return err
+			}
+			var format uint64
+			if len(args) > 1 {
+				format, err = strconv.ParseUint(args[1], 10, 32)
+				if err != nil {
+					return err
+				}
+			}
 
 			home := ctx.Config.RootDir
 			db, err := openDB(home, server.GetAppDBBackend(ctx.Viper))
@@ -41,12 +64,116 @@ func RestoreSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 			app := appCreator(logger, db, nil, ctx.Viper)
 
 			sm := app.SnapshotManager()
-			return sm.RestoreLocalSnapshot(height, uint32(format))
+			if len(args) == 1 {
+				snapshots, err := sm.List()
+				if err != nil {
+					return err
+				}
+				defaultFormat, err := defaultRestoreFormat(snapshots, height)
+				if err != nil {
+					return err
+				}
+				format = uint64(defaultFormat)
+			}
+
+			if trustedAppHash != nil {
+				if uint32(format) == snapshottypes.FormatIncremental {
+					return fmt.Errorf("incremental snapshots can't be verified with --%s, restore a full snapshot format instead", FlagTrustedAppHash)
+				}
+
+				if err := verifyLocalSnapshot(sm, height, uint32(format), trustedAppHash); err != nil {
+					return err
+				}
+			}
+
+			if err := sm.RestoreLocalSnapshot(height, uint32(format)); err != nil {
+				return err
+			}
+
+			if trustedAppHash != nil {
+				if hash := app.CommitMultiStore().LastCommitID().Hash; !bytes.Equal(hash, trustedAppHash) {
+					return fmt.Errorf("restored app hash %X doesn't match the trusted app hash %X", hash, trustedAppHash)
+				}
+			}
+			return nil
 		},
 	}
+
+	addTrustedFlags(cmd)
+
 	return cmd
 }
 
+// defaultRestoreFormat returns the latest format of the local snapshots at the
+// height, preferring the full snapshots over the incremental ones.
+func defaultRestoreFormat(snapshots []*snapshottypes.Snapshot, height uint64) (uint32, error) {
+	format := uint32(0)
+	incremental := false
+	for _, snapshot := range snapshots {
+		if snapshot.Height != height {
+			continue
+		}
+
+		if snapshot.Format == snapshottypes.FormatIncremental {
+			incremental = true
+		} else if snapshot.Format > format {
+			format = snapshot.Format
+		}
+	}
+
+	switch {
+	case format != 0:
+		return format, nil
+	case incremental:
+		return snapshottypes.FormatIncremental, nil
+	default:
+		return 0, fmt.Errorf("no local snapshot at height %d", height)
+	}
+}
+
+// verifyLocalSnapshot rebuilds the stores of a local snapshot, checking their
+// app hash matches the trusted one.
+func verifyLocalSnapshot(sm *snapshots.Manager, height uint64, format uint32, trustedAppHash []byte) error {
+	list, err := sm.List()
+	if err != nil {
+		return err
+	}
+	var snapshot *snapshottypes.Snapshot
+	for _, s := range list {
+		if s.Height == height && s.Format == format {
+			snapshot = s
+		}
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
+	}
+
+	chunks := make(chan io.ReadCloser)
+	loadErr := make(chan error, 1)
+	go func() {
+		defer close(chunks)
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			bz, err := sm.LoadChunk(height, format, i)
+			if err != nil {
+				loadErr <- err
+				return
+			}
+			chunks <- io.NopCloser(bytes.NewReader(bz))
+		}
+		loadErr <- nil
+	}()
+
+	manifest, err := readSnapshot(snapshot, chunks, true)
+	if err := <-loadErr; err != nil {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("invalid snapshot: %w", err)
+	}
+
+	return checkAppHash(trustedAppHash, manifest.AppHash)
+}
+
 func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("application", backendType, dataDir)
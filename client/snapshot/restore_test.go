@@ -0,0 +1,35 @@
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+)
+
+func TestDefaultRestoreFormat(t *testing.T) {
+	snapshots := []*snapshottypes.Snapshot{
+		{Height: 20, Format: snapshottypes.FormatIncremental},
+		{Height: 20, Format: snapshottypes.FormatSections},
+		{Height: 20, Format: snapshottypes.FormatStream},
+		{Height: 15, Format: snapshottypes.FormatIncremental},
+		{Height: 10, Format: snapshottypes.FormatStream},
+	}
+
+	// the full snapshots are preferred over the incremental ones
+	format, err := defaultRestoreFormat(snapshots, 20)
+	require.NoError(t, err)
+	require.Equal(t, snapshottypes.FormatSections, format)
+
+	format, err = defaultRestoreFormat(snapshots, 15)
+	require.NoError(t, err)
+	require.Equal(t, snapshottypes.FormatIncremental, format)
+
+	format, err = defaultRestoreFormat(snapshots, 10)
+	require.NoError(t, err)
+	require.Equal(t, snapshottypes.FormatStream, format)
+
+	_, err = defaultRestoreFormat(snapshots, 5)
+	require.ErrorContains(t, err, "no local snapshot at height 5")
+}
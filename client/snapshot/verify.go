@@ -0,0 +1,142 @@
+package snapshot
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagTrustedAppHash = "trusted-app-hash"
+	FlagTrustedHeight  = "trusted-height"
+)
+
+// VerifySnapshotCmd returns a command to verify a portable archive format snapshot
+func VerifySnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <archive-file>",
+		Short: "Verify a snapshot archive file (.tar.gz) and print its manifest",
+		Long: `Verify a snapshot archive file (.tar.gz) and print its manifest.
+
+The chunks are checked against the snapshot metadata, and the stores are rebuilt
+to compute the app hash of the snapshot height. With --trusted-app-hash, the
+computed app hash must match it, it is the app hash in the header of the block
+following the snapshot height, obtained from a trusted source.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			trustedAppHash, err := trustedAppHashFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			archive, err := openArchive(args[0])
+			if err != nil {
+				return err
+			}
+			defer archive.Close()
+			snapshot := archive.snapshot
+
+			if err := checkTrustedHeight(cmd, snapshot.Height); err != nil {
+				return err
+			}
+
+			// make sure the channel is unbuffered, because the tar reader can't do concurrency
+			chunks := make(chan io.ReadCloser)
+			readErr := make(chan error, 1)
+			go func() {
+				defer close(chunks)
+				readErr <- archive.readChunks(chunks)
+			}()
+
+			manifest, err := readSnapshot(&snapshot, chunks, true)
+			if err := <-readErr; err != nil {
+				return err
+			}
+			if err != nil {
+				return fmt.Errorf("invalid snapshot: %w", err)
+			}
+
+			archiveManifest, err := archive.readManifest()
+			if err != nil {
+				return err
+			}
+			if archiveManifest != nil && !reflect.DeepEqual(archiveManifest.withoutHashes(), manifest.withoutHashes()) {
+				return errors.New("invalid archive, the manifest doesn't match the snapshot")
+			}
+
+			if err := checkAppHash(trustedAppHash, manifest.AppHash); err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(manifest, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			if trustedAppHash == nil {
+				cmd.Println("snapshot is consistent, but its app hash was not checked against a trusted one")
+			}
+
+			return nil
+		},
+	}
+
+	addTrustedFlags(cmd)
+
+	return cmd
+}
+
+func addTrustedFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagTrustedAppHash, "", "Hex encoded app hash of the snapshot height, from the header of the next block, to check the snapshot against")
+	cmd.Flags().Uint64(FlagTrustedHeight, 0, "Height of the trusted app hash, which must be the snapshot height")
+}
+
+// trustedAppHashFromFlags returns the trusted app hash, nil if not set.
+func trustedAppHashFromFlags(cmd *cobra.Command) ([]byte, error) {
+	appHash, err := cmd.Flags().GetString(FlagTrustedAppHash)
+	if err != nil || appHash == "" {
+		return nil, err
+	}
+	trustedHeight, err := cmd.Flags().GetUint64(FlagTrustedHeight)
+	if err != nil {
+		return nil, err
+	}
+	if trustedHeight == 0 {
+		return nil, fmt.Errorf("--%s requires --%s", FlagTrustedAppHash, FlagTrustedHeight)
+	}
+
+	bz, err := hex.DecodeString(appHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", FlagTrustedAppHash, err)
+	}
+	return bz, nil
+}
+
+// checkTrustedHeight checks the trusted height, if set, is the snapshot height.
+func checkTrustedHeight(cmd *cobra.Command, height uint64) error {
+	trustedHeight, err := cmd.Flags().GetUint64(FlagTrustedHeight)
+	if err != nil {
+		return err
+	}
+	if trustedHeight != 0 && trustedHeight != height {
+		return fmt.Errorf("snapshot height %d doesn't match the trusted height %d", height, trustedHeight)
+	}
+	return nil
+}
+
+// checkAppHash checks the hex encoded app hash matches the trusted one, if set.
+func checkAppHash(trustedAppHash []byte, appHash string) error {
+	if trustedAppHash == nil {
+		return nil
+	}
+	if !strings.EqualFold(appHash, hex.EncodeToString(trustedAppHash)) {
+		return fmt.Errorf("app hash %s doesn't match the trusted app hash %X", appHash, trustedAppHash)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package versionedkv
+
+import (
+	"fmt"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/versionedkv"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const FlagAppDBBackend = "app-db-backend"
+
+// Cmd returns the versioned KV index commands.
+func Cmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "versioned-kv",
+		Short: "Versioned KV index subcommands",
+	}
+
+	cmd.AddCommand(BackfillCmd(appCreator, defaultNodeHome))
+
+	return cmd
+}
+
+// BackfillCmd writes the IAVL history of the application stores to the
+// versioned KV index.
+func BackfillCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backfill",
+		Short: "Build the versioned KV index from the IAVL history of the application stores",
+		Long: `Build the versioned KV index from the IAVL history of the application stores.
+
+If the index already serves a range of heights, only the heights committed after it are written.
+Otherwise, the whole history available in the IAVL stores is written, and the historical queries
+are served from the index from the earliest height available in all the stores.
+
+The node must be stopped while the index is backfilled.
+
+Note: When the --app-db-backend flag is not specified, the default backend type is 'goleveldb'.
+Supported app-db-backend types include 'goleveldb', 'rocksdb', 'pebbledb'.`,
+		Example: "backfill --app-db-backend 'goleveldb'",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			vp := viper.New()
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			home := vp.GetString(flags.FlagHome)
+			if home == "" {
+				home = defaultNodeHome
+				vp.Set(flags.FlagHome, home)
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(vp), filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+
+			logger := log.NewLogger(cmd.OutOrStdout())
+			app := appCreator(logger, db, nil, vp)
+			defer app.Close()
+
+			rootMultiStore, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("currently only support the versioned KV index of rootmulti.Store type")
+			}
+
+			index, err := server.GetVersionedKVIndex(vp)
+			if err != nil {
+				return err
+			}
+			defer index.Close()
+
+			if err := versionedkv.Backfill(index, rootMultiStore, logger); err != nil {
+				return err
+			}
+
+			cmd.Printf("the versioned KV index serves the heights %d to %d\n", index.Earliest(), index.Latest())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for application and versioned KV index databases")
+
+	return cmd
+}
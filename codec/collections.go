@@ -8,6 +8,7 @@ import (
 	gogotypes "github.com/cosmos/gogoproto/types"
 	"google.golang.org/protobuf/encoding/protojson"
 	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"cosmossdk.io/collections"
 	collcodec "cosmossdk.io/collections/codec"
@@ -46,6 +47,10 @@ func (boolValue) ValueType() string {
 	return "protobuf/bool"
 }
 
+func (boolValue) JSONSchema() map[string]any {
+	return map[string]any{"type": "boolean"}
+}
+
 type protoMessage[T any] interface {
 	*T
 	proto.Message
@@ -87,6 +92,21 @@ func (c collValue[T, PT]) ValueType() string {
 	return "github.com/cosmos/gogoproto/" + c.messageName
 }
 
+// JSONSchema returns the JSON schema of the message as encoded by
+// ProtoMarshalJSON, or a schema matching any value if the message descriptor
+// is not registered.
+func (c collValue[T, PT]) JSONSchema() map[string]any {
+	desc, err := proto.HybridResolver.FindDescriptorByName(protoreflect.FullName(c.messageName))
+	if err != nil {
+		return map[string]any{}
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return map[string]any{}
+	}
+	return ProtoJSONSchema(md, true)
+}
+
 type protoMessageV2[T any] interface {
 	*T
 	protov2.Message
@@ -132,6 +152,10 @@ func (c collValue2[T, PT]) ValueType() string {
 	return "google.golang.org/protobuf/" + c.messageName
 }
 
+func (c collValue2[T, PT]) JSONSchema() map[string]any {
+	return ProtoJSONSchema(PT(new(T)).ProtoReflect().Descriptor(), false)
+}
+
 // CollInterfaceValue instantiates a new collections.ValueCodec for a generic
 // interface value. The codec must be able to marshal and unmarshal the
 // interface.
@@ -175,3 +199,10 @@ func (c collInterfaceValue[T]) ValueType() string {
 	var t T
 	return fmt.Sprintf("%T", t)
 }
+
+// JSONSchema returns the JSON schema of an Any, as the concrete message of the
+// interface is only known when the value is decoded.
+func (c collInterfaceValue[T]) JSONSchema() map[string]any {
+	schema, _ := wellKnownJSONSchema("google.protobuf.Any")
+	return schema
+}
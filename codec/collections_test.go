@@ -9,6 +9,8 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/collections/colltest"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -77,3 +79,62 @@ func TestCollectionsCorrectness(t *testing.T) {
 		})
 	})
 }
+
+func TestCollectionsJSONSchema(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	anySchema := map[string]any{
+		"type":       "object",
+		"properties": map[string]any{"@type": map[string]any{"type": "string"}},
+		"required":   []string{"@type"},
+	}
+
+	t.Run("BoolValue", func(t *testing.T) {
+		require.Equal(t, map[string]any{"type": "boolean"}, jsonSchema(t, codec.BoolValue))
+	})
+
+	t.Run("CollValue", func(t *testing.T) {
+		require.Equal(t, map[string]any{
+			"title": "testpb.HasAnimal",
+			"type":  "object",
+			"properties": map[string]any{
+				"animal": anySchema,
+				"x":      map[string]any{"type": "string", "pattern": "^-?[0-9]+$"},
+			},
+		}, jsonSchema(t, codec.CollValue[testdata.HasAnimal](cdc)))
+
+		// well known types are encoded as scalars
+		require.Equal(t, map[string]any{"type": "string", "pattern": "^[0-9]+$"}, jsonSchema(t, codec.CollValue[gogotypes.UInt64Value](cdc)))
+	})
+
+	t.Run("CollValueV2", func(t *testing.T) {
+		schema := jsonSchema(t, codec.CollValueV2[bankv1beta1.Metadata]())
+		require.Equal(t, "cosmos.bank.v1beta1.Metadata", schema["title"])
+
+		// fields are named after their JSON name
+		properties := schema["properties"].(map[string]any)
+		require.Contains(t, properties, "uriHash")
+		require.Equal(t, map[string]any{
+			"type": "array",
+			"items": map[string]any{
+				"title": "cosmos.bank.v1beta1.DenomUnit",
+				"type":  "object",
+				"properties": map[string]any{
+					"denom":    map[string]any{"type": "string"},
+					"exponent": map[string]any{"type": "integer"},
+					"aliases":  map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+				},
+			},
+		}, properties["denomUnits"])
+	})
+
+	t.Run("CollInterfaceValue", func(t *testing.T) {
+		require.Equal(t, anySchema, jsonSchema(t, codec.CollInterfaceValue[testdata.Animal](cdc)))
+	})
+}
+
+func jsonSchema(t *testing.T, valueCodec any) map[string]any {
+	t.Helper()
+	schemaCodec, ok := valueCodec.(collcodec.HasJSONSchema)
+	require.True(t, ok, "%T does not provide a JSON schema", valueCodec)
+	return schemaCodec.JSONSchema()
+}
@@ -0,0 +1,126 @@
+package codec
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ProtoJSONSchema returns the JSON schema of the proto3 JSON encoding of the
+// messages with the provided descriptor. Fields are named after their proto
+// name when origName is set, as done by ProtoMarshalJSON, and after their
+// JSON name otherwise, as done by protojson. Recursive messages are described
+// as any object past their first occurrence.
+func ProtoJSONSchema(md protoreflect.MessageDescriptor, origName bool) map[string]any {
+	return protoMessageJSONSchema(md, origName, map[protoreflect.FullName]bool{})
+}
+
+func protoMessageJSONSchema(md protoreflect.MessageDescriptor, origName bool, visiting map[protoreflect.FullName]bool) map[string]any {
+	if schema, ok := wellKnownJSONSchema(md.FullName()); ok {
+		return schema
+	}
+	if visiting[md.FullName()] {
+		return map[string]any{"type": "object"}
+	}
+	visiting[md.FullName()] = true
+	defer delete(visiting, md.FullName())
+
+	properties := map[string]any{}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := fd.JSONName()
+		if origName {
+			name = string(fd.Name())
+		}
+		properties[name] = protoFieldJSONSchema(fd, origName, visiting)
+	}
+
+	return map[string]any{
+		"title":      string(md.FullName()),
+		"type":       "object",
+		"properties": properties,
+	}
+}
+
+func protoFieldJSONSchema(fd protoreflect.FieldDescriptor, origName bool, visiting map[protoreflect.FullName]bool) map[string]any {
+	switch {
+	case fd.IsMap():
+		return map[string]any{
+			"type":                 "object",
+			"additionalProperties": protoKindJSONSchema(fd.MapValue(), origName, visiting),
+		}
+	case fd.IsList():
+		return map[string]any{
+			"type":  "array",
+			"items": protoKindJSONSchema(fd, origName, visiting),
+		}
+	default:
+		return protoKindJSONSchema(fd, origName, visiting)
+	}
+}
+
+func protoKindJSONSchema(fd protoreflect.FieldDescriptor, origName bool, visiting map[protoreflect.FullName]bool) map[string]any {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		// 64 bits integers are encoded as strings
+		return map[string]any{"type": "string", "pattern": "^-?[0-9]+$"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{"type": "string", "pattern": "^[0-9]+$"}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return map[string]any{"type": "number"}
+	case protoreflect.StringKind:
+		return map[string]any{"type": "string"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "contentEncoding": "base64"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]string, values.Len())
+		for i := range names {
+			names[i] = string(values.Get(i).Name())
+		}
+		return map[string]any{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return protoMessageJSONSchema(fd.Message(), origName, visiting)
+	default:
+		return map[string]any{}
+	}
+}
+
+// wellKnownJSONSchema returns the JSON schema of the well known types with a
+// special JSON encoding.
+func wellKnownJSONSchema(name protoreflect.FullName) (map[string]any, bool) {
+	switch name {
+	case "google.protobuf.Any":
+		return map[string]any{
+			"type":       "object",
+			"properties": map[string]any{"@type": map[string]any{"type": "string"}},
+			"required":   []string{"@type"},
+		}, true
+	case "google.protobuf.Timestamp":
+		return map[string]any{"type": "string", "format": "date-time"}, true
+	case "google.protobuf.Duration":
+		return map[string]any{"type": "string", "pattern": "^-?[0-9]+(\\.[0-9]+)?s$"}, true
+	case "google.protobuf.FieldMask", "google.protobuf.StringValue":
+		return map[string]any{"type": "string"}, true
+	case "google.protobuf.BytesValue":
+		return map[string]any{"type": "string", "contentEncoding": "base64"}, true
+	case "google.protobuf.BoolValue":
+		return map[string]any{"type": "boolean"}, true
+	case "google.protobuf.Int32Value", "google.protobuf.UInt32Value":
+		return map[string]any{"type": "integer"}, true
+	case "google.protobuf.Int64Value":
+		return map[string]any{"type": "string", "pattern": "^-?[0-9]+$"}, true
+	case "google.protobuf.UInt64Value":
+		return map[string]any{"type": "string", "pattern": "^[0-9]+$"}, true
+	case "google.protobuf.FloatValue", "google.protobuf.DoubleValue":
+		return map[string]any{"type": "number"}, true
+	case "google.protobuf.Struct", "google.protobuf.Value", "google.protobuf.ListValue":
+		return map[string]any{}, true
+	default:
+		return nil, false
+	}
+}
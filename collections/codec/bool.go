@@ -53,6 +53,10 @@ func (b boolKey[T]) KeyType() string {
 	return "bool"
 }
 
+func (b boolKey[T]) JSONSchema() map[string]any {
+	return map[string]any{"type": "boolean"}
+}
+
 func (b boolKey[T]) EncodeNonTerminal(buffer []byte, key T) (int, error) {
 	return b.Encode(buffer, key)
 }
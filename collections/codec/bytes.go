@@ -45,6 +45,14 @@ func (b bytesKey[T]) KeyType() string {
 	return "bytes"
 }
 
+func (bytesKey[T]) JSONSchema() map[string]any {
+	// types with a custom JSON encoding, e.g. bech32 addresses, are not described
+	if _, ok := any(*new(T)).(json.Marshaler); ok {
+		return map[string]any{}
+	}
+	return map[string]any{"type": "string", "contentEncoding": "base64"}
+}
+
 func (b bytesKey[T]) EncodeNonTerminal(buffer []byte, key T) (int, error) {
 	if len(key) > MaxBytesKeyNonTerminalSize {
 		return 0, fmt.Errorf(
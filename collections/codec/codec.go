@@ -167,3 +167,5 @@ func (k keyToValueCodec[K]) Stringify(value K) string {
 func (k keyToValueCodec[K]) ValueType() string {
 	return k.kc.KeyType()
 }
+
+func (k keyToValueCodec[K]) JSONSchema() map[string]any { return JSONSchema(k.kc) }
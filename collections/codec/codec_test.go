@@ -37,3 +37,20 @@ func TestUntypedValueCodec(t *testing.T) {
 		require.Equal(t, "hello", s)
 	})
 }
+
+type jsonBytes []byte
+
+func (b jsonBytes) MarshalJSON() ([]byte, error) { return []byte(`"custom"`), nil }
+
+func TestJSONSchema(t *testing.T) {
+	require.Equal(t, map[string]any{"type": "string"}, JSONSchema(NewStringKeyCodec[string]()))
+	require.Equal(t, map[string]any{"type": "boolean"}, JSONSchema(NewBoolKey[bool]()))
+	require.Equal(t, map[string]any{"type": "string", "pattern": "^[0-9]+$"}, JSONSchema(NewUint64Key[uint64]()))
+	require.Equal(t, map[string]any{"type": "string", "pattern": "^-?[0-9]+$"}, JSONSchema(NewInt32Key[int32]()))
+	require.Equal(t, map[string]any{"type": "string", "contentEncoding": "base64"}, JSONSchema(NewBytesKey[[]byte]()))
+	require.Equal(t, map[string]any{"type": "string"}, JSONSchema(KeyToValueCodec(NewStringKeyCodec[string]())))
+
+	// unknown encodings are described by the empty schema
+	require.Equal(t, map[string]any{}, JSONSchema(NewBytesKey[jsonBytes]()))
+	require.Equal(t, map[string]any{}, JSONSchema(struct{}{}))
+}
@@ -52,6 +52,8 @@ func (i int64Key[T]) KeyType() string {
 	return "int64"
 }
 
+func (i int64Key[T]) JSONSchema() map[string]any { return integerJSONSchema(true) }
+
 func (i int64Key[T]) EncodeNonTerminal(buffer []byte, key T) (int, error) {
 	return i.Encode(buffer, key)
 }
@@ -110,6 +112,8 @@ func (i int32Key[T]) KeyType() string {
 	return "int32"
 }
 
+func (i int32Key[T]) JSONSchema() map[string]any { return integerJSONSchema(true) }
+
 func (i int32Key[T]) EncodeNonTerminal(buffer []byte, key T) (int, error) {
 	return i.Encode(buffer, key)
 }
@@ -0,0 +1,27 @@
+package codec
+
+// HasJSONSchema is implemented by the KeyCodec and ValueCodec types describing
+// the JSON produced by their EncodeJSON method with a JSON schema.
+type HasJSONSchema interface {
+	// JSONSchema returns the JSON schema of the JSON encoding of the codec.
+	JSONSchema() map[string]any
+}
+
+// JSONSchema returns the JSON schema of the JSON encoding of the provided key
+// or value codec, or the empty schema matching any JSON if the codec does not
+// implement HasJSONSchema.
+func JSONSchema(codec any) map[string]any {
+	if c, ok := codec.(HasJSONSchema); ok {
+		return c.JSONSchema()
+	}
+	return map[string]any{}
+}
+
+// integerJSONSchema is the JSON schema of the integers encoded as strings.
+func integerJSONSchema(signed bool) map[string]any {
+	pattern := "^[0-9]+$"
+	if signed {
+		pattern = "^-?[0-9]+$"
+	}
+	return map[string]any{"type": "string", "pattern": pattern}
+}
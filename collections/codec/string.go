@@ -66,3 +66,7 @@ func (stringKey[T]) Stringify(key T) string {
 func (stringKey[T]) KeyType() string {
 	return "string"
 }
+
+func (stringKey[T]) JSONSchema() map[string]any {
+	return map[string]any{"type": "string"}
+}
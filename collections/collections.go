@@ -90,6 +90,9 @@ type Collection interface {
 	ValueCodec() codec.UntypedValueCodec
 
 	genesisHandler
+	entryDecoder
+
+	jsonSchema() map[string]any
 }
 
 // Prefix defines a segregation bytes namespace for specific collections objects.
@@ -157,3 +160,9 @@ func (c collectionImpl[K, V]) exportGenesis(ctx context.Context, w io.Writer) er
 }
 
 func (c collectionImpl[K, V]) defaultGenesis(w io.Writer) error { return c.m.defaultGenesis(w) }
+
+func (c collectionImpl[K, V]) jsonSchema() map[string]any { return c.m.jsonSchema() }
+
+func (c collectionImpl[K, V]) decodeEntry(key, value []byte) (jsonMapEntry, error) {
+	return c.m.decodeEntry(key, value)
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+
+	"cosmossdk.io/collections/codec"
 )
 
 type genesisHandler interface {
@@ -151,3 +153,24 @@ func (m Map[K, V]) defaultGenesis(writer io.Writer) error {
 	_, err := writer.Write([]byte(`[]`))
 	return err
 }
+
+// jsonSchema returns the JSON schema of the genesis of the map, an array of
+// entries with the key and the value, which is omitted by key sets.
+func (m Map[K, V]) jsonSchema() map[string]any {
+	properties := map[string]any{"key": codec.JSONSchema(m.kc)}
+	required := []string{"key"}
+	if _, ok := any(m.vc).(NoValue); !ok {
+		properties["value"] = codec.JSONSchema(m.vc)
+		required = append(required, "value")
+	}
+
+	return map[string]any{
+		"type": "array",
+		"items": map[string]any{
+			"type":                 "object",
+			"properties":           properties,
+			"required":             required,
+			"additionalProperties": false,
+		},
+	}
+}
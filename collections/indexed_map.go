@@ -56,6 +56,11 @@ func NewIndexedMap[PrimaryKey, Value any, Idx Indexes[PrimaryKey, Value]](
 	}
 }
 
+// GetPrefix returns the prefix of the objects of the IndexedMap.
+func (m *IndexedMap[PrimaryKey, Value, Idx]) GetPrefix() []byte {
+	return m.m.GetPrefix()
+}
+
 // Get gets the object given its primary key.
 func (m *IndexedMap[PrimaryKey, Value, Idx]) Get(ctx context.Context, pk PrimaryKey) (Value, error) {
 	return m.m.Get(ctx, pk)
@@ -0,0 +1,312 @@
+package indexes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+// QueryIndex is an index which can be queried with a Query. It is implemented
+// by Multi, Unique and ReversePair.
+type QueryIndex[ReferenceKey, PrimaryKey any] interface {
+	// refKeyPrefix returns the bytes prefixing the index keys of the reference key.
+	refKeyPrefix(ref ReferenceKey) ([]byte, error)
+	// walkRaw walks the index keys between the raw start and end keys, providing
+	// the raw index key and the primary key it references.
+	walkRaw(
+		ctx context.Context, start, end []byte, order collections.Order,
+		walkFunc func(rawKey []byte, pk PrimaryKey) (stop bool, err error),
+	) error
+}
+
+// PageRequest defines the page of the results of a Query.
+type PageRequest struct {
+	// Key is the NextKey of the previous page, the page starts from it.
+	Key []byte
+	// Offset is the number of results skipped, only one of Key and Offset can
+	// be set.
+	Offset uint64
+	// Limit is the maximum number of results of the page, there is no limit if
+	// it is zero.
+	Limit uint64
+	// CountTotal counts the total number of results, when Key is not set.
+	CountTotal bool
+	// Reverse returns the results in descending index key order.
+	Reverse bool
+}
+
+// Page is a page of the results of a Query.
+type Page[PrimaryKey, Value any] struct {
+	// Results are the primary keys and values of the page, in index key order.
+	Results []collections.KeyValue[PrimaryKey, Value]
+	// NextKey is the raw index key of the first result of the next page, nil
+	// if there are no more results. It remains valid when the index changes.
+	NextKey []byte
+	// Total is the total number of results, if CountTotal was set.
+	Total uint64
+}
+
+// Query is a query of the values of an IndexedMap through one of its indexes,
+// bounded by reference keys and filtered by predicates. Results are returned
+// in index key order.
+type Query[ReferenceKey, PrimaryKey, Value any] struct {
+	get        func(ctx context.Context, pk PrimaryKey) (Value, error)
+	index      QueryIndex[ReferenceKey, PrimaryKey]
+	prefix     *ReferenceKey
+	start, end *ReferenceKey
+	predicates []func(pk PrimaryKey, value Value) (bool, error)
+}
+
+// NewQuery returns a Query of the values of the indexed map referenced by the
+// provided index of the indexed map, matching every value.
+func NewQuery[ReferenceKey, PrimaryKey, Value any, Idx collections.Indexes[PrimaryKey, Value]](
+	indexedMap *collections.IndexedMap[PrimaryKey, Value, Idx],
+	index QueryIndex[ReferenceKey, PrimaryKey],
+) *Query[ReferenceKey, PrimaryKey, Value] {
+	return &Query[ReferenceKey, PrimaryKey, Value]{
+		get:   indexedMap.Get,
+		index: index,
+	}
+}
+
+// Prefix restricts the query to the values referenced by the provided
+// reference key, or by the reference keys starting with it when it is a
+// collections.Pair created with collections.PairPrefix.
+func (q *Query[ReferenceKey, PrimaryKey, Value]) Prefix(ref ReferenceKey) *Query[ReferenceKey, PrimaryKey, Value] {
+	q.prefix = &ref
+	return q
+}
+
+// StartInclusive restricts the query to the values referenced by reference
+// keys greater or equal to the provided one.
+func (q *Query[ReferenceKey, PrimaryKey, Value]) StartInclusive(ref ReferenceKey) *Query[ReferenceKey, PrimaryKey, Value] {
+	q.start = &ref
+	return q
+}
+
+// EndExclusive restricts the query to the values referenced by reference keys
+// smaller than the provided one.
+func (q *Query[ReferenceKey, PrimaryKey, Value]) EndExclusive(ref ReferenceKey) *Query[ReferenceKey, PrimaryKey, Value] {
+	q.end = &ref
+	return q
+}
+
+// Where restricts the query to the values matching the predicate, predicates
+// are applied in order.
+func (q *Query[ReferenceKey, PrimaryKey, Value]) Where(predicate func(pk PrimaryKey, value Value) (bool, error)) *Query[ReferenceKey, PrimaryKey, Value] {
+	q.predicates = append(q.predicates, predicate)
+	return q
+}
+
+// Page returns a page of the results of the query.
+func (q *Query[ReferenceKey, PrimaryKey, Value]) Page(ctx context.Context, req PageRequest) (page Page[PrimaryKey, Value], err error) {
+	if req.Offset > 0 && req.Key != nil {
+		return page, errors.New("invalid request, either offset or key is expected, got both")
+	}
+
+	start, end, err := q.bounds()
+	if err != nil {
+		return page, err
+	}
+	if end != nil && bytes.Compare(start, end) >= 0 {
+		return page, nil
+	}
+
+	// resume from the next key, in the bounds of the query
+	if req.Key != nil {
+		if bytes.Compare(req.Key, start) < 0 || (end != nil && bytes.Compare(req.Key, end) >= 0) {
+			return page, fmt.Errorf("invalid request, key %X out of the query bounds", req.Key)
+		}
+		if req.Reverse {
+			end = append(bytes.Clone(req.Key), 0)
+		} else {
+			start = req.Key
+		}
+	}
+
+	order := collections.OrderAscending
+	if req.Reverse {
+		order = collections.OrderDescending
+	}
+
+	var matched uint64
+	err = q.index.walkRaw(ctx, start, end, order, func(rawKey []byte, pk PrimaryKey) (bool, error) {
+		value, err := q.get(ctx, pk)
+		if err != nil {
+			return true, err
+		}
+		for _, predicate := range q.predicates {
+			include, err := predicate(pk, value)
+			if err != nil {
+				return true, err
+			}
+			if !include {
+				return false, nil
+			}
+		}
+
+		matched++
+		switch {
+		case matched <= req.Offset:
+		case req.Limit == 0 || uint64(len(page.Results)) < req.Limit:
+			page.Results = append(page.Results, collections.KeyValue[PrimaryKey, Value]{Key: pk, Value: value})
+		case page.NextKey == nil:
+			page.NextKey = bytes.Clone(rawKey)
+			// keep counting the results
+			return !req.CountTotal || req.Key != nil, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return Page[PrimaryKey, Value]{}, err
+	}
+
+	if req.CountTotal && req.Key == nil {
+		page.Total = matched
+	}
+	return page, nil
+}
+
+// PageResults is Page with the page request and results flattened, used to
+// adapt the query to other pagination types, e.g. the gRPC query pagination
+// of the Cosmos SDK.
+func (q *Query[ReferenceKey, PrimaryKey, Value]) PageResults(
+	ctx context.Context, key []byte, offset, limit uint64, countTotal, reverse bool,
+) (results []collections.KeyValue[PrimaryKey, Value], nextKey []byte, total uint64, err error) {
+	page, err := q.Page(ctx, PageRequest{Key: key, Offset: offset, Limit: limit, CountTotal: countTotal, Reverse: reverse})
+	return page.Results, page.NextKey, page.Total, err
+}
+
+// bounds returns the raw start and end index keys of the query, a nil end
+// meaning the end of the index.
+func (q *Query[ReferenceKey, PrimaryKey, Value]) bounds() (start, end []byte, err error) {
+	start = []byte{}
+	if q.prefix != nil {
+		prefix, err := q.index.refKeyPrefix(*q.prefix)
+		if err != nil {
+			return nil, nil, err
+		}
+		start, end = prefix, prefixEnd(prefix)
+	}
+
+	if q.start != nil {
+		refStart, err := q.index.refKeyPrefix(*q.start)
+		if err != nil {
+			return nil, nil, err
+		}
+		if bytes.Compare(refStart, start) > 0 {
+			start = refStart
+		}
+	}
+
+	if q.end != nil {
+		refEnd, err := q.index.refKeyPrefix(*q.end)
+		if err != nil {
+			return nil, nil, err
+		}
+		if end == nil || bytes.Compare(refEnd, end) < 0 {
+			end = refEnd
+		}
+	}
+
+	return start, end, nil
+}
+
+// prefixEnd returns the key following all the keys with the provided prefix, or
+// nil if there is none.
+func prefixEnd(prefix []byte) []byte {
+	end := bytes.Clone(prefix)
+	for len(end) > 0 {
+		if end[len(end)-1] != 255 {
+			end[len(end)-1]++
+			return end
+		}
+		end = end[:len(end)-1]
+	}
+	return nil
+}
+
+// walkRawKeys walks the keys of an index collection between the raw start and
+// end keys, an empty range is not an error.
+func walkRawKeys[K, V, PrimaryKey any](
+	ctx context.Context,
+	coll interface {
+		IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (collections.Iterator[K, V], error)
+		KeyCodec() codec.KeyCodec[K]
+	},
+	start, end []byte,
+	order collections.Order,
+	primaryKey func(collections.KeyValue[K, V]) PrimaryKey,
+	walkFunc func(rawKey []byte, pk PrimaryKey) (stop bool, err error),
+) error {
+	iter, err := coll.IterateRaw(ctx, start, end, order)
+	if errors.Is(err, collections.ErrInvalidIterator) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
+		rawKey, err := collections.EncodeKeyWithPrefix(nil, coll.KeyCodec(), kv.Key)
+		if err != nil {
+			return err
+		}
+		stop, err := walkFunc(rawKey, primaryKey(kv))
+		if err != nil || stop {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) refKeyPrefix(ref ReferenceKey) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(nil, m.refKeys.KeyCodec(), collections.PairPrefix[ReferenceKey, PrimaryKey](ref))
+}
+
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) walkRaw(
+	ctx context.Context, start, end []byte, order collections.Order,
+	walkFunc func(rawKey []byte, pk PrimaryKey) (stop bool, err error),
+) error {
+	return walkRawKeys(ctx, m.refKeys, start, end, order,
+		func(kv collections.KeyValue[collections.Pair[ReferenceKey, PrimaryKey], collections.NoValue]) PrimaryKey {
+			return kv.Key.K2()
+		}, walkFunc)
+}
+
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) refKeyPrefix(ref ReferenceKey) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(nil, i.refKeys.KeyCodec(), ref)
+}
+
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) walkRaw(
+	ctx context.Context, start, end []byte, order collections.Order,
+	walkFunc func(rawKey []byte, pk PrimaryKey) (stop bool, err error),
+) error {
+	return walkRawKeys(ctx, i.refKeys, start, end, order,
+		func(kv collections.KeyValue[ReferenceKey, PrimaryKey]) PrimaryKey {
+			return kv.Value
+		}, walkFunc)
+}
+
+func (i *ReversePair[K1, K2, Value]) refKeyPrefix(ref K2) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(nil, i.refKeys.KeyCodec(), collections.PairPrefix[K2, K1](ref))
+}
+
+func (i *ReversePair[K1, K2, Value]) walkRaw(
+	ctx context.Context, start, end []byte, order collections.Order,
+	walkFunc func(rawKey []byte, pk collections.Pair[K1, K2]) (stop bool, err error),
+) error {
+	return walkRawKeys(ctx, i.refKeys, start, end, order,
+		func(kv collections.KeyValue[collections.Pair[K2, K1], collections.NoValue]) collections.Pair[K1, K2] {
+			return collections.Join(kv.Key.K2(), kv.Key.K1())
+		}, walkFunc)
+}
@@ -0,0 +1,154 @@
+package indexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+)
+
+type queryIndexes struct {
+	City *Multi[string, uint64, company]
+	Vat  *Unique[uint64, uint64, company]
+}
+
+func (i queryIndexes) IndexesList() []collections.Index[uint64, company] {
+	return []collections.Index[uint64, company]{i.City, i.Vat}
+}
+
+func TestQuery(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	im := collections.NewIndexedMap(sb, collections.NewPrefix(0), "companies", collections.Uint64Key, colltest.MockValueCodec[company](),
+		queryIndexes{
+			City: NewMulti(sb, collections.NewPrefix(1), "companies_by_city", collections.StringKey, collections.Uint64Key, func(_ uint64, value company) (string, error) {
+				return value.City, nil
+			}),
+			Vat: NewUnique(sb, collections.NewPrefix(2), "companies_by_vat", collections.Uint64Key, collections.Uint64Key, func(_ uint64, value company) (uint64, error) {
+				return value.Vat, nil
+			}),
+		},
+	)
+
+	companies := []company{
+		{City: "milan", Vat: 30},
+		{City: "rome", Vat: 10},
+		{City: "milan", Vat: 20},
+		{City: "berlin", Vat: 50},
+		{City: "milan", Vat: 40},
+		{City: "paris", Vat: 60},
+	}
+	for i, c := range companies {
+		require.NoError(t, im.Set(ctx, uint64(i), c))
+	}
+
+	pks := func(page Page[uint64, company]) []uint64 {
+		keys := []uint64{}
+		for _, kv := range page.Results {
+			keys = append(keys, kv.Key)
+		}
+		return keys
+	}
+
+	// prefix
+	page, err := NewQuery(im, im.Indexes.City).Prefix("milan").Page(ctx, PageRequest{CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 2, 4}, pks(page))
+	require.Equal(t, companies[2], page.Results[1].Value)
+	require.Equal(t, uint64(3), page.Total)
+	require.Nil(t, page.NextKey)
+
+	// range over the reference keys, in index order
+	page, err = NewQuery(im, im.Indexes.City).StartInclusive("milan").EndExclusive("rome").Page(ctx, PageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 2, 4, 5}, pks(page))
+
+	page, err = NewQuery(im, im.Indexes.Vat).StartInclusive(20).EndExclusive(50).Page(ctx, PageRequest{Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4, 0, 2}, pks(page))
+
+	// predicates
+	page, err = NewQuery(im, im.Indexes.City).
+		Where(func(_ uint64, c company) (bool, error) { return c.Vat >= 20, nil }).
+		Where(func(pk uint64, _ company) (bool, error) { return pk != 0, nil }).
+		Page(ctx, PageRequest{CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 2, 4, 5}, pks(page))
+	require.Equal(t, uint64(4), page.Total)
+
+	// empty bounds
+	page, err = NewQuery(im, im.Indexes.City).Prefix("milan").StartInclusive("rome").Page(ctx, PageRequest{})
+	require.NoError(t, err)
+	require.Empty(t, page.Results)
+
+	_, err = NewQuery(im, im.Indexes.City).Page(ctx, PageRequest{Key: []byte("key"), Offset: 1})
+	require.Error(t, err)
+}
+
+func TestQueryPagination(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	im := collections.NewIndexedMap(sb, collections.NewPrefix(0), "balances", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Value,
+		balanceIndex{
+			Denom: NewReversePair[uint64](sb, collections.NewPrefix(1), "balances_by_denom", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		},
+	)
+
+	for _, account := range []string{"alice", "bob", "carol", "dave", "eve"} {
+		require.NoError(t, im.Set(ctx, collections.Join(account, "atom"), 1))
+		require.NoError(t, im.Set(ctx, collections.Join(account, "osmo"), 2))
+	}
+
+	query := NewQuery(im, im.Indexes.Denom).Prefix("atom")
+	accounts := func(page Page[collections.Pair[string, string], uint64]) []string {
+		keys := []string{}
+		for _, kv := range page.Results {
+			require.Equal(t, "atom", kv.Key.K2())
+			keys = append(keys, kv.Key.K1())
+		}
+		return keys
+	}
+
+	// by key
+	page, err := query.Page(ctx, PageRequest{Limit: 2, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "bob"}, accounts(page))
+	require.Equal(t, uint64(5), page.Total)
+	require.NotNil(t, page.NextKey)
+
+	// the next key remains valid when the index changes
+	require.NoError(t, im.Remove(ctx, collections.Join("alice", "atom")))
+	require.NoError(t, im.Set(ctx, collections.Join("aaron", "atom"), 1))
+
+	page, err = query.Page(ctx, PageRequest{Key: page.NextKey, Limit: 2, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"carol", "dave"}, accounts(page))
+	require.Zero(t, page.Total)
+
+	page, err = query.Page(ctx, PageRequest{Key: page.NextKey, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"eve"}, accounts(page))
+	require.Nil(t, page.NextKey)
+
+	// by offset
+	page, err = query.Page(ctx, PageRequest{Offset: 4, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"eve"}, accounts(page))
+
+	// reversed
+	page, err = query.Page(ctx, PageRequest{Limit: 2, Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"eve", "dave"}, accounts(page))
+
+	page, err = query.Page(ctx, PageRequest{Key: page.NextKey, Limit: 2, Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"carol", "bob"}, accounts(page))
+
+	// keys out of the query bounds are rejected
+	osmoPage, err := NewQuery(im, im.Indexes.Denom).Prefix("osmo").Page(ctx, PageRequest{Limit: 1})
+	require.NoError(t, err)
+	_, err = query.Page(ctx, PageRequest{Key: osmoPage.NextKey})
+	require.ErrorContains(t, err, "out of the query bounds")
+}
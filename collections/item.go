@@ -56,6 +56,7 @@ func (noKey) Size(_ noKey) int                      { return 0 }
 func (noKey) Encode(_ []byte, _ noKey) (int, error) { return 0, nil }
 func (noKey) Decode(_ []byte) (int, noKey, error)   { return 0, noKey{}, nil }
 func (noKey) EncodeJSON(_ noKey) ([]byte, error)    { return []byte(`"item"`), nil }
+func (noKey) JSONSchema() map[string]any            { return map[string]any{"const": "item"} }
 func (noKey) DecodeJSON(b []byte) (noKey, error) {
 	if !bytes.Equal(b, []byte(`"item"`)) {
 		return noKey{}, fmt.Errorf("%w: invalid item json key bytes", ErrEncoding)
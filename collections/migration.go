@@ -0,0 +1,290 @@
+package collections
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// DefaultMigrationBatchSize is the default number of entries a Migration
+// reads from the old collection before writing them to the new one.
+const DefaultMigrationBatchSize = 10_000
+
+// MigrationCollection is a collection whose entries can be migrated with a
+// Migration, it is implemented by Map and IndexedMap.
+type MigrationCollection[K, V any] interface {
+	Has(ctx context.Context, key K) (bool, error)
+	Set(ctx context.Context, key K, value V) error
+	Remove(ctx context.Context, key K) error
+	Iterate(ctx context.Context, ranger Ranger[K]) (Iterator[K, V], error)
+	KeyCodec() codec.KeyCodec[K]
+	ValueCodec() codec.ValueCodec[V]
+	GetPrefix() []byte
+}
+
+// MigrationReport reports the entries changed by a Migration.
+type MigrationReport struct {
+	// Migrated is the number of entries migrated.
+	Migrated uint64
+	// KeysChanged is the number of migrated entries whose raw key, including
+	// the collection prefix, changed.
+	KeysChanged uint64
+	// ValuesChanged is the number of migrated entries whose raw value changed.
+	ValuesChanged uint64
+}
+
+// Migration migrates the entries of an old collection to a new collection,
+// which can have a different prefix, key codec or value type. Every entry is
+// removed from the old collection and the transformed entry is set in the new
+// one, so the indexes of an IndexedMap are updated as well.
+//
+// The old collection is usually declared with a throwaway SchemaBuilder, as
+// its prefix can be the one of the new collection. When both collections have
+// the same prefix the migration is in place, the raw keys of the entries must
+// not change and the entries whose raw value does not change are not written.
+//
+// A Migration can be run from a module migration handler, e.g.:
+//
+//	cfg.RegisterMigration(types.ModuleName, 1, func(ctx sdk.Context) error {
+//		_, err := migration.Migrate(ctx)
+//		return err
+//	})
+type Migration[OldK, OldV, NewK, NewV any] struct {
+	from      MigrationCollection[OldK, OldV]
+	to        MigrationCollection[NewK, NewV]
+	transform func(key OldK, value OldV) (NewK, NewV, error)
+	batchSize int
+}
+
+// NewMigration returns a Migration of the entries of the collection from to
+// the collection to, transforming every entry with the provided function.
+func NewMigration[OldK, OldV, NewK, NewV any](
+	from MigrationCollection[OldK, OldV],
+	to MigrationCollection[NewK, NewV],
+	transform func(key OldK, value OldV) (NewK, NewV, error),
+) *Migration[OldK, OldV, NewK, NewV] {
+	return &Migration[OldK, OldV, NewK, NewV]{
+		from:      from,
+		to:        to,
+		transform: transform,
+		batchSize: DefaultMigrationBatchSize,
+	}
+}
+
+// WithBatchSize sets the number of entries read from the old collection
+// before writing them to the new one.
+func (m *Migration[OldK, OldV, NewK, NewV]) WithBatchSize(size int) *Migration[OldK, OldV, NewK, NewV] {
+	m.batchSize = size
+	return m
+}
+
+// Migrate migrates the entries and verifies the old collection is empty and
+// the new collection has gained the migrated entries, unless the migration is
+// in place. It errors with ErrConflict if an entry is migrated to a key which
+// is already set.
+func (m *Migration[OldK, OldV, NewK, NewV]) Migrate(ctx context.Context) (MigrationReport, error) {
+	return m.run(ctx, false)
+}
+
+// DryRun reports what Migrate would change without writing to the store. It
+// keeps the keys of the migrated entries in memory to detect conflicts.
+func (m *Migration[OldK, OldV, NewK, NewV]) DryRun(ctx context.Context) (MigrationReport, error) {
+	return m.run(ctx, true)
+}
+
+// migrationEntry is an entry of the old collection and its transformation.
+type migrationEntry[OldK, NewK, NewV any] struct {
+	key       OldK
+	newKey    NewK
+	newRawKey []byte
+	newValue  NewV
+	// unchanged is set when the entry is migrated in place to the same value.
+	unchanged bool
+}
+
+func (m *Migration[OldK, OldV, NewK, NewV]) run(ctx context.Context, dryRun bool) (report MigrationReport, err error) {
+	if m.batchSize <= 0 {
+		return report, fmt.Errorf("invalid migration batch size %d", m.batchSize)
+	}
+
+	fromPrefix, toPrefix := m.from.GetPrefix(), m.to.GetPrefix()
+	inPlace := bytes.Equal(fromPrefix, toPrefix)
+	if !inPlace && (bytes.HasPrefix(fromPrefix, toPrefix) || bytes.HasPrefix(toPrefix, fromPrefix)) {
+		return report, fmt.Errorf("migration has overlapping prefixes 0x%x and 0x%x", fromPrefix, toPrefix)
+	}
+
+	var initialCount uint64
+	if !inPlace && !dryRun {
+		initialCount, err = countEntries(ctx, m.to)
+		if err != nil {
+			return report, err
+		}
+	}
+
+	// keys of the migrated entries, to detect conflicts without writing
+	var newRawKeys map[string]struct{}
+	if dryRun {
+		newRawKeys = map[string]struct{}{}
+	}
+
+	var last *OldK
+	for {
+		entries, err := m.nextBatch(ctx, last, &report)
+		if err != nil {
+			return report, err
+		}
+		if len(entries) == 0 {
+			break
+		}
+		last = &entries[len(entries)-1].key
+
+		for _, entry := range entries {
+			if !inPlace {
+				if err := m.checkConflict(ctx, entry, newRawKeys); err != nil {
+					return report, err
+				}
+			}
+			if dryRun || entry.unchanged {
+				continue
+			}
+
+			if err := m.from.Remove(ctx, entry.key); err != nil {
+				return report, err
+			}
+			if err := m.to.Set(ctx, entry.newKey, entry.newValue); err != nil {
+				return report, err
+			}
+		}
+	}
+
+	if dryRun {
+		return report, nil
+	}
+	return report, m.verify(ctx, inPlace, initialCount+report.Migrated)
+}
+
+// nextBatch reads and transforms the entries of the old collection following
+// the last key, adding them to the report.
+func (m *Migration[OldK, OldV, NewK, NewV]) nextBatch(
+	ctx context.Context, last *OldK, report *MigrationReport,
+) ([]migrationEntry[OldK, NewK, NewV], error) {
+	ranger := new(Range[OldK])
+	if last != nil {
+		ranger.StartExclusive(*last)
+	}
+	iter, err := m.from.Iterate(ctx, ranger)
+	if errors.Is(err, ErrInvalidIterator) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var entries []migrationEntry[OldK, NewK, NewV]
+	for ; iter.Valid() && len(entries) < m.batchSize; iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		newKey, newValue, err := m.transform(kv.Key, kv.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to migrate key %s: %w", m.from.KeyCodec().Stringify(kv.Key), err)
+		}
+
+		inPlace := bytes.Equal(m.from.GetPrefix(), m.to.GetPrefix())
+		rawKey, err := EncodeKeyWithPrefix(m.to.GetPrefix(), m.to.KeyCodec(), newKey)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(rawKey, iter.iter.Key()) {
+			if inPlace {
+				return nil, fmt.Errorf("in place migration cannot change the key %s", m.from.KeyCodec().Stringify(kv.Key))
+			}
+			report.KeysChanged++
+		}
+
+		rawValue, err := m.to.ValueCodec().Encode(newValue)
+		if err != nil {
+			return nil, err
+		}
+		valueChanged := !bytes.Equal(rawValue, iter.iter.Value())
+		if valueChanged {
+			report.ValuesChanged++
+		}
+
+		entries = append(entries, migrationEntry[OldK, NewK, NewV]{
+			key:       kv.Key,
+			newKey:    newKey,
+			newRawKey: rawKey,
+			newValue:  newValue,
+			unchanged: inPlace && !valueChanged,
+		})
+		report.Migrated++
+	}
+
+	return entries, nil
+}
+
+// checkConflict errors with ErrConflict if the new key of the entry is set in
+// the new collection, or is in the migrated keys when they are tracked.
+func (m *Migration[OldK, OldV, NewK, NewV]) checkConflict(
+	ctx context.Context, entry migrationEntry[OldK, NewK, NewV], newRawKeys map[string]struct{},
+) error {
+	has, err := m.to.Has(ctx, entry.newKey)
+	if err != nil {
+		return err
+	}
+	if newRawKeys != nil {
+		_, migrated := newRawKeys[string(entry.newRawKey)]
+		has = has || migrated
+		newRawKeys[string(entry.newRawKey)] = struct{}{}
+	}
+	if has {
+		return fmt.Errorf("%w: key %s is already set", ErrConflict, m.to.KeyCodec().Stringify(entry.newKey))
+	}
+	return nil
+}
+
+// verify checks the old collection is empty and the new collection has the
+// expected number of entries.
+func (m *Migration[OldK, OldV, NewK, NewV]) verify(ctx context.Context, inPlace bool, expected uint64) error {
+	if !inPlace {
+		left, err := countEntries(ctx, m.from)
+		if err != nil {
+			return err
+		}
+		if left != 0 {
+			return fmt.Errorf("migration left %d entries in the old collection", left)
+		}
+	}
+
+	count, err := countEntries(ctx, m.to)
+	if err != nil {
+		return err
+	}
+	if count != expected {
+		return fmt.Errorf("migration expected %d entries in the new collection, got %d", expected, count)
+	}
+	return nil
+}
+
+func countEntries[K, V any](ctx context.Context, coll MigrationCollection[K, V]) (uint64, error) {
+	iter, err := coll.Iterate(ctx, nil)
+	if errors.Is(err, ErrInvalidIterator) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count, nil
+}
@@ -0,0 +1,167 @@
+package collections_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+)
+
+func TestMigration(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+
+	// the old collection is declared with its own schema builder
+	oldMap := collections.NewMap(collections.NewSchemaBuilder(sk), collections.NewPrefix(10), "old", collections.Uint64Key, collections.StringValue)
+	newMap := collections.NewMap(collections.NewSchemaBuilder(sk), collections.NewPrefix(11), "new", collections.StringKey, collections.Uint64Value)
+	for i := uint64(0); i < 5; i++ {
+		require.NoError(t, oldMap.Set(ctx, i, strconv.FormatUint(i*10, 10)))
+	}
+
+	migration := collections.NewMigration(oldMap, newMap, func(key uint64, value string) (string, uint64, error) {
+		n, err := strconv.ParseUint(value, 10, 64)
+		return strconv.FormatUint(key, 10), n, err
+	}).WithBatchSize(2)
+
+	// dry run
+	report, err := migration.DryRun(ctx)
+	require.NoError(t, err)
+	require.Equal(t, collections.MigrationReport{Migrated: 5, KeysChanged: 5, ValuesChanged: 5}, report)
+	has, err := oldMap.Has(ctx, 0)
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = newMap.Has(ctx, "0")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// migration
+	report, err = migration.Migrate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, collections.MigrationReport{Migrated: 5, KeysChanged: 5, ValuesChanged: 5}, report)
+
+	// the old collection is empty
+	_, err = oldMap.Iterate(ctx, nil)
+	require.ErrorIs(t, err, collections.ErrInvalidIterator)
+
+	newIter, err := newMap.Iterate(ctx, nil)
+	require.NoError(t, err)
+	kvs, err := newIter.KeyValues()
+	require.NoError(t, err)
+	require.Equal(t, []collections.KeyValue[string, uint64]{
+		{Key: "0", Value: 0}, {Key: "1", Value: 10}, {Key: "2", Value: 20}, {Key: "3", Value: 30}, {Key: "4", Value: 40},
+	}, kvs)
+
+	// the migration of no entries is a no-op
+	report, err = migration.Migrate(ctx)
+	require.NoError(t, err)
+	require.Zero(t, report)
+}
+
+func TestMigrationInPlace(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+
+	oldMap := collections.NewMap(collections.NewSchemaBuilder(sk), collections.NewPrefix(10), "old", collections.StringKey, collections.Uint64Value)
+	newMap := collections.NewMap(collections.NewSchemaBuilder(sk), collections.NewPrefix(10), "new", collections.StringKey, collections.StringValue)
+	require.NoError(t, oldMap.Set(ctx, "a", 1))
+	require.NoError(t, oldMap.Set(ctx, "b", 2))
+	require.NoError(t, oldMap.Set(ctx, "c", 3))
+
+	report, err := collections.NewMigration(oldMap, newMap, func(key string, value uint64) (string, string, error) {
+		return key, strconv.FormatUint(value, 10), nil
+	}).WithBatchSize(1).Migrate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, collections.MigrationReport{Migrated: 3, ValuesChanged: 3}, report)
+
+	value, err := newMap.Get(ctx, "b")
+	require.NoError(t, err)
+	require.Equal(t, "2", value)
+
+	// the entries left unchanged are kept
+	report, err = collections.NewMigration(newMap, newMap, func(key, value string) (string, string, error) {
+		return key, value, nil
+	}).Migrate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, collections.MigrationReport{Migrated: 3}, report)
+	value, err = newMap.Get(ctx, "c")
+	require.NoError(t, err)
+	require.Equal(t, "3", value)
+
+	// the keys of an in place migration cannot change
+	_, err = collections.NewMigration(newMap, newMap, func(key, value string) (string, string, error) {
+		return key + "x", value, nil
+	}).DryRun(ctx)
+	require.ErrorContains(t, err, "cannot change the key")
+
+	// overlapping prefixes are rejected
+	otherMap := collections.NewMap(collections.NewSchemaBuilder(sk), collections.NewPrefix("\x0a\x01"), "other", collections.StringKey, collections.StringValue)
+	_, err = collections.NewMigration(newMap, otherMap, func(key, value string) (string, string, error) {
+		return key, value, nil
+	}).DryRun(ctx)
+	require.ErrorContains(t, err, "overlapping prefixes")
+}
+
+func TestMigrationIndexedMap(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+
+	oldMap := collections.NewMap(collections.NewSchemaBuilder(sk), collections.NewPrefix(10), "old", collections.Uint64Key, colltest.MockValueCodec[company]())
+	im := newTestIndexedMap(collections.NewSchemaBuilder(sk))
+	require.NoError(t, oldMap.Set(ctx, 1, company{City: "milan", Vat: 10}))
+	require.NoError(t, oldMap.Set(ctx, 2, company{City: "rome", Vat: 20}))
+	require.NoError(t, oldMap.Set(ctx, 3, company{City: "milan", Vat: 30}))
+
+	report, err := collections.NewMigration(oldMap, im, func(key uint64, value company) (string, company, error) {
+		return strconv.FormatUint(key, 10), value, nil
+	}).Migrate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), report.Migrated)
+
+	// the indexes are rebuilt
+	iter, err := im.Indexes.City.MatchExact(ctx, "milan")
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "3"}, pks)
+
+	pk, err := im.Indexes.Vat.MatchExact(ctx, 20)
+	require.NoError(t, err)
+	require.Equal(t, "2", pk)
+}
+
+func TestMigrationConflict(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+
+	oldMap := collections.NewMap(collections.NewSchemaBuilder(sk), collections.NewPrefix(10), "old", collections.Uint64Key, collections.Uint64Value)
+	newMap := collections.NewMap(collections.NewSchemaBuilder(sk), collections.NewPrefix(11), "new", collections.Uint64Key, collections.Uint64Value)
+	require.NoError(t, oldMap.Set(ctx, 1, 1))
+	require.NoError(t, oldMap.Set(ctx, 2, 2))
+
+	// two entries migrated to the same key
+	migration := collections.NewMigration(oldMap, newMap, func(_, value uint64) (uint64, uint64, error) {
+		return 0, value, nil
+	})
+	_, err := migration.DryRun(ctx)
+	require.ErrorIs(t, err, collections.ErrConflict)
+	_, err = migration.Migrate(ctx)
+	require.ErrorIs(t, err, collections.ErrConflict)
+
+	// an entry migrated to a key already set
+	sk, ctx = colltest.MockStore()
+	oldMap = collections.NewMap(collections.NewSchemaBuilder(sk), collections.NewPrefix(10), "old", collections.Uint64Key, collections.Uint64Value)
+	newMap = collections.NewMap(collections.NewSchemaBuilder(sk), collections.NewPrefix(11), "new", collections.Uint64Key, collections.Uint64Value)
+	require.NoError(t, oldMap.Set(ctx, 1, 1))
+	require.NoError(t, newMap.Set(ctx, 1, 1))
+	_, err = collections.NewMigration(oldMap, newMap, func(key, value uint64) (uint64, uint64, error) {
+		return key, value, nil
+	}).DryRun(ctx)
+	require.ErrorIs(t, err, collections.ErrConflict)
+
+	// transform errors are returned
+	errTransform := errors.New("transform error")
+	_, err = collections.NewMigration(oldMap, newMap, func(_, _ uint64) (uint64, uint64, error) {
+		return 0, 0, errTransform
+	}).Migrate(ctx)
+	require.ErrorIs(t, err, errTransform)
+}
@@ -197,6 +197,15 @@ func (p pairKeyCodec[K1, K2]) EncodeJSON(v Pair[K1, K2]) ([]byte, error) {
 	return json.Marshal(jsonPairKey{k1Json, k2Json})
 }
 
+func (p pairKeyCodec[K1, K2]) JSONSchema() map[string]any {
+	return map[string]any{
+		"type":        "array",
+		"prefixItems": []any{codec.JSONSchema(p.keyCodec1), codec.JSONSchema(p.keyCodec2)},
+		"minItems":    2,
+		"maxItems":    2,
+	}
+}
+
 func (p pairKeyCodec[K1, K2]) DecodeJSON(b []byte) (Pair[K1, K2], error) {
 	pairJSON := jsonPairKey{}
 	err := json.Unmarshal(b, &pairJSON)
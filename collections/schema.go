@@ -2,6 +2,7 @@ package collections
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"sort"
@@ -278,6 +279,28 @@ func (s Schema) exportGenesis(ctx context.Context, target appmodule.GenesisTarge
 	return coll.exportGenesis(ctx, wc)
 }
 
+// JSONSchemaDraft is the JSON schema draft of the documents returned by
+// Schema.JSONSchema.
+const JSONSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema returns a JSON schema document describing the genesis of the
+// schema, an object with a property per collection as written by
+// DefaultGenesis and ExportGenesis. Keys and values whose codec does not
+// implement codec.HasJSONSchema are described by the empty schema.
+func (s Schema) JSONSchema() ([]byte, error) {
+	properties := make(map[string]any, len(s.collectionsOrdered))
+	for _, name := range s.collectionsOrdered {
+		properties[name] = s.collectionsByName[name].jsonSchema()
+	}
+
+	return json.Marshal(map[string]any{
+		"$schema":              JSONSchemaDraft,
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	})
+}
+
 func (s Schema) getCollection(name string) (Collection, error) {
 	coll, ok := s.collectionsByName[name]
 	if !ok {
@@ -293,3 +316,60 @@ func (s Schema) ListCollections() []Collection {
 	}
 	return colls
 }
+
+// DecodeEntry decodes the raw key, stripped of the collection prefix, and the
+// raw value of an entry of the collection with the provided prefix, returning
+// it as human-readable JSON in the form:
+// {"collection": <name>, "key": <json key>, "value": <json value>}.
+func (s Schema) DecodeEntry(prefix, key, value []byte) ([]byte, error) {
+	coll, ok := s.collectionsByPrefix[string(prefix)]
+	if !ok {
+		return nil, fmt.Errorf("unknown collection prefix: %x", prefix)
+	}
+
+	entry, err := coll.decodeEntry(key, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode entry of %s: %w", coll.GetName(), err)
+	}
+
+	return json.Marshal(schemaEntry{
+		Collection: coll.GetName(),
+		Key:        entry.Key,
+		Value:      entry.Value,
+	})
+}
+
+type schemaEntry struct {
+	Collection string          `json:"collection"`
+	Key        json.RawMessage `json:"key"`
+	Value      json.RawMessage `json:"value"`
+}
+
+type entryDecoder interface {
+	decodeEntry(key, value []byte) (jsonMapEntry, error)
+}
+
+func (m Map[K, V]) decodeEntry(key, value []byte) (jsonMapEntry, error) {
+	read, k, err := m.kc.Decode(key)
+	if err != nil {
+		return jsonMapEntry{}, fmt.Errorf("%w: key decode: %s", ErrEncoding, err)
+	}
+	if read != len(key) {
+		return jsonMapEntry{}, fmt.Errorf("%w: key decoder didn't fully consume the key: %T %x %d", ErrEncoding, m.kc, key, read)
+	}
+	keyBz, err := m.kc.EncodeJSON(k)
+	if err != nil {
+		return jsonMapEntry{}, err
+	}
+
+	v, err := m.vc.Decode(value)
+	if err != nil {
+		return jsonMapEntry{}, fmt.Errorf("%w: value decode: %s", ErrEncoding, err)
+	}
+	valueBz, err := m.vc.EncodeJSON(v)
+	if err != nil {
+		return jsonMapEntry{}, err
+	}
+
+	return jsonMapEntry{Key: keyBz, Value: valueBz}, nil
+}
@@ -70,3 +70,89 @@ func TestSchemaBuilderCantBeUsedAfterBuild(t *testing.T) {
 		NewMap(schemaBuilder, NewPrefix(2), "def", Uint64Key, Uint64Value)
 	})
 }
+
+func TestSchemaDecodeEntry(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	m := NewMap(schemaBuilder, NewPrefix(1), "balances", PairKeyCodec(StringKey, StringKey), Uint64Value)
+	item := NewItem(schemaBuilder, NewPrefix(2), "params", StringValue)
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, m.Set(ctx, Join("alice", "atom"), 100))
+	require.NoError(t, item.Set(ctx, "params"))
+
+	key, err := EncodeKeyWithPrefix(nil, m.KeyCodec(), Join("alice", "atom"))
+	require.NoError(t, err)
+	value, err := Uint64Value.Encode(100)
+	require.NoError(t, err)
+
+	bz, err := schema.DecodeEntry(NewPrefix(1), key, value)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"collection":"balances","key":["alice","atom"],"value":"100"}`, string(bz))
+
+	bz, err = schema.DecodeEntry(NewPrefix(2), nil, []byte("params"))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"collection":"params","key":"item","value":"params"}`, string(bz))
+
+	_, err = schema.DecodeEntry(NewPrefix(3), key, value)
+	require.ErrorContains(t, err, "unknown collection prefix")
+
+	_, err = schema.DecodeEntry(NewPrefix(1), key, value[:4])
+	require.ErrorIs(t, err, ErrEncoding)
+}
+
+func TestSchemaJSONSchema(t *testing.T) {
+	sk, _ := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	NewMap(schemaBuilder, NewPrefix(1), "balances", PairKeyCodec(StringKey, StringKey), Uint64Value)
+	NewKeySet(schemaBuilder, NewPrefix(2), "denoms", StringKey)
+	NewItem(schemaBuilder, NewPrefix(3), "params", BoolValue)
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	bz, err := schema.JSONSchema()
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"additionalProperties": false,
+		"properties": {
+			"balances": {
+				"type": "array",
+				"items": {
+					"type": "object",
+					"additionalProperties": false,
+					"required": ["key", "value"],
+					"properties": {
+						"key": {
+							"type": "array",
+							"minItems": 2,
+							"maxItems": 2,
+							"prefixItems": [{"type": "string"}, {"type": "string"}]
+						},
+						"value": {"type": "string", "pattern": "^[0-9]+$"}
+					}
+				}
+			},
+			"denoms": {
+				"type": "array",
+				"items": {
+					"type": "object",
+					"additionalProperties": false,
+					"required": ["key"],
+					"properties": {"key": {"type": "string"}}
+				}
+			},
+			"params": {
+				"type": "array",
+				"items": {
+					"type": "object",
+					"additionalProperties": false,
+					"required": ["key", "value"],
+					"properties": {"key": {"const": "item"}, "value": {"type": "boolean"}}
+				}
+			}
+		}
+	}`, string(bz))
+}
@@ -33,6 +33,7 @@ func NewDebugBackend(backend ormtable.Backend, debugger Debugger) ormtable.Backe
 		IndexStore:      NewDebugStore(backend.IndexStore(), debugger, "index"),
 		ValidateHooks:   hooks,
 		WriteHooks:      hooks,
+		ChangeHooks:     backend.ChangeHooks(),
 	})
 }
 
@@ -0,0 +1,95 @@
+package ormdb
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/orm/model/ormtable"
+	"cosmossdk.io/orm/types/ormevents"
+)
+
+// tableEventHooks emits the rows changed in the tables of a module as typed
+// events through the event service.
+type tableEventHooks struct {
+	eventService event.Service
+	nonConsensus bool
+}
+
+var _ ormtable.ChangeHooks = tableEventHooks{}
+
+func (h tableEventHooks) OnChange(ctx context.Context, primaryKey []byte, existing, n proto.Message) error {
+	var (
+		evt protoiface.MessageV1
+		err error
+	)
+	switch {
+	case existing == nil:
+		evt, err = newEventCreate(primaryKey, n)
+	case n == nil:
+		evt, err = newEventDelete(primaryKey, existing)
+	default:
+		evt, err = newEventUpdate(primaryKey, existing, n)
+	}
+	if err != nil {
+		return err
+	}
+
+	manager := h.eventService.EventManager(ctx)
+	if h.nonConsensus {
+		return manager.EmitNonConsensus(ctx, evt)
+	}
+	return manager.Emit(ctx, evt)
+}
+
+func newEventCreate(primaryKey []byte, n proto.Message) (*ormevents.EventCreate, error) {
+	newAny, err := anypb.New(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ormevents.EventCreate{
+		Table:      tableName(n),
+		PrimaryKey: primaryKey,
+		New:        newAny,
+	}, nil
+}
+
+func newEventUpdate(primaryKey []byte, existing, n proto.Message) (*ormevents.EventUpdate, error) {
+	oldAny, err := anypb.New(existing)
+	if err != nil {
+		return nil, err
+	}
+
+	newAny, err := anypb.New(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ormevents.EventUpdate{
+		Table:      tableName(n),
+		PrimaryKey: primaryKey,
+		Old:        oldAny,
+		New:        newAny,
+	}, nil
+}
+
+func newEventDelete(primaryKey []byte, existing proto.Message) (*ormevents.EventDelete, error) {
+	oldAny, err := anypb.New(existing)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ormevents.EventDelete{
+		Table:      tableName(existing),
+		PrimaryKey: primaryKey,
+		Old:        oldAny,
+	}, nil
+}
+
+func tableName(message proto.Message) string {
+	return string(message.ProtoReflect().Descriptor().FullName())
+}
@@ -14,6 +14,7 @@ import (
 
 	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/orm/encoding/encodeutil"
 	"cosmossdk.io/orm/encoding/ormkv"
@@ -75,6 +76,18 @@ type ModuleDBOptions struct {
 
 	// KVStoreService is the storage service to use for the DB if transient storage is used.
 	TransientStoreService store.TransientStoreService
+
+	// EventService is an optional event service. If it is set, the rows
+	// inserted, updated and deleted in the tables are emitted as
+	// ormevents.EventCreate, ormevents.EventUpdate and ormevents.EventDelete
+	// typed events, which are offered to the ABCI listeners of the app with
+	// the other events of the block. Emitting these events is state-machine
+	// breaking unless NonConsensusEvents is set.
+	EventService event.Service
+
+	// NonConsensusEvents emits the table events with EmitNonConsensus, so that
+	// they are not included in consensus.
+	NonConsensusEvents bool
 }
 
 // NewModuleDB constructs a ModuleDB instance from the provided schema and options.
@@ -91,6 +104,14 @@ func NewModuleDB(schema *ormv1alpha1.ModuleSchemaDescriptor, options ModuleDBOpt
 		fileResolver = protoregistry.GlobalFiles
 	}
 
+	var changeHooks ormtable.ChangeHooks
+	if options.EventService != nil {
+		changeHooks = tableEventHooks{
+			eventService: options.EventService,
+			nonConsensus: options.NonConsensusEvents,
+		}
+	}
+
 	for _, entry := range schema.SchemaFile {
 		var backendResolver ormtable.BackendResolver
 
@@ -105,8 +126,11 @@ func NewModuleDB(schema *ormv1alpha1.ModuleSchemaDescriptor, options ModuleDBOpt
 					return ormtable.NewBackend(ormtable.BackendOptions{
 						CommitmentStore: kvStore,
 						IndexStore:      kvStore,
+						ChangeHooks:     changeHooks,
 					}), nil
 				}
+			} else if changeHooks != nil {
+				return nil, fmt.Errorf("missing KVStoreService, required to emit events")
 			}
 		case ormv1alpha1.StorageType_STORAGE_TYPE_MEMORY:
 			service := options.MemoryStoreService
@@ -119,6 +143,7 @@ func NewModuleDB(schema *ormv1alpha1.ModuleSchemaDescriptor, options ModuleDBOpt
 				return ormtable.NewBackend(ormtable.BackendOptions{
 					CommitmentStore: kvStore,
 					IndexStore:      kvStore,
+					ChangeHooks:     changeHooks,
 				}), nil
 			}
 		case ormv1alpha1.StorageType_STORAGE_TYPE_TRANSIENT:
@@ -132,6 +157,7 @@ func NewModuleDB(schema *ormv1alpha1.ModuleSchemaDescriptor, options ModuleDBOpt
 				return ormtable.NewBackend(ormtable.BackendOptions{
 					CommitmentStore: kvStore,
 					IndexStore:      kvStore,
+					ChangeHooks:     changeHooks,
 				}), nil
 			}
 		default:
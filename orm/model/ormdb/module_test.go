@@ -10,6 +10,10 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/golang/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/anypb"
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/golden"
 
@@ -18,10 +22,12 @@ import (
 	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
 	"cosmossdk.io/core/appconfig"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/genesis"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	_ "cosmossdk.io/orm" // required for ORM module registration
+	"cosmossdk.io/orm/encoding/ormkv"
 	"cosmossdk.io/orm/internal/testkv"
 	"cosmossdk.io/orm/internal/testpb"
 	"cosmossdk.io/orm/model/ormdb"
@@ -29,6 +35,7 @@ import (
 	"cosmossdk.io/orm/testing/ormmocks"
 	"cosmossdk.io/orm/testing/ormtest"
 	"cosmossdk.io/orm/types/ormerrors"
+	"cosmossdk.io/orm/types/ormevents"
 )
 
 // These tests use a simulated bank keeper. Addresses and balances use
@@ -356,6 +363,103 @@ func TestHooks(t *testing.T) {
 	assert.NilError(t, k.Burn(ctx, acct1, denom, 5))
 }
 
+type testEventService struct {
+	events             []protoiface.MessageV1
+	nonConsensusEvents []protoiface.MessageV1
+}
+
+func (s *testEventService) EventManager(context.Context) event.Manager { return s }
+
+func (s *testEventService) Emit(_ context.Context, evt protoiface.MessageV1) error {
+	s.events = append(s.events, evt)
+	return nil
+}
+
+func (s *testEventService) EmitKV(context.Context, string, ...event.Attribute) error {
+	return nil
+}
+
+func (s *testEventService) EmitNonConsensus(_ context.Context, evt protoiface.MessageV1) error {
+	s.nonConsensusEvents = append(s.nonConsensusEvents, evt)
+	return nil
+}
+
+func TestEvents(t *testing.T) {
+	eventService := &testEventService{}
+	db, err := ormdb.NewModuleDB(TestBankSchema, ormdb.ModuleDBOptions{
+		KVStoreService: testStoreService{db: dbm.NewMemDB()},
+		EventService:   eventService,
+	})
+	assert.NilError(t, err)
+	k, err := NewKeeper(db)
+	assert.NilError(t, err)
+	ctx := context.Background()
+
+	primaryKey := func(message proto.Message) []byte {
+		_, pk, err := db.GetTable(message).PrimaryKey().(ormkv.IndexCodec).EncodeKeyFromMessage(message.ProtoReflect())
+		assert.NilError(t, err)
+		return pk
+	}
+	newAny := func(message proto.Message) *anypb.Any {
+		a, err := anypb.New(message)
+		assert.NilError(t, err)
+		return a
+	}
+
+	denom := "foo"
+	acct1 := "bob"
+	acct2 := "sally"
+	supply10 := &testpb.Supply{Denom: denom, Amount: 10}
+	supply5 := &testpb.Supply{Denom: denom, Amount: 5}
+	balance10 := &testpb.Balance{Address: acct1, Denom: denom, Amount: 10}
+	balance5 := &testpb.Balance{Address: acct1, Denom: denom, Amount: 5}
+	balance2 := &testpb.Balance{Address: acct2, Denom: denom, Amount: 5}
+
+	assert.NilError(t, k.Mint(ctx, acct1, denom, 10))
+	assert.NilError(t, k.Send(ctx, acct1, acct2, denom, 5))
+	assert.NilError(t, k.Burn(ctx, acct1, denom, 5))
+
+	assert.DeepEqual(t, []protoiface.MessageV1{
+		&ormevents.EventCreate{Table: "testpb.Supply", PrimaryKey: primaryKey(supply10), New: newAny(supply10)},
+		&ormevents.EventCreate{Table: "testpb.Balance", PrimaryKey: primaryKey(balance10), New: newAny(balance10)},
+		&ormevents.EventUpdate{Table: "testpb.Balance", PrimaryKey: primaryKey(balance10), Old: newAny(balance10), New: newAny(balance5)},
+		&ormevents.EventCreate{Table: "testpb.Balance", PrimaryKey: primaryKey(balance2), New: newAny(balance2)},
+		&ormevents.EventUpdate{Table: "testpb.Supply", PrimaryKey: primaryKey(supply10), Old: newAny(supply10), New: newAny(supply5)},
+		&ormevents.EventDelete{Table: "testpb.Balance", PrimaryKey: primaryKey(balance5), Old: newAny(balance5)},
+	}, eventService.events, protocmp.Transform())
+	assert.Equal(t, len(eventService.nonConsensusEvents), 0)
+
+	// events can be kept out of consensus
+	eventService = &testEventService{}
+	db, err = ormdb.NewModuleDB(TestBankSchema, ormdb.ModuleDBOptions{
+		KVStoreService:     testStoreService{db: dbm.NewMemDB()},
+		EventService:       eventService,
+		NonConsensusEvents: true,
+	})
+	assert.NilError(t, err)
+	k, err = NewKeeper(db)
+	assert.NilError(t, err)
+	assert.NilError(t, k.Mint(ctx, acct1, denom, 10))
+	assert.Equal(t, len(eventService.events), 0)
+	assert.Equal(t, len(eventService.nonConsensusEvents), 2)
+
+	// events require a store service
+	_, err = ormdb.NewModuleDB(TestBankSchema, ormdb.ModuleDBOptions{EventService: eventService})
+	assert.ErrorContains(t, err, "missing KVStoreService")
+
+	// an error of the change hooks fails the write
+	ctrl := gomock.NewController(t)
+	changeHooks := ormmocks.NewMockChangeHooks(ctrl)
+	db, err = ormdb.NewModuleDB(TestBankSchema, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+	k, err = NewKeeper(db)
+	assert.NilError(t, err)
+	errHooks := fmt.Errorf("change hooks error")
+	changeHooks.EXPECT().OnChange(gomock.Any(), primaryKey(supply10), gomock.Nil(), ormmocks.Eq(supply10)).Return(errHooks)
+	ctx = ormtable.WrapContextDefault(ormtest.NewMemoryBackend().WithChangeHooks(changeHooks))
+	assert.ErrorIs(t, k.Mint(ctx, acct1, denom, 10), errHooks)
+}
+
 type testStoreService struct {
 	db dbm.DB
 }
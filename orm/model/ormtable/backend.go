@@ -42,6 +42,12 @@ type Backend interface {
 
 	// WithWriteHooks returns a copy of this backend with the provided write hooks.
 	WithWriteHooks(WriteHooks) Backend
+
+	// ChangeHooks returns a ChangeHooks instance or nil.
+	ChangeHooks() ChangeHooks
+
+	// WithChangeHooks returns a copy of this backend with the provided change hooks.
+	WithChangeHooks(ChangeHooks) Backend
 }
 
 // ReadBackendOptions defines options for creating a ReadBackend.
@@ -90,6 +96,7 @@ type backend struct {
 	indexStore      kv.Store
 	validateHooks   ValidateHooks
 	writeHooks      WriteHooks
+	changeHooks     ChangeHooks
 }
 
 func (c backend) ValidateHooks() ValidateHooks {
@@ -110,6 +117,15 @@ func (c backend) WithWriteHooks(hooks WriteHooks) Backend {
 	return c
 }
 
+func (c backend) ChangeHooks() ChangeHooks {
+	return c.changeHooks
+}
+
+func (c backend) WithChangeHooks(hooks ChangeHooks) Backend {
+	c.changeHooks = hooks
+	return c
+}
+
 func (backend) private() {}
 
 func (c backend) CommitmentStoreReader() kv.ReadonlyStore {
@@ -145,6 +161,10 @@ type BackendOptions struct {
 	ValidateHooks ValidateHooks
 
 	WriteHooks WriteHooks
+
+	// ChangeHooks are optional hooks listening to the rows changed by ORM
+	// insert, update and delete operations.
+	ChangeHooks ChangeHooks
 }
 
 // NewBackend creates a new Backend.
@@ -158,6 +178,7 @@ func NewBackend(options BackendOptions) Backend {
 		indexStore:      indexStore,
 		validateHooks:   options.ValidateHooks,
 		writeHooks:      options.WriteHooks,
+		changeHooks:     options.ChangeHooks,
 	}
 }
 
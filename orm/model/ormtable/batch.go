@@ -64,7 +64,10 @@ func flushBuf(store kv.Store, writes []*batchWriterEntry) error {
 	for _, write := range writes {
 		switch {
 		case write.hookCall != nil:
-			write.hookCall()
+			err := write.hookCall()
+			if err != nil {
+				return err
+			}
 		case !write.delete:
 			err := store.Set(write.key, write.value)
 			if err != nil {
@@ -92,7 +95,7 @@ func (w *batchIndexCommitmentWriter) Close() {
 type batchWriterEntry struct {
 	key, value []byte
 	delete     bool
-	hookCall   func()
+	hookCall   func() error
 }
 
 type batchStoreWriter struct {
@@ -113,7 +116,7 @@ func (b *batchStoreWriter) Delete(key []byte) error {
 	return nil
 }
 
-func (w *batchIndexCommitmentWriter) enqueueHook(f func()) {
+func (w *batchIndexCommitmentWriter) enqueueHook(f func() error) {
 	w.indexWriter.append(&batchWriterEntry{hookCall: f})
 }
 
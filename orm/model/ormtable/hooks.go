@@ -38,3 +38,15 @@ type WriteHooks interface {
 	// OnDelete is called after the entity is deleted from the store.
 	OnDelete(context.Context, proto.Message)
 }
+
+// ChangeHooks defines an interface for listening to the rows inserted, updated
+// and deleted along with their primary key, as they are written to the store.
+// Unlike WriteHooks, an error returned by ChangeHooks fails the operation,
+// which may have been partially written to the store, so the enclosing
+// transaction must be discarded.
+type ChangeHooks interface {
+	// OnChange is called after a row is written to the store. existing is nil
+	// when the row is inserted and n is nil when the row is deleted. primaryKey
+	// is the primary key of the row as encoded in the store.
+	OnChange(ctx context.Context, primaryKey []byte, existing, n proto.Message) error
+}
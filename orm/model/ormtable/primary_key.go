@@ -170,8 +170,14 @@ func (p primaryKeyIndex) doDeleteWithWriteBatch(ctx context.Context, backend Bac
 	}
 
 	if writeHooks := backend.WriteHooks(); writeHooks != nil {
-		writer.enqueueHook(func() {
+		writer.enqueueHook(func() error {
 			writeHooks.OnDelete(ctx, message)
+			return nil
+		})
+	}
+	if changeHooks := backend.ChangeHooks(); changeHooks != nil {
+		writer.enqueueHook(func() error {
+			return changeHooks.OnChange(ctx, primaryKeyBz, message, nil)
 		})
 	}
 
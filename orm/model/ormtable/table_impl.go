@@ -143,8 +143,14 @@ func (t tableImpl) doSave(ctx context.Context, writer *batchIndexCommitmentWrite
 
 		}
 		if writeHooks := writer.WriteHooks(); writeHooks != nil {
-			writer.enqueueHook(func() {
+			writer.enqueueHook(func() error {
 				writeHooks.OnInsert(ctx, message)
+				return nil
+			})
+		}
+		if changeHooks := writer.ChangeHooks(); changeHooks != nil {
+			writer.enqueueHook(func() error {
+				return changeHooks.OnChange(ctx, pk, nil, message)
 			})
 		}
 	} else {
@@ -156,8 +162,14 @@ func (t tableImpl) doSave(ctx context.Context, writer *batchIndexCommitmentWrite
 			}
 		}
 		if writeHooks := writer.WriteHooks(); writeHooks != nil {
-			writer.enqueueHook(func() {
+			writer.enqueueHook(func() error {
 				writeHooks.OnUpdate(ctx, existing, message)
+				return nil
+			})
+		}
+		if changeHooks := writer.ChangeHooks(); changeHooks != nil {
+			writer.enqueueHook(func() error {
+				return changeHooks.OnChange(ctx, pk, existing, message)
 			})
 		}
 	}
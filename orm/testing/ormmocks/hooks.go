@@ -64,17 +64,17 @@ func (mr *MockValidateHooksMockRecorder) ValidateInsert(arg0, arg1 interface{})
 }
 
 // ValidateUpdate mocks base method.
-func (m *MockValidateHooks) ValidateUpdate(ctx context.Context, existing, new proto.Message) error {
+func (m *MockValidateHooks) ValidateUpdate(ctx context.Context, existing, n proto.Message) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateUpdate", ctx, existing, new)
+	ret := m.ctrl.Call(m, "ValidateUpdate", ctx, existing, n)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ValidateUpdate indicates an expected call of ValidateUpdate.
-func (mr *MockValidateHooksMockRecorder) ValidateUpdate(ctx, existing, new interface{}) *gomock.Call {
+func (mr *MockValidateHooksMockRecorder) ValidateUpdate(ctx, existing, n interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUpdate", reflect.TypeOf((*MockValidateHooks)(nil).ValidateUpdate), ctx, existing, new)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUpdate", reflect.TypeOf((*MockValidateHooks)(nil).ValidateUpdate), ctx, existing, n)
 }
 
 // MockWriteHooks is a mock of WriteHooks interface.
@@ -125,13 +125,50 @@ func (mr *MockWriteHooksMockRecorder) OnInsert(arg0, arg1 interface{}) *gomock.C
 }
 
 // OnUpdate mocks base method.
-func (m *MockWriteHooks) OnUpdate(ctx context.Context, existing, new proto.Message) {
+func (m *MockWriteHooks) OnUpdate(ctx context.Context, existing, n proto.Message) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "OnUpdate", ctx, existing, new)
+	m.ctrl.Call(m, "OnUpdate", ctx, existing, n)
 }
 
 // OnUpdate indicates an expected call of OnUpdate.
-func (mr *MockWriteHooksMockRecorder) OnUpdate(ctx, existing, new interface{}) *gomock.Call {
+func (mr *MockWriteHooksMockRecorder) OnUpdate(ctx, existing, n interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUpdate", reflect.TypeOf((*MockWriteHooks)(nil).OnUpdate), ctx, existing, new)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUpdate", reflect.TypeOf((*MockWriteHooks)(nil).OnUpdate), ctx, existing, n)
+}
+
+// MockChangeHooks is a mock of ChangeHooks interface.
+type MockChangeHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeHooksMockRecorder
+}
+
+// MockChangeHooksMockRecorder is the mock recorder for MockChangeHooks.
+type MockChangeHooksMockRecorder struct {
+	mock *MockChangeHooks
+}
+
+// NewMockChangeHooks creates a new mock instance.
+func NewMockChangeHooks(ctrl *gomock.Controller) *MockChangeHooks {
+	mock := &MockChangeHooks{ctrl: ctrl}
+	mock.recorder = &MockChangeHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeHooks) EXPECT() *MockChangeHooksMockRecorder {
+	return m.recorder
+}
+
+// OnChange mocks base method.
+func (m *MockChangeHooks) OnChange(ctx context.Context, primaryKey []byte, existing, n proto.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnChange", ctx, primaryKey, existing, n)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnChange indicates an expected call of OnChange.
+func (mr *MockChangeHooksMockRecorder) OnChange(ctx, primaryKey, existing, n interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnChange", reflect.TypeOf((*MockChangeHooks)(nil).OnChange), ctx, primaryKey, existing, n)
 }
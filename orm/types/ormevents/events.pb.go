@@ -0,0 +1,362 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: ormevents/events.proto
+
+package ormevents
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventCreate is emitted when a row is inserted into an ORM table.
+type EventCreate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// table is the full name of the message type of the table.
+	Table string `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// primary_key is the primary key of the row as encoded in the store.
+	PrimaryKey []byte `protobuf:"bytes,2,opt,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	// new is the inserted row.
+	New *anypb.Any `protobuf:"bytes,3,opt,name=new,proto3" json:"new,omitempty"`
+}
+
+func (x *EventCreate) Reset() {
+	*x = EventCreate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ormevents_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCreate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCreate) ProtoMessage() {}
+
+func (x *EventCreate) ProtoReflect() protoreflect.Message {
+	mi := &file_ormevents_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventCreate.ProtoReflect.Descriptor instead.
+func (*EventCreate) Descriptor() ([]byte, []int) {
+	return file_ormevents_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventCreate) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *EventCreate) GetPrimaryKey() []byte {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return nil
+}
+
+func (x *EventCreate) GetNew() *anypb.Any {
+	if x != nil {
+		return x.New
+	}
+	return nil
+}
+
+// EventUpdate is emitted when a row of an ORM table is updated.
+type EventUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// table is the full name of the message type of the table.
+	Table string `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// primary_key is the primary key of the row as encoded in the store.
+	PrimaryKey []byte `protobuf:"bytes,2,opt,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	// old is the row before the update.
+	Old *anypb.Any `protobuf:"bytes,3,opt,name=old,proto3" json:"old,omitempty"`
+	// new is the row after the update.
+	New *anypb.Any `protobuf:"bytes,4,opt,name=new,proto3" json:"new,omitempty"`
+}
+
+func (x *EventUpdate) Reset() {
+	*x = EventUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ormevents_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdate) ProtoMessage() {}
+
+func (x *EventUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_ormevents_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventUpdate.ProtoReflect.Descriptor instead.
+func (*EventUpdate) Descriptor() ([]byte, []int) {
+	return file_ormevents_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventUpdate) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *EventUpdate) GetPrimaryKey() []byte {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return nil
+}
+
+func (x *EventUpdate) GetOld() *anypb.Any {
+	if x != nil {
+		return x.Old
+	}
+	return nil
+}
+
+func (x *EventUpdate) GetNew() *anypb.Any {
+	if x != nil {
+		return x.New
+	}
+	return nil
+}
+
+// EventDelete is emitted when a row is deleted from an ORM table.
+type EventDelete struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// table is the full name of the message type of the table.
+	Table string `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// primary_key is the primary key of the row as encoded in the store.
+	PrimaryKey []byte `protobuf:"bytes,2,opt,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	// old is the deleted row.
+	Old *anypb.Any `protobuf:"bytes,3,opt,name=old,proto3" json:"old,omitempty"`
+}
+
+func (x *EventDelete) Reset() {
+	*x = EventDelete{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ormevents_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventDelete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDelete) ProtoMessage() {}
+
+func (x *EventDelete) ProtoReflect() protoreflect.Message {
+	mi := &file_ormevents_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventDelete.ProtoReflect.Descriptor instead.
+func (*EventDelete) Descriptor() ([]byte, []int) {
+	return file_ormevents_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventDelete) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *EventDelete) GetPrimaryKey() []byte {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return nil
+}
+
+func (x *EventDelete) GetOld() *anypb.Any {
+	if x != nil {
+		return x.Old
+	}
+	return nil
+}
+
+var File_ormevents_events_proto protoreflect.FileDescriptor
+
+var file_ormevents_events_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x6f, 0x72, 0x6d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x6f, 0x72, 0x6d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x19,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6c, 0x0a, 0x0b, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12,
+	0x26, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x6e, 0x79, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x22, 0x94, 0x01, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x26,
+	0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x52, 0x03, 0x6f, 0x6c, 0x64, 0x12, 0x26, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x22, 0x6c,
+	0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x03, 0x6f, 0x6c, 0x64, 0x42, 0x22, 0x5a, 0x20,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6f, 0x72, 0x6d,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6f, 0x72, 0x6d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ormevents_events_proto_rawDescOnce sync.Once
+	file_ormevents_events_proto_rawDescData = file_ormevents_events_proto_rawDesc
+)
+
+func file_ormevents_events_proto_rawDescGZIP() []byte {
+	file_ormevents_events_proto_rawDescOnce.Do(func() {
+		file_ormevents_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_ormevents_events_proto_rawDescData)
+	})
+	return file_ormevents_events_proto_rawDescData
+}
+
+var file_ormevents_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ormevents_events_proto_goTypes = []any{
+	(*EventCreate)(nil), // 0: cosmos.orm.events.v1.EventCreate
+	(*EventUpdate)(nil), // 1: cosmos.orm.events.v1.EventUpdate
+	(*EventDelete)(nil), // 2: cosmos.orm.events.v1.EventDelete
+	(*anypb.Any)(nil),   // 3: google.protobuf.Any
+}
+var file_ormevents_events_proto_depIdxs = []int32{
+	3, // 0: cosmos.orm.events.v1.EventCreate.new:type_name -> google.protobuf.Any
+	3, // 1: cosmos.orm.events.v1.EventUpdate.old:type_name -> google.protobuf.Any
+	3, // 2: cosmos.orm.events.v1.EventUpdate.new:type_name -> google.protobuf.Any
+	3, // 3: cosmos.orm.events.v1.EventDelete.old:type_name -> google.protobuf.Any
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_ormevents_events_proto_init() }
+func file_ormevents_events_proto_init() {
+	if File_ormevents_events_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ormevents_events_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*EventCreate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ormevents_events_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*EventUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ormevents_events_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*EventDelete); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ormevents_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ormevents_events_proto_goTypes,
+		DependencyIndexes: file_ormevents_events_proto_depIdxs,
+		MessageInfos:      file_ormevents_events_proto_msgTypes,
+	}.Build()
+	File_ormevents_events_proto = out.File
+	file_ormevents_events_proto_rawDesc = nil
+	file_ormevents_events_proto_goTypes = nil
+	file_ormevents_events_proto_depIdxs = nil
+}
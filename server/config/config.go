@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"math"
+	"strconv"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -88,6 +90,14 @@ type BaseConfig struct {
 	// IAVLDisableFastNode enables or disables the fast sync node.
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
 
+	// ParallelExecWorkers defines the number of workers executing the txs of a
+	// block in parallel. Txs are executed sequentially if it is zero.
+	ParallelExecWorkers int `mapstructure:"parallel-exec-workers"`
+
+	// VersionedKVIndex enables the versioned KV index, serving the historical
+	// queries which do not request proofs.
+	VersionedKVIndex bool `mapstructure:"versioned-kv-index"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -156,6 +166,11 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotIncrementalInterval sets the interval at which incremental state
+	// sync snapshots are taken on top of the latest snapshot. 0 disables
+	// incremental snapshots.
+	SnapshotIncrementalInterval uint64 `mapstructure:"snapshot-incremental-interval"`
 }
 
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
@@ -166,6 +181,24 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int `mapstructure:"max-txs"`
+
+	// MaxTxsPerSender defines the maximum amount of txs of a single sender, zero
+	// indicates that it is unbounded. When the mempool is full, the lowest
+	// priority txs are evicted in favor of higher priority ones.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender"`
+
+	// TxTTLBlocks defines the number of blocks after which a tx is evicted, zero
+	// indicates that txs don't expire by height.
+	TxTTLBlocks int64 `mapstructure:"tx-ttl-blocks"`
+
+	// TxTTL defines the duration after which a tx is evicted, zero indicates
+	// that txs don't expire by time.
+	TxTTL time.Duration `mapstructure:"tx-ttl"`
+
+	// MinReplacementFeeBump defines the minimum fee increase, in percent, of a tx
+	// replacing a tx with the same sender and nonce, zero indicates that any
+	// replacement is accepted.
+	MinReplacementFeeBump uint64 `mapstructure:"min-replacement-fee-bump"`
 }
 
 // State Streaming configuration
@@ -173,6 +206,7 @@ type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
 		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		File FileListenerConfig `mapstructure:"file"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
@@ -180,6 +214,16 @@ type (
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
 	}
+	// FileListenerConfig defines application configuration for the in-process
+	// streaming service writing to local files
+	FileListenerConfig struct {
+		Enable        bool     `mapstructure:"enable"`
+		Keys          []string `mapstructure:"keys"`
+		Dir           string   `mapstructure:"dir"`
+		Format        string   `mapstructure:"format"`
+		RotateBlocks  uint64   `mapstructure:"rotate-blocks"`
+		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -229,6 +273,8 @@ func DefaultConfig() *Config {
 			IndexEvents:         make([]string, 0),
 			IAVLCacheSize:       781250,
 			IAVLDisableFastNode: false,
+			ParallelExecWorkers: 0,
+			VersionedKVIndex:    false,
 			AppDBBackend:        "",
 		},
 		Telemetry: telemetry.Config{
@@ -253,14 +299,23 @@ func DefaultConfig() *Config {
 			Enable: true,
 		},
 		StateSync: StateSyncConfig{
-			SnapshotInterval:   0,
-			SnapshotKeepRecent: 2,
+			SnapshotInterval:            0,
+			SnapshotKeepRecent:          2,
+			SnapshotIncrementalInterval: 0,
 		},
 		Streaming: StreamingConfig{
 			ABCI: ABCIListenerConfig{
 				Keys:          []string{},
 				StopNodeOnErr: true,
 			},
+			File: FileListenerConfig{
+				Enable:        false,
+				Keys:          []string{},
+				Dir:           "data/streaming",
+				Format:        "jsonl",
+				RotateBlocks:  1000,
+				StopNodeOnErr: true,
+			},
 		},
 		Mempool: MempoolConfig{
 			MaxTxs: -1,
@@ -285,5 +340,59 @@ func (c Config) ValidateBasic() error {
 		)
 	}
 
+	if err := c.validateIncrementalSnapshots(); err != nil {
+		return err
+	}
+
+	if c.ParallelExecWorkers < 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("parallel-exec-workers must not be negative, got %d", c.ParallelExecWorkers)
+	}
+
+	if c.Mempool.MaxTxsPerSender < 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("mempool.max-txs-per-sender must not be negative, got %d", c.Mempool.MaxTxsPerSender)
+	}
+	if c.Mempool.TxTTLBlocks < 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("mempool.tx-ttl-blocks must not be negative, got %d", c.Mempool.TxTTLBlocks)
+	}
+	if c.Mempool.TxTTL < 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("mempool.tx-ttl must not be negative, got %s", c.Mempool.TxTTL)
+	}
+
+	return nil
+}
+
+// validateIncrementalSnapshots checks that the incremental snapshots have a
+// base snapshot, and that pruning keeps the versions since the base snapshot
+// until the incremental snapshot is taken.
+func (c Config) validateIncrementalSnapshots() error {
+	interval := c.StateSync.SnapshotIncrementalInterval
+	if interval == 0 {
+		return nil
+	}
+	if c.StateSync.SnapshotInterval == 0 {
+		return sdkerrors.ErrAppConfig.Wrap("state-sync.snapshot-incremental-interval requires state-sync.snapshot-interval")
+	}
+
+	var keepRecent uint64
+	switch c.Pruning {
+	case pruningtypes.PruningOptionNothing:
+		return nil
+	case pruningtypes.PruningOptionCustom:
+		var err error
+		keepRecent, err = strconv.ParseUint(c.PruningKeepRecent, 10, 64)
+		if err != nil {
+			return sdkerrors.ErrAppConfig.Wrapf("invalid pruning-keep-recent %q: %s", c.PruningKeepRecent, err)
+		}
+	default:
+		keepRecent = pruningtypes.NewPruningOptionsFromString(c.Pruning).KeepRecent
+	}
+
+	// the base snapshot is taken at most one incremental interval earlier
+	if keepRecent < interval {
+		return sdkerrors.ErrAppConfig.Wrapf(
+			"pruning-keep-recent %d must not be less than state-sync.snapshot-incremental-interval %d", keepRecent, interval,
+		)
+	}
+
 	return nil
 }
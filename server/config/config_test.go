@@ -5,11 +5,14 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	pruningtypes "cosmossdk.io/store/pruning/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -52,6 +55,14 @@ func TestStreamingConfig(t *testing.T) {
 				Plugin:        "plugin-A",
 				StopNodeOnErr: false,
 			},
+			File: FileListenerConfig{
+				Enable:        true,
+				Keys:          []string{"three"},
+				Dir:           "/var/streaming",
+				Format:        "jsonl-columns",
+				RotateBlocks:  100,
+				StopNodeOnErr: true,
+			},
 		},
 	}
 
@@ -68,6 +79,12 @@ func TestStreamingConfig(t *testing.T) {
 		`keys = ["one", "two", ]`,
 		`plugin = "plugin-A"`,
 		`stop-node-on-err = false`,
+		`enable = true`,
+		`keys = ["three", ]`,
+		`dir = "/var/streaming"`,
+		`format = "jsonl-columns"`,
+		`rotate-blocks = 100`,
+		`stop-node-on-err = true`,
 	}
 
 	for _, line := range expectedLines {
@@ -234,3 +251,57 @@ func TestAppConfig(t *testing.T) {
 	require.NoError(t, v.Unmarshal(appCfg))
 	require.EqualValues(t, appCfg, defAppConfig)
 }
+
+func TestValidateParallelExecWorkers(t *testing.T) {
+	cfg := DefaultConfig()
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.ParallelExecWorkers = 4
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.ParallelExecWorkers = -1
+	require.Error(t, cfg.ValidateBasic())
+}
+
+func TestValidateMempool(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Mempool.MaxTxsPerSender = 16
+	cfg.Mempool.TxTTLBlocks = 10
+	cfg.Mempool.TxTTL = time.Minute
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg = DefaultConfig()
+	cfg.Mempool.MaxTxsPerSender = -1
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg = DefaultConfig()
+	cfg.Mempool.TxTTLBlocks = -1
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg = DefaultConfig()
+	cfg.Mempool.TxTTL = -time.Second
+	require.Error(t, cfg.ValidateBasic())
+}
+
+func TestValidateIncrementalSnapshots(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.StateSync.SnapshotIncrementalInterval = 100
+	require.ErrorContains(t, cfg.ValidateBasic(), "requires state-sync.snapshot-interval")
+
+	cfg.StateSync.SnapshotInterval = 1000
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Pruning = pruningtypes.PruningOptionNothing
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Pruning = pruningtypes.PruningOptionCustom
+	cfg.PruningKeepRecent = "100"
+	cfg.PruningInterval = "10"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.PruningKeepRecent = "99"
+	require.ErrorContains(t, cfg.ValidateBasic(), "must not be less than state-sync.snapshot-incremental-interval")
+
+	cfg.Pruning = pruningtypes.PruningOptionEverything
+	require.Error(t, cfg.ValidateBasic())
+}
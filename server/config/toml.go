@@ -81,6 +81,18 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Default is false.
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 
+# ParallelExecWorkers defines the number of workers executing the txs of a block
+# in parallel. The results and the app hash are the same as when executing them
+# sequentially, which is the case when it is 0.
+parallel-exec-workers = {{ .BaseConfig.ParallelExecWorkers }}
+
+# VersionedKVIndex maintains a flat index of the versions of every key at Commit,
+# stored in data/versioned_kv.db, and serves the historical queries which do not
+# request proofs from it instead of loading the IAVL trees of past heights.
+# The history committed before enabling it is written with the
+# 'versioned-kv backfill' command.
+versioned-kv-index = {{ .BaseConfig.VersionedKVIndex }}
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # The fallback is the db_backend value set in CometBFT's config.toml.
@@ -208,6 +220,11 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# snapshot-incremental-interval specifies the block interval at which incremental snapshots are
+# taken on top of the latest snapshot (0 to disable). It requires snapshot-interval, and the
+# pruning must keep at least as many recent heights.
+snapshot-incremental-interval = {{ .StateSync.SnapshotIncrementalInterval }}
+
 ###############################################################################
 ###                              State Streaming                            ###
 ###############################################################################
@@ -234,6 +251,35 @@ plugin = "{{ .Streaming.ABCI.Plugin }}"
 # stop-node-on-err specifies whether to stop the node on message delivery error.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# streaming.file specifies the configuration for the in-process streaming service
+# writing every block to local files, which indexers on the same host can tail.
+[streaming.file]
+
+# enable defines if the file streaming service should be enabled.
+enable = {{ .Streaming.File.Enable }}
+
+# List of kv store keys whose changes are written to the files.
+# The store key names MUST match the module's StoreKey name.
+# ["*"] to write the changes of all keys.
+keys = [{{ range .Streaming.File.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# dir is the directory the files are written to, relative to the node home
+# directory if it is not absolute.
+dir = "{{ .Streaming.File.Dir }}"
+
+# format is the layout of the state changes written for every block.
+# jsonl: an array of store key-value pairs
+# jsonl-columns: one JSON array per store key-value pair field (store_key, delete, key, value)
+format = "{{ .Streaming.File.Format }}"
+
+# rotate-blocks is the number of blocks written to a file before starting a new
+# one, named after the height of its first block (0 to never rotate).
+rotate-blocks = {{ .Streaming.File.RotateBlocks }}
+
+# stop-node-on-err specifies whether to stop the node when a block can't be
+# written. When enabled, every block is synced to disk before being acknowledged.
+stop-node-on-err = {{ .Streaming.File.StopNodeOnErr }}
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -246,6 +292,27 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = {{ .Mempool.MaxTxs }}
+
+# Setting any of the options below enables the bounded priority mempool, which
+# orders txs by priority and evicts the lowest priority txs in favor of higher
+# priority ones when max-txs is reached, instead of rejecting them.
+#
+# MaxTxsPerSender defines the maximum amount of txs of a single sender in the
+# mempool, 0 for no limit.
+max-txs-per-sender = {{ .Mempool.MaxTxsPerSender }}
+
+# TxTTLBlocks defines the number of blocks after which a tx is evicted from the
+# mempool, 0 to disable it.
+tx-ttl-blocks = {{ .Mempool.TxTTLBlocks }}
+
+# TxTTL defines the duration (e.g. "10m") after which a tx is evicted from the
+# mempool, 0 to disable it.
+tx-ttl = "{{ .Mempool.TxTTL }}"
+
+# MinReplacementFeeBump defines the minimum fee increase, in percent, of a tx
+# replacing a tx with the same sender and nonce. A replacement with a lower bump
+# is rejected. Any replacement is accepted if it is 0.
+min-replacement-fee-bump = {{ .Mempool.MinReplacementFeeBump }}
 `
 
 var configTemplate *template.Template
@@ -3,10 +3,13 @@ package v2alpha1
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -17,6 +20,9 @@ type Config struct {
 	ChainID           string
 	SdkConfig         *sdk.Config
 	InterfaceRegistry codectypes.InterfaceRegistry
+	// GenesisSchemas are the collections schemas of the application modules,
+	// keyed by the name of the module store.
+	GenesisSchemas map[string]collections.Schema
 }
 
 // Register registers the cosmos sdk reflection service
@@ -58,6 +64,10 @@ func (r reflectionServiceServer) GetTxDescriptor(_ context.Context, _ *GetTxDesc
 	return &GetTxDescriptorResponse{Tx: r.desc.Tx}, nil
 }
 
+func (r reflectionServiceServer) GetGenesisSchemasDescriptor(_ context.Context, _ *GetGenesisSchemasDescriptorRequest) (*GetGenesisSchemasDescriptorResponse, error) {
+	return &GetGenesisSchemasDescriptorResponse{GenesisSchemas: r.desc.GenesisSchemas}, nil
+}
+
 func newReflectionServiceServer(grpcSrv *grpc.Server, conf Config) (reflectionServiceServer, error) {
 	// set chain descriptor
 	chainDescriptor := &ChainDescriptor{Id: conf.ChainID}
@@ -76,13 +86,19 @@ func newReflectionServiceServer(grpcSrv *grpc.Server, conf Config) (reflectionSe
 		return reflectionServiceServer{}, fmt.Errorf("unable to create deliver descriptor: %w", err)
 	}
 	authnDescriptor := newAuthnDescriptor(conf.SigningModes)
+	// set genesis schemas descriptor
+	genesisSchemasDescriptor, err := newGenesisSchemasDescriptor(conf.GenesisSchemas)
+	if err != nil {
+		return reflectionServiceServer{}, fmt.Errorf("unable to create genesis schemas descriptor: %w", err)
+	}
 	desc := &AppDescriptor{
-		Authn:         authnDescriptor,
-		Chain:         chainDescriptor,
-		Codec:         codecDescriptor,
-		Configuration: configurationDescriptor,
-		QueryServices: queryServiceDescriptor,
-		Tx:            txDescriptor,
+		Authn:          authnDescriptor,
+		Chain:          chainDescriptor,
+		Codec:          codecDescriptor,
+		Configuration:  configurationDescriptor,
+		QueryServices:  queryServiceDescriptor,
+		Tx:             txDescriptor,
+		GenesisSchemas: genesisSchemasDescriptor,
 	}
 
 	ifaceList := make([]string, len(desc.Codec.Interfaces))
@@ -197,3 +213,26 @@ func newAuthnDescriptor(signingModes map[string]int32) *AuthnDescriptor {
 	}
 	return &AuthnDescriptor{SignModes: signModesDesc}
 }
+
+// newGenesisSchemasDescriptor describes the collections genesis of the modules
+// given their collections schemas.
+func newGenesisSchemasDescriptor(schemas map[string]collections.Schema) (*GenesisSchemasDescriptor, error) {
+	modules := make([]string, 0, len(schemas))
+	for module := range schemas {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	moduleDescriptors := make([]*ModuleGenesisSchemaDescriptor, 0, len(modules))
+	for _, module := range modules {
+		jsonSchema, err := schemas[module].JSONSchema()
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate the genesis JSON schema of module %s: %w", module, err)
+		}
+		moduleDescriptors = append(moduleDescriptors, &ModuleGenesisSchemaDescriptor{
+			Module:     module,
+			JsonSchema: string(jsonSchema),
+		})
+	}
+	return &GenesisSchemasDescriptor{Modules: moduleDescriptors}, nil
+}
@@ -43,6 +43,8 @@ type AppDescriptor struct {
 	QueryServices *QueryServicesDescriptor `protobuf:"bytes,5,opt,name=query_services,json=queryServices,proto3" json:"query_services,omitempty"`
 	// tx provides metadata information regarding how to send transactions to the given application
 	Tx *TxDescriptor `protobuf:"bytes,6,opt,name=tx,proto3" json:"tx,omitempty"`
+	// genesis_schemas provides the JSON schemas of the collections genesis of the application modules
+	GenesisSchemas *GenesisSchemasDescriptor `protobuf:"bytes,7,opt,name=genesis_schemas,json=genesisSchemas,proto3" json:"genesis_schemas,omitempty"`
 }
 
 func (m *AppDescriptor) Reset()         { *m = AppDescriptor{} }
@@ -120,6 +122,13 @@ func (m *AppDescriptor) GetTx() *TxDescriptor {
 	return nil
 }
 
+func (m *AppDescriptor) GetGenesisSchemas() *GenesisSchemasDescriptor {
+	if m != nil {
+		return m.GenesisSchemas
+	}
+	return nil
+}
+
 // TxDescriptor describes the accepted transaction type
 type TxDescriptor struct {
 	// fullname is the protobuf fullname of the raw transaction type (for instance the tx.Tx type)
@@ -657,6 +666,108 @@ func (m *MsgDescriptor) GetMsgTypeUrl() string {
 	return ""
 }
 
+// GenesisSchemasDescriptor contains the JSON schemas of the collections genesis of the modules
+type GenesisSchemasDescriptor struct {
+	// modules is a list of ModuleGenesisSchemaDescriptor, sorted by module name
+	Modules []*ModuleGenesisSchemaDescriptor `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+}
+
+func (m *GenesisSchemasDescriptor) Reset()         { *m = GenesisSchemasDescriptor{} }
+func (m *GenesisSchemasDescriptor) String() string { return proto.CompactTextString(m) }
+func (*GenesisSchemasDescriptor) ProtoMessage()    {}
+func (*GenesisSchemasDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{11}
+}
+func (m *GenesisSchemasDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisSchemasDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisSchemasDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisSchemasDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisSchemasDescriptor.Merge(m, src)
+}
+func (m *GenesisSchemasDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisSchemasDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisSchemasDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisSchemasDescriptor proto.InternalMessageInfo
+
+func (m *GenesisSchemasDescriptor) GetModules() []*ModuleGenesisSchemaDescriptor {
+	if m != nil {
+		return m.Modules
+	}
+	return nil
+}
+
+// ModuleGenesisSchemaDescriptor describes the collections genesis of a module
+type ModuleGenesisSchemaDescriptor struct {
+	// module is the name of the module store
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// json_schema is the JSON schema document of the genesis of the module collections,
+	// as exported by collections.Schema
+	JsonSchema string `protobuf:"bytes,2,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+}
+
+func (m *ModuleGenesisSchemaDescriptor) Reset()         { *m = ModuleGenesisSchemaDescriptor{} }
+func (m *ModuleGenesisSchemaDescriptor) String() string { return proto.CompactTextString(m) }
+func (*ModuleGenesisSchemaDescriptor) ProtoMessage()    {}
+func (*ModuleGenesisSchemaDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{12}
+}
+func (m *ModuleGenesisSchemaDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleGenesisSchemaDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleGenesisSchemaDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleGenesisSchemaDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleGenesisSchemaDescriptor.Merge(m, src)
+}
+func (m *ModuleGenesisSchemaDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleGenesisSchemaDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleGenesisSchemaDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleGenesisSchemaDescriptor proto.InternalMessageInfo
+
+func (m *ModuleGenesisSchemaDescriptor) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ModuleGenesisSchemaDescriptor) GetJsonSchema() string {
+	if m != nil {
+		return m.JsonSchema
+	}
+	return ""
+}
+
 // GetAuthnDescriptorRequest is the request used for the GetAuthnDescriptor RPC
 type GetAuthnDescriptorRequest struct {
 }
@@ -665,7 +776,7 @@ func (m *GetAuthnDescriptorRequest) Reset()         { *m = GetAuthnDescriptorReq
 func (m *GetAuthnDescriptorRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAuthnDescriptorRequest) ProtoMessage()    {}
 func (*GetAuthnDescriptorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{11}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{13}
 }
 func (m *GetAuthnDescriptorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -704,7 +815,7 @@ func (m *GetAuthnDescriptorResponse) Reset()         { *m = GetAuthnDescriptorRe
 func (m *GetAuthnDescriptorResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAuthnDescriptorResponse) ProtoMessage()    {}
 func (*GetAuthnDescriptorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{12}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{14}
 }
 func (m *GetAuthnDescriptorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -748,7 +859,7 @@ func (m *GetChainDescriptorRequest) Reset()         { *m = GetChainDescriptorReq
 func (m *GetChainDescriptorRequest) String() string { return proto.CompactTextString(m) }
 func (*GetChainDescriptorRequest) ProtoMessage()    {}
 func (*GetChainDescriptorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{13}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{15}
 }
 func (m *GetChainDescriptorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -787,7 +898,7 @@ func (m *GetChainDescriptorResponse) Reset()         { *m = GetChainDescriptorRe
 func (m *GetChainDescriptorResponse) String() string { return proto.CompactTextString(m) }
 func (*GetChainDescriptorResponse) ProtoMessage()    {}
 func (*GetChainDescriptorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{14}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{16}
 }
 func (m *GetChainDescriptorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,7 +942,7 @@ func (m *GetCodecDescriptorRequest) Reset()         { *m = GetCodecDescriptorReq
 func (m *GetCodecDescriptorRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCodecDescriptorRequest) ProtoMessage()    {}
 func (*GetCodecDescriptorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{15}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{17}
 }
 func (m *GetCodecDescriptorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,7 +981,7 @@ func (m *GetCodecDescriptorResponse) Reset()         { *m = GetCodecDescriptorRe
 func (m *GetCodecDescriptorResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCodecDescriptorResponse) ProtoMessage()    {}
 func (*GetCodecDescriptorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{16}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{18}
 }
 func (m *GetCodecDescriptorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -914,7 +1025,7 @@ func (m *GetConfigurationDescriptorRequest) Reset()         { *m = GetConfigurat
 func (m *GetConfigurationDescriptorRequest) String() string { return proto.CompactTextString(m) }
 func (*GetConfigurationDescriptorRequest) ProtoMessage()    {}
 func (*GetConfigurationDescriptorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{17}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{19}
 }
 func (m *GetConfigurationDescriptorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -953,7 +1064,7 @@ func (m *GetConfigurationDescriptorResponse) Reset()         { *m = GetConfigura
 func (m *GetConfigurationDescriptorResponse) String() string { return proto.CompactTextString(m) }
 func (*GetConfigurationDescriptorResponse) ProtoMessage()    {}
 func (*GetConfigurationDescriptorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{18}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{20}
 }
 func (m *GetConfigurationDescriptorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -997,7 +1108,7 @@ func (m *GetQueryServicesDescriptorRequest) Reset()         { *m = GetQueryServi
 func (m *GetQueryServicesDescriptorRequest) String() string { return proto.CompactTextString(m) }
 func (*GetQueryServicesDescriptorRequest) ProtoMessage()    {}
 func (*GetQueryServicesDescriptorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{19}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{21}
 }
 func (m *GetQueryServicesDescriptorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1036,7 +1147,7 @@ func (m *GetQueryServicesDescriptorResponse) Reset()         { *m = GetQueryServ
 func (m *GetQueryServicesDescriptorResponse) String() string { return proto.CompactTextString(m) }
 func (*GetQueryServicesDescriptorResponse) ProtoMessage()    {}
 func (*GetQueryServicesDescriptorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{20}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{22}
 }
 func (m *GetQueryServicesDescriptorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1191,7 @@ func (m *GetTxDescriptorRequest) Reset()         { *m = GetTxDescriptorRequest{}
 func (m *GetTxDescriptorRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxDescriptorRequest) ProtoMessage()    {}
 func (*GetTxDescriptorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{21}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{23}
 }
 func (m *GetTxDescriptorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1120,7 +1231,7 @@ func (m *GetTxDescriptorResponse) Reset()         { *m = GetTxDescriptorResponse
 func (m *GetTxDescriptorResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxDescriptorResponse) ProtoMessage()    {}
 func (*GetTxDescriptorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{22}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{24}
 }
 func (m *GetTxDescriptorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1156,6 +1267,89 @@ func (m *GetTxDescriptorResponse) GetTx() *TxDescriptor {
 	return nil
 }
 
+// GetGenesisSchemasDescriptorRequest is the request used for the GetGenesisSchemasDescriptor RPC
+type GetGenesisSchemasDescriptorRequest struct {
+}
+
+func (m *GetGenesisSchemasDescriptorRequest) Reset()         { *m = GetGenesisSchemasDescriptorRequest{} }
+func (m *GetGenesisSchemasDescriptorRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGenesisSchemasDescriptorRequest) ProtoMessage()    {}
+func (*GetGenesisSchemasDescriptorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{25}
+}
+func (m *GetGenesisSchemasDescriptorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetGenesisSchemasDescriptorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetGenesisSchemasDescriptorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetGenesisSchemasDescriptorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGenesisSchemasDescriptorRequest.Merge(m, src)
+}
+func (m *GetGenesisSchemasDescriptorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetGenesisSchemasDescriptorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGenesisSchemasDescriptorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGenesisSchemasDescriptorRequest proto.InternalMessageInfo
+
+// GetGenesisSchemasDescriptorResponse is the response returned by the GetGenesisSchemasDescriptor RPC
+type GetGenesisSchemasDescriptorResponse struct {
+	// genesis_schemas provides the JSON schemas of the collections genesis of the modules
+	GenesisSchemas *GenesisSchemasDescriptor `protobuf:"bytes,1,opt,name=genesis_schemas,json=genesisSchemas,proto3" json:"genesis_schemas,omitempty"`
+}
+
+func (m *GetGenesisSchemasDescriptorResponse) Reset()         { *m = GetGenesisSchemasDescriptorResponse{} }
+func (m *GetGenesisSchemasDescriptorResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGenesisSchemasDescriptorResponse) ProtoMessage()    {}
+func (*GetGenesisSchemasDescriptorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{26}
+}
+func (m *GetGenesisSchemasDescriptorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetGenesisSchemasDescriptorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetGenesisSchemasDescriptorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetGenesisSchemasDescriptorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGenesisSchemasDescriptorResponse.Merge(m, src)
+}
+func (m *GetGenesisSchemasDescriptorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetGenesisSchemasDescriptorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGenesisSchemasDescriptorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGenesisSchemasDescriptorResponse proto.InternalMessageInfo
+
+func (m *GetGenesisSchemasDescriptorResponse) GetGenesisSchemas() *GenesisSchemasDescriptor {
+	if m != nil {
+		return m.GenesisSchemas
+	}
+	return nil
+}
+
 // QueryServicesDescriptor contains the list of cosmos-sdk queriable services
 type QueryServicesDescriptor struct {
 	// query_services is a list of cosmos-sdk QueryServiceDescriptor
@@ -1166,7 +1360,7 @@ func (m *QueryServicesDescriptor) Reset()         { *m = QueryServicesDescriptor
 func (m *QueryServicesDescriptor) String() string { return proto.CompactTextString(m) }
 func (*QueryServicesDescriptor) ProtoMessage()    {}
 func (*QueryServicesDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{23}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{27}
 }
 func (m *QueryServicesDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1216,7 +1410,7 @@ func (m *QueryServiceDescriptor) Reset()         { *m = QueryServiceDescriptor{}
 func (m *QueryServiceDescriptor) String() string { return proto.CompactTextString(m) }
 func (*QueryServiceDescriptor) ProtoMessage()    {}
 func (*QueryServiceDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{24}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{28}
 }
 func (m *QueryServiceDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1281,7 +1475,7 @@ func (m *QueryMethodDescriptor) Reset()         { *m = QueryMethodDescriptor{} }
 func (m *QueryMethodDescriptor) String() string { return proto.CompactTextString(m) }
 func (*QueryMethodDescriptor) ProtoMessage()    {}
 func (*QueryMethodDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{25}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{29}
 }
 func (m *QueryMethodDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1336,6 +1530,8 @@ func init() {
 	proto.RegisterType((*InterfaceAcceptingMessageDescriptor)(nil), "cosmos.base.reflection.v2alpha1.InterfaceAcceptingMessageDescriptor")
 	proto.RegisterType((*ConfigurationDescriptor)(nil), "cosmos.base.reflection.v2alpha1.ConfigurationDescriptor")
 	proto.RegisterType((*MsgDescriptor)(nil), "cosmos.base.reflection.v2alpha1.MsgDescriptor")
+	proto.RegisterType((*GenesisSchemasDescriptor)(nil), "cosmos.base.reflection.v2alpha1.GenesisSchemasDescriptor")
+	proto.RegisterType((*ModuleGenesisSchemaDescriptor)(nil), "cosmos.base.reflection.v2alpha1.ModuleGenesisSchemaDescriptor")
 	proto.RegisterType((*GetAuthnDescriptorRequest)(nil), "cosmos.base.reflection.v2alpha1.GetAuthnDescriptorRequest")
 	proto.RegisterType((*GetAuthnDescriptorResponse)(nil), "cosmos.base.reflection.v2alpha1.GetAuthnDescriptorResponse")
 	proto.RegisterType((*GetChainDescriptorRequest)(nil), "cosmos.base.reflection.v2alpha1.GetChainDescriptorRequest")
@@ -1348,6 +1544,8 @@ func init() {
 	proto.RegisterType((*GetQueryServicesDescriptorResponse)(nil), "cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse")
 	proto.RegisterType((*GetTxDescriptorRequest)(nil), "cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest")
 	proto.RegisterType((*GetTxDescriptorResponse)(nil), "cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse")
+	proto.RegisterType((*GetGenesisSchemasDescriptorRequest)(nil), "cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorRequest")
+	proto.RegisterType((*GetGenesisSchemasDescriptorResponse)(nil), "cosmos.base.reflection.v2alpha1.GetGenesisSchemasDescriptorResponse")
 	proto.RegisterType((*QueryServicesDescriptor)(nil), "cosmos.base.reflection.v2alpha1.QueryServicesDescriptor")
 	proto.RegisterType((*QueryServiceDescriptor)(nil), "cosmos.base.reflection.v2alpha1.QueryServiceDescriptor")
 	proto.RegisterType((*QueryMethodDescriptor)(nil), "cosmos.base.reflection.v2alpha1.QueryMethodDescriptor")
@@ -1358,80 +1556,89 @@ func init() {
 }
 
 var fileDescriptor_15c91f0b8d6bf3d0 = []byte{
-	// 1155 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x3a, 0xbf, 0x5f, 0x9b, 0x46, 0x0c, 0x24, 0x71, 0xdd, 0xe2, 0xa6, 0x1b, 0x09, 0xf5,
-	0x52, 0xbb, 0x49, 0xa3, 0xb4, 0x82, 0x94, 0xca, 0x69, 0x68, 0x15, 0x89, 0xa0, 0xe0, 0xa4, 0x80,
-	0x10, 0xea, 0x6a, 0xbd, 0x3b, 0x5e, 0x8f, 0xf0, 0xee, 0x6c, 0x76, 0xc6, 0xc1, 0xb9, 0x72, 0xe0,
-	0x0c, 0xe2, 0x4f, 0xe0, 0xc0, 0x9d, 0xbf, 0x02, 0xc1, 0xa5, 0x12, 0x17, 0x8e, 0x28, 0x41, 0xe2,
-	0x00, 0x7f, 0x04, 0x9a, 0x1f, 0x76, 0xc6, 0xce, 0xda, 0xde, 0x24, 0x3d, 0x25, 0xb3, 0xef, 0xbd,
-	0x6f, 0xbe, 0xef, 0xed, 0xe8, 0x7d, 0xb3, 0x86, 0x07, 0x1e, 0x65, 0x21, 0x65, 0xe5, 0x9a, 0xcb,
-	0x70, 0x39, 0xc1, 0xf5, 0x26, 0xf6, 0x38, 0xa1, 0x51, 0xf9, 0x68, 0xcd, 0x6d, 0xc6, 0x0d, 0x77,
-	0xd5, 0x78, 0x56, 0x8a, 0x13, 0xca, 0x29, 0xba, 0xa3, 0x2a, 0x4a, 0xa2, 0xa2, 0x64, 0x44, 0x3b,
-	0x15, 0x85, 0xdb, 0x01, 0xa5, 0x41, 0x13, 0x97, 0xdd, 0x98, 0x94, 0xdd, 0x28, 0xa2, 0xdc, 0x15,
-	0x71, 0xa6, 0xca, 0xed, 0x7f, 0xc6, 0x61, 0xae, 0x12, 0xc7, 0xdb, 0x98, 0x79, 0x09, 0x89, 0x39,
-	0x4d, 0xd0, 0x73, 0x98, 0x74, 0x5b, 0xbc, 0x11, 0xe5, 0xad, 0x65, 0xeb, 0xde, 0xb5, 0xb5, 0x07,
-	0xa5, 0x11, 0x1b, 0x94, 0x2a, 0x22, 0xfb, 0x0c, 0xa0, 0xaa, 0xca, 0x05, 0x8e, 0xd7, 0x70, 0x49,
-	0x94, 0xcf, 0x65, 0xc4, 0x79, 0x26, 0xb2, 0x4d, 0x1c, 0x59, 0x2e, 0x71, 0xa8, 0x8f, 0xbd, 0xfc,
-	0x78, 0x56, 0x1c, 0x91, 0xdd, 0x83, 0x23, 0x1e, 0xa0, 0x57, 0x30, 0xe7, 0xd1, 0xa8, 0x4e, 0x82,
-	0x56, 0x22, 0x3b, 0x90, 0x9f, 0x90, 0x78, 0x8f, 0x33, 0xe0, 0x19, 0x55, 0x06, 0x6e, 0x2f, 0x1c,
-	0x72, 0xe0, 0xc6, 0x61, 0x0b, 0x27, 0xc7, 0x0e, 0xc3, 0xc9, 0x11, 0xf1, 0x30, 0xcb, 0x4f, 0x66,
-	0xdc, 0xe0, 0x53, 0x51, 0xb6, 0xaf, 0xab, 0xcc, 0x0d, 0x0e, 0xcd, 0x00, 0x7a, 0x02, 0x39, 0xde,
-	0xce, 0x4f, 0x49, 0xd0, 0xfb, 0x23, 0x41, 0x0f, 0xda, 0x06, 0x52, 0x8e, 0xb7, 0xed, 0x08, 0xae,
-	0x9b, 0xcf, 0x50, 0x01, 0x66, 0xea, 0xad, 0x66, 0x33, 0x72, 0x43, 0x2c, 0x5f, 0xf5, 0x6c, 0xb5,
-	0xbb, 0x46, 0x5b, 0x30, 0x11, 0xb2, 0x80, 0xe5, 0x73, 0xcb, 0xe3, 0xf7, 0xae, 0xad, 0x95, 0x46,
-	0x6e, 0xb6, 0xcb, 0x02, 0x63, 0x37, 0x59, 0x6b, 0x37, 0x60, 0xbe, 0xef, 0x64, 0xa0, 0x97, 0x00,
-	0x8c, 0x04, 0x91, 0x13, 0x52, 0x1f, 0xb3, 0xbc, 0x25, 0xc1, 0x37, 0x46, 0x82, 0xef, 0x93, 0x20,
-	0x22, 0x51, 0xb0, 0x4b, 0x7d, 0x6c, 0x6c, 0x32, 0x2b, 0x90, 0xc4, 0x33, 0x66, 0xff, 0x60, 0xc1,
-	0x42, 0x6a, 0x12, 0x42, 0x30, 0x61, 0xe8, 0x93, 0xff, 0xa3, 0x45, 0x98, 0x8a, 0x5a, 0x61, 0x0d,
-	0x27, 0xf2, 0x60, 0x4e, 0x56, 0xf5, 0x0a, 0x7d, 0x0c, 0x2b, 0xf2, 0xe0, 0x3a, 0x24, 0xaa, 0x53,
-	0x27, 0x4e, 0xe8, 0x11, 0xf1, 0x71, 0xe2, 0x84, 0x98, 0x37, 0xa8, 0xef, 0x74, 0x5b, 0x35, 0x2e,
-	0xa1, 0xee, 0xc8, 0xd4, 0x9d, 0xa8, 0x4e, 0xf7, 0x74, 0xe2, 0xae, 0xcc, 0x7b, 0xae, 0xd3, 0xec,
-	0xbb, 0x30, 0xdf, 0x77, 0x9e, 0xd1, 0x0d, 0xc8, 0x11, 0x5f, 0x53, 0xc9, 0x11, 0xdf, 0x0e, 0x60,
-	0xbe, 0xef, 0xa8, 0xa2, 0x03, 0x00, 0x12, 0x71, 0x9c, 0xd4, 0x5d, 0xaf, 0xdb, 0xa0, 0xf5, 0x91,
-	0x0d, 0xda, 0xe9, 0x94, 0x18, 0xed, 0x31, 0x70, 0xec, 0x5f, 0x72, 0xf0, 0x76, 0x4a, 0xce, 0xd0,
-	0x13, 0xf0, 0x9d, 0x05, 0xb7, 0xbb, 0x10, 0x8e, 0xeb, 0x79, 0x38, 0xe6, 0x24, 0x0a, 0x9c, 0x10,
-	0x33, 0xe6, 0x06, 0xb8, 0x73, 0x34, 0xb6, 0xb3, 0x93, 0xab, 0x74, 0x30, 0x76, 0x15, 0x84, 0x41,
-	0xb6, 0x40, 0x06, 0x25, 0x31, 0x74, 0x04, 0x8b, 0x67, 0x3c, 0x48, 0x18, 0x37, 0x71, 0x88, 0xc5,
-	0x9a, 0xe5, 0xc7, 0x25, 0x83, 0xa7, 0xd9, 0x19, 0xec, 0x9c, 0x55, 0x1b, 0x9b, 0x2f, 0x90, 0x94,
-	0x38, 0xb3, 0x3f, 0x87, 0xe2, 0xf0, 0xc2, 0xa1, 0xed, 0xbb, 0x09, 0x33, 0xfc, 0x38, 0xc6, 0x4e,
-	0x2b, 0x69, 0xca, 0x63, 0x36, 0x5b, 0x9d, 0x16, 0xeb, 0x97, 0x49, 0xd3, 0xfe, 0x06, 0x56, 0x32,
-	0xf4, 0x64, 0x28, 0xfa, 0x3a, 0x2c, 0xd6, 0x09, 0x6e, 0xfa, 0x8e, 0xdf, 0xcd, 0x77, 0x44, 0x40,
-	0xbd, 0x95, 0xd9, 0xea, 0x3b, 0x32, 0x7a, 0x06, 0xf6, 0x89, 0x88, 0xd9, 0x5f, 0xc1, 0xd2, 0x80,
-	0x51, 0x86, 0x2a, 0xf0, 0x6e, 0x0d, 0x7b, 0x8d, 0x87, 0x6b, 0xe2, 0x4d, 0xd3, 0x56, 0xc4, 0x1d,
-	0xd7, 0xf7, 0x13, 0xcc, 0x98, 0x13, 0x27, 0xb8, 0x4e, 0xda, 0x9a, 0x41, 0x41, 0x25, 0x55, 0x54,
-	0x4e, 0x45, 0xa5, 0xec, 0xc9, 0x0c, 0x7b, 0x15, 0xe6, 0x7a, 0xa6, 0x00, 0x5a, 0x86, 0xeb, 0x21,
-	0x0b, 0x9c, 0x6e, 0x1b, 0x14, 0x04, 0x84, 0x2c, 0x38, 0xd0, 0x9d, 0xb8, 0x05, 0x37, 0x5f, 0x60,
-	0xde, 0x6f, 0x1f, 0xf8, 0xb0, 0x85, 0x19, 0xb7, 0x7d, 0x28, 0xa4, 0x05, 0x59, 0x4c, 0x23, 0x86,
-	0xdf, 0x94, 0x49, 0x69, 0x0a, 0xfd, 0xce, 0xd3, 0x43, 0xe1, 0x5c, 0xf0, 0x8c, 0x82, 0xf2, 0x37,
-	0xeb, 0x4a, 0xfe, 0xd6, 0xa1, 0xd0, 0x67, 0x5a, 0xbd, 0x14, 0xfa, 0x83, 0x06, 0x05, 0x69, 0x8d,
-	0xd6, 0x95, 0xac, 0xd1, 0x5e, 0x81, 0xbb, 0x72, 0x97, 0x74, 0x9f, 0xd3, 0x54, 0x8e, 0xc0, 0x1e,
-	0x96, 0xa4, 0x29, 0xed, 0xc1, 0x94, 0xb2, 0x45, 0xcd, 0xe9, 0xf2, 0xf6, 0xaa, 0x71, 0x34, 0xb9,
-	0x41, 0x1e, 0xa9, 0xc9, 0xb5, 0x25, 0xb9, 0x81, 0x49, 0x9a, 0x5c, 0x15, 0xa6, 0x85, 0xa5, 0x12,
-	0x39, 0x5b, 0xaf, 0xe6, 0xcd, 0x1d, 0x20, 0x3b, 0x0f, 0x8b, 0x2f, 0x30, 0xef, 0x71, 0x5b, 0xcd,
-	0xe9, 0x0b, 0x58, 0x3a, 0x17, 0xd1, 0x44, 0x94, 0x95, 0x5b, 0x97, 0xb5, 0xf2, 0x63, 0x58, 0x1a,
-	0xc0, 0x0b, 0xbd, 0x3a, 0x77, 0x0b, 0x51, 0x2e, 0xf2, 0xe8, 0x42, 0x4a, 0x07, 0x5e, 0x42, 0xec,
-	0x9f, 0x2c, 0x58, 0x4c, 0xcf, 0x1c, 0x3a, 0xb1, 0x6e, 0xc1, 0x2c, 0x61, 0xc2, 0xf7, 0x5b, 0x4d,
-	0x2c, 0x07, 0xe2, 0x4c, 0x75, 0x86, 0xb0, 0x5d, 0xb9, 0x46, 0x7b, 0x30, 0xad, 0x5c, 0xb6, 0x33,
-	0xd3, 0x37, 0xb2, 0x91, 0x55, 0x96, 0x6b, 0xbe, 0x14, 0x0d, 0x63, 0xef, 0xc3, 0x42, 0x6a, 0x46,
-	0xea, 0x85, 0xe0, 0x3d, 0x98, 0x17, 0x3c, 0x1d, 0xd5, 0xb7, 0xd8, 0xe5, 0x0d, 0x3d, 0xb2, 0xe7,
-	0xc4, 0x63, 0x89, 0xb3, 0xe7, 0xf2, 0xc6, 0xda, 0xcf, 0x00, 0x6f, 0x55, 0xbb, 0x5c, 0xb4, 0x7e,
-	0xf4, 0xbb, 0x05, 0xe8, 0xfc, 0xa0, 0x42, 0xef, 0x8f, 0x94, 0x30, 0x70, 0xf4, 0x15, 0x3e, 0xb8,
-	0x54, 0xad, 0x3a, 0x5a, 0xf6, 0xe6, 0xb7, 0x7f, 0xfc, 0xfd, 0x63, 0x6e, 0x03, 0xad, 0x97, 0x07,
-	0x7d, 0x4a, 0xac, 0xd6, 0x30, 0x77, 0x57, 0xcb, 0x6e, 0x1c, 0x1b, 0xfe, 0x51, 0x56, 0x97, 0x76,
-	0xad, 0xa6, 0xff, 0xea, 0x92, 0x49, 0x4d, 0xfa, 0x14, 0xcd, 0xa6, 0x66, 0xc0, 0x90, 0xbd, 0xb4,
-	0x1a, 0xf5, 0xe9, 0xd0, 0x51, 0xd3, 0x77, 0xcb, 0xca, 0xa6, 0x26, 0x75, 0x20, 0x67, 0x54, 0x93,
-	0x3e, 0xaf, 0x2f, 0xaf, 0x46, 0x7e, 0xc0, 0xfc, 0x6b, 0x69, 0x33, 0x48, 0xf7, 0xf0, 0xad, 0x6c,
-	0xcc, 0x86, 0xcd, 0xf8, 0xc2, 0xb3, 0x2b, 0x61, 0x68, 0x95, 0xdb, 0x52, 0xe5, 0x87, 0x68, 0xf3,
-	0xc2, 0x2a, 0xcd, 0xcf, 0xa9, 0xff, 0x94, 0xda, 0x41, 0x73, 0x2e, 0x93, 0xda, 0xe1, 0xa6, 0x91,
-	0x4d, 0xed, 0x08, 0x4f, 0xb1, 0x3f, 0x92, 0x6a, 0x9f, 0xa2, 0x27, 0x17, 0x54, 0xdb, 0x3b, 0xa5,
-	0xd1, 0x6f, 0x16, 0xcc, 0xf7, 0xb9, 0x05, 0x7a, 0x94, 0x85, 0x5f, 0x8a, 0xf3, 0x14, 0x1e, 0x5f,
-	0xbc, 0xf0, 0x8a, 0xef, 0x8e, 0xb7, 0x8d, 0xd5, 0xd6, 0x67, 0xbf, 0x9e, 0x14, 0xad, 0xd7, 0x27,
-	0x45, 0xeb, 0xaf, 0x93, 0xa2, 0xf5, 0xfd, 0x69, 0x71, 0xec, 0xf5, 0x69, 0x71, 0xec, 0xcf, 0xd3,
-	0xe2, 0xd8, 0x97, 0x9b, 0x01, 0xe1, 0x8d, 0x56, 0xad, 0xe4, 0xd1, 0xb0, 0xb3, 0x83, 0xfa, 0x73,
-	0x9f, 0xf9, 0x5f, 0x97, 0x45, 0x37, 0x70, 0x52, 0x0e, 0x92, 0xd8, 0x4b, 0xfb, 0xf1, 0xa3, 0x36,
-	0x25, 0x7f, 0xb3, 0x78, 0xf8, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xdb, 0xec, 0xac, 0x26,
-	0x11, 0x00, 0x00,
+	// 1298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x53, 0xdb, 0x46,
+	0x14, 0x8f, 0x0c, 0xe1, 0xcf, 0x4b, 0xc0, 0xd3, 0x6d, 0x01, 0x45, 0x24, 0x86, 0x88, 0x4e, 0x27,
+	0x97, 0xd8, 0x81, 0x30, 0x24, 0x2d, 0x84, 0xd4, 0x40, 0x61, 0x98, 0x29, 0x1d, 0x6a, 0x48, 0xcb,
+	0x74, 0x3a, 0xd1, 0xc8, 0xd2, 0x5a, 0xde, 0xd6, 0xfa, 0x83, 0x56, 0xa6, 0xe6, 0xda, 0x43, 0x67,
+	0x7a, 0x6b, 0xa7, 0xb7, 0x5e, 0xfb, 0x0d, 0xfa, 0x29, 0x3a, 0xcd, 0x25, 0x33, 0xbd, 0xf4, 0xd8,
+	0x81, 0xde, 0xda, 0x43, 0x3f, 0x42, 0x47, 0xbb, 0x6b, 0x23, 0x1b, 0x49, 0x16, 0x38, 0x27, 0x58,
+	0xbd, 0xdf, 0xfb, 0xed, 0xef, 0xbd, 0x5d, 0xbd, 0xf7, 0x64, 0x78, 0x64, 0xb8, 0xd4, 0x76, 0x69,
+	0xa9, 0xaa, 0x53, 0x5c, 0xf2, 0x71, 0xad, 0x81, 0x8d, 0x80, 0xb8, 0x4e, 0xe9, 0x64, 0x49, 0x6f,
+	0x78, 0x75, 0x7d, 0x31, 0xf2, 0xac, 0xe8, 0xf9, 0x6e, 0xe0, 0xa2, 0x39, 0xee, 0x51, 0x0c, 0x3d,
+	0x8a, 0x11, 0x6b, 0xdb, 0x43, 0xb9, 0x6b, 0xb9, 0xae, 0xd5, 0xc0, 0x25, 0xdd, 0x23, 0x25, 0xdd,
+	0x71, 0xdc, 0x40, 0x0f, 0xed, 0x94, 0xbb, 0xab, 0xaf, 0x86, 0x61, 0xa2, 0xec, 0x79, 0x5b, 0x98,
+	0x1a, 0x3e, 0xf1, 0x02, 0xd7, 0x47, 0xdb, 0x70, 0x53, 0x6f, 0x06, 0x75, 0x47, 0x96, 0xe6, 0xa5,
+	0x07, 0xb7, 0x96, 0x1e, 0x15, 0xfb, 0x6c, 0x50, 0x2c, 0x87, 0xe8, 0x0b, 0x82, 0x0a, 0x77, 0x0f,
+	0x79, 0x8c, 0xba, 0x4e, 0x1c, 0x39, 0x97, 0x91, 0x67, 0x33, 0x44, 0x47, 0x79, 0x98, 0x3b, 0xe3,
+	0x71, 0x4d, 0x6c, 0xc8, 0x43, 0x59, 0x79, 0x42, 0x74, 0x17, 0x4f, 0xf8, 0x00, 0xbd, 0x84, 0x09,
+	0xc3, 0x75, 0x6a, 0xc4, 0x6a, 0xfa, 0x2c, 0x03, 0xf2, 0x30, 0xe3, 0x7b, 0x9a, 0x81, 0x2f, 0xe2,
+	0x15, 0xe1, 0xed, 0xa6, 0x43, 0x1a, 0x4c, 0x1e, 0x37, 0xb1, 0x7f, 0xaa, 0x51, 0xec, 0x9f, 0x10,
+	0x03, 0x53, 0xf9, 0x66, 0xc6, 0x0d, 0x3e, 0x0d, 0xdd, 0x0e, 0x84, 0x57, 0x74, 0x83, 0xe3, 0xa8,
+	0x01, 0x3d, 0x83, 0x5c, 0xd0, 0x92, 0x47, 0x18, 0xe9, 0xc3, 0xbe, 0xa4, 0x87, 0xad, 0x08, 0x53,
+	0x2e, 0x68, 0xa1, 0x2a, 0xe4, 0x2d, 0xec, 0x60, 0x4a, 0xa8, 0x46, 0x8d, 0x3a, 0xb6, 0x75, 0x2a,
+	0x8f, 0x32, 0xae, 0xf7, 0xfb, 0x72, 0xed, 0x70, 0xbf, 0x03, 0xee, 0x16, 0xe1, 0x9d, 0xb4, 0xba,
+	0x2c, 0xaa, 0x03, 0xb7, 0xa3, 0xfb, 0x22, 0x05, 0xc6, 0x6a, 0xcd, 0x46, 0xc3, 0xd1, 0x6d, 0xcc,
+	0xae, 0xd3, 0x78, 0xa5, 0xb3, 0x46, 0x1b, 0x30, 0x6c, 0x53, 0x8b, 0xca, 0xb9, 0xf9, 0xa1, 0x07,
+	0xb7, 0x96, 0x8a, 0x7d, 0x45, 0xec, 0x51, 0x2b, 0xb2, 0x33, 0xf3, 0x55, 0xeb, 0x90, 0xef, 0xb9,
+	0x7d, 0xe8, 0x05, 0x00, 0x25, 0x96, 0xa3, 0xd9, 0xae, 0x89, 0xa9, 0x2c, 0x31, 0xf2, 0x95, 0xbe,
+	0xe4, 0x07, 0xc4, 0x72, 0x88, 0x63, 0xed, 0xb9, 0x26, 0x8e, 0x6c, 0x32, 0x1e, 0x32, 0x85, 0xcf,
+	0xa8, 0xfa, 0xa3, 0x04, 0x53, 0xb1, 0x20, 0x84, 0x60, 0x38, 0x12, 0x1f, 0xfb, 0x1f, 0x4d, 0xc3,
+	0x88, 0xd3, 0xb4, 0xab, 0xd8, 0x67, 0x97, 0xff, 0x66, 0x45, 0xac, 0xd0, 0xc7, 0xb0, 0xc0, 0x5e,
+	0x0e, 0x8d, 0x38, 0x35, 0x57, 0xf3, 0x7c, 0xf7, 0x84, 0x98, 0xd8, 0xd7, 0x6c, 0x1c, 0xd4, 0x5d,
+	0x53, 0xeb, 0xa4, 0x6a, 0x88, 0x51, 0xcd, 0x31, 0xe8, 0xae, 0x53, 0x73, 0xf7, 0x05, 0x70, 0x8f,
+	0xe1, 0xb6, 0x05, 0x4c, 0xbd, 0x0f, 0xf9, 0x9e, 0x77, 0x06, 0x4d, 0x42, 0x8e, 0x98, 0x42, 0x4a,
+	0x8e, 0x98, 0xaa, 0x05, 0xf9, 0x9e, 0xd7, 0x01, 0x1d, 0x02, 0x10, 0x27, 0xc0, 0x7e, 0x4d, 0x37,
+	0x3a, 0x09, 0x5a, 0xee, 0x9b, 0xa0, 0xdd, 0xb6, 0x4b, 0x24, 0x3d, 0x11, 0x1e, 0xf5, 0xd7, 0x1c,
+	0xbc, 0x1d, 0x83, 0x49, 0xbd, 0x01, 0xdf, 0x49, 0x70, 0xb7, 0x43, 0xa1, 0xe9, 0x86, 0x81, 0xbd,
+	0x80, 0x38, 0x96, 0x66, 0x63, 0x4a, 0x75, 0x0b, 0xb7, 0xaf, 0xc6, 0x56, 0x76, 0x71, 0xe5, 0x36,
+	0xc7, 0x1e, 0xa7, 0x88, 0x88, 0x55, 0x48, 0x12, 0x88, 0xa2, 0x13, 0x98, 0xbe, 0xd0, 0x41, 0x6c,
+	0xaf, 0x81, 0x6d, 0x1c, 0xae, 0xa9, 0x3c, 0xc4, 0x14, 0x3c, 0xcf, 0xae, 0x60, 0xf7, 0xc2, 0x3b,
+	0xb2, 0xf9, 0x14, 0x89, 0xb1, 0x53, 0xf5, 0x73, 0x28, 0xa4, 0x3b, 0xa6, 0xa6, 0xef, 0x0e, 0x8c,
+	0x05, 0xa7, 0x1e, 0xd6, 0x9a, 0x7e, 0x83, 0x5d, 0xb3, 0xf1, 0xca, 0x68, 0xb8, 0x7e, 0xe1, 0x37,
+	0xd4, 0x6f, 0x60, 0x21, 0x43, 0x4e, 0x52, 0xd9, 0x97, 0x61, 0xba, 0x46, 0x70, 0xc3, 0xd4, 0xcc,
+	0x0e, 0x5e, 0x0b, 0x0d, 0xfc, 0x54, 0xc6, 0x2b, 0xef, 0x30, 0xeb, 0x05, 0xd9, 0x27, 0xa1, 0x4d,
+	0xfd, 0x12, 0x66, 0x12, 0xca, 0x25, 0x2a, 0xc3, 0xbd, 0x2a, 0x36, 0xea, 0x8f, 0x97, 0xc2, 0x93,
+	0x76, 0x9b, 0x4e, 0xa0, 0xe9, 0xa6, 0xe9, 0x63, 0x4a, 0x35, 0xcf, 0xc7, 0x35, 0xd2, 0x12, 0x0a,
+	0x14, 0x0e, 0x2a, 0x73, 0x4c, 0x99, 0x43, 0xf6, 0x19, 0x42, 0x5d, 0x84, 0x89, 0xae, 0x2a, 0x80,
+	0xe6, 0xe1, 0xb6, 0x4d, 0x2d, 0xad, 0x93, 0x06, 0x4e, 0x01, 0x36, 0xb5, 0x0e, 0x45, 0x26, 0x02,
+	0x90, 0x93, 0xaa, 0x17, 0x3a, 0x82, 0x51, 0xdb, 0x35, 0x9b, 0x8d, 0xce, 0x6b, 0xb0, 0xde, 0xbf,
+	0x08, 0x31, 0x7c, 0x17, 0x63, 0xe4, 0x98, 0xdb, 0x74, 0xea, 0x11, 0xdc, 0x4b, 0x45, 0x86, 0x05,
+	0x82, 0x63, 0x85, 0x64, 0xb1, 0x42, 0x73, 0x70, 0xeb, 0x2b, 0xea, 0x3a, 0xa2, 0x42, 0x8b, 0x63,
+	0x85, 0xf0, 0x11, 0xa7, 0x50, 0x67, 0xe1, 0xce, 0x0e, 0x0e, 0x7a, 0x5b, 0x2e, 0x3e, 0x6e, 0x62,
+	0x1a, 0xa8, 0x26, 0x28, 0x71, 0x46, 0xea, 0xb9, 0x0e, 0xc5, 0x6f, 0xaa, 0xb1, 0x0b, 0x09, 0xbd,
+	0xdd, 0xba, 0x4b, 0xc2, 0x25, 0xe3, 0x85, 0x04, 0x3e, 0x13, 0x48, 0x03, 0xcd, 0x04, 0x6d, 0x09,
+	0x3d, 0x8d, 0xbe, 0x5b, 0x42, 0xaf, 0x31, 0x22, 0x21, 0x34, 0x65, 0x97, 0x10, 0x3b, 0x4e, 0xa8,
+	0x0b, 0x70, 0x9f, 0xed, 0x12, 0x3f, 0x1b, 0x08, 0x29, 0x27, 0xa0, 0xa6, 0x81, 0x84, 0xa4, 0x7d,
+	0x18, 0xe1, 0xa3, 0x84, 0x2c, 0x65, 0x9c, 0x18, 0x92, 0x18, 0x05, 0x8f, 0x10, 0x97, 0x34, 0x57,
+	0x08, 0x71, 0x2d, 0x50, 0xd3, 0x40, 0x42, 0x5c, 0x05, 0x46, 0xc3, 0x31, 0x84, 0x60, 0x9a, 0x59,
+	0x5d, 0x12, 0x65, 0x9b, 0x48, 0x95, 0x61, 0x7a, 0x07, 0x07, 0x87, 0xad, 0xcb, 0x9a, 0x8e, 0x60,
+	0xe6, 0x92, 0x45, 0x08, 0xe1, 0xe3, 0x8f, 0x74, 0xcd, 0xf1, 0x47, 0x7d, 0x97, 0x45, 0x9b, 0x38,
+	0xc9, 0x88, 0xfd, 0xbf, 0x97, 0x60, 0x21, 0x15, 0x26, 0xc4, 0xc4, 0x0c, 0x53, 0xd2, 0x9b, 0x1e,
+	0xa6, 0x4e, 0x61, 0x26, 0x21, 0x93, 0xe8, 0xe5, 0xa5, 0x59, 0x93, 0x17, 0xb0, 0x27, 0x57, 0x3a,
+	0x9b, 0xc4, 0x51, 0x53, 0xfd, 0x45, 0x82, 0xe9, 0x78, 0x64, 0x6a, 0xcf, 0x98, 0x85, 0x71, 0x42,
+	0x35, 0x51, 0xd8, 0xc2, 0xda, 0x35, 0x56, 0x19, 0x23, 0x94, 0x57, 0x42, 0xb4, 0x0f, 0xa3, 0x7c,
+	0xce, 0x69, 0x77, 0xd5, 0x95, 0x6c, 0x62, 0xf9, 0xd0, 0xd3, 0x55, 0x65, 0x39, 0x8d, 0x7a, 0x00,
+	0x53, 0xb1, 0x88, 0xd8, 0x91, 0xec, 0x3d, 0xc8, 0x87, 0x3a, 0x35, 0x9e, 0x37, 0x4f, 0x0f, 0xea,
+	0xa2, 0xba, 0x4e, 0x84, 0x8f, 0x19, 0xcf, 0xbe, 0x1e, 0xd4, 0x97, 0x7e, 0xbe, 0x0d, 0x6f, 0x55,
+	0x3a, 0x5a, 0x44, 0xfc, 0xe8, 0x95, 0x04, 0xe8, 0x72, 0x69, 0x45, 0x1f, 0x64, 0x38, 0xed, 0x84,
+	0x62, 0xad, 0xac, 0x5e, 0xcb, 0x97, 0xdf, 0x3f, 0x75, 0xed, 0xdb, 0x3f, 0xfe, 0xfe, 0x29, 0xb7,
+	0x82, 0x96, 0x4b, 0x49, 0x1f, 0x8c, 0x8b, 0x55, 0x1c, 0xe8, 0x8b, 0x25, 0xdd, 0xf3, 0x22, 0x1d,
+	0xbc, 0xc4, 0x3f, 0xcd, 0x44, 0x34, 0xbd, 0xc3, 0x63, 0xa6, 0x68, 0xe2, 0xeb, 0xbe, 0xb2, 0x7a,
+	0x2d, 0xdf, 0x01, 0xa3, 0xe1, 0x1f, 0x88, 0xed, 0x68, 0x7a, 0xe6, 0xdc, 0x6c, 0xd1, 0xc4, 0xb6,
+	0x10, 0x65, 0xf5, 0x5a, 0xbe, 0x83, 0x46, 0xc3, 0x3e, 0x53, 0xff, 0x91, 0x44, 0xfb, 0x8a, 0x9f,
+	0xa2, 0x36, 0xb2, 0x29, 0x4b, 0xeb, 0x4a, 0xca, 0xe6, 0x40, 0x1c, 0x22, 0xca, 0x2d, 0x16, 0xe5,
+	0x3a, 0x5a, 0xbb, 0x72, 0x94, 0xd1, 0x8f, 0xe6, 0x7f, 0x79, 0xb4, 0x49, 0x75, 0x2e, 0x53, 0xb4,
+	0xe9, 0x6d, 0x4e, 0xd9, 0x1c, 0x88, 0x43, 0x44, 0xfb, 0x11, 0x8b, 0xf6, 0x39, 0x7a, 0x76, 0xc5,
+	0x68, 0xbb, 0xab, 0x34, 0xfa, 0x5d, 0x82, 0x7c, 0x4f, 0x7f, 0x43, 0x4f, 0xb2, 0xe8, 0x8b, 0xe9,
+	0x95, 0xca, 0xd3, 0xab, 0x3b, 0x0e, 0x78, 0x76, 0x41, 0x2b, 0xb2, 0x42, 0xff, 0x49, 0x30, 0x9b,
+	0xd2, 0x2b, 0x51, 0xa6, 0xc4, 0xf7, 0x69, 0xc8, 0xca, 0xd6, 0x60, 0x24, 0x22, 0xe0, 0x6d, 0x16,
+	0xf0, 0x87, 0x68, 0xfd, 0x8a, 0x01, 0xf7, 0xf4, 0xf8, 0x8d, 0xcf, 0x7e, 0x3b, 0x2b, 0x48, 0xaf,
+	0xcf, 0x0a, 0xd2, 0x5f, 0x67, 0x05, 0xe9, 0x87, 0xf3, 0xc2, 0x8d, 0xd7, 0xe7, 0x85, 0x1b, 0x7f,
+	0x9e, 0x17, 0x6e, 0x7c, 0xb1, 0x66, 0x91, 0xa0, 0xde, 0xac, 0x16, 0x0d, 0xd7, 0x6e, 0xef, 0xc1,
+	0xff, 0x3c, 0xa4, 0xe6, 0xd7, 0xa5, 0xf0, 0x02, 0x60, 0xbf, 0x64, 0xf9, 0x9e, 0x11, 0xf7, 0xab,
+	0x5e, 0x75, 0x84, 0xfd, 0x18, 0xf7, 0xf8, 0xff, 0x01, 0x00, 0xfe, 0x4f, 0xc4, 0xcd, 0xff, 0x13,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1460,6 +1667,8 @@ type ReflectionServiceClient interface {
 	GetQueryServicesDescriptor(ctx context.Context, in *GetQueryServicesDescriptorRequest, opts ...grpc.CallOption) (*GetQueryServicesDescriptorResponse, error)
 	// GetTxDescriptor returns information on the used transaction object and available msgs that can be used
 	GetTxDescriptor(ctx context.Context, in *GetTxDescriptorRequest, opts ...grpc.CallOption) (*GetTxDescriptorResponse, error)
+	// GetGenesisSchemasDescriptor returns the JSON schemas of the collections genesis of the application modules
+	GetGenesisSchemasDescriptor(ctx context.Context, in *GetGenesisSchemasDescriptorRequest, opts ...grpc.CallOption) (*GetGenesisSchemasDescriptorResponse, error)
 }
 
 type reflectionServiceClient struct {
@@ -1524,6 +1733,15 @@ func (c *reflectionServiceClient) GetTxDescriptor(ctx context.Context, in *GetTx
 	return out, nil
 }
 
+func (c *reflectionServiceClient) GetGenesisSchemasDescriptor(ctx context.Context, in *GetGenesisSchemasDescriptorRequest, opts ...grpc.CallOption) (*GetGenesisSchemasDescriptorResponse, error) {
+	out := new(GetGenesisSchemasDescriptorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.reflection.v2alpha1.ReflectionService/GetGenesisSchemasDescriptor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReflectionServiceServer is the server API for ReflectionService service.
 type ReflectionServiceServer interface {
 	// GetAuthnDescriptor returns information on how to authenticate transactions in the application
@@ -1540,6 +1758,8 @@ type ReflectionServiceServer interface {
 	GetQueryServicesDescriptor(context.Context, *GetQueryServicesDescriptorRequest) (*GetQueryServicesDescriptorResponse, error)
 	// GetTxDescriptor returns information on the used transaction object and available msgs that can be used
 	GetTxDescriptor(context.Context, *GetTxDescriptorRequest) (*GetTxDescriptorResponse, error)
+	// GetGenesisSchemasDescriptor returns the JSON schemas of the collections genesis of the application modules
+	GetGenesisSchemasDescriptor(context.Context, *GetGenesisSchemasDescriptorRequest) (*GetGenesisSchemasDescriptorResponse, error)
 }
 
 // UnimplementedReflectionServiceServer can be embedded to have forward compatible implementations.
@@ -1564,6 +1784,9 @@ func (*UnimplementedReflectionServiceServer) GetQueryServicesDescriptor(ctx cont
 func (*UnimplementedReflectionServiceServer) GetTxDescriptor(ctx context.Context, req *GetTxDescriptorRequest) (*GetTxDescriptorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTxDescriptor not implemented")
 }
+func (*UnimplementedReflectionServiceServer) GetGenesisSchemasDescriptor(ctx context.Context, req *GetGenesisSchemasDescriptorRequest) (*GetGenesisSchemasDescriptorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGenesisSchemasDescriptor not implemented")
+}
 
 func RegisterReflectionServiceServer(s grpc1.Server, srv ReflectionServiceServer) {
 	s.RegisterService(&_ReflectionService_serviceDesc, srv)
@@ -1677,6 +1900,25 @@ func _ReflectionService_GetTxDescriptor_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReflectionService_GetGenesisSchemasDescriptor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGenesisSchemasDescriptorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReflectionServiceServer).GetGenesisSchemasDescriptor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.reflection.v2alpha1.ReflectionService/GetGenesisSchemasDescriptor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReflectionServiceServer).GetGenesisSchemasDescriptor(ctx, req.(*GetGenesisSchemasDescriptorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var ReflectionService_serviceDesc = _ReflectionService_serviceDesc
 var _ReflectionService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.base.reflection.v2alpha1.ReflectionService",
 	HandlerType: (*ReflectionServiceServer)(nil),
@@ -1705,6 +1947,10 @@ var _ReflectionService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxDescriptor",
 			Handler:    _ReflectionService_GetTxDescriptor_Handler,
 		},
+		{
+			MethodName: "GetGenesisSchemasDescriptor",
+			Handler:    _ReflectionService_GetGenesisSchemasDescriptor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/reflection/v2alpha1/reflection.proto",
@@ -1730,6 +1976,18 @@ func (m *AppDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GenesisSchemas != nil {
+		{
+			size, err := m.GenesisSchemas.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReflection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Tx != nil {
 		{
 			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
@@ -2189,6 +2447,80 @@ func (m *MsgDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisSchemasDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisSchemasDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisSchemasDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Modules) > 0 {
+		for iNdEx := len(m.Modules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Modules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReflection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleGenesisSchemaDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleGenesisSchemaDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleGenesisSchemaDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JsonSchema) > 0 {
+		i -= len(m.JsonSchema)
+		copy(dAtA[i:], m.JsonSchema)
+		i = encodeVarintReflection(dAtA, i, uint64(len(m.JsonSchema)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintReflection(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GetAuthnDescriptorRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2537,7 +2869,7 @@ func (m *GetTxDescriptorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryServicesDescriptor) Marshal() (dAtA []byte, err error) {
+func (m *GetGenesisSchemasDescriptorRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2547,34 +2879,20 @@ func (m *QueryServicesDescriptor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryServicesDescriptor) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetGenesisSchemasDescriptorRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryServicesDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetGenesisSchemasDescriptorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.QueryServices) > 0 {
-		for iNdEx := len(m.QueryServices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueryServices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintReflection(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryServiceDescriptor) Marshal() (dAtA []byte, err error) {
+func (m *GetGenesisSchemasDescriptorResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2584,25 +2902,97 @@ func (m *QueryServiceDescriptor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryServiceDescriptor) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetGenesisSchemasDescriptorResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryServiceDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetGenesisSchemasDescriptorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Methods) > 0 {
-		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Methods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintReflection(dAtA, i, uint64(size))
+	if m.GenesisSchemas != nil {
+		{
+			size, err := m.GenesisSchemas.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReflection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServicesDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServicesDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServicesDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueryServices) > 0 {
+		for iNdEx := len(m.QueryServices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueryServices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReflection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Methods) > 0 {
+		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Methods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReflection(dAtA, i, uint64(size))
 			}
 			i--
 			dAtA[i] = 0x1a
@@ -2706,6 +3096,10 @@ func (m *AppDescriptor) Size() (n int) {
 		l = m.Tx.Size()
 		n += 1 + l + sovReflection(uint64(l))
 	}
+	if m.GenesisSchemas != nil {
+		l = m.GenesisSchemas.Size()
+		n += 1 + l + sovReflection(uint64(l))
+	}
 	return n
 }
 
@@ -2878,6 +3272,38 @@ func (m *MsgDescriptor) Size() (n int) {
 	return n
 }
 
+func (m *GenesisSchemasDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Modules) > 0 {
+		for _, e := range m.Modules {
+			l = e.Size()
+			n += 1 + l + sovReflection(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleGenesisSchemaDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	l = len(m.JsonSchema)
+	if l > 0 {
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	return n
+}
+
 func (m *GetAuthnDescriptorRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3010,6 +3436,28 @@ func (m *GetTxDescriptorResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetGenesisSchemasDescriptorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetGenesisSchemasDescriptorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GenesisSchemas != nil {
+		l = m.GenesisSchemas.Size()
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryServicesDescriptor) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3315,6 +3763,42 @@ func (m *AppDescriptor) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GenesisSchemas == nil {
+				m.GenesisSchemas = &GenesisSchemasDescriptor{}
+			}
+			if err := m.GenesisSchemas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReflection(dAtA[iNdEx:])
@@ -4377,6 +4861,204 @@ func (m *MsgDescriptor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GenesisSchemasDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisSchemasDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisSchemasDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Modules = append(m.Modules, &ModuleGenesisSchemaDescriptor{})
+			if err := m.Modules[len(m.Modules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleGenesisSchemaDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleGenesisSchemaDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleGenesisSchemaDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetAuthnDescriptorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5193,6 +5875,142 @@ func (m *GetTxDescriptorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetGenesisSchemasDescriptorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetGenesisSchemasDescriptorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetGenesisSchemasDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetGenesisSchemasDescriptorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetGenesisSchemasDescriptorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetGenesisSchemasDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GenesisSchemas == nil {
+				m.GenesisSchemas = &GenesisSchemasDescriptor{}
+			}
+			if err := m.GenesisSchemas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryServicesDescriptor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,15 +7,18 @@ import (
 
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	mempoolservice "github.com/cosmos/cosmos-sdk/client/grpc/mempool"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
 	reflection "github.com/cosmos/cosmos-sdk/server/grpc/reflection/v2alpha1"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino" // Import amino.proto file for reflection
 )
 
@@ -40,6 +43,12 @@ func NewGRPCServer(clientCtx client.Context, app types.Application, cfg config.G
 
 	app.RegisterGRPCServer(grpcSrv)
 
+	// The mempool service is node-local, it is only served by the gRPC server
+	// and not through ABCI queries.
+	if mempoolApp, ok := app.(interface{ Mempool() mempool.Mempool }); ok {
+		mempoolservice.RegisterMempoolService(grpcSrv, mempoolApp.Mempool(), clientCtx.TxConfig.TxEncoder())
+	}
+
 	// Reflection allows consumers to build dynamic clients that can write to any
 	// Cosmos SDK application without relying on application packages at compile
 	// time.
@@ -56,6 +65,14 @@ func NewGRPCServer(clientCtx client.Context, app types.Application, cfg config.G
 		ChainID:           clientCtx.ChainID,
 		SdkConfig:         sdk.GetConfig(),
 		InterfaceRegistry: clientCtx.InterfaceRegistry,
+		GenesisSchemas: func() map[string]collections.Schema {
+			if schemasApp, ok := app.(interface {
+				CollectionsSchemas() map[string]collections.Schema
+			}); ok {
+				return schemasApp.CollectionsSchemas()
+			}
+			return nil
+		}(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to register reflection service: %w", err)
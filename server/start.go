@@ -75,10 +75,13 @@ const (
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagShutdownGrace       = "shutdown-grace"
+	FlagParallelExecWorkers = "parallel-exec-workers"
+	FlagVersionedKVIndex    = "versioned-kv-index"
 
 	// state sync-related flags
-	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
-	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotInterval            = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent          = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotIncrementalInterval = "state-sync.snapshot-incremental-interval"
 
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
@@ -97,7 +100,11 @@ const (
 	flagGRPCWebEnable = "grpc-web.enable"
 
 	// mempool flags
-	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolMaxTxs                = "mempool.max-txs"
+	FlagMempoolMaxTxsPerSender       = "mempool.max-txs-per-sender"
+	FlagMempoolTxTTLBlocks           = "mempool.tx-ttl-blocks"
+	FlagMempoolTxTTL                 = "mempool.tx-ttl"
+	FlagMempoolMinReplacementFeeBump = "mempool.min-replacement-fee-bump"
 
 	// testnet keys
 	KeyIsTestnet             = "is-testnet"
@@ -994,8 +1001,15 @@ func addStartNodeFlags(cmd *cobra.Command, opts StartCmdOptions) {
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled)")
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint64(FlagStateSyncSnapshotIncrementalInterval, 0, "State sync incremental snapshot interval")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().Int(FlagParallelExecWorkers, 0, "Number of workers executing the txs of a block in parallel (0 executes them sequentially)")
+	cmd.Flags().Bool(FlagVersionedKVIndex, false, "Maintain the versioned KV index and serve the historical queries without proofs from it")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().Int(FlagMempoolMaxTxsPerSender, 0, "Sets the maximum number of txs of a single sender in the app-side mempool (0 for no limit)")
+	cmd.Flags().Int64(FlagMempoolTxTTLBlocks, 0, "Sets the number of blocks after which a tx is evicted from the app-side mempool (0 to disable)")
+	cmd.Flags().Duration(FlagMempoolTxTTL, 0, "Sets the duration after which a tx is evicted from the app-side mempool (0 to disable)")
+	cmd.Flags().Uint64(FlagMempoolMinReplacementFeeBump, 0, "Sets the minimum fee increase, in percent, of a tx replacing another one in the app-side mempool")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
 
 	// support old flags name for backwards compatibility
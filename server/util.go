@@ -31,6 +31,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/versionedkv"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
@@ -539,17 +540,29 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
+	snapshotOptions.IncrementalInterval = cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotIncrementalInterval))
 
 	defaultMempool := baseapp.SetMempool(mempool.NoOpMempool{})
 	if maxTxs := cast.ToInt(appOpts.Get(FlagMempoolMaxTxs)); maxTxs >= 0 {
-		defaultMempool = baseapp.SetMempool(
-			mempool.NewSenderNonceMempool(
-				mempool.SenderNonceMaxTxOpt(maxTxs),
-			),
-		)
+		mempoolCfg := mempool.DefaultBoundedMempoolConfig()
+		mempoolCfg.MaxTx = maxTxs
+		mempoolCfg.MaxSenderTx = cast.ToInt(appOpts.Get(FlagMempoolMaxTxsPerSender))
+		mempoolCfg.TxTTLBlocks = cast.ToInt64(appOpts.Get(FlagMempoolTxTTLBlocks))
+		mempoolCfg.TxTTL = cast.ToDuration(appOpts.Get(FlagMempoolTxTTL))
+		mempoolCfg.MinReplacementFeeBump = cast.ToUint64(appOpts.Get(FlagMempoolMinReplacementFeeBump))
+
+		if mempoolCfg.MaxSenderTx > 0 || mempoolCfg.TxTTLBlocks > 0 || mempoolCfg.TxTTL > 0 || mempoolCfg.MinReplacementFeeBump > 0 {
+			defaultMempool = baseapp.SetMempool(mempool.NewBoundedMempool(mempoolCfg))
+		} else {
+			defaultMempool = baseapp.SetMempool(
+				mempool.NewSenderNonceMempool(
+					mempool.SenderNonceMaxTxOpt(maxTxs),
+				),
+			)
+		}
 	}
 
-	return []func(*baseapp.BaseApp){
+	baseappOptions := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
@@ -564,7 +577,30 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		defaultMempool,
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
+		baseapp.SetParallelExecution(cast.ToInt(appOpts.Get(FlagParallelExecWorkers))),
+	}
+
+	if cast.ToBool(appOpts.Get(FlagVersionedKVIndex)) {
+		versionedKVIndex, err := GetVersionedKVIndex(appOpts)
+		if err != nil {
+			panic(err)
+		}
+
+		baseappOptions = append(baseappOptions, baseapp.SetVersionedKVIndex(versionedKVIndex))
 	}
+
+	return baseappOptions
+}
+
+// GetVersionedKVIndex opens the versioned KV index stored in the data directory.
+func GetVersionedKVIndex(appOpts types.AppOptions) (*versionedkv.Index, error) {
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	db, err := dbm.NewDB("versioned_kv", GetAppDBBackend(appOpts), filepath.Join(homeDir, "data"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the versioned KV index: %w", err)
+	}
+
+	return versionedkv.NewIndex(db)
 }
 
 func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
@@ -579,6 +579,10 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 
 	// Set the AnteHandler for the app
 	app.SetAnteHandler(anteHandler)
+
+	// the fees deposited by the ante handler don't make the txs executed in
+	// parallel conflict
+	app.SetParallelExecMerger(app.GetKey(banktypes.StoreKey), bankkeeper.NewDepositMerger(authtypes.NewModuleAddress(authtypes.FeeCollectorName)))
 }
 
 func (app *SimApp) setPostHandler() {
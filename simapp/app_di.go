@@ -35,6 +35,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
@@ -290,6 +291,10 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 
 	// Set the AnteHandler for the app
 	app.SetAnteHandler(anteHandler)
+
+	// the fees deposited by the ante handler don't make the txs executed in
+	// parallel conflict
+	app.SetParallelExecMerger(app.GetKey(banktypes.StoreKey), bankkeeper.NewDepositMerger(authtypes.NewModuleAddress(authtypes.FeeCollectorName)))
 }
 
 // LegacyAmino returns SimApp's amino codec.
@@ -0,0 +1,36 @@
+package simapp
+
+import (
+	"cosmossdk.io/collections"
+	circuittypes "cosmossdk.io/x/circuit/types"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// CollectionsSchemas returns the collections schemas of the modules, keyed by
+// the name of the module store. It is used by the debug state command.
+func (app *SimApp) CollectionsSchemas() map[string]collections.Schema {
+	schemas := map[string]collections.Schema{
+		authtypes.StoreKey:     app.AccountKeeper.Schema,
+		circuittypes.StoreKey:  app.CircuitKeeper.Schema,
+		crisistypes.StoreKey:   app.CrisisKeeper.Schema,
+		distrtypes.StoreKey:    app.DistrKeeper.Schema,
+		evidencetypes.StoreKey: app.EvidenceKeeper.Schema,
+		govtypes.StoreKey:      app.GovKeeper.Schema,
+		minttypes.StoreKey:     app.MintKeeper.Schema,
+	}
+
+	// the bank keeper is only provided as an interface when using depinject
+	if bankKeeper, ok := any(app.BankKeeper).(bankkeeper.BaseKeeper); ok {
+		schemas[banktypes.StoreKey] = bankKeeper.Schema
+	}
+
+	return schemas
+}
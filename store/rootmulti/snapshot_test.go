@@ -1,6 +1,7 @@
 package rootmulti_test
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
@@ -11,6 +12,7 @@ import (
 	"testing"
 
 	dbm "github.com/cosmos/cosmos-db"
+	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -233,6 +235,168 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshotRestore_Sections(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+
+	sourceSnapshots, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(sourceSnapshots, snapshottypes.NewSnapshotOptions(0, 0), source, nil, log.NewNopLogger())
+	snapshot, err := manager.Create(version)
+	require.NoError(t, err)
+	require.Equal(t, snapshottypes.FormatSections, snapshot.Format)
+
+	// the transient store is not snapshotted
+	names := []string{}
+	for _, section := range snapshot.Metadata.Sections {
+		names = append(names, section.Name)
+	}
+	require.Equal(t, []string{"iavl1", "iavl2", "iavl3"}, names)
+
+	chunks := make([][]byte, snapshot.Chunks)
+	for i := range chunks {
+		chunks[i], err = manager.LoadChunk(snapshot.Height, snapshot.Format, uint32(i))
+		require.NoError(t, err)
+	}
+
+	restore := func(chunks [][]byte) (*rootmulti.Store, error) {
+		target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+		targetSnapshots, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+		require.NoError(t, err)
+		manager := snapshots.NewManager(targetSnapshots, snapshottypes.NewSnapshotOptions(0, 0), target, nil, log.NewNopLogger())
+		if err := manager.Restore(*snapshot); err != nil {
+			return nil, err
+		}
+		for _, chunk := range chunks {
+			if _, err := manager.RestoreChunk(chunk); err != nil {
+				return nil, err
+			}
+		}
+		return target, nil
+	}
+
+	target, err := restore(chunks)
+	require.NoError(t, err)
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, name := range []string{"iavl1", "iavl2", "iavl3"} {
+		sourceStore := source.GetStoreByName(name).(types.CommitKVStore)
+		targetStore := target.GetStoreByName(name).(types.CommitKVStore)
+		assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", name)
+	}
+
+	// a corrupted chunk is rejected, naming its store
+	corrupted := append([][]byte{}, chunks...)
+	corrupted[1] = append([]byte{}, chunks[1]...)
+	corrupted[1][len(corrupted[1])-1]++
+	_, err = restore(corrupted)
+	require.ErrorIs(t, err, snapshottypes.ErrChunkHashMismatch)
+	require.Contains(t, err.Error(), "store iavl2")
+
+	// the sections are rebuilt from the chunks when the snapshot is saved again
+	loaded, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	saved, err := loaded.Save(snapshot.Height, snapshot.Format, ch)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, saved)
+}
+
+func TestMultistoreSnapshot_ReadSections(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(0, 0), source, nil, log.NewNopLogger())
+	snapshot, err := manager.Create(version)
+	require.NoError(t, err)
+
+	// each section starts with the item of its store
+	_, chunks, err := snapshotStore.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	names := []string{}
+	err = snapshots.ReadSections(snapshot, chunks, func(section snapshottypes.SnapshotSection, protoReader protoio.Reader) error {
+		var item snapshottypes.SnapshotItem
+		require.NoError(t, protoReader.ReadMsg(&item))
+		require.Equal(t, section.Name, item.GetStore().GetName())
+		names = append(names, section.Name)
+		for {
+			err := protoReader.ReadMsg(&item)
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			require.NoError(t, err)
+			require.NotNil(t, item.GetIAVL())
+		}
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"iavl1", "iavl2", "iavl3"}, names)
+
+	// the chunks must belong to their section
+	_, chunks, err = snapshotStore.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	invalid := *snapshot
+	invalid.Metadata.Sections = append([]snapshottypes.SnapshotSection{}, snapshot.Metadata.Sections...)
+	invalid.Metadata.Sections[0].Name, invalid.Metadata.Sections[1].Name = "iavl2", "iavl1"
+	err = snapshots.ReadSections(&invalid, chunks, func(snapshottypes.SnapshotSection, protoio.Reader) error { return nil })
+	require.ErrorIs(t, err, snapshottypes.ErrInvalidMetadata)
+	require.Contains(t, err.Error(), "chunk 0 of store iavl2 is chunk 0 of store iavl1")
+}
+
+func TestMultistoreSnapshotRestore_Incremental(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	require.EqualValues(t, 3, source.LastCommitID().Version)
+
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(0, 0), source, nil, log.NewNopLogger())
+
+	// an incremental snapshot needs a base snapshot
+	_, err = manager.CreateIncremental(2)
+	require.Error(t, err)
+
+	_, err = manager.Create(1)
+	require.NoError(t, err)
+	snapshot, err := manager.CreateIncremental(2)
+	require.NoError(t, err)
+	require.Equal(t, snapshottypes.FormatIncremental, snapshot.Format)
+	require.Equal(t, uint64(1), snapshot.Metadata.BaseHeight)
+	snapshot, err = manager.CreateIncremental(3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), snapshot.Metadata.BaseHeight)
+
+	// the full snapshot and the incremental snapshots are restored in order
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	manager = snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(0, 0), target, nil, log.NewNopLogger())
+	require.NoError(t, manager.RestoreLocalSnapshot(3, snapshottypes.FormatIncremental))
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, name := range []string{"iavl1", "iavl2", "iavl3"} {
+		sourceStore := source.GetStoreByName(name).(types.CommitKVStore)
+		targetStore := target.GetStoreByName(name).(types.CommitKVStore)
+		assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", name)
+	}
+
+	// an incremental snapshot is only restored on top of its base height
+	target = newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	chunks := make(chan io.ReadCloser)
+	go func() {
+		streamWriter := snapshots.NewStreamWriter(chunks)
+		require.NotNil(t, streamWriter)
+		defer streamWriter.Close()
+		require.NoError(t, source.SnapshotIncrement(2, 3, streamWriter))
+	}()
+	streamReader, err := snapshots.NewStreamReader(chunks)
+	require.NoError(t, err)
+	defer streamReader.Close()
+	_, err = target.RestoreIncrement(2, 3, streamReader)
+	require.Error(t, err)
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Helper()
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
@@ -1,6 +1,7 @@
 package rootmulti
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"errors"
 	"fmt"
@@ -77,8 +78,10 @@ type Store struct {
 }
 
 var (
-	_ types.CommitMultiStore = (*Store)(nil)
-	_ types.Queryable        = (*Store)(nil)
+	_ types.CommitMultiStore               = (*Store)(nil)
+	_ types.Queryable                      = (*Store)(nil)
+	_ snapshottypes.SectionSnapshotter     = (*Store)(nil)
+	_ snapshottypes.IncrementalSnapshotter = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -814,6 +817,65 @@ func parsePath(path string) (storeName, subpath string, err error) {
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	if err := rs.validateSnapshotHeight(height); err != nil {
+		return err
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
+	}
+
+	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
+	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
+	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
+	// are demarcated by new SnapshotStore items.
+	for _, store := range stores {
+		if err := rs.exportStore(height, store, protoWriter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnapshotStoreNames implements snapshottypes.SectionSnapshotter.
+func (rs *Store) SnapshotStoreNames() ([]string, error) {
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(stores))
+	for i, store := range stores {
+		names[i] = store.name
+	}
+
+	return names, nil
+}
+
+// SnapshotStore implements snapshottypes.SectionSnapshotter.
+func (rs *Store) SnapshotStore(height uint64, name string, protoWriter protoio.Writer) error {
+	if err := rs.validateSnapshotHeight(height); err != nil {
+		return err
+	}
+
+	store, ok := rs.GetStoreByName(name).(*iavl.Store)
+	if !ok || store == nil {
+		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot non-IAVL store %q", name)
+	}
+
+	return rs.exportStore(height, snapshotStore{Store: store, name: name}, protoWriter)
+}
+
+// snapshotStore is a store to snapshot, with its name.
+type snapshotStore struct {
+	*iavl.Store
+	name string
+}
+
+// validateSnapshotHeight checks that a snapshot can be taken at the height.
+func (rs *Store) validateSnapshotHeight(height uint64) error {
 	if height == 0 {
 		return errorsmod.Wrap(types.ErrLogic, "cannot snapshot height 0")
 	}
@@ -821,22 +883,23 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
+	return nil
+}
+
+// snapshotStores returns the stores to snapshot, sorted by name.
+func (rs *Store) snapshotStores() ([]snapshotStore, error) {
 	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
-	stores := []namedStore{}
+	stores := []snapshotStore{}
 	keys := keysFromStoreKeyMap(rs.stores)
 	for _, key := range keys {
 		switch store := rs.GetCommitKVStore(key).(type) {
 		case *iavl.Store:
-			stores = append(stores, namedStore{name: key.Name(), Store: store})
+			stores = append(stores, snapshotStore{name: key.Name(), Store: store})
 		case *transient.Store, *mem.Store:
 			// Non-persisted stores shouldn't be snapshotted
 			continue
 		default:
-			return errorsmod.Wrapf(types.ErrLogic,
+			return nil, errorsmod.Wrapf(types.ErrLogic,
 				"don't know how to snapshot store %q of type %T", key.Name(), store)
 		}
 	}
@@ -844,63 +907,55 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
 
-	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
-	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
-	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
-	// are demarcated by new SnapshotStore items.
-	for _, store := range stores {
-		rs.logger.Debug("starting snapshot", "store", store.name, "height", height)
-		exporter, err := store.Export(int64(height))
-		if err != nil {
-			rs.logger.Error("snapshot failed; exporter error", "store", store.name, "err", err)
-			return err
-		}
-
-		err = func() error {
-			defer exporter.Close()
+	return stores, nil
+}
 
-			err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-				Item: &snapshottypes.SnapshotItem_Store{
-					Store: &snapshottypes.SnapshotStoreItem{
-						Name: store.name,
-					},
-				},
-			})
-			if err != nil {
-				rs.logger.Error("snapshot failed; item store write failed", "store", store.name, "err", err)
-				return err
-			}
+// exportStore writes the SnapshotStore item of a store, followed by its IAVL
+// nodes at the height.
+func (rs *Store) exportStore(height uint64, store snapshotStore, protoWriter protoio.Writer) error {
+	rs.logger.Debug("starting snapshot", "store", store.name, "height", height)
+	exporter, err := store.Export(int64(height))
+	if err != nil {
+		rs.logger.Error("snapshot failed; exporter error", "store", store.name, "err", err)
+		return err
+	}
+	defer exporter.Close()
 
-			nodeCount := 0
-			for {
-				node, err := exporter.Next()
-				if err == iavltree.ErrorExportDone {
-					rs.logger.Debug("snapshot Done", "store", store.name, "nodeCount", nodeCount)
-					break
-				} else if err != nil {
-					return err
-				}
-				err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-					Item: &snapshottypes.SnapshotItem_IAVL{
-						IAVL: &snapshottypes.SnapshotIAVLItem{
-							Key:     node.Key,
-							Value:   node.Value,
-							Height:  int32(node.Height),
-							Version: node.Version,
-						},
-					},
-				})
-				if err != nil {
-					return err
-				}
-				nodeCount++
-			}
+	err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Store{
+			Store: &snapshottypes.SnapshotStoreItem{
+				Name: store.name,
+			},
+		},
+	})
+	if err != nil {
+		rs.logger.Error("snapshot failed; item store write failed", "store", store.name, "err", err)
+		return err
+	}
 
-			return nil
-		}()
+	nodeCount := 0
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ErrorExportDone {
+			rs.logger.Debug("snapshot Done", "store", store.name, "nodeCount", nodeCount)
+			break
+		} else if err != nil {
+			return err
+		}
+		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_IAVL{
+				IAVL: &snapshottypes.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
+				},
+			},
+		})
 		if err != nil {
 			return err
 		}
+		nodeCount++
 	}
 
 	return nil
@@ -952,25 +1007,11 @@ loop:
 				rs.logger.Error("failed to restore; received IAVL node item before store item")
 				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received IAVL node item before store item")
 			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
-			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
+			node, err := snapshotNode(item.IAVL)
+			if err != nil {
+				return snapshottypes.SnapshotItem{}, err
 			}
-			err := importer.Add(node)
+			err = importer.Add(node)
 			if err != nil {
 				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL node import failed")
 			}
@@ -992,6 +1033,279 @@ loop:
 	return snapshotItem, rs.LoadLatestVersion()
 }
 
+// RestoreStore implements snapshottypes.SectionSnapshotter. The stores can be
+// restored concurrently, as they are imported into distinct IAVL trees.
+func (rs *Store) RestoreStore(height uint64, name string, protoReader protoio.Reader) error {
+	var snapshotItem snapshottypes.SnapshotItem
+	if err := protoReader.ReadMsg(&snapshotItem); err != nil {
+		return errorsmod.Wrap(err, "invalid protobuf message")
+	}
+	if item := snapshotItem.GetStore(); item == nil || item.Name != name {
+		return errorsmod.Wrapf(types.ErrLogic, "expected store item of store %q", name)
+	}
+
+	store, ok := rs.GetStoreByName(name).(*iavl.Store)
+	if !ok || store == nil {
+		return errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", name)
+	}
+	importer, err := store.Import(int64(height))
+	if err != nil {
+		return errorsmod.Wrap(err, "import failed")
+	}
+	defer importer.Close()
+	rs.logger.Debug("restoring snapshot", "store", name)
+
+	for {
+		snapshotItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return errorsmod.Wrap(err, "invalid protobuf message")
+		}
+
+		item := snapshotItem.GetIAVL()
+		if item == nil {
+			return errorsmod.Wrapf(types.ErrLogic, "unexpected snapshot item %T in store %q", snapshotItem.Item, name)
+		}
+		node, err := snapshotNode(item)
+		if err != nil {
+			return err
+		}
+		if err := importer.Add(node); err != nil {
+			return errorsmod.Wrap(err, "IAVL node import failed")
+		}
+	}
+
+	if err := importer.Commit(); err != nil {
+		return errorsmod.Wrap(err, "IAVL commit failed")
+	}
+
+	return nil
+}
+
+// FinalizeRestore implements snapshottypes.SectionSnapshotter.
+func (rs *Store) FinalizeRestore(height uint64) error {
+	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
+	return rs.LoadLatestVersion()
+}
+
+// SnapshotIncrement implements snapshottypes.IncrementalSnapshotter. The changes
+// of each IAVL store are written after its SnapshotStore item, by version, and
+// followed by its hash at the height, so that the restored stores are checked.
+func (rs *Store) SnapshotIncrement(baseHeight, height uint64, protoWriter protoio.Writer) error {
+	if err := rs.validateSnapshotHeight(height); err != nil {
+		return err
+	}
+	if baseHeight == 0 || baseHeight >= height {
+		return errorsmod.Wrapf(types.ErrLogic, "invalid base height %v for snapshot height %v", baseHeight, height)
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
+	}
+	cInfo, err := rs.GetCommitInfo(int64(height))
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		// the changes can't be traversed from a pruned version
+		if !store.VersionExists(int64(baseHeight)) {
+			return errorsmod.Wrapf(types.ErrLogic, "version %v of store %q doesn't exist", baseHeight, store.name)
+		}
+
+		var hash []byte
+		for _, storeInfo := range cInfo.StoreInfos {
+			if storeInfo.Name == store.name {
+				hash = storeInfo.GetHash()
+			}
+		}
+
+		rs.logger.Debug("starting incremental snapshot", "store", store.name, "base", baseHeight, "height", height)
+		err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_Store{
+				Store: &snapshottypes.SnapshotStoreItem{
+					Name: store.name,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		err = store.TraverseStateChanges(int64(baseHeight)+1, int64(height), func(version int64, changeSet *iavltree.ChangeSet) error {
+			for _, pair := range changeSet.Pairs {
+				err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+					Item: &snapshottypes.SnapshotItem_Change{
+						Change: &snapshottypes.SnapshotChangeItem{
+							Version: version,
+							Key:     pair.Key,
+							Value:   pair.Value,
+							Delete:  pair.Delete,
+						},
+					},
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to traverse the changes of store %q", store.name)
+		}
+
+		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_StoreHash{
+				StoreHash: &snapshottypes.SnapshotStoreHashItem{
+					Hash: hash,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RestoreIncrement implements snapshottypes.IncrementalSnapshotter. The
+// changes of each store are committed version by version, so that the IAVL
+// trees are identical to the ones of the snapshotted stores.
+func (rs *Store) RestoreIncrement(
+	baseHeight, height uint64, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	if latest := rs.LastCommitID().Version; latest != int64(baseHeight) {
+		return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+			"cannot restore incremental snapshot from height %v at height %v", baseHeight, latest)
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+	restored := make(map[string]bool, len(stores))
+
+	var (
+		store        *iavl.Store
+		name         string
+		version      int64
+		snapshotItem snapshottypes.SnapshotItem
+	)
+	// commitTo commits the versions of the store up to the given one
+	commitTo := func(target int64) {
+		for ; version < target; version++ {
+			store.Commit()
+		}
+	}
+
+loop:
+	for {
+		snapshotItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if store != nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "missing hash of store %q", name)
+			}
+			var ok bool
+			name = item.Store.Name
+			store, ok = rs.GetStoreByName(name).(*iavl.Store)
+			if !ok || store == nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", name)
+			}
+			version = int64(baseHeight)
+			rs.logger.Debug("restoring incremental snapshot", "store", name)
+
+		case *snapshottypes.SnapshotItem_Change:
+			if store == nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received change item before store item")
+			}
+			if item.Change.Version <= version || item.Change.Version > int64(height) {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+					"unexpected change at version %v of store %q", item.Change.Version, name)
+			}
+			commitTo(item.Change.Version - 1)
+
+			// Protobuf does not differentiate between []byte{} as nil, but IAVL does not allow
+			// nil keys nor nil values, so we can always set them to empty.
+			key, value := item.Change.Key, item.Change.Value
+			if key == nil {
+				key = []byte{}
+			}
+			if item.Change.Delete {
+				store.Delete(key)
+			} else {
+				if value == nil {
+					value = []byte{}
+				}
+				store.Set(key, value)
+			}
+
+		case *snapshottypes.SnapshotItem_StoreHash:
+			if store == nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received store hash item before store item")
+			}
+			commitTo(int64(height))
+			if hash := store.LastCommitID().Hash; !bytes.Equal(hash, item.StoreHash.Hash) {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+					"restored store %q hash %X doesn't match the snapshot hash %X", name, hash, item.StoreHash.Hash)
+			}
+			restored[name] = true
+			store = nil
+
+		default:
+			break loop
+		}
+	}
+
+	if store != nil {
+		return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "missing hash of store %q", name)
+	}
+	for _, store := range stores {
+		if !restored[store.name] {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "missing store %q", store.name)
+		}
+	}
+
+	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
+	return snapshotItem, rs.LoadLatestVersion()
+}
+
+// snapshotNode returns the IAVL node of a snapshot item.
+func snapshotNode(item *snapshottypes.SnapshotIAVLItem) (*iavltree.ExportNode, error) {
+	if item.Height > math.MaxInt8 {
+		return nil, errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
+			item.Height, math.MaxInt8)
+	}
+	node := &iavltree.ExportNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Height:  int8(item.Height),
+		Version: item.Version,
+	}
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+
+	return node, nil
+}
+
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
 	var db dbm.DB
 
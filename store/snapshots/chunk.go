@@ -17,6 +17,10 @@ type ChunkWriter struct {
 	chunkSize uint64
 	written   uint64
 	closed    bool
+
+	// header returns the bytes written at the start of a chunk, given its index.
+	header func(index uint32) []byte
+	chunks uint32
 }
 
 // NewChunkWriter creates a new ChunkWriter. If chunkSize is 0, no chunking will be done.
@@ -39,6 +43,14 @@ func (w *ChunkWriter) chunk() error {
 	w.ch <- pr
 	w.pipe = pw
 	w.written = 0
+
+	if w.header != nil {
+		if _, err := pw.Write(w.header(w.chunks)); err != nil {
+			return err
+		}
+	}
+	w.chunks++
+
 	return nil
 }
 
@@ -169,7 +181,7 @@ func DrainChunks(chunks <-chan io.ReadCloser) {
 
 // ValidRestoreHeight will check height is valid for snapshot restore or not
 func ValidRestoreHeight(format uint32, height uint64) error {
-	if format != snapshottypes.CurrentFormat {
+	if format != snapshottypes.FormatStream && format != snapshottypes.FormatSections {
 		return errors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
 
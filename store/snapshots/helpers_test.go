@@ -152,11 +152,11 @@ func (m *mockSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) er
 }
 
 func (m *mockSnapshotter) SnapshotFormat() uint32 {
-	return snapshottypes.CurrentFormat
+	return snapshottypes.FormatStream
 }
 
 func (m *mockSnapshotter) SupportedFormats() []uint32 {
-	return []uint32{snapshottypes.CurrentFormat}
+	return []uint32{snapshottypes.FormatStream}
 }
 
 func (m *mockSnapshotter) PruneSnapshotHeight(height int64) {
@@ -186,11 +186,11 @@ func (m *mockErrorSnapshotter) Restore(
 }
 
 func (m *mockErrorSnapshotter) SnapshotFormat() uint32 {
-	return snapshottypes.CurrentFormat
+	return snapshottypes.FormatStream
 }
 
 func (m *mockErrorSnapshotter) SupportedFormats() []uint32 {
-	return []uint32{snapshottypes.CurrentFormat}
+	return []uint32{snapshottypes.FormatStream}
 }
 
 func (m *mockErrorSnapshotter) PruneSnapshotHeight(height int64) {
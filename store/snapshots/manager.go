@@ -11,6 +11,8 @@ import (
 	"sort"
 	"sync"
 
+	protoio "github.com/cosmos/gogoproto/io"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/snapshots/types"
@@ -62,8 +64,11 @@ const (
 	opPrune    operation = "prune"
 	opRestore  operation = "restore"
 
-	chunkBufferSize   = 4
-	chunkIDBufferSize = 1024
+	chunkBufferSize = 4
+	// sectionChunkBufferSize is the number of chunks buffered for a section of a
+	// FormatSections snapshot, so that the next sections are restored meanwhile.
+	sectionChunkBufferSize = 64
+	chunkIDBufferSize      = 1024
 
 	snapshotMaxItemSize = int(64e6) // SDK has no key/value size limit, so we set an arbitrary limit
 )
@@ -183,9 +188,88 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	format := m.snapshotFormat()
+	if format == types.FormatSections {
+		go m.createSections(height, ch)
+	} else {
+		go m.createSnapshot(height, ch)
+	}
+
+	return m.store.Save(height, format, ch)
+}
+
+// CreateIncremental creates an incremental snapshot on top of the latest
+// snapshot and returns its metadata.
+func (m *Manager) CreateIncremental(height uint64) (*types.Snapshot, error) {
+	if m == nil {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
+	}
+
+	multistore, ok := m.multistore.(types.IncrementalSnapshotter)
+	if !ok {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "multistore doesn't support incremental snapshots")
+	}
+
+	err := m.begin(opSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	base, err := m.store.GetLatest()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to examine latest snapshot")
+	}
+	if base == nil {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no base snapshot for the incremental snapshot")
+	}
+	if base.Height >= height {
+		return nil, errorsmod.Wrapf(storetypes.ErrConflict,
+			"a more recent snapshot already exists at height %v", base.Height)
+	}
+
+	ch := make(chan io.ReadCloser)
+	go m.createIncrement(multistore, base.Height, height, ch)
+
+	return m.store.SaveIncrement(height, base.Height, ch)
+}
+
+// createIncrement writes the incremental snapshot from the base height to the
+// height to the channel.
+func (m *Manager) createIncrement(multistore types.IncrementalSnapshotter, baseHeight, height uint64, ch chan<- io.ReadCloser) {
+	streamWriter := NewStreamWriter(ch)
+	if streamWriter == nil {
+		return
+	}
+	defer func() {
+		if err := streamWriter.Close(); err != nil {
+			streamWriter.CloseWithError(err)
+		}
+	}()
+
+	if err := multistore.SnapshotIncrement(baseHeight, height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	if err := m.snapshotExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+}
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+// snapshotFormat returns the format of the snapshots taken from the multistore,
+// FormatSections if it snapshots its stores independently.
+func (m *Manager) snapshotFormat() uint32 {
+	if _, ok := m.multistore.(types.SectionSnapshotter); ok {
+		return types.FormatSections
+	}
+
+	return types.FormatStream
+}
+
+// isFormatRestorable returns if the multistore can restore the snapshot format.
+func (m *Manager) isFormatRestorable(format uint32) bool {
+	return format == types.FormatStream || format == m.snapshotFormat()
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
@@ -205,10 +289,47 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		streamWriter.CloseWithError(err)
 		return
 	}
+	if err := m.snapshotExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+}
+
+// createSections takes a FormatSections snapshot, writing a section per store,
+// then a section for the extensions, if any, to the channel.
+func (m *Manager) createSections(height uint64, ch chan<- io.ReadCloser) {
+	multistore := m.multistore.(types.SectionSnapshotter)
+
+	names, err := multistore.SnapshotStoreNames()
+	if err != nil {
+		// the chunk writer closes the channel
+		NewChunkWriter(ch, 0).CloseWithError(err)
+		return
+	}
+	defer close(ch)
+
+	for _, name := range names {
+		err := writeSection(ch, name, func(protoWriter protoio.Writer) error {
+			return multistore.SnapshotStore(height, name, protoWriter)
+		})
+		if err != nil {
+			return
+		}
+	}
+
+	if len(m.extensions) > 0 {
+		_ = writeSection(ch, "", func(protoWriter protoio.Writer) error {
+			return m.snapshotExtensions(height, protoWriter)
+		})
+	}
+}
+
+// snapshotExtensions writes the snapshot items of the extensions.
+func (m *Manager) snapshotExtensions(height uint64, protoWriter protoio.Writer) error {
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
-		err := streamWriter.WriteMsg(&types.SnapshotItem{
+		err := protoWriter.WriteMsg(&types.SnapshotItem{
 			Item: &types.SnapshotItem_Extension{
 				Extension: &types.SnapshotExtensionMeta{
 					Name:   name,
@@ -217,17 +338,17 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 		payloadWriter := func(payload []byte) error {
-			return types.WriteExtensionPayload(streamWriter, payload)
+			return types.WriteExtensionPayload(protoWriter, payload)
 		}
 		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 	}
+
+	return nil
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
@@ -275,9 +396,14 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if !m.isFormatRestorable(snapshot.Format) {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
+	if snapshot.Format == types.FormatSections {
+		if err := validateSections(snapshot); err != nil {
+			return err
+		}
+	}
 	if snapshot.Height == 0 {
 		return errorsmod.Wrap(storetypes.ErrLogic, "cannot restore snapshot at height 0")
 	}
@@ -343,17 +469,165 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
-	var nextItem types.SnapshotItem
+	switch snapshot.Format {
+	case types.FormatSections:
+		return m.doRestoreSections(snapshot, chChunks)
+	case types.FormatIncremental:
+		return m.doRestoreIncrement(snapshot, chChunks)
+	}
+
 	streamReader, err := NewStreamReader(chChunks)
 	if err != nil {
 		return err
 	}
 	defer streamReader.Close()
 
+	nextItem, err := m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	if err != nil {
+		return errorsmod.Wrap(err, "multistore restore")
+	}
+
+	return m.restoreExtensions(snapshot.Height, nextItem, streamReader)
+}
+
+// doRestoreSections restores a FormatSections snapshot, restoring the stores
+// concurrently as their chunks are received, then the extensions.
+func (m *Manager) doRestoreSections(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+	// the remaining chunks are drained if the restoration fails
+	defer func() { go DrainChunks(chChunks) }()
+
+	multistore := m.multistore.(types.SectionSnapshotter)
+
+	var (
+		wg         sync.WaitGroup
+		failOnce   sync.Once
+		failed     = make(chan struct{})
+		restoreErr error
+	)
+	fail := func(err error) {
+		failOnce.Do(func() {
+			restoreErr = err
+			close(failed)
+		})
+	}
+
+	// restoreSection restores a section in a new goroutine, routing its chunks
+	// from the chunk channel.
+	chunk := uint32(0)
+	restoreSection := func(section types.SnapshotSection, restore func(protoio.Reader) error) {
+		select {
+		case <-failed:
+			return
+		default:
+		}
+
+		sectionCh := make(chan io.ReadCloser, sectionChunkBufferSize)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			streamReader, err := NewStreamReader(sectionCh)
+			if err != nil {
+				DrainChunks(sectionCh)
+				fail(errorsmod.Wrapf(err, "%s restore", sectionDisplayName(section.Name)))
+				return
+			}
+			defer streamReader.Close()
+
+			if err := restore(streamReader); err != nil {
+				fail(errorsmod.Wrapf(err, "%s restore", sectionDisplayName(section.Name)))
+			}
+		}()
+
+		defer close(sectionCh)
+		for index := uint32(0); index < section.Chunks; index++ {
+			var reader io.ReadCloser
+			select {
+			case reader = <-chChunks:
+			case <-failed:
+				return
+			}
+			if reader == nil {
+				fail(errorsmod.Wrapf(types.ErrInvalidMetadata, "missing chunk %d of %s", index, sectionDisplayName(section.Name)))
+				return
+			}
+
+			select {
+			case sectionCh <- newSectionChunkReader(reader, section.Name, index, snapshot.Metadata.ChunkHashes[chunk]):
+			case <-failed:
+				reader.Close()
+				return
+			}
+			chunk++
+		}
+	}
+
+	sections := snapshot.Metadata.Sections
+	for _, section := range sections {
+		if section.Name == "" {
+			break
+		}
+
+		name := section.Name
+		restoreSection(section, func(protoReader protoio.Reader) error {
+			return multistore.RestoreStore(snapshot.Height, name, protoReader)
+		})
+	}
+
+	wg.Wait()
+	if restoreErr != nil {
+		return restoreErr
+	}
+	if err := multistore.FinalizeRestore(snapshot.Height); err != nil {
+		return errorsmod.Wrap(err, "multistore restore")
+	}
+
+	if last := sections[len(sections)-1]; last.Name == "" {
+		restoreSection(last, func(protoReader protoio.Reader) error {
+			var nextItem types.SnapshotItem
+			if err := protoReader.ReadMsg(&nextItem); err != nil && err != io.EOF {
+				return err
+			}
+
+			return m.restoreExtensions(snapshot.Height, nextItem, protoReader)
+		})
+		wg.Wait()
+	}
+
+	return restoreErr
+}
+
+// doRestoreIncrement restores an incremental snapshot, on top of its base
+// snapshot.
+func (m *Manager) doRestoreIncrement(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+	multistore, ok := m.multistore.(types.IncrementalSnapshotter)
+	if !ok {
+		DrainChunks(chChunks)
+		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+
+	streamReader, err := NewStreamReader(chChunks)
+	if err != nil {
+		return err
+	}
+	defer streamReader.Close()
+
+	nextItem, err := multistore.RestoreIncrement(snapshot.Metadata.BaseHeight, snapshot.Height, streamReader)
+	if err != nil {
+		return errorsmod.Wrap(err, "multistore restore")
+	}
+
+	return m.restoreExtensions(snapshot.Height, nextItem, streamReader)
+}
+
+// restoreExtensions restores the extensions from their snapshot items, given
+// the first item.
+func (m *Manager) restoreExtensions(height uint64, nextItem types.SnapshotItem, protoReader protoio.Reader) error {
 	// payloadReader reads an extension payload for extension snapshotter, it returns `io.EOF` at extension boundaries.
 	payloadReader := func() ([]byte, error) {
 		nextItem.Reset()
-		if err := streamReader.ReadMsg(&nextItem); err != nil {
+		if err := protoReader.ReadMsg(&nextItem); err != nil {
 			return nil, err
 		}
 		payload := nextItem.GetExtensionPayload()
@@ -363,11 +637,6 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return payload.Payload, nil
 	}
 
-	nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
-	if err != nil {
-		return errorsmod.Wrap(err, "multistore restore")
-	}
-
 	for {
 		if nextItem.Item == nil {
 			// end of stream
@@ -385,12 +654,12 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 			return errorsmod.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
 		}
 
-		if err := extension.RestoreExtension(snapshot.Height, metadata.Format, payloadReader); err != nil {
+		if err := extension.RestoreExtension(height, metadata.Format, payloadReader); err != nil {
 			return errorsmod.Wrapf(err, "extension %s restore", metadata.Name)
 		}
 
 		if nextItem.GetExtensionPayload() != nil {
-			return errorsmod.Wrapf(err, "extension %s don't exhausted payload stream", metadata.Name)
+			return errorsmod.Wrapf(storetypes.ErrLogic, "extension %s don't exhausted payload stream", metadata.Name)
 		}
 	}
 	return nil
@@ -424,6 +693,10 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	hash := sha256.Sum256(chunk)
 	expected := m.restoreSnapshot.Metadata.ChunkHashes[m.restoreChunkIndex]
 	if !bytes.Equal(hash[:], expected) {
+		if section, index, ok := sectionOf(m.restoreSnapshot, m.restoreChunkIndex); ok {
+			return false, errorsmod.Wrapf(types.ErrChunkHashMismatch,
+				"chunk %d of %s: expected %x, got %x", index, sectionDisplayName(section.Name), hash, expected)
+		}
 		return false, errorsmod.Wrapf(types.ErrChunkHashMismatch,
 			"expected %x, got %x", hash, expected)
 	}
@@ -462,7 +735,7 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 
 // RestoreLocalSnapshot restores app state from a local snapshot.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
-	snapshot, ch, err := m.store.Load(height, format)
+	snapshot, err := m.store.Get(height, format)
 	if err != nil {
 		return err
 	}
@@ -471,6 +744,26 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
 	}
 
+	// an incremental snapshot is restored on top of its chain of base snapshots
+	chain := []*types.Snapshot{snapshot}
+	if snapshot.Format == types.FormatIncremental {
+		if _, ok := m.multistore.(types.IncrementalSnapshotter); !ok {
+			return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+		}
+		if chain, err = m.restoreChain(snapshot); err != nil {
+			return err
+		}
+	}
+
+	if !m.isFormatRestorable(chain[0].Format) {
+		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", chain[0].Format)
+	}
+	if chain[0].Format == types.FormatSections {
+		if err := validateSections(*chain[0]); err != nil {
+			return err
+		}
+	}
+
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
@@ -480,7 +773,63 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	}
 	defer m.endLocked()
 
-	return m.doRestoreSnapshot(*snapshot, ch)
+	for _, snapshot := range chain {
+		loaded, ch, err := m.store.Load(snapshot.Height, snapshot.Format)
+		if err != nil {
+			return err
+		}
+		if loaded == nil {
+			return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", snapshot.Height, snapshot.Format)
+		}
+
+		m.logger.Info("restoring snapshot", "height", snapshot.Height, "format", snapshot.Format)
+		if err := m.doRestoreSnapshot(*loaded, ch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreChain returns the snapshots restoring an incremental snapshot, in
+// order: a full snapshot, followed by the incremental snapshots on top of it.
+// The full snapshots are preferred as bases over the incremental ones.
+func (m *Manager) restoreChain(snapshot *types.Snapshot) ([]*types.Snapshot, error) {
+	// the snapshots are listed by descending height and format
+	snapshots, err := m.store.List()
+	if err != nil {
+		return nil, err
+	}
+
+	chain := []*types.Snapshot{snapshot}
+	for snapshot.Format == types.FormatIncremental {
+		var base *types.Snapshot
+		for _, s := range snapshots {
+			if s.Height != snapshot.Metadata.BaseHeight {
+				continue
+			}
+			if m.isFormatRestorable(s.Format) {
+				base = s
+				break
+			}
+			if s.Format == types.FormatIncremental {
+				base = s
+			}
+		}
+		if base == nil {
+			return nil, fmt.Errorf("base snapshot doesn't exist, height: %d, incremental snapshot height: %d",
+				snapshot.Metadata.BaseHeight, snapshot.Height)
+		}
+
+		chain = append(chain, base)
+		snapshot = base
+	}
+
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+
+	return chain, nil
 }
 
 // sortedExtensionNames sort extension names for deterministic iteration.
@@ -510,12 +859,16 @@ func (m *Manager) SnapshotIfApplicable(height int64) {
 	if m == nil {
 		return
 	}
-	if !m.shouldTakeSnapshot(height) {
-		m.logger.Debug("snapshot is skipped", "height", height)
+	if m.shouldTakeSnapshot(height) {
+		// start the routine after need to create a snapshot
+		go m.snapshot(height, false)
 		return
 	}
-	// start the routine after need to create a snapshot
-	go m.snapshot(height)
+	if m.shouldTakeIncrement(height) {
+		go m.snapshot(height, true)
+		return
+	}
+	m.logger.Debug("snapshot is skipped", "height", height)
 }
 
 // shouldTakeSnapshot returns true is snapshot should be taken at height.
@@ -523,17 +876,26 @@ func (m *Manager) shouldTakeSnapshot(height int64) bool {
 	return m.opts.Interval > 0 && uint64(height)%m.opts.Interval == 0
 }
 
-func (m *Manager) snapshot(height int64) {
-	m.logger.Info("creating state snapshot", "height", height)
+// shouldTakeIncrement returns true if an incremental snapshot should be taken at height.
+func (m *Manager) shouldTakeIncrement(height int64) bool {
+	return m.opts.IncrementalInterval > 0 && uint64(height)%m.opts.IncrementalInterval == 0
+}
+
+func (m *Manager) snapshot(height int64, incremental bool) {
+	m.logger.Info("creating state snapshot", "height", height, "incremental", incremental)
 
 	if height <= 0 {
 		m.logger.Error("snapshot height must be positive", "height", height)
 		return
 	}
 
-	snapshot, err := m.Create(uint64(height))
+	create := m.Create
+	if incremental {
+		create = m.CreateIncremental
+	}
+	snapshot, err := create(uint64(height))
 	if err != nil {
-		m.logger.Error("failed to create state snapshot", "height", height, "err", err)
+		m.logger.Error("failed to create state snapshot", "height", height, "incremental", incremental, "err", err)
 		return
 	}
 
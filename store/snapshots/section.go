@@ -0,0 +1,293 @@
+package snapshots
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+
+	protoio "github.com/cosmos/gogoproto/io"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/snapshots/types"
+)
+
+// maxSectionNameLength is the maximum length of the name of a section, so that
+// the chunk headers fit in the read buffer.
+const maxSectionNameLength = 1024
+
+// sectionChunkHeader returns the header starting the chunks of the
+// FormatSections snapshots, made of the name of their section and their index
+// in the section: uvarint(len(name)) | name | uvarint(index).
+func sectionChunkHeader(name string, index uint32) []byte {
+	header := binary.AppendUvarint(nil, uint64(len(name)))
+	header = append(header, name...)
+	return binary.AppendUvarint(header, uint64(index))
+}
+
+// peekSectionChunkHeader returns the section name and the index in the section
+// of a chunk, and the length of its header, without consuming it.
+func peekSectionChunkHeader(r *bufio.Reader) (name string, index uint32, n int, err error) {
+	peek := func(size int) ([]byte, error) {
+		bz, err := r.Peek(size)
+		if len(bz) > 0 && err == io.EOF {
+			err = nil
+		}
+		return bz, err
+	}
+
+	bz, err := peek(binary.MaxVarintLen64)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	nameLength, n := binary.Uvarint(bz)
+	if n <= 0 || nameLength > maxSectionNameLength {
+		return "", 0, 0, errorsmod.Wrap(types.ErrInvalidMetadata, "invalid section chunk header")
+	}
+
+	bz, err = peek(n + int(nameLength) + binary.MaxVarintLen32)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	if len(bz) < n+int(nameLength) {
+		return "", 0, 0, errorsmod.Wrap(types.ErrInvalidMetadata, "truncated section chunk header")
+	}
+
+	name = string(bz[n : n+int(nameLength)])
+	n += int(nameLength)
+
+	idx, m := binary.Uvarint(bz[n:])
+	if m <= 0 || idx > uint64(^uint32(0)) {
+		return "", 0, 0, errorsmod.Wrap(types.ErrInvalidMetadata, "invalid section chunk header")
+	}
+
+	return name, uint32(idx), n + m, nil
+}
+
+// sectionHash returns the hash of a section given the hashes of its chunks.
+func sectionHash(chunkHashes [][]byte) []byte {
+	hasher := sha256.New()
+	for _, chunkHash := range chunkHashes {
+		hasher.Write(chunkHash)
+	}
+
+	return hasher.Sum(nil)
+}
+
+// sectionDisplayName returns the name of a section used in the logs and errors.
+func sectionDisplayName(name string) string {
+	if name == "" {
+		return "extensions"
+	}
+
+	return fmt.Sprintf("store %s", name)
+}
+
+// validateSections checks that the sections of a FormatSections snapshot
+// describe its chunks.
+func validateSections(snapshot types.Snapshot) error {
+	sections := snapshot.Metadata.Sections
+	if len(sections) == 0 {
+		return errorsmod.Wrap(types.ErrInvalidMetadata, "no sections")
+	}
+
+	names := make(map[string]bool, len(sections))
+	chunks := uint32(0)
+	for i, section := range sections {
+		if section.Chunks == 0 {
+			return errorsmod.Wrapf(types.ErrInvalidMetadata, "%s has no chunks", sectionDisplayName(section.Name))
+		}
+		if names[section.Name] {
+			return errorsmod.Wrapf(types.ErrInvalidMetadata, "duplicated %s", sectionDisplayName(section.Name))
+		}
+		if section.Name == "" && i != len(sections)-1 {
+			return errorsmod.Wrap(types.ErrInvalidMetadata, "extensions must be the last section")
+		}
+		names[section.Name] = true
+
+		if uint64(chunks)+uint64(section.Chunks) > uint64(len(snapshot.Metadata.ChunkHashes)) {
+			return errorsmod.Wrapf(types.ErrInvalidMetadata, "sections have more chunks than the snapshot")
+		}
+
+		expected := sectionHash(snapshot.Metadata.ChunkHashes[chunks : chunks+section.Chunks])
+		if !bytes.Equal(section.Hash, expected) {
+			return errorsmod.Wrapf(types.ErrChunkHashMismatch, "%s: expected %x, got %x",
+				sectionDisplayName(section.Name), expected, section.Hash)
+		}
+
+		chunks += section.Chunks
+	}
+
+	if chunks != snapshot.Chunks {
+		return errorsmod.Wrapf(types.ErrInvalidMetadata, "sections have %d chunks, but snapshot has %d chunks",
+			chunks, snapshot.Chunks)
+	}
+
+	return nil
+}
+
+// sectionOf returns the section of a chunk of a FormatSections snapshot, and
+// the index of the chunk in the section.
+func sectionOf(snapshot *types.Snapshot, chunk uint32) (types.SnapshotSection, uint32, bool) {
+	if snapshot.Format != types.FormatSections {
+		return types.SnapshotSection{}, 0, false
+	}
+
+	for _, section := range snapshot.Metadata.Sections {
+		if chunk < section.Chunks {
+			return section, chunk, true
+		}
+		chunk -= section.Chunks
+	}
+
+	return types.SnapshotSection{}, 0, false
+}
+
+// writeSection writes a section of a snapshot to the chunk channel, the items
+// being written by the given function to a stream starting on a new chunk.
+// The error is also passed to the reader of the chunks.
+func writeSection(ch chan<- io.ReadCloser, name string, write func(protoio.Writer) error) error {
+	sectionCh := make(chan io.ReadCloser)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for chunk := range sectionCh {
+			ch <- chunk
+		}
+	}()
+
+	streamWriter := newSectionStreamWriter(sectionCh, name)
+	if streamWriter == nil {
+		<-done
+		return fmt.Errorf("failed to write %s: zlib failure", sectionDisplayName(name))
+	}
+
+	err := write(streamWriter)
+	if err != nil {
+		streamWriter.CloseWithError(err)
+	} else {
+		// the error is passed to the reader by Close
+		err = streamWriter.Close()
+	}
+
+	<-done
+	return err
+}
+
+// sectionChunkReader reads a chunk of a section without its header, checking
+// that the header matches the section and that the hash of the chunk matches
+// the snapshot metadata.
+type sectionChunkReader struct {
+	chunk    io.ReadCloser
+	reader   *bufio.Reader
+	hasher   hash.Hash
+	expected []byte
+
+	section string
+	index   uint32
+	started bool
+}
+
+func newSectionChunkReader(chunk io.ReadCloser, section string, index uint32, expected []byte) *sectionChunkReader {
+	hasher := sha256.New()
+	return &sectionChunkReader{
+		chunk:    chunk,
+		reader:   bufio.NewReader(io.TeeReader(chunk, hasher)),
+		hasher:   hasher,
+		expected: expected,
+		section:  section,
+		index:    index,
+	}
+}
+
+// Read implements io.Reader.
+func (r *sectionChunkReader) Read(p []byte) (int, error) {
+	if !r.started {
+		r.started = true
+
+		name, index, n, err := peekSectionChunkHeader(r.reader)
+		if err != nil {
+			return 0, errorsmod.Wrapf(err, "chunk %d", r.index)
+		}
+		if name != r.section || index != r.index {
+			return 0, errorsmod.Wrapf(types.ErrInvalidMetadata, "chunk %d of %s is chunk %d of %s",
+				r.index, sectionDisplayName(r.section), index, sectionDisplayName(name))
+		}
+		if _, err := r.reader.Discard(n); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.reader.Read(p)
+	if err == io.EOF && !bytes.Equal(r.hasher.Sum(nil), r.expected) {
+		return n, errorsmod.Wrapf(types.ErrChunkHashMismatch, "chunk %d: expected %x, got %x",
+			r.index, r.expected, r.hasher.Sum(nil))
+	}
+
+	return n, err
+}
+
+// Close implements io.Closer.
+func (r *sectionChunkReader) Close() error {
+	return r.chunk.Close()
+}
+
+// ReadSections reads the sections of a FormatSections snapshot sequentially,
+// calling read with a reader of the items of each section. The chunks are
+// checked against the snapshot metadata, and drained on error.
+func ReadSections(snapshot *types.Snapshot, chunks <-chan io.ReadCloser, read func(types.SnapshotSection, protoio.Reader) error) error {
+	defer DrainChunks(chunks)
+
+	if snapshot.Format != types.FormatSections {
+		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+	if err := validateSections(*snapshot); err != nil {
+		return err
+	}
+
+	chunk := uint32(0)
+	for _, section := range snapshot.Metadata.Sections {
+		sectionCh := make(chan io.ReadCloser)
+		missing := make(chan uint32, 1)
+		go func(section types.SnapshotSection, first uint32) {
+			defer close(sectionCh)
+			for index := uint32(0); index < section.Chunks; index++ {
+				reader, ok := <-chunks
+				if !ok {
+					missing <- index
+					return
+				}
+				sectionCh <- newSectionChunkReader(reader, section.Name, index, snapshot.Metadata.ChunkHashes[first+index])
+			}
+			missing <- section.Chunks
+		}(section, chunk)
+
+		err := readSection(sectionCh, section, read)
+		DrainChunks(sectionCh)
+		if index := <-missing; index < section.Chunks {
+			return errorsmod.Wrapf(types.ErrInvalidMetadata, "missing chunk %d of %s", index, sectionDisplayName(section.Name))
+		}
+		if err != nil {
+			return errorsmod.Wrapf(err, "%s", sectionDisplayName(section.Name))
+		}
+
+		chunk += section.Chunks
+	}
+
+	return nil
+}
+
+// readSection calls read with a stream reader of the chunks of a section.
+func readSection(sectionCh <-chan io.ReadCloser, section types.SnapshotSection, read func(types.SnapshotSection, protoio.Reader) error) error {
+	streamReader, err := NewStreamReader(sectionCh)
+	if err != nil {
+		return err
+	}
+	defer streamReader.Close()
+
+	return read(section, streamReader)
+}
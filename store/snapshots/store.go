@@ -1,6 +1,7 @@
 package snapshots
 
 import (
+	"bufio"
 	"crypto/sha256"
 	"encoding/binary"
 	"hash"
@@ -181,49 +182,86 @@ func (s *Store) loadChunkFile(height uint64, format, chunk uint32) (io.ReadClose
 	return os.Open(path)
 }
 
-// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
+// Prune removes old snapshots. The given number of most recent heights with full snapshots
+// (regardless of format) are retained, along with the incremental snapshots built on top of them.
 func (s *Store) Prune(retain uint32) (uint64, error) {
-	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(uint64(math.MaxUint64), math.MaxUint32))
+	snapshots, err := s.List()
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to prune snapshots")
 	}
-	defer iter.Close()
+
+	// The full snapshots of the latest retained heights are kept, with the
+	// incremental snapshots whose chain of base heights leads to them.
+	kept := make(map[uint64]bool)
+	for _, snapshot := range snapshots {
+		if snapshot.Format == types.FormatIncremental {
+			continue
+		}
+		if kept[snapshot.Height] || uint32(len(kept)) < retain {
+			kept[snapshot.Height] = true
+		}
+	}
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		// the bases of the incremental snapshots are at lower heights
+		if snapshot := snapshots[i]; snapshot.Format == types.FormatIncremental && kept[snapshot.Metadata.BaseHeight] {
+			kept[snapshot.Height] = true
+		}
+	}
 
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
-	skip := make(map[uint64]bool)
-	for ; iter.Valid(); iter.Next() {
-		height, format, err := decodeKey(iter.Key())
-		if err != nil {
-			return 0, errors.Wrap(err, "failed to prune snapshots")
-		}
-		if skip[height] || uint32(len(skip)) < retain {
-			skip[height] = true
+	for _, snapshot := range snapshots {
+		if kept[snapshot.Height] && (snapshot.Format != types.FormatIncremental || kept[snapshot.Metadata.BaseHeight]) {
 			continue
 		}
-		err = s.Delete(height, format)
+		err = s.Delete(snapshot.Height, snapshot.Format)
 		if err != nil {
 			return 0, errors.Wrap(err, "failed to prune snapshots")
 		}
 		pruned++
-		prunedHeights[height] = true
+		prunedHeights[snapshot.Height] = true
 	}
 	// Since Delete() deletes a specific format, while we want to prune a height, we clean up
 	// the height directory as well
 	for height, ok := range prunedHeights {
-		if ok {
+		if ok && !kept[height] {
 			err = os.Remove(s.pathHeight(height))
 			if err != nil {
 				return 0, errors.Wrapf(err, "failed to remove snapshot directory for height %v", height)
 			}
 		}
 	}
-	return pruned, iter.Error()
+	return pruned, nil
 }
 
 // Save saves a snapshot to disk, returning it.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	if format == types.FormatIncremental {
+		DrainChunks(chunks)
+		return nil, errors.Wrap(storetypes.ErrLogic, "incremental snapshots must be saved with their base height")
+	}
+
+	return s.save(height, format, 0, chunks)
+}
+
+// SaveIncrement saves an incremental snapshot on top of the snapshot at the
+// base height to disk, returning it.
+func (s *Store) SaveIncrement(
+	height, baseHeight uint64, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	if baseHeight == 0 || baseHeight >= height {
+		DrainChunks(chunks)
+		return nil, errors.Wrapf(storetypes.ErrLogic,
+			"invalid base height %v for incremental snapshot at height %v", baseHeight, height)
+	}
+
+	return s.save(height, types.FormatIncremental, baseHeight, chunks)
+}
+
+func (s *Store) save(
+	height uint64, format uint32, baseHeight uint64, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
 	if height == 0 {
@@ -256,6 +294,9 @@ func (s *Store) Save(
 	snapshot := &types.Snapshot{
 		Height: height,
 		Format: format,
+		Metadata: types.Metadata{
+			BaseHeight: baseHeight,
+		},
 	}
 
 	dirCreated := false
@@ -275,6 +316,14 @@ func (s *Store) Save(
 			dirCreated = true
 		}
 
+		if format == types.FormatSections {
+			var err error
+			if chunkBody, err = addSectionChunk(snapshot, chunkBody); err != nil {
+				chunkBody.Close()
+				return nil, errors.Wrapf(err, "failed to generate snapshot chunk %d", index)
+			}
+		}
+
 		if err := s.saveChunk(chunkBody, index, snapshot, chunkHasher, snapshotHasher); err != nil {
 			return nil, err
 		}
@@ -282,9 +331,43 @@ func (s *Store) Save(
 	}
 	snapshot.Chunks = index
 	snapshot.Hash = snapshotHasher.Sum(nil)
+
+	// the section hashes are known once the chunks are hashed
+	chunk := uint32(0)
+	for i, section := range snapshot.Metadata.Sections {
+		snapshot.Metadata.Sections[i].Hash = sectionHash(snapshot.Metadata.ChunkHashes[chunk : chunk+section.Chunks])
+		chunk += section.Chunks
+	}
+
 	return snapshot, s.saveSnapshot(snapshot)
 }
 
+// addSectionChunk adds a chunk of a FormatSections snapshot to the sections of
+// its metadata, given the header of the chunk. It returns the chunk to save,
+// including its header.
+func addSectionChunk(snapshot *types.Snapshot, chunkBody io.ReadCloser) (io.ReadCloser, error) {
+	reader := bufio.NewReader(chunkBody)
+	name, index, _, err := peekSectionChunkHeader(reader)
+	if err != nil {
+		return chunkBody, err
+	}
+
+	sections := snapshot.Metadata.Sections
+	switch {
+	case index == 0:
+		snapshot.Metadata.Sections = append(sections, types.SnapshotSection{Name: name, Chunks: 1})
+	case len(sections) == 0 || sections[len(sections)-1].Name != name || sections[len(sections)-1].Chunks != index:
+		return chunkBody, errors.Wrapf(types.ErrInvalidMetadata, "unexpected chunk %d of %s", index, sectionDisplayName(name))
+	default:
+		sections[len(sections)-1].Chunks++
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{reader, chunkBody}, nil
+}
+
 // saveChunk saves the given chunkBody with the given index to its appropriate path on disk.
 // The hash of the chunk is appended to the snapshot's metadata,
 // and the overall snapshot hash is updated with the chunk content too.
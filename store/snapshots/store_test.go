@@ -331,3 +331,89 @@ func TestStore_Save(t *testing.T) {
 	require.NoError(t, err)
 	close(ch)
 }
+
+func TestStore_SaveSections(t *testing.T) {
+	store := setupStore(t)
+
+	// the chunks start with the name of their section and their index in it
+	chunks := [][]byte{
+		{1, 'a', 0, 1, 2},
+		{1, 'a', 1, 3},
+		{1, 'b', 0, 4},
+		{0, 0, 5},
+	}
+	snapshot, err := store.Save(4, types.FormatSections, makeChunks(chunks))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(4), snapshot.Chunks)
+	assert.Equal(t, []types.SnapshotSection{
+		{Name: "a", Chunks: 2, Hash: hash(checksums(chunks[:2]))},
+		{Name: "b", Chunks: 1, Hash: hash(checksums(chunks[2:3]))},
+		{Name: "", Chunks: 1, Hash: hash(checksums(chunks[3:]))},
+	}, snapshot.Metadata.Sections)
+
+	loaded, err := store.Get(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, loaded)
+
+	// the chunks of a section must be consecutive
+	_, err = store.Save(5, types.FormatSections, makeChunks([][]byte{{1, 'a', 0}, {1, 'b', 0}, {1, 'a', 1}}))
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+
+	// a section must start with its first chunk
+	_, err = store.Save(6, types.FormatSections, makeChunks([][]byte{{1, 'a', 1}}))
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+}
+
+func TestStore_PruneIncremental(t *testing.T) {
+	store, err := snapshots.NewStore(db.NewMemDB(), GetTempDir(t))
+	require.NoError(t, err)
+
+	_, err = store.Save(1, 1, makeChunks([][]byte{{1}}))
+	require.NoError(t, err)
+	_, err = store.SaveIncrement(2, 1, makeChunks([][]byte{{2}}))
+	require.NoError(t, err)
+	_, err = store.SaveIncrement(3, 2, makeChunks([][]byte{{3}}))
+	require.NoError(t, err)
+	_, err = store.Save(4, 1, makeChunks([][]byte{{4}}))
+	require.NoError(t, err)
+	_, err = store.SaveIncrement(5, 4, makeChunks([][]byte{{5}}))
+	require.NoError(t, err)
+	// the base of an incremental snapshot may be missing
+	_, err = store.SaveIncrement(6, 3, makeChunks([][]byte{{6}}))
+	require.NoError(t, err)
+	_, err = store.SaveIncrement(7, 1, makeChunks([][]byte{{7}}))
+	require.NoError(t, err)
+
+	heights := func() []uint64 {
+		list, err := store.List()
+		require.NoError(t, err)
+		heights := []uint64{}
+		for _, snapshot := range list {
+			heights = append(heights, snapshot.Height)
+		}
+		return heights
+	}
+
+	// the incremental snapshots are saved with their base height
+	snapshot, err := store.Get(3, types.FormatIncremental)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), snapshot.Metadata.BaseHeight)
+
+	// the incremental snapshots must be saved with a base height
+	_, err = store.Save(8, types.FormatIncremental, makeChunks([][]byte{{8}}))
+	require.Error(t, err)
+	_, err = store.SaveIncrement(8, 8, makeChunks([][]byte{{8}}))
+	require.Error(t, err)
+
+	// retaining 2 full snapshots retains all the chains
+	pruned, err := store.Prune(2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+	assert.Equal(t, []uint64{7, 6, 5, 4, 3, 2, 1}, heights())
+
+	// retaining the latest full snapshot prunes the chains of the previous one
+	pruned, err = store.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, pruned)
+	assert.Equal(t, []uint64{5, 4}, heights())
+}
@@ -30,7 +30,21 @@ type StreamWriter struct {
 
 // NewStreamWriter set up a stream pipeline to serialize snapshot DB records.
 func NewStreamWriter(ch chan<- io.ReadCloser) *StreamWriter {
+	return newStreamWriter(NewChunkWriter(ch, snapshotChunkSize))
+}
+
+// newSectionStreamWriter set up a stream pipeline to serialize the items of a
+// section, starting every chunk with the section chunk header.
+func newSectionStreamWriter(ch chan<- io.ReadCloser, name string) *StreamWriter {
 	chunkWriter := NewChunkWriter(ch, snapshotChunkSize)
+	chunkWriter.header = func(index uint32) []byte {
+		return sectionChunkHeader(name, index)
+	}
+
+	return newStreamWriter(chunkWriter)
+}
+
+func newStreamWriter(chunkWriter *ChunkWriter) *StreamWriter {
 	bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
 	zWriter, err := zlib.NewWriterLevel(bufWriter, snapshotCompressionLevel)
 	if err != nil {
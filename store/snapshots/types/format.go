@@ -3,4 +3,25 @@ package types
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 3
+const CurrentFormat uint32 = FormatSections
+
+const (
+	// FormatStream is the format of the snapshots streaming the items of all
+	// the stores and extensions in a single zlib stream, which must be restored
+	// sequentially.
+	FormatStream uint32 = 3
+
+	// FormatSections is the format of the snapshots made of a section per store,
+	// followed by a section for the extensions. Each section is an independent
+	// zlib stream of items starting on a new chunk, described in the snapshot
+	// metadata, hence the stores can be verified and restored in parallel.
+	// It is only taken by multistores implementing SectionSnapshotter.
+	FormatSections uint32 = 4
+
+	// FormatIncremental is the format of the incremental snapshots, holding the
+	// changes of the stores since the snapshot at the base height of their
+	// metadata, followed by the items of the extensions in a single zlib
+	// stream. They are only restored locally, on top of their base snapshot,
+	// and are taken by multistores implementing IncrementalSnapshotter.
+	FormatIncremental uint32 = 5
+)
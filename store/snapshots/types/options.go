@@ -8,6 +8,12 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// IncrementalInterval defines at which heights an incremental snapshot is
+	// taken on top of the latest snapshot, between the snapshots taken every
+	// Interval. Zero disables the incremental snapshots. The versions of the
+	// stores since the latest snapshot must not be pruned when it is taken.
+	IncrementalInterval uint64
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
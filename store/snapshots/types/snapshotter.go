@@ -26,6 +26,44 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// SectionSnapshotter is a Snapshotter whose stores can be snapshotted and
+// restored independently, as sections of the FormatSections snapshots.
+type SectionSnapshotter interface {
+	Snapshotter
+
+	// SnapshotStoreNames returns the names of the stores to snapshot, in the
+	// order of their sections.
+	SnapshotStoreNames() ([]string, error)
+
+	// SnapshotStore writes the snapshot items of a store into the protobuf
+	// writer, starting with its SnapshotStoreItem.
+	SnapshotStore(height uint64, name string, protoWriter protoio.Writer) error
+
+	// RestoreStore restores a store from the reader of its snapshot items,
+	// starting with its SnapshotStoreItem. It may be called concurrently for
+	// different stores.
+	RestoreStore(height uint64, name string, protoReader protoio.Reader) error
+
+	// FinalizeRestore completes the restoration of the stores at the height,
+	// once they are all restored.
+	FinalizeRestore(height uint64) error
+}
+
+// IncrementalSnapshotter is a Snapshotter which also takes the incremental
+// snapshots, holding the changes of its stores since a base height.
+type IncrementalSnapshotter interface {
+	Snapshotter
+
+	// SnapshotIncrement writes the snapshot items of the changes of the stores
+	// from the base height to the height into the protobuf writer.
+	SnapshotIncrement(baseHeight, height uint64, protoWriter protoio.Writer) error
+
+	// RestoreIncrement applies the changes of the stores from the base height,
+	// which must be the latest height of the stores, to the height. It returns
+	// the next snapshot item, following the items of the stores.
+	RestoreIncrement(baseHeight, height uint64, protoReader protoio.Reader) (SnapshotItem, error)
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
@@ -497,7 +497,6 @@ func (s *CLITestSuite) TestCLISendGenerateSignAndBroadcast() {
 }
 
 func (s *CLITestSuite) TestCLIMultisignInsufficientCosigners() {
-	s.T().Skip("skipping test for HV2 (multisig not relevant)")
 	// Fetch account and a multisig info
 	account1, err := s.clientCtx.Keyring.Key("newAccount1")
 	s.Require().NoError(err)
@@ -589,7 +588,6 @@ func (s *CLITestSuite) TestCLIEncode() {
 }
 
 func (s *CLITestSuite) TestCLIMultisignSortSignatures() {
-	s.T().Skip("skipping test for HV2 (multisig not relevant)")
 	// Generate 2 accounts and a multisig.
 	account1, err := s.clientCtx.Keyring.Key("newAccount1")
 	s.Require().NoError(err)
@@ -669,7 +667,6 @@ func (s *CLITestSuite) TestCLIMultisignSortSignatures() {
 }
 
 func (s *CLITestSuite) TestSignWithMultisig() {
-	s.T().Skip("skipping test for HV2 (multisig not relevant)")
 	// Generate a account for signing.
 	account1, err := s.clientCtx.Keyring.Key("newAccount1")
 	s.Require().NoError(err)
@@ -678,7 +675,7 @@ func (s *CLITestSuite) TestSignWithMultisig() {
 	s.Require().NoError(err)
 
 	// Create an address that is not in the keyring, will be used to simulate `--multisig`
-	multisig := "cosmos1hd6fsrvnz6qkp87s3u86ludegq97agxsdkwzyh"
+	multisig := "0xbb749580d916816c1fd0e1f8dff1b9400bfda0d0"
 	_, err = s.ac.StringToBytes(multisig)
 	s.Require().NoError(err)
 
@@ -711,7 +708,6 @@ func (s *CLITestSuite) TestSignWithMultisig() {
 }
 
 func (s *CLITestSuite) TestCLIMultisign() {
-	s.T().Skip("skipping test for HV2 (multisig not relevant)")
 	// Generate 2 accounts and a multisig.
 	account1, err := s.clientCtx.Keyring.Key("newAccount1")
 	s.Require().NoError(err)
@@ -781,7 +777,6 @@ func (s *CLITestSuite) TestCLIMultisign() {
 }
 
 func (s *CLITestSuite) TestSignBatchMultisig() {
-	s.T().Skip("skipping test for HV2 (multisig not relevant)")
 	// Fetch 2 accounts and a multisig.
 	account1, err := s.clientCtx.Keyring.Key("newAccount1")
 	s.Require().NoError(err)
@@ -89,6 +89,11 @@ func (a genericAddressKey[T]) KeyType() string {
 	return a.keyType
 }
 
+// JSONSchema returns the JSON schema of the hex encoded address.
+func (a genericAddressKey[T]) JSONSchema() map[string]any {
+	return map[string]any{"type": "string", "pattern": "^0x[0-9a-fA-F]{40}$"}
+}
+
 func (a genericAddressKey[T]) EncodeNonTerminal(buffer []byte, key T) (int, error) {
 	return collections.BytesKey.EncodeNonTerminal(buffer, key)
 }
@@ -120,6 +125,10 @@ func (g lengthPrefixedAddressKey[T]) Size(key T) int { return g.SizeNonTerminal(
 
 func (g lengthPrefixedAddressKey[T]) KeyType() string { return "index_key/" + g.KeyCodec.KeyType() }
 
+func (g lengthPrefixedAddressKey[T]) JSONSchema() map[string]any {
+	return collcodec.JSONSchema(g.KeyCodec)
+}
+
 // Deprecated: LengthPrefixedAddressKey implements an SDK backwards compatible indexing key encoder
 // for addresses.
 // The status quo in the SDK is that address keys are length prefixed even when they're the
@@ -173,6 +182,10 @@ func (i intValueCodec) ValueType() string {
 	return "math.Int"
 }
 
+func (i intValueCodec) JSONSchema() map[string]any {
+	return map[string]any{"type": "string", "pattern": "^-?[0-9]+$"}
+}
+
 type legacyDecValueCodec struct{}
 
 func (i legacyDecValueCodec) Encode(value math.LegacyDec) ([]byte, error) {
@@ -209,6 +222,10 @@ func (i legacyDecValueCodec) ValueType() string {
 	return LegacyDec
 }
 
+func (i legacyDecValueCodec) JSONSchema() map[string]any {
+	return map[string]any{"type": "string", "pattern": "^-?[0-9]+\\.[0-9]{18}$"}
+}
+
 type timeKeyCodec struct{}
 
 func (timeKeyCodec) Encode(buffer []byte, key time.Time) (int, error) {
@@ -240,6 +257,10 @@ func (timeKeyCodec) DecodeJSON(b []byte) (time.Time, error) {
 
 func (timeKeyCodec) Stringify(key time.Time) string { return key.String() }
 func (timeKeyCodec) KeyType() string                { return "sdk/time.Time" }
+func (timeKeyCodec) JSONSchema() map[string]any {
+	return map[string]any{"type": "string", "format": "date-time"}
+}
+
 func (t timeKeyCodec) EncodeNonTerminal(buffer []byte, key time.Time) (int, error) {
 	return t.Encode(buffer, key)
 }
@@ -1,10 +1,13 @@
 package types
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/stretchr/testify/require"
 )
@@ -34,3 +37,29 @@ func TestCollectionsCorrectness(t *testing.T) {
 		colltest.TestKeyCodec(t, TimeKey, time.Time{})
 	})
 }
+
+func TestCollectionsJSONSchema(t *testing.T) {
+	jsonSchema := func(codec any) map[string]any {
+		schemaCodec, ok := codec.(collcodec.HasJSONSchema)
+		require.True(t, ok, "%T does not provide a JSON schema", codec)
+		return schemaCodec.JSONSchema()
+	}
+
+	addressSchema := map[string]any{"type": "string", "pattern": "^0x[0-9a-fA-F]{40}$"}
+	require.Equal(t, addressSchema, jsonSchema(AccAddressKey))
+	require.Equal(t, addressSchema, jsonSchema(LengthPrefixedAddressKey(ValAddressKey)))
+
+	bz, err := AccAddressKey.EncodeJSON(AccAddress(make([]byte, 20)))
+	require.NoError(t, err)
+	var addr string
+	require.NoError(t, json.Unmarshal(bz, &addr))
+	require.Regexp(t, addressSchema["pattern"], addr)
+	require.Equal(t, map[string]any{"type": "string", "format": "date-time"}, jsonSchema(TimeKey))
+	require.Equal(t, map[string]any{"type": "string", "pattern": "^-?[0-9]+$"}, jsonSchema(IntValue))
+
+	bz, err = LegacyDecValue.EncodeJSON(math.LegacyNewDecWithPrec(-15, 1))
+	require.NoError(t, err)
+	var dec string
+	require.NoError(t, json.Unmarshal(bz, &dec))
+	require.Regexp(t, jsonSchema(LegacyDecValue)["pattern"], dec)
+}
@@ -0,0 +1,303 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ ExtMempool         = (*BoundedMempool[int64])(nil)
+	_ InspectableMempool = (*BoundedMempool[int64])(nil)
+)
+
+type (
+	// BoundedMempoolConfig defines the configuration used to configure the
+	// BoundedMempool.
+	BoundedMempoolConfig[C comparable] struct {
+		// PriorityNonceMempoolConfig configures the underlying priority mempool.
+		// Unlike PriorityNonceMempool, when MaxTx > 0 and the mempool is full, the
+		// lowest priority transaction is evicted in favor of a higher priority one.
+		PriorityNonceMempoolConfig[C]
+
+		// MaxSenderTx sets the maximum number of transactions of a single sender,
+		// there is no cap if MaxSenderTx == 0.
+		MaxSenderTx int
+
+		// TxTTLBlocks sets the number of blocks after which a transaction is
+		// evicted, it can be included in the next TxTTLBlocks blocks following its
+		// insertion. Transactions don't expire by height if TxTTLBlocks == 0.
+		TxTTLBlocks int64
+
+		// TxTTL sets the time after which a transaction is evicted, transactions
+		// don't expire by time if TxTTL == 0.
+		TxTTL time.Duration
+
+		// MinReplacementFeeBump sets the minimum fee increase, in percent, of a
+		// transaction replacing another transaction of the same sender and nonce.
+		// It is only used if TxReplacement is nil, any replacement is accepted if
+		// both are unset.
+		MinReplacementFeeBump uint64
+	}
+
+	// BoundedMempool is a PriorityNonceMempool with per-sender limits, the
+	// expiration of transactions, and the eviction of the lowest priority
+	// transactions when full, preventing a single sender from filling it.
+	BoundedMempool[C comparable] struct {
+		mtx  sync.Mutex
+		pool *PriorityNonceMempool[C]
+		cfg  BoundedMempoolConfig[C]
+		now  func() time.Time
+
+		// inserted tracks the insertions of the transactions in the mempool by
+		// sender and nonce, and queue holds them in order, when they expire.
+		seq      uint64
+		inserted map[txKey]uint64
+		queue    []insertion
+	}
+
+	// insertion records the insertion of a transaction in the BoundedMempool.
+	insertion struct {
+		key    txKey
+		seq    uint64
+		tx     sdk.Tx
+		height int64
+		time   time.Time
+	}
+)
+
+// DefaultBoundedMempoolConfig returns a BoundedMempoolConfig using ctx.Priority
+// as the transaction priority, with no limits.
+func DefaultBoundedMempoolConfig() BoundedMempoolConfig[int64] {
+	return BoundedMempoolConfig[int64]{
+		PriorityNonceMempoolConfig: DefaultPriorityNonceMempoolConfig(),
+	}
+}
+
+// NewBoundedMempool returns a bounded mempool, returning txs in the same order
+// as a PriorityNonceMempool.
+func NewBoundedMempool[C comparable](cfg BoundedMempoolConfig[C]) *BoundedMempool[C] {
+	if cfg.TxReplacement == nil && cfg.MinReplacementFeeBump > 0 {
+		cfg.TxReplacement = NewFeeBumpTxReplacement[C](cfg.MinReplacementFeeBump)
+	}
+
+	// the capacity is enforced by the bounded mempool
+	poolCfg := cfg.PriorityNonceMempoolConfig
+	poolCfg.MaxTx = 0
+
+	return &BoundedMempool[C]{
+		pool:     NewPriorityMempool(poolCfg),
+		cfg:      cfg,
+		now:      time.Now,
+		inserted: make(map[txKey]uint64),
+	}
+}
+
+// NewFeeBumpTxReplacement returns a TxReplacement rule accepting a transaction
+// replacing another one if its fee is at least bump percent higher, for every
+// denomination of the fee of the replaced transaction.
+func NewFeeBumpTxReplacement[C comparable](bump uint64) func(op, np C, oTx, nTx sdk.Tx) bool {
+	threshold := sdkmath.NewIntFromUint64(100 + bump)
+	return func(_, _ C, oTx, nTx sdk.Tx) bool {
+		oFeeTx, ok := oTx.(sdk.FeeTx)
+		if !ok {
+			return false
+		}
+		nFeeTx, ok := nTx.(sdk.FeeTx)
+		if !ok {
+			return false
+		}
+
+		nFee := nFeeTx.GetFee()
+		for _, coin := range oFeeTx.GetFee() {
+			if nFee.AmountOf(coin.Denom).MulRaw(100).LT(coin.Amount.Mul(threshold)) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Insert attempts to insert a Tx into the mempool, evicting the expired
+// transactions first.
+//
+// A transaction of a sender having MaxSenderTx transactions is rejected, unless
+// it replaces one of them. When the mempool is full, the lowest priority
+// transaction is evicted if it has a lower priority than the inserted one,
+// among the last transactions (nonce-wise) of the other senders, to not leave a
+// nonce gap. Otherwise, the transaction is rejected.
+func (mp *BoundedMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	if mp.cfg.MaxTx < 0 {
+		return nil
+	}
+
+	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
+	if err != nil {
+		return err
+	}
+	if len(sigs) == 0 {
+		return fmt.Errorf("tx must have at least one signer")
+	}
+	key := txKey{address: sigs[0].Signer.String(), nonce: sigs[0].Sequence}
+
+	mp.evictExpired(ctx)
+
+	if _, replacement := mp.pool.scores[txMeta[C]{nonce: key.nonce, sender: key.address}]; !replacement {
+		if senderIndex, ok := mp.pool.senderIndices[key.address]; ok && mp.cfg.MaxSenderTx > 0 && senderIndex.Len() >= mp.cfg.MaxSenderTx {
+			return ErrMempoolSenderTxMaxCapacity
+		}
+
+		if mp.cfg.MaxTx > 0 && mp.pool.priorityIndex.Len() >= mp.cfg.MaxTx {
+			priority := mp.cfg.TxPriority.GetTxPriority(ctx, tx)
+			if err := mp.evictLowerPriority(key.address, priority); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := mp.pool.insert(ctx, tx); err != nil {
+		return err
+	}
+
+	if mp.expires() {
+		mp.seq++
+		mp.inserted[key] = mp.seq
+		item := insertion{key: key, seq: mp.seq, tx: tx, time: mp.now()}
+		if mp.cfg.TxTTLBlocks > 0 {
+			item.height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+		}
+		mp.queue = append(mp.queue, item)
+	}
+
+	return nil
+}
+
+// expires returns whether the transactions expire.
+func (mp *BoundedMempool[C]) expires() bool {
+	return mp.cfg.TxTTLBlocks > 0 || mp.cfg.TxTTL > 0
+}
+
+// evictLowerPriority evicts the lowest priority transaction with a priority
+// lower than the given one, among the last transactions of the senders other
+// than the given one. It returns ErrMempoolTxMaxCapacity if there is none.
+func (mp *BoundedMempool[C]) evictLowerPriority(sender string, priority C) error {
+	for node := mp.pool.priorityIndex.Back(); node != nil; node = node.Prev() {
+		key := node.Key().(txMeta[C])
+		if mp.cfg.TxPriority.Compare(key.priority, priority) >= 0 {
+			break
+		}
+		if key.sender == sender || key.senderElement.Next() != nil {
+			continue
+		}
+
+		return mp.remove(node.Value.(sdk.Tx))
+	}
+
+	return ErrMempoolTxMaxCapacity
+}
+
+// evictExpired evicts the transactions which expired at the height of the
+// context or now.
+func (mp *BoundedMempool[C]) evictExpired(ctx context.Context) {
+	if len(mp.queue) == 0 {
+		return
+	}
+
+	var height int64
+	if mp.cfg.TxTTLBlocks > 0 {
+		height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	}
+	now := mp.now()
+
+	for len(mp.queue) > 0 {
+		item := mp.queue[0]
+		expired := (mp.cfg.TxTTLBlocks > 0 && height > item.height+mp.cfg.TxTTLBlocks) ||
+			(mp.cfg.TxTTL > 0 && now.Sub(item.time) > mp.cfg.TxTTL)
+		if !expired {
+			return
+		}
+
+		mp.queue[0] = insertion{}
+		mp.queue = mp.queue[1:]
+		// the transaction may have been removed or replaced since
+		if mp.inserted[item.key] == item.seq {
+			_ = mp.remove(item.tx)
+		}
+	}
+}
+
+// remove removes a transaction from the mempool, the caller must hold the mutex.
+func (mp *BoundedMempool[C]) remove(tx sdk.Tx) error {
+	if err := mp.pool.remove(tx); err != nil || !mp.expires() {
+		return err
+	}
+
+	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
+	if err != nil {
+		return err
+	}
+	delete(mp.inserted, txKey{address: sigs[0].Signer.String(), nonce: sigs[0].Sequence})
+
+	return nil
+}
+
+// Select returns a set of transactions from the mempool, ordered by priority
+// and sender-nonce, after evicting the expired transactions.
+//
+// NOTE: It is not safe to use this iterator while removing transactions from
+// the underlying mempool.
+func (mp *BoundedMempool[C]) Select(ctx context.Context, txs [][]byte) Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	mp.evictExpired(ctx)
+	return mp.pool.doSelect(ctx, txs)
+}
+
+// SelectBy will hold the mutex during the iteration, callback returns if continue.
+func (mp *BoundedMempool[C]) SelectBy(ctx context.Context, txs [][]byte, callback func(sdk.Tx) bool) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	mp.evictExpired(ctx)
+
+	iter := mp.pool.doSelect(ctx, txs)
+	for iter != nil && callback(iter.Tx()) {
+		iter = iter.Next()
+	}
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *BoundedMempool[C]) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.pool.priorityIndex.Len()
+}
+
+// Remove removes a transaction from the mempool, returning an error if
+// unsuccessful.
+func (mp *BoundedMempool[C]) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.remove(tx)
+}
+
+// Txs returns the transactions in the mempool, ordered by priority, then
+// sender and nonce.
+func (mp *BoundedMempool[C]) Txs() []TxInfo {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.pool.txs()
+}
+
+// SenderTxs returns the transactions of a sender in the mempool, in nonce order.
+func (mp *BoundedMempool[C]) SenderTxs(sender string) []TxInfo {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.pool.senderTxs(sender)
+}
@@ -0,0 +1,194 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// feeTestTx is a testTx with a fee.
+type feeTestTx struct {
+	testTx
+	fee sdk.Coins
+}
+
+func (tx feeTestTx) GetGas() uint64 { return 0 }
+
+func (tx feeTestTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx feeTestTx) FeePayer() []byte { return tx.address }
+
+func (tx feeTestTx) FeeGranter() []byte { return nil }
+
+var _ sdk.FeeTx = feeTestTx{}
+
+func selectAll(t *testing.T, mp mempool.Mempool, ctx sdk.Context) []sdk.Tx {
+	t.Helper()
+	var txs []sdk.Tx
+	mempool.SelectBy(ctx, mp, nil, func(tx sdk.Tx) bool {
+		txs = append(txs, tx)
+		return true
+	})
+	return txs
+}
+
+func TestBoundedMempool_MaxSenderTx(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	cfg := mempool.DefaultBoundedMempoolConfig()
+	cfg.MaxSenderTx = 2
+	mp := mempool.NewBoundedMempool(cfg)
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 2, address: sa}))
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 3, address: sa}), mempool.ErrMempoolSenderTxMaxCapacity)
+	require.Equal(t, 2, mp.CountTx())
+
+	// a replacement is not limited
+	require.NoError(t, mp.Insert(ctx.WithPriority(20), testTx{priority: 20, nonce: 2, address: sa}))
+	require.Equal(t, 2, mp.CountTx())
+
+	// other senders are not limited
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 1, address: sb}))
+	require.Equal(t, 3, mp.CountTx())
+
+	// once a tx of the sender is removed, another one can be inserted
+	require.NoError(t, mp.Remove(testTx{priority: 10, nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 3, address: sa}))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestBoundedMempool_EvictLowestPriority(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+	sc := accounts[2].Address
+
+	cfg := mempool.DefaultBoundedMempoolConfig()
+	cfg.MaxTx = 3
+	mp := mempool.NewBoundedMempool(cfg)
+
+	txs := []testTx{
+		{priority: 5, nonce: 1, address: sa},
+		{priority: 20, nonce: 2, address: sa},
+		{priority: 10, nonce: 1, address: sb},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	// a tx with a lower priority than every evictable tx is rejected
+	err := mp.Insert(ctx.WithPriority(8), testTx{priority: 8, nonce: 1, address: sc})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, 3, mp.CountTx())
+
+	// the lowest priority tx of sa isn't its last one, so the tx of sb is evicted
+	require.NoError(t, mp.Insert(ctx.WithPriority(15), testTx{priority: 15, nonce: 1, address: sc}))
+	require.Equal(t, 3, mp.CountTx())
+	require.ErrorIs(t, mp.Remove(txs[2]), mempool.ErrTxNotFound)
+
+	// the txs of the sender are not evicted in favor of its own tx
+	err = mp.Insert(ctx.WithPriority(30), testTx{priority: 30, nonce: 2, address: sc})
+	require.NoError(t, err)
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, []sdk.Tx{
+		testTx{priority: 15, nonce: 1, address: sc},
+		testTx{priority: 30, nonce: 2, address: sc},
+		txs[0],
+	}, selectAll(t, mp, ctx))
+}
+
+func TestBoundedMempool_TTL(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{Height: 10}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	// by height
+	cfg := mempool.DefaultBoundedMempoolConfig()
+	cfg.TxTTLBlocks = 2
+	mp := mempool.NewBoundedMempool(cfg)
+
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(11), testTx{nonce: 1, address: sb}))
+	require.Len(t, selectAll(t, mp, ctx.WithBlockHeight(12)), 2)
+	require.Equal(t, []sdk.Tx{testTx{nonce: 1, address: sb}}, selectAll(t, mp, ctx.WithBlockHeight(13)))
+	require.Equal(t, 1, mp.CountTx())
+	require.Empty(t, selectAll(t, mp, ctx.WithBlockHeight(14)))
+	require.Equal(t, 0, mp.CountTx())
+
+	// a replaced tx expires from its replacement
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(12), testTx{nonce: 1, address: sa}))
+	require.Len(t, selectAll(t, mp, ctx.WithBlockHeight(14)), 1)
+	require.Empty(t, selectAll(t, mp, ctx.WithBlockHeight(15)))
+
+	// by time
+	now := time.Now()
+	cfg = mempool.DefaultBoundedMempoolConfig()
+	cfg.TxTTL = time.Minute
+	mp = mempool.NewBoundedMempool(cfg)
+	mp.SetClock(func() time.Time { return now })
+
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 1, address: sa}))
+	now = now.Add(30 * time.Second)
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 1, address: sb}))
+	now = now.Add(31 * time.Second)
+	require.Equal(t, []sdk.Tx{testTx{nonce: 1, address: sb}}, selectAll(t, mp, ctx))
+	now = now.Add(30 * time.Second)
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 2, address: sa}))
+	require.Equal(t, 1, mp.CountTx())
+}
+
+func TestBoundedMempool_MinReplacementFeeBump(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+
+	cfg := mempool.DefaultBoundedMempoolConfig()
+	cfg.MinReplacementFeeBump = 10
+	mp := mempool.NewBoundedMempool(cfg)
+
+	newTx := func(amount int64) feeTestTx {
+		return feeTestTx{
+			testTx: testTx{nonce: 1, address: sa},
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("stake", amount)),
+		}
+	}
+
+	require.NoError(t, mp.Insert(ctx, newTx(100)))
+	require.Error(t, mp.Insert(ctx, newTx(100)))
+	require.Error(t, mp.Insert(ctx, newTx(109)))
+	require.NoError(t, mp.Insert(ctx, newTx(110)))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []sdk.Tx{newTx(110)}, selectAll(t, mp, ctx))
+
+	// the fee must be bumped for every denom of the replaced tx
+	tx := newTx(200)
+	tx.fee = sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	require.Error(t, mp.Insert(ctx, tx))
+}
+
+func TestBoundedMempool_Disabled(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+
+	cfg := mempool.DefaultBoundedMempoolConfig()
+	cfg.MaxTx = -1
+	mp := mempool.NewBoundedMempool(cfg)
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 1, address: accounts[0].Address}))
+	require.Equal(t, 0, mp.CountTx())
+}
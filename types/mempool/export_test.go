@@ -0,0 +1,8 @@
+package mempool
+
+import "time"
+
+// SetClock sets the clock used to expire the transactions.
+func (mp *BoundedMempool[C]) SetClock(now func() time.Time) {
+	mp.now = now
+}
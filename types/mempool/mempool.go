@@ -34,6 +34,30 @@ type ExtMempool interface {
 	SelectBy(context.Context, [][]byte, func(sdk.Tx) bool)
 }
 
+// TxInfo describes a transaction in a mempool.
+type TxInfo struct {
+	Tx sdk.Tx
+	// Sender is the address of the first signer of the transaction, and Nonce
+	// its sequence.
+	Sender string
+	Nonce  uint64
+	// Priority is the formatted priority of the transaction, empty if the
+	// mempool doesn't prioritize transactions.
+	Priority string
+}
+
+// InspectableMempool is a Mempool listing its transactions, for introspection.
+type InspectableMempool interface {
+	Mempool
+
+	// Txs returns the transactions in the mempool, in the mempool order.
+	Txs() []TxInfo
+
+	// SenderTxs returns the transactions of a sender in the mempool, in nonce
+	// order.
+	SenderTxs(sender string) []TxInfo
+}
+
 // Iterator defines an app-side mempool iterator interface that is as minimal as
 // possible. The order of iteration is determined by the app-side mempool
 // implementation.
@@ -47,8 +71,9 @@ type Iterator interface {
 }
 
 var (
-	ErrTxNotFound           = errors.New("tx not found in mempool")
-	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+	ErrTxNotFound                 = errors.New("tx not found in mempool")
+	ErrMempoolTxMaxCapacity       = errors.New("pool reached max tx capacity")
+	ErrMempoolSenderTxMaxCapacity = errors.New("sender reached max tx capacity")
 )
 
 // SelectBy is compatible with old interface to avoid breaking api.
@@ -12,8 +12,9 @@ import (
 )
 
 var (
-	_ ExtMempool = (*PriorityNonceMempool[int64])(nil)
-	_ Iterator   = (*PriorityNonceIterator[int64])(nil)
+	_ ExtMempool         = (*PriorityNonceMempool[int64])(nil)
+	_ InspectableMempool = (*PriorityNonceMempool[int64])(nil)
+	_ Iterator           = (*PriorityNonceIterator[int64])(nil)
 )
 
 type (
@@ -210,6 +211,12 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 		return nil
 	}
 
+	return mp.insert(ctx, tx)
+}
+
+// insert inserts a Tx into the mempool regardless of its capacity, the caller
+// must hold the mutex.
+func (mp *PriorityNonceMempool[C]) insert(ctx context.Context, tx sdk.Tx) error {
 	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
 	if err != nil {
 		return err
@@ -441,11 +448,65 @@ func (mp *PriorityNonceMempool[C]) CountTx() int {
 	return mp.priorityIndex.Len()
 }
 
+// Txs returns the transactions in the mempool, ordered by priority, then
+// sender and nonce.
+func (mp *PriorityNonceMempool[C]) Txs() []TxInfo {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.txs()
+}
+
+func (mp *PriorityNonceMempool[C]) txs() []TxInfo {
+	txs := make([]TxInfo, 0, mp.priorityIndex.Len())
+	for node := mp.priorityIndex.Front(); node != nil; node = node.Next() {
+		txs = append(txs, mp.txInfo(node))
+	}
+	return txs
+}
+
+// SenderTxs returns the transactions of a sender in the mempool, in nonce order.
+func (mp *PriorityNonceMempool[C]) SenderTxs(sender string) []TxInfo {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.senderTxs(sender)
+}
+
+func (mp *PriorityNonceMempool[C]) senderTxs(sender string) []TxInfo {
+	senderIndex, ok := mp.senderIndices[sender]
+	if !ok {
+		return []TxInfo{}
+	}
+
+	txs := make([]TxInfo, 0, senderIndex.Len())
+	for node := senderIndex.Front(); node != nil; node = node.Next() {
+		txs = append(txs, mp.txInfo(node))
+	}
+	return txs
+}
+
+// txInfo returns the TxInfo of an element of the priority or sender indices.
+func (mp *PriorityNonceMempool[C]) txInfo(node *skiplist.Element) TxInfo {
+	key := node.Key().(txMeta[C])
+	// the priority of a sender index key isn't updated on replacement
+	score := mp.scores[txMeta[C]{nonce: key.nonce, sender: key.sender}]
+	return TxInfo{
+		Tx:       node.Value.(sdk.Tx),
+		Sender:   key.sender,
+		Nonce:    key.nonce,
+		Priority: fmt.Sprint(score.priority),
+	}
+}
+
 // Remove removes a transaction from the mempool in O(log n) time, returning an
 // error if unsuccessful.
 func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+	return mp.remove(tx)
+}
+
+// remove removes a transaction from the mempool, the caller must hold the mutex.
+func (mp *PriorityNonceMempool[C]) remove(tx sdk.Tx) error {
 	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
 	if err != nil {
 		return err
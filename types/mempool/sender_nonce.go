@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math/rand" // #nosec // math/rand is used for random selection and seeded from crypto/rand
+	"sort"
 	"sync"
 
 	"github.com/huandu/skiplist"
@@ -15,8 +16,9 @@ import (
 )
 
 var (
-	_ ExtMempool = (*SenderNonceMempool)(nil)
-	_ Iterator   = (*senderNonceMempoolIterator)(nil)
+	_ ExtMempool         = (*SenderNonceMempool)(nil)
+	_ InspectableMempool = (*SenderNonceMempool)(nil)
+	_ Iterator           = (*senderNonceMempoolIterator)(nil)
 )
 
 var DefaultMaxTx = -1
@@ -210,6 +212,45 @@ func (snm *SenderNonceMempool) CountTx() int {
 	return len(snm.existingTx)
 }
 
+// Txs returns the transactions in the mempool, ordered by sender and nonce.
+// Note, the mempool order is random across senders.
+func (snm *SenderNonceMempool) Txs() []TxInfo {
+	snm.mtx.Lock()
+	defer snm.mtx.Unlock()
+
+	senders := make([]string, 0, len(snm.senders))
+	for sender := range snm.senders {
+		senders = append(senders, sender)
+	}
+	sort.Strings(senders)
+
+	txs := make([]TxInfo, 0, len(snm.existingTx))
+	for _, sender := range senders {
+		txs = append(txs, snm.senderTxs(sender)...)
+	}
+	return txs
+}
+
+// SenderTxs returns the transactions of a sender in the mempool, in nonce order.
+func (snm *SenderNonceMempool) SenderTxs(sender string) []TxInfo {
+	snm.mtx.Lock()
+	defer snm.mtx.Unlock()
+	return snm.senderTxs(sender)
+}
+
+func (snm *SenderNonceMempool) senderTxs(sender string) []TxInfo {
+	senderTxs, found := snm.senders[sender]
+	if !found {
+		return []TxInfo{}
+	}
+
+	txs := make([]TxInfo, 0, senderTxs.Len())
+	for node := senderTxs.Front(); node != nil; node = node.Next() {
+		txs = append(txs, TxInfo{Tx: node.Value.(sdk.Tx), Sender: sender, Nonce: node.Key().(uint64)})
+	}
+	return txs
+}
+
 // Remove removes a tx from the mempool. It returns an error if the tx does not
 // have at least one signer or the tx was not found in the pool.
 func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
@@ -335,3 +335,47 @@ func getCollIter[K, V any, C Collection[K, V]](ctx context.Context, coll C, pref
 	}
 	return coll.IterateRaw(ctx, start, end, collections.OrderAscending)
 }
+
+// IndexQuery defines the minimum required API of a query over a secondary index
+// of a collection to work with pagination, e.g. the indexes.Query of an
+// IndexedMap.
+type IndexQuery[K, V any] interface {
+	// PageResults returns the results of the page starting at key, or at offset
+	// if key is nil, and the key of the next page, nil if there is none. The
+	// total number of results is returned if countTotal is set and key is nil.
+	PageResults(
+		ctx context.Context, key []byte, offset, limit uint64, countTotal, reverse bool,
+	) (results []collections.KeyValue[K, V], nextKey []byte, total uint64, err error)
+}
+
+// CollectionIndexPaginate paginates the results of a query over a secondary
+// index of a collection, following the same semantics as CollectionPaginate.
+// The NextKey of the PageResponse is the index key of the next result.
+// transformFunc is used to transform the result to a different type.
+func CollectionIndexPaginate[K, V any, Q IndexQuery[K, V], T any](
+	ctx context.Context,
+	query Q,
+	pageReq *PageRequest,
+	transformFunc func(key K, value V) (T, error),
+) ([]T, *PageResponse, error) {
+	pageReq = initPageRequestDefaults(pageReq)
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	kvs, nextKey, total, err := query.PageResults(ctx, pageReq.Key, pageReq.Offset, pageReq.Limit, pageReq.CountTotal, pageReq.Reverse)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make([]T, 0, len(kvs))
+	for _, kv := range kvs {
+		result, err := transformFunc(kv.Key, kv.Value)
+		if err != nil {
+			return nil, nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, &PageResponse{NextKey: nextKey, Total: total}, nil
+}
@@ -2,6 +2,8 @@ package query
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	db "github.com/cosmos/cosmos-db"
@@ -162,6 +164,45 @@ func TestCollectionPagination(t *testing.T) {
 	}
 }
 
+// mockIndexQuery is an IndexQuery returning a fixed page, recording the page
+// request.
+type mockIndexQuery struct {
+	key                 []byte
+	offset, limit       uint64
+	countTotal, reverse bool
+}
+
+func (q *mockIndexQuery) PageResults(
+	_ context.Context, key []byte, offset, limit uint64, countTotal, reverse bool,
+) ([]collections.KeyValue[string, uint64], []byte, uint64, error) {
+	q.key, q.offset, q.limit, q.countTotal, q.reverse = key, offset, limit, countTotal, reverse
+	if limit > 10 {
+		return nil, nil, 0, errors.New("limit too high")
+	}
+	return []collections.KeyValue[string, uint64]{{Key: "a", Value: 1}, {Key: "b", Value: 2}}, []byte("c"), 3, nil
+}
+
+func TestCollectionIndexPaginate(t *testing.T) {
+	transform := func(key string, value uint64) (string, error) {
+		return fmt.Sprintf("%s=%d", key, value), nil
+	}
+
+	q := &mockIndexQuery{}
+	results, res, err := CollectionIndexPaginate(context.Background(), q, &PageRequest{Key: []byte("a"), Limit: 2, Reverse: true}, transform)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a=1", "b=2"}, results)
+	require.Equal(t, &PageResponse{NextKey: []byte("c"), Total: 3}, res)
+	require.Equal(t, &mockIndexQuery{key: []byte("a"), limit: 2, reverse: true}, q)
+
+	// default limit
+	_, _, err = CollectionIndexPaginate(context.Background(), q, nil, transform)
+	require.ErrorContains(t, err, "limit too high")
+	require.Equal(t, &mockIndexQuery{limit: DefaultLimit, countTotal: true}, q)
+
+	_, _, err = CollectionIndexPaginate(context.Background(), q, &PageRequest{Key: []byte("a"), Offset: 1}, transform)
+	require.Error(t, err)
+}
+
 type testStore struct {
 	db db.DB
 }
@@ -115,7 +115,7 @@ func (cgts ConsumeTxSizeGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 			return sdk.Context{}, err
 		}
 
-		// HV2: multisig disabled in Heimdall
+		// HV2: single signer txs only, see SigGasConsumeDecorator
 		if len(signers) == 0 {
 			return ctx, sdkerrors.ErrNoSignatures
 		}
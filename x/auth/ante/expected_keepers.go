@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -23,3 +24,9 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// FeeMarketKeeper defines the expected fee market keeper.
+type FeeMarketKeeper interface {
+	GetBaseFee(ctx context.Context) (math.Int, error)
+	AddCollectedBaseFee(ctx context.Context, amount math.Int) error
+}
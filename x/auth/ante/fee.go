@@ -23,6 +23,8 @@ var (
 
 // TxFeeChecker check if the provided fee is enough and returns the effective fee and tx priority,
 // the effective fee should be deducted later, and the priority should be returned in abci response.
+// The package ships FixedTxFeeChecker (the default), GasPriceTxFeeChecker, MsgTypeTxFeeChecker and
+// the fee market checker returned by NewFeeMarketTxFeeChecker.
 type TxFeeChecker func(ctx sdk.Context, tx sdk.Tx, params types.Params) (sdk.Coins, int64, error)
 
 // DeductFeeDecorator deducts fees from the fee payer. The fee payer is the fee granter (if specified) or first signer of the tx.
@@ -38,7 +40,7 @@ type DeductFeeDecorator struct {
 
 func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) DeductFeeDecorator {
 	if tfc == nil {
-		tfc = checkTxFeeWithValidatorMinGasPrices
+		tfc = FixedTxFeeChecker
 	}
 
 	return DeductFeeDecorator{
@@ -15,6 +15,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	antetestutil "github.com/cosmos/cosmos-sdk/x/auth/ante/testutil"
 )
 
 func TestDeductFeeDecorator_ZeroGas(t *testing.T) {
@@ -144,3 +145,128 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+func TestGasPriceTxFeeChecker(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	accs := s.CreateTestAccounts(1)
+
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(gasLimit)
+	tx := s.txBuilder.GetTx()
+
+	params := authtypes.DefaultParams()
+
+	// the fee provided in the tx covers a low gas price
+	s.ctx = s.ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.DecCoins{sdk.NewDecCoinFromDec("pol", math.LegacyNewDec(20))})
+	fee, priority, err := ante.GasPriceTxFeeChecker(s.ctx, tx, params)
+	require.NoError(t, err)
+	require.Equal(t, feeAmount, fee)
+	// 1000000000000000pol / 200000 gas
+	require.Equal(t, int64(5000000000), priority)
+
+	// the fee provided in the tx does not cover a high gas price
+	s.ctx = s.ctx.WithMinGasPrices(sdk.DecCoins{sdk.NewDecCoinFromDec("pol", math.LegacyNewDec(10000000000))})
+	_, _, err = ante.GasPriceTxFeeChecker(s.ctx, tx, params)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// min gas prices are not enforced in DeliverTx
+	s.ctx = s.ctx.WithIsCheckTx(false)
+	fee, _, err = ante.GasPriceTxFeeChecker(s.ctx, tx, params)
+	require.NoError(t, err)
+	require.Equal(t, feeAmount, fee)
+}
+
+func TestMsgTypeTxFeeChecker(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	accs := s.CreateTestAccounts(1)
+
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	require.NoError(t, s.txBuilder.SetMsgs(msg, msg))
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	tx := s.txBuilder.GetTx()
+
+	// message types without an entry are charged the default tx fees
+	params := authtypes.DefaultParams()
+	fee, priority, err := ante.MsgTypeTxFeeChecker(s.ctx, tx, params)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 2000000000000000)), fee)
+	// 2000000000000000pol / DefaultMaxTxGas
+	require.Equal(t, int64(2000000000), priority)
+
+	// message types with an entry are charged the fee from the table, once per message
+	params.MsgFees = []authtypes.MsgFee{{MsgTypeUrl: sdk.MsgTypeURL(msg), Fee: "3000000000000000"}}
+	fee, _, err = ante.MsgTypeTxFeeChecker(s.ctx, tx, params)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 6000000000000000)), fee)
+
+	params.MsgFees = []authtypes.MsgFee{{MsgTypeUrl: sdk.MsgTypeURL(msg), Fee: "abc"}}
+	_, _, err = ante.MsgTypeTxFeeChecker(s.ctx, tx, params)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidTxFees)
+
+	// the decorator deducts the fee computed from the table
+	params.MsgFees = []authtypes.MsgFee{{MsgTypeUrl: sdk.MsgTypeURL(msg), Fee: "3000000000000000"}}
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	dfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, nil, ante.MsgTypeTxFeeChecker)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+	expectedFee := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 6000000000000000))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, expectedFee).Return(nil)
+
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+}
+
+func TestFeeMarketTxFeeChecker(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	accs := s.CreateTestAccounts(1)
+
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(gasLimit)
+	tx := s.txBuilder.GetTx()
+
+	feeMarketKeeper := antetestutil.NewMockFeeMarketKeeper(gomock.NewController(t))
+	checker := ante.NewFeeMarketTxFeeChecker(feeMarketKeeper)
+	params := authtypes.DefaultParams()
+
+	// base fee of 4000000000pol per gas: 800000000000000pol are required, the tip is 200000000000000pol
+	baseFee := math.NewInt(4000000000)
+	feeMarketKeeper.EXPECT().GetBaseFee(gomock.Any()).Return(baseFee, nil).AnyTimes()
+
+	// the base fee is not collected in CheckTx
+	fee, priority, err := checker(s.ctx.WithIsCheckTx(true), tx, params)
+	require.NoError(t, err)
+	require.Equal(t, feeAmount, fee)
+	// 200000000000000pol / 200000 gas
+	require.Equal(t, int64(1000000000), priority)
+
+	// the base fee portion is collected in DeliverTx
+	feeMarketKeeper.EXPECT().AddCollectedBaseFee(gomock.Any(), math.NewInt(800000000000000)).Return(nil)
+	fee, _, err = checker(s.ctx.WithIsCheckTx(false), tx, params)
+	require.NoError(t, err)
+	require.Equal(t, feeAmount, fee)
+
+	// the fee does not cover the base fee
+	s.txBuilder.SetGasLimit(gasLimit * 2)
+	_, _, err = checker(s.ctx, s.txBuilder.GetTx(), params)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// the fee is not paid in the fee token
+	s.txBuilder.SetGasLimit(gasLimit)
+	s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000000000000)))
+	_, _, err = checker(s.ctx, s.txBuilder.GetTx(), params)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+}
@@ -76,6 +76,11 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		return sdk.Context{}, err
 	}
 
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
 	signerStrs := make([]string, len(signers))
 	for i, pk := range pubkeys {
 		var err error
@@ -86,10 +91,22 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 
 		// PublicKey was omitted from slice since it has already been set in context
 		if pk == nil {
-			if !simulate {
-				continue
+			if simulate {
+				pk = simSecp256k1Pubkey
+			} else {
+				acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
+				if err != nil {
+					return ctx, err
+				}
+				if acc.GetPubKey() != nil || !ctx.IsSigverifyTx() || i >= len(sigs) {
+					continue
+				}
+				// HV2: the account has no pubkey yet, so recover it from the signature
+				pk, err = spkd.recoverPubKey(ctx, tx, acc, sigs[i])
+				if err != nil {
+					return ctx, err
+				}
 			}
-			pk = simSecp256k1Pubkey
 		}
 		// Only make check if simulate=false
 		if !simulate && !bytes.Equal(pk.Address(), signers[i]) && ctx.IsSigverifyTx() {
@@ -117,11 +134,6 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	// indices:
 	// - signature (via `tx.signature='<sig_as_base64>'`),
 	// - concat(address,"/",sequence) (via `tx.acc_seq='cosmos1abc...def/42'`).
-	sigs, err := sigTx.GetSignaturesV2()
-	if err != nil {
-		return ctx, err
-	}
-
 	var events sdk.Events
 	for i, sig := range sigs {
 		events = append(events, sdk.NewEvent(sdk.EventTypeTx,
@@ -144,6 +156,52 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
+// recoverPubKey recovers the secp256k1 public key of a signer from its 65 bytes recoverable signature,
+// for txs which omit the public key in their SignerInfo.
+// The recovered key still has to match the signer address, and the signature is then verified
+// against it by the SigVerificationDecorator.
+func (spkd SetPubKeyDecorator) recoverPubKey(ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, sig signing.SignatureV2) (cryptotypes.PubKey, error) {
+	data, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey can only be recovered from a single signature")
+	}
+
+	if len(data.Signature) != secp256k1.SigSize {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
+			"pubkey can only be recovered from a %d bytes signature, got %d", secp256k1.SigSize, len(data.Signature))
+	}
+
+	var accNum uint64
+	if ctx.BlockHeight() != 0 {
+		accNum = acc.GetAccountNumber()
+	}
+
+	signerData := authsigning.SignerData{
+		Address:       acc.GetAddress().String(),
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      acc.GetSequence(),
+	}
+	signBytes, err := authsigning.GetSignBytesAdapter(ctx, spkd.signModeHandler, data.SignMode, signerData, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// ethereum wallets encode the recovery id as 27 or 28
+	rsv := make([]byte, secp256k1.SigSize)
+	copy(rsv, data.Signature)
+	if rsv[secp256k1.SigSize-1] >= 27 {
+		rsv[secp256k1.SigSize-1] -= 27
+	}
+
+	pkBz, err := authsigning.RecoverPubKey(signBytes, rsv)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "failed to recover pubkey from signature: %s", err)
+	}
+
+	return &secp256k1.PubKey{Key: pkBz}, nil
+}
+
 // Consume parameter-defined amount of gas for each signature according to the passed-in SignatureVerificationGasConsumer function
 // before calling the next AnteHandler
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
@@ -183,7 +241,8 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		return ctx, err
 	}
 
-	// HV2: multisig disabled in Heimdall
+	// HV2: Heimdall txs carry exactly one signer. Multisig accounts are supported,
+	// they sign as a single signer holding a LegacyAminoPubKey.
 	if len(signers) == 0 {
 		return ctx, sdkerrors.ErrNoSignatures
 	}
@@ -473,15 +532,27 @@ func ConsumeMultisignatureVerificationGas(
 	meter storetypes.GasMeter, sig *signing.MultiSignatureData, pubkey multisig.PubKey,
 	params types.Params, accSeq uint64,
 ) error {
+	if sig.BitArray == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "multisig signature is missing its bit array")
+	}
+
+	pubKeys := pubkey.GetPubKeys()
 	size := sig.BitArray.Count()
+	if size != len(pubKeys) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "bit array size is incorrect, expected: %d, got: %d", len(pubKeys), size)
+	}
+
 	sigIndex := 0
 
 	for i := 0; i < size; i++ {
 		if !sig.BitArray.GetIndex(i) {
 			continue
 		}
+		if sigIndex >= len(sig.Signatures) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "not enough signatures for bit array, got %d", len(sig.Signatures))
+		}
 		sigV2 := signing.SignatureV2{
-			PubKey:   pubkey.GetPubKeys()[i],
+			PubKey:   pubKeys[i],
 			Data:     sig.Signatures[sigIndex],
 			Sequence: accSeq,
 		}
@@ -7,8 +7,10 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
@@ -18,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
@@ -70,6 +73,108 @@ func TestSetPubKey(t *testing.T) {
 	}
 }
 
+func TestSetPubKeyFromSignature(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	// make block height non-zero to ensure account numbers part of signBytes
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	priv1, pub1, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, _ := testdata.KeyTestPubAddr()
+
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	testCases := []struct {
+		name     string
+		signer   cryptotypes.PrivKey
+		malleate func(sig []byte) []byte
+		expErr   error
+	}{
+		{
+			name:     "pubkey recovered from signature",
+			signer:   priv1,
+			malleate: func(sig []byte) []byte { return sig },
+		},
+		{
+			name:   "pubkey recovered from signature with ethereum recovery id",
+			signer: priv1,
+			malleate: func(sig []byte) []byte {
+				sig[len(sig)-1] += 27
+				return sig
+			},
+		},
+		{
+			name:     "recovered pubkey does not match signer",
+			signer:   priv2,
+			malleate: func(sig []byte) []byte { return sig },
+			expErr:   sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name:     "signature without recovery id",
+			signer:   priv1,
+			malleate: func(sig []byte) []byte { return sig[:64] },
+			expErr:   sdkerrors.ErrInvalidPubKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, signMode := range []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON} {
+			t.Run(fmt.Sprintf("%s with %s", tc.name, signMode), func(t *testing.T) {
+				acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+				require.NoError(t, acc.SetAccountNumber(1000))
+				suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+				suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+				require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+				suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+				suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+				// the signer info omits the public key
+				sigV2 := signing.SignatureV2{
+					Data:     &signing.SingleSignatureData{SignMode: signMode},
+					Sequence: 0,
+				}
+				require.NoError(t, suite.txBuilder.SetSignatures(sigV2))
+
+				signBytes, err := authsign.GetSignBytesAdapter(suite.ctx, suite.clientCtx.TxConfig.SignModeHandler(), signMode,
+					authsign.SignerData{
+						Address:       addr1.String(),
+						ChainID:       suite.ctx.ChainID(),
+						AccountNumber: acc.GetAccountNumber(),
+						Sequence:      0,
+					}, suite.txBuilder.GetTx())
+				require.NoError(t, err)
+				sig, err := tc.signer.Sign(signBytes)
+				require.NoError(t, err)
+
+				sigV2.Data = &signing.SingleSignatureData{SignMode: signMode, Signature: tc.malleate(sig)}
+				require.NoError(t, suite.txBuilder.SetSignatures(sigV2))
+				tx := suite.txBuilder.GetTx()
+
+				pubKeys, err := tx.GetPubKeys()
+				require.NoError(t, err)
+				require.Nil(t, pubKeys[0])
+
+				_, err = antehandler(suite.ctx, tx, false)
+				if tc.expErr != nil {
+					require.ErrorIs(t, err, tc.expErr)
+					pk, err := suite.accountKeeper.GetPubKey(suite.ctx, addr1)
+					require.NoError(t, err)
+					require.Nil(t, pk)
+					return
+				}
+				require.NoError(t, err)
+
+				pk, err := suite.accountKeeper.GetPubKey(suite.ctx, addr1)
+				require.NoError(t, err)
+				require.True(t, pub1.Equals(pk))
+			})
+		}
+	}
+}
+
 func TestConsumeSignatureVerificationGas(t *testing.T) {
 	suite := SetupTestSuite(t, false)
 	params := types.DefaultParams()
@@ -246,6 +351,185 @@ func TestSigVerification(t *testing.T) {
 	}
 }
 
+func TestSigVerificationEIP191(t *testing.T) {
+	// SIGN_MODE_EIP_191 is enabled by the default sign modes
+	suite := SetupTestSuite(t, false)
+	require.Contains(t, suite.clientCtx.TxConfig.SignModeHandler().SupportedModes(), signingv1beta1.SignMode_SIGN_MODE_EIP_191)
+
+	// make block height non-zero to ensure account numbers part of signBytes
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	require.NoError(t, acc.SetAccountNumber(1000))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	testCases := []struct {
+		name     string
+		malleate func(tx authsign.Tx) authsign.Tx
+		expErr   bool
+	}{
+		{
+			name:     "valid eip191 signature",
+			malleate: func(tx authsign.Tx) authsign.Tx { return tx },
+		},
+		{
+			name: "signature over the amino json bytes without the eip191 envelope",
+			malleate: func(tx authsign.Tx) authsign.Tx {
+				sigs, err := tx.GetSignaturesV2()
+				require.NoError(t, err)
+				signBytes, err := authsign.GetSignBytesAdapter(suite.ctx, suite.clientCtx.TxConfig.SignModeHandler(),
+					signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, authsign.SignerData{
+						Address:       addr1.String(),
+						ChainID:       suite.ctx.ChainID(),
+						AccountNumber: acc.GetAccountNumber(),
+						Sequence:      0,
+						PubKey:        priv1.PubKey(),
+					}, tx)
+				require.NoError(t, err)
+				sig, err := priv1.Sign(signBytes)
+				require.NoError(t, err)
+				sigs[0].Data = &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_EIP_191, Signature: sig}
+				require.NoError(t, suite.txBuilder.SetSignatures(sigs...))
+				return suite.txBuilder.GetTx()
+			},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv1}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_EIP_191)
+			require.NoError(t, err)
+			tx = tc.malleate(tx)
+
+			_, err = antehandler(suite.ctx, tx, false)
+			if tc.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSigVerificationMultisig(t *testing.T) {
+	// 2-of-3 multisig built from keccak-based secp256k1 keys
+	privs := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	pubKeys := make([]cryptotypes.PubKey, len(privs))
+	for i, priv := range privs {
+		pubKeys[i] = priv.PubKey()
+	}
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	addr := sdk.AccAddress(multisigKey.Address())
+
+	testCases := []struct {
+		name       string
+		signers    []int
+		seq        uint64
+		txSigLimit uint64
+		expErr     error
+		expGasCost uint64
+	}{
+		{"threshold reached", []int{0, 2}, 0, types.DefaultTxSigLimit, nil, 2 * types.DefaultSigVerifyCostSecp256k1},
+		{"all keys sign", []int{0, 1, 2}, 0, types.DefaultTxSigLimit, nil, 3 * types.DefaultSigVerifyCostSecp256k1},
+		{"below threshold", []int{1}, 0, types.DefaultTxSigLimit, sdkerrors.ErrUnauthorized, 0},
+		{"wrong sequence", []int{0, 1}, 1, types.DefaultTxSigLimit, sdkerrors.ErrWrongSequence, 0},
+		{"sub keys above the sig limit", []int{0, 1}, 0, 2, sdkerrors.ErrTooManySignatures, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTestSuite(t, false)
+			// store accesses are free so that only the signature verification gas is consumed
+			suite.ctx = suite.ctx.WithKVGasConfig(storetypes.GasConfig{})
+
+			params := types.DefaultParams()
+			params.TxSigLimit = tc.txSigLimit
+			require.NoError(t, suite.accountKeeper.Params.Set(suite.ctx, params))
+
+			acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+			require.NoError(t, acc.SetAccountNumber(1000))
+			suite.accountKeeper.SetAccount(suite.ctx, acc)
+			suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), addr, types.FeeCollectorName, testdata.NewTestFeeAmount()).Return(nil).AnyTimes()
+
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			// set the signer info first so that it is part of the sign bytes
+			multisigData := multisig.NewMultisig(len(pubKeys))
+			require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey:   multisigKey,
+				Data:     multisigData,
+				Sequence: tc.seq,
+			}))
+
+			signerData := authsign.SignerData{
+				Address:       addr.String(),
+				ChainID:       suite.ctx.ChainID(),
+				AccountNumber: acc.GetAccountNumber(),
+				Sequence:      tc.seq,
+				PubKey:        multisigKey,
+			}
+			for _, i := range tc.signers {
+				sig, err := tx.SignWithPrivKey(
+					suite.ctx, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData,
+					suite.txBuilder, privs[i], suite.clientCtx.TxConfig, tc.seq)
+				require.NoError(t, err)
+				require.NoError(t, multisig.AddSignatureV2(multisigData, sig, pubKeys))
+			}
+			require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey:   multisigKey,
+				Data:     multisigData,
+				Sequence: tc.seq,
+			}))
+
+			// the full ante handler chain
+			newCtx, err := suite.anteHandler(suite.ctx, suite.txBuilder.GetTx(), false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expGasCost, newCtx.GasMeter().GasConsumed())
+		})
+	}
+}
+
+func TestConsumeMultisignatureVerificationGasMalformed(t *testing.T) {
+	params := types.DefaultParams()
+	msg := []byte{1, 2, 3, 4}
+
+	pkSet, sigSet := generatePubKeysAndSignatures(3, msg, false)
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pkSet)
+
+	// bit array larger than the number of keys in the multisig
+	oversized := multisig.NewMultisig(len(pkSet) + 1)
+	err := ante.ConsumeMultisignatureVerificationGas(storetypes.NewInfiniteGasMeter(), oversized, multisigKey, params, 0)
+	require.Error(t, err)
+
+	// bits set without the matching signatures
+	missingSigs := multisig.NewMultisig(len(pkSet))
+	missingSigs.BitArray.SetIndex(0, true)
+	missingSigs.BitArray.SetIndex(1, true)
+	missingSigs.Signatures = []signing.SignatureData{
+		&signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: sigSet[0]},
+	}
+	err = ante.ConsumeMultisignatureVerificationGas(storetypes.NewInfiniteGasMeter(), missingSigs, multisigKey, params, 0)
+	require.Error(t, err)
+}
+
 func TestSigIntegration(t *testing.T) {
 	// generate private keys
 	privs := []cryptotypes.PrivKey{
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	address "cosmossdk.io/core/address"
+	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
 	types0 "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gomock "github.com/golang/mock/gomock"
@@ -141,3 +142,55 @@ func (mr *MockFeegrantKeeperMockRecorder) UseGrantedFees(ctx, granter, grantee,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseGrantedFees", reflect.TypeOf((*MockFeegrantKeeper)(nil).UseGrantedFees), ctx, granter, grantee, fee, msgs)
 }
+
+// MockFeeMarketKeeper is a mock of FeeMarketKeeper interface.
+type MockFeeMarketKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeMarketKeeperMockRecorder
+}
+
+// MockFeeMarketKeeperMockRecorder is the mock recorder for MockFeeMarketKeeper.
+type MockFeeMarketKeeperMockRecorder struct {
+	mock *MockFeeMarketKeeper
+}
+
+// NewMockFeeMarketKeeper creates a new mock instance.
+func NewMockFeeMarketKeeper(ctrl *gomock.Controller) *MockFeeMarketKeeper {
+	mock := &MockFeeMarketKeeper{ctrl: ctrl}
+	mock.recorder = &MockFeeMarketKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeMarketKeeper) EXPECT() *MockFeeMarketKeeperMockRecorder {
+	return m.recorder
+}
+
+// AddCollectedBaseFee mocks base method.
+func (m *MockFeeMarketKeeper) AddCollectedBaseFee(ctx context.Context, amount math.Int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCollectedBaseFee", ctx, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCollectedBaseFee indicates an expected call of AddCollectedBaseFee.
+func (mr *MockFeeMarketKeeperMockRecorder) AddCollectedBaseFee(ctx, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollectedBaseFee", reflect.TypeOf((*MockFeeMarketKeeper)(nil).AddCollectedBaseFee), ctx, amount)
+}
+
+// GetBaseFee mocks base method.
+func (m *MockFeeMarketKeeper) GetBaseFee(ctx context.Context) (math.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaseFee", ctx)
+	ret0, _ := ret[0].(math.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBaseFee indicates an expected call of GetBaseFee.
+func (mr *MockFeeMarketKeeperMockRecorder) GetBaseFee(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseFee", reflect.TypeOf((*MockFeeMarketKeeper)(nil).GetBaseFee), ctx)
+}
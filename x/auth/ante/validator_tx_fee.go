@@ -11,9 +11,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
-// checkTxFeeWithValidatorMinGasPrices implements the default fee logic, where the minimum price per
-// unit of gas is fixed and set by each validator, can the tx priority is computed from the gas price.
-func checkTxFeeWithValidatorMinGasPrices(_ sdk.Context, _ sdk.Tx, params types.Params) (sdk.Coins, int64, error) {
+// FixedTxFeeChecker implements the default heimdall fee logic, where every tx is charged params.TxFees
+// in the fee token regardless of the gas it requests, and the tx priority is computed from params.MaxTxGas.
+func FixedTxFeeChecker(_ sdk.Context, _ sdk.Tx, params types.Params) (sdk.Coins, int64, error) {
 	amount, ok := sdkmath.NewIntFromString(params.GetTxFees())
 	if !ok {
 		return nil, 0, errorsmod.Wrap(sdkerrors.ErrInvalidTxFees, "must provide correct txFees")
@@ -23,36 +23,128 @@ func checkTxFeeWithValidatorMinGasPrices(_ sdk.Context, _ sdk.Tx, params types.P
 	gas := params.GetMaxTxGas()
 	feeCoins := sdk.Coins{sdk.Coin{Denom: types.FeeToken, Amount: amount}}
 
-	// HV2: removed because it had also been removed in heimdall
+	priority := getTxPriority(feeCoins, int64(gas))
+	return feeCoins, priority, nil
+}
+
+// GasPriceTxFeeChecker implements the upstream fee logic, where the minimum price per unit of gas is set
+// by each validator through minimum-gas-prices, and the tx priority is computed from the gas price.
+// The fee and gas limit are the ones provided in the tx.
+func GasPriceTxFeeChecker(ctx sdk.Context, tx sdk.Tx, _ types.Params) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
 	// Ensure that the provided fees meet a minimum threshold for the validator,
 	// if this is a CheckTx. This is only for local mempool purposes, and thus
 	// is only ran on check tx.
-	/*
-		if ctx.IsCheckTx() {
-
-			minGasPrices := ctx.MinGasPrices()
-			if !minGasPrices.IsZero() {
-				requiredFees := make(sdk.Coins, len(minGasPrices))
-
-				// Determine the required fees by multiplying each required minimum gas
-				// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-				glDec := sdkmath.LegacyNewDec(int64(gas))
-				for i, gp := range minGasPrices {
-					fee := gp.Amount.Mul(glDec)
-					requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
-				}
-
-				if !feeCoins.IsAnyGTE(requiredFees) {
-					return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
-				}
+	if ctx.IsCheckTx() {
+		minGasPrices := ctx.MinGasPrices()
+		if !minGasPrices.IsZero() {
+			requiredFees := make(sdk.Coins, len(minGasPrices))
+
+			// Determine the required fees by multiplying each required minimum gas
+			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+			glDec := sdkmath.LegacyNewDec(int64(gas))
+			for i, gp := range minGasPrices {
+				fee := gp.Amount.Mul(glDec)
+				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+			}
+
+			if !feeCoins.IsAnyGTE(requiredFees) {
+				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
 			}
 		}
-	*/
+	}
+
+	if gas == 0 {
+		return feeCoins, 0, nil
+	}
 
 	priority := getTxPriority(feeCoins, int64(gas))
 	return feeCoins, priority, nil
 }
 
+// MsgTypeTxFeeChecker implements a fee logic driven by the governance controlled params.MsgFees table.
+// Every message in the tx is charged the fee configured for its type URL, or params.TxFees when the
+// type has no entry, and the sum is charged in the fee token. As in FixedTxFeeChecker, the gas
+// provided in the tx is ignored and the tx priority is computed from params.MaxTxGas.
+func MsgTypeTxFeeChecker(_ sdk.Context, tx sdk.Tx, params types.Params) (sdk.Coins, int64, error) {
+	defaultFee, ok := sdkmath.NewIntFromString(params.GetTxFees())
+	if !ok {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrInvalidTxFees, "must provide correct txFees")
+	}
+
+	msgFees := make(map[string]sdkmath.Int, len(params.MsgFees))
+	for _, mf := range params.MsgFees {
+		fee, ok := sdkmath.NewIntFromString(mf.Fee)
+		if !ok {
+			return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInvalidTxFees, "must provide correct fee for %s", mf.MsgTypeUrl)
+		}
+		msgFees[mf.MsgTypeUrl] = fee
+	}
+
+	amount := sdkmath.ZeroInt()
+	for _, msg := range tx.GetMsgs() {
+		fee, found := msgFees[sdk.MsgTypeURL(msg)]
+		if !found {
+			fee = defaultFee
+		}
+		amount = amount.Add(fee)
+	}
+
+	gas := params.GetMaxTxGas()
+	feeCoins := sdk.NewCoins(sdk.NewCoin(types.FeeToken, amount))
+
+	priority := getTxPriority(feeCoins, int64(gas))
+	return feeCoins, priority, nil
+}
+
+// NewFeeMarketTxFeeChecker returns an EIP-1559 style TxFeeChecker backed by the fee market keeper.
+// The tx must pay, in the fee token, at least the current base fee for each unit of gas it requests.
+// The base fee portion is recorded in the fee market so that it can be burned at the end of the block,
+// and the tx priority is computed from the tip, i.e. the fee paid above the base fee.
+func NewFeeMarketTxFeeChecker(fmk FeeMarketKeeper) TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx, _ types.Params) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		baseFee, err := fmk.GetBaseFee(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		feeCoins := feeTx.GetFee()
+		gas := feeTx.GetGas()
+
+		paid := feeCoins.AmountOf(types.FeeToken)
+		required := baseFee.Mul(sdkmath.NewIntFromUint64(gas))
+		if paid.LT(required) {
+			return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s%s required: %s%s", paid, types.FeeToken, required, types.FeeToken)
+		}
+
+		// the base fee is only collected once the tx is included in a block
+		if !ctx.IsCheckTx() {
+			if err := fmk.AddCollectedBaseFee(ctx, required); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		var priority int64
+		if tip := paid.Sub(required); tip.IsPositive() && gas > 0 {
+			priority = getTxPriority(sdk.NewCoins(sdk.NewCoin(types.FeeToken, tip)), int64(gas))
+		}
+
+		return feeCoins, priority, nil
+	}
+}
+
 // getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
 // provided in a transaction.
 // NOTE: This implementation should be used with a great consideration as it opens potential attack vectors
@@ -71,12 +71,8 @@ The SIGN_MODE_DIRECT sign mode is not supported.'
 	return cmd
 }
 
-//nolint:revive,govet
 func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 	return func(cmd *cobra.Command, args []string) (err error) {
-		// HV2: multisig disabled in Heimdall
-		return fmt.Errorf("multi-sign is currently not supported in heimdall")
-
 		clientCtx, err := client.GetClientTxContext(cmd)
 		if err != nil {
 			return err
@@ -233,12 +229,10 @@ The SIGN_MODE_DIRECT sign mode is not supported.'
 	}
 
 	cmd.Flags().Bool(flagNoAutoIncrement, false, "disable sequence auto increment")
-	/* HV2: multisig disabled in Heimdall
 	cmd.Flags().String(
 		flagMultisig, "",
 		"Address of the multisig account that the transaction signs on behalf of",
 	)
-	*/
 	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.Flags().MarkHidden(flags.FlagOutput) // signing makes sense to output only json
@@ -246,12 +240,8 @@ The SIGN_MODE_DIRECT sign mode is not supported.'
 	return cmd
 }
 
-//nolint:revive,govet
 func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) (err error) {
-		// HV2: multisig disabled in Heimdall
-		return fmt.Errorf("multi-sign is currently not supported in heimdall")
-
 		var clientCtx client.Context
 
 		clientCtx, err = client.GetClientTxContext(cmd)
@@ -50,8 +50,7 @@ overwritten by the default flag values.
 		Args:   cobra.MinimumNArgs(1),
 	}
 
-	// HV2: multisig disabled in Heimdall
-	// cmd.Flags().String(flagMultisig, "", "Address or key name of the multisig account on behalf of which the transaction shall be signed")
+	cmd.Flags().String(flagMultisig, "", "Address or key name of the multisig account on behalf of which the transaction shall be signed")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
 	cmd.Flags().Bool(flagSigOnly, false, "Print only the generated signature, then exit")
 	cmd.Flags().Bool(flagAppend, false, "Combine all message and generate single signed transaction for broadcast.")
@@ -63,7 +62,6 @@ overwritten by the default flag values.
 	return cmd
 }
 
-//nolint:staticcheck
 func makeSignBatchCmd() func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		clientCtx, err := client.GetClientTxContext(cmd)
@@ -77,7 +75,6 @@ func makeSignBatchCmd() func(cmd *cobra.Command, args []string) error {
 		txCfg := clientCtx.TxConfig
 		printSignatureOnly, _ := cmd.Flags().GetBool(flagSigOnly)
 
-		//nolint:ineffassign
 		ms, err := cmd.Flags().GetString(flagMultisig)
 		if err != nil {
 			return err
@@ -97,9 +94,6 @@ func makeSignBatchCmd() func(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		// HV2: multisig disabled in Heimdall: enforcing `ms = ""` to reduce differences with upstream
-		ms = ""
-
 		if !clientCtx.Offline {
 			if ms == "" {
 				from, err := cmd.Flags().GetString(flags.FlagFrom)
@@ -345,9 +339,7 @@ the transaction to fail.
 		Args:   cobra.ExactArgs(1),
 	}
 
-	/* HV2: multisig disabled in Heimdall
 	cmd.Flags().String(flagMultisig, "", "Address or key name of the multisig account on behalf of which the transaction shall be signed")
-	*/
 	cmd.Flags().Bool(flagOverwrite, false, "Overwrite existing signatures with a new one. If disabled, new signature will be appended")
 	cmd.Flags().Bool(flagSigOnly, false, "Print only the signatures")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
@@ -385,7 +377,6 @@ func makeSignCmd() func(cmd *cobra.Command, args []string) error {
 	}
 }
 
-//nolint:ineffassign,staticcheck
 func signTx(cmd *cobra.Command, clientCtx client.Context, txF tx.Factory, newTx sdk.Tx) error {
 	f := cmd.Flags()
 	txCfg := clientCtx.TxConfig
@@ -419,9 +410,6 @@ func signTx(cmd *cobra.Command, clientCtx client.Context, txF tx.Factory, newTx
 		return err
 	}
 
-	// HV2: multisig disabled in Heimdall: enforcing `multisig = ""` to reduce differences with upstream
-	multisig = ""
-
 	if multisig != "" {
 		// hex decode error, maybe it's a name, we try to fetch from keyring
 		multisigAddr, multisigName, _, err := client.GetFromFields(clientCtx, txF.Keybase(), multisig)
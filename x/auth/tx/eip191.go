@@ -0,0 +1,57 @@
+package tx
+
+import (
+	"context"
+	"strconv"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/aminojson"
+)
+
+// EIP191MessagePrefix is the EIP-191 (version 0x45) prefix prepended by Ethereum
+// wallets to the messages signed with personal_sign.
+const EIP191MessagePrefix = "\x19Ethereum Signed Message:\n"
+
+var _ txsigning.SignModeHandler = SignModeEIP191Handler{}
+
+// SignModeEIP191Handler defines the SIGN_MODE_EIP_191 SignModeHandler. The sign
+// bytes are the SIGN_MODE_LEGACY_AMINO_JSON sign bytes wrapped in the EIP-191
+// personal_sign envelope, so that an Ethereum wallet holding the key of an
+// account can sign its transactions.
+type SignModeEIP191Handler struct {
+	aminoJSON *aminojson.SignModeHandler
+}
+
+// NewSignModeEIP191Handler returns a new SignModeEIP191Handler, rendering the
+// transactions with a SIGN_MODE_LEGACY_AMINO_JSON handler built with options.
+func NewSignModeEIP191Handler(options aminojson.SignModeHandlerOptions) SignModeEIP191Handler {
+	return SignModeEIP191Handler{
+		aminoJSON: aminojson.NewSignModeHandler(options),
+	}
+}
+
+// Mode implements txsigning.SignModeHandler.Mode
+func (SignModeEIP191Handler) Mode() signingv1beta1.SignMode {
+	return signingv1beta1.SignMode_SIGN_MODE_EIP_191
+}
+
+// GetSignBytes implements txsigning.SignModeHandler.GetSignBytes
+func (h SignModeEIP191Handler) GetSignBytes(ctx context.Context, signerData txsigning.SignerData, txData txsigning.TxData) ([]byte, error) {
+	msg, err := h.aminoJSON.GetSignBytes(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return EIP191PersonalSignBytes(msg), nil
+}
+
+// EIP191PersonalSignBytes wraps msg in the EIP-191 personal_sign envelope, i.e.
+// "\x19Ethereum Signed Message:\n" + len(msg) + msg. Its keccak256 digest is the
+// one signed by Ethereum wallets.
+func EIP191PersonalSignBytes(msg []byte) []byte {
+	prefix := EIP191MessagePrefix + strconv.Itoa(len(msg))
+	bz := make([]byte, 0, len(prefix)+len(msg))
+	bz = append(bz, prefix...)
+	return append(bz, msg...)
+}
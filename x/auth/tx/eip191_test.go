@@ -0,0 +1,108 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/signing/aminojson"
+	"cosmossdk.io/x/tx/signing/testutil"
+)
+
+func TestSignModeEIP191Handler(t *testing.T) {
+	handlerOptions := testutil.HandlerArgumentOptions{
+		ChainID: "test-chain",
+		Memo:    "sometestmemo",
+		Msg: &bankv1beta1.MsgSend{
+			FromAddress: "foo",
+			ToAddress:   "bar",
+			Amount:      []*basev1beta1.Coin{{Denom: "pol", Amount: "100"}},
+		},
+		AccNum:        1,
+		AccSeq:        2,
+		SignerAddress: "signerAddress",
+		Fee: &txv1beta1.Fee{
+			Amount: []*basev1beta1.Coin{{Denom: "pol", Amount: "1000"}},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions
+		error    string
+	}{
+		{
+			name: "happy path",
+			malleate: func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions {
+				return opts
+			},
+		},
+		{
+			name: "empty signer",
+			malleate: func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions {
+				opts.SignerAddress = ""
+				return opts
+			},
+			error: "got empty address in SIGN_MODE_LEGACY_AMINO_JSON handler: invalid request",
+		},
+		{
+			name: "nil fee",
+			malleate: func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions {
+				opts.Fee = nil
+				return opts
+			},
+			error: "fee cannot be nil",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := tc.malleate(handlerOptions)
+			signerData, txData, err := testutil.MakeHandlerArguments(opts)
+			require.NoError(t, err)
+
+			handler := NewSignModeEIP191Handler(aminojson.SignModeHandlerOptions{})
+			require.Equal(t, signingv1beta1.SignMode_SIGN_MODE_EIP_191, handler.Mode())
+
+			signBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+			if tc.error != "" {
+				require.ErrorContains(t, err, tc.error)
+				return
+			}
+			require.NoError(t, err)
+
+			aminoJSONBytes, err := aminojson.NewSignModeHandler(aminojson.SignModeHandlerOptions{}).
+				GetSignBytes(context.Background(), signerData, txData)
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(string(signBytes), EIP191MessagePrefix))
+			require.Equal(t, EIP191PersonalSignBytes(aminoJSONBytes), signBytes)
+		})
+	}
+}
+
+func TestEIP191PersonalSignBytes(t *testing.T) {
+	message := []byte(`{"account_number":"1","chain_id":"test-chain"}`)
+	signBytes := EIP191PersonalSignBytes(message)
+	require.Equal(t, "\x19Ethereum Signed Message:\n46"+string(message), string(signBytes))
+
+	// the keccak256 digest of the sign bytes is the one signed by ethereum wallets over personal_sign
+	digest := crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+	require.Equal(t, digest, crypto.Keccak256(signBytes))
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sig, err := crypto.Sign(digest, privKey)
+	require.NoError(t, err)
+
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(signBytes), sig)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(privKey.PublicKey), crypto.PubkeyToAddress(*pubKey))
+}
@@ -176,6 +176,9 @@ type Params struct {
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
 	MaxTxGas               uint64 `protobuf:"varint,6,opt,name=max_tx_gas,json=maxTxGas,proto3" json:"max_tx_gas,omitempty"`
 	TxFees                 string `protobuf:"bytes,7,opt,name=tx_fees,json=txFees,proto3" json:"tx_fees,omitempty"`
+	// msg_fees overrides tx_fees for the listed message types when the
+	// per-message-type fee checker is wired into the ante handler.
+	MsgFees []MsgFee `protobuf:"bytes,8,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -260,66 +263,134 @@ func (m *Params) GetTxFees() string {
 	return ""
 }
 
+func (m *Params) GetMsgFees() []MsgFee {
+	if m != nil {
+		return m.MsgFees
+	}
+	return nil
+}
+
+// MsgFee defines the fee, in the fee token, charged for each occurrence of a
+// message type in a transaction.
+type MsgFee struct {
+	// msg_type_url is the type URL of the message, e.g. /cosmos.bank.v1beta1.MsgSend.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// fee is the amount charged per message, as a base-10 big integer.
+	Fee string `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (m *MsgFee) Reset()         { *m = MsgFee{} }
+func (m *MsgFee) String() string { return proto.CompactTextString(m) }
+func (*MsgFee) ProtoMessage()    {}
+func (*MsgFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *MsgFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFee.Merge(m, src)
+}
+func (m *MsgFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFee proto.InternalMessageInfo
+
+func (m *MsgFee) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgFee) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*MsgFee)(nil), "cosmos.auth.v1beta1.MsgFee")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 773 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcf, 0x6e, 0xe3, 0x44,
-	0x1c, 0x8e, 0x9b, 0x90, 0xd2, 0x49, 0xb7, 0x50, 0x6f, 0xe8, 0x7a, 0xab, 0x55, 0xec, 0x8d, 0x04,
-	0x1b, 0x2a, 0x6a, 0xd3, 0xa0, 0x22, 0x91, 0x5b, 0x13, 0x60, 0xb5, 0x5a, 0x76, 0x59, 0x39, 0xb0,
-	0x87, 0xbd, 0x58, 0x63, 0xe7, 0x57, 0x77, 0xd4, 0x8c, 0xc7, 0x78, 0xc6, 0x95, 0xbd, 0x67, 0x0e,
-	0x2b, 0x0e, 0x08, 0xf1, 0x04, 0x85, 0x27, 0xe8, 0x61, 0x1f, 0x02, 0x71, 0xaa, 0x38, 0x71, 0x8a,
-	0x50, 0x7a, 0xe8, 0x0a, 0xf1, 0x10, 0xc8, 0x33, 0x4e, 0x9b, 0x74, 0x73, 0x89, 0x3c, 0xdf, 0xf7,
-	0xfd, 0xfe, 0x7d, 0xf3, 0xcb, 0xa0, 0x56, 0xc0, 0x38, 0x65, 0xdc, 0xc1, 0xa9, 0x38, 0x72, 0x4e,
-	0xf6, 0x7c, 0x10, 0x78, 0x4f, 0x1e, 0xec, 0x38, 0x61, 0x82, 0xe9, 0xb7, 0x15, 0x6f, 0x4b, 0xa8,
-	0xe4, 0xb7, 0x37, 0x31, 0x25, 0x11, 0x73, 0xe4, 0xaf, 0xd2, 0x6d, 0xdf, 0x55, 0x3a, 0x4f, 0x9e,
-	0x9c, 0x32, 0x48, 0x51, 0xcd, 0x90, 0x85, 0x4c, 0xe1, 0xc5, 0xd7, 0x2c, 0x20, 0x64, 0x2c, 0x1c,
-	0x83, 0x23, 0x4f, 0x7e, 0x7a, 0xe8, 0xe0, 0x28, 0x57, 0x54, 0xfb, 0xb7, 0x15, 0xd4, 0xe8, 0x63,
-	0x0e, 0x07, 0x41, 0xc0, 0xd2, 0x48, 0xe8, 0x5d, 0xb4, 0x8a, 0x47, 0xa3, 0x04, 0x38, 0x37, 0x34,
-	0x4b, 0xeb, 0xac, 0xf5, 0x8d, 0xbf, 0x5e, 0xef, 0x36, 0xcb, 0x1a, 0x07, 0x8a, 0x19, 0x8a, 0x84,
-	0x44, 0xa1, 0x3b, 0x13, 0xea, 0xcf, 0xd1, 0x6a, 0x9c, 0xfa, 0xde, 0x31, 0xe4, 0xc6, 0x8a, 0xa5,
-	0x75, 0x1a, 0xdd, 0xa6, 0xad, 0x0a, 0xda, 0xb3, 0x82, 0xf6, 0x41, 0x94, 0xf7, 0x1f, 0xfc, 0x3b,
-	0x31, 0x9b, 0x71, 0xea, 0x8f, 0x49, 0x50, 0x68, 0x3f, 0x61, 0x94, 0x08, 0xa0, 0xb1, 0xc8, 0x7f,
-	0xbf, 0x3c, 0xdb, 0x41, 0xd7, 0x84, 0x5b, 0x8f, 0x53, 0xff, 0x31, 0xe4, 0xfa, 0x87, 0x68, 0x03,
-	0xab, 0xb6, 0xbc, 0x28, 0xa5, 0x3e, 0x24, 0x46, 0xd5, 0xd2, 0x3a, 0x35, 0xf7, 0x56, 0x89, 0x3e,
-	0x95, 0xa0, 0xbe, 0x8d, 0xde, 0xe5, 0xf0, 0x43, 0x0a, 0x51, 0x00, 0x46, 0x4d, 0x0a, 0xae, 0xce,
-	0xbd, 0xc1, 0xab, 0x53, 0xb3, 0xf2, 0xe6, 0xd4, 0xac, 0xfc, 0xf9, 0x7a, 0xf7, 0xde, 0x12, 0x7b,
-	0xed, 0x72, 0xee, 0x47, 0x3f, 0x5d, 0x9e, 0xed, 0x6c, 0x29, 0xc1, 0x2e, 0x1f, 0x1d, 0x3b, 0x73,
-	0x9e, 0xb4, 0xff, 0xd3, 0xd0, 0xad, 0x27, 0x6c, 0x94, 0x8e, 0xaf, 0x5c, 0x7a, 0x84, 0xd6, 0x7d,
-	0xcc, 0xc1, 0x2b, 0x1b, 0x91, 0x56, 0x35, 0xba, 0x96, 0xbd, 0xac, 0xc2, 0x5c, 0xa6, 0x7e, 0xed,
-	0x7c, 0x62, 0x6a, 0x6e, 0xc3, 0x9f, 0x33, 0x5c, 0x47, 0xb5, 0x08, 0x53, 0x90, 0xce, 0xad, 0xb9,
-	0xf2, 0x5b, 0xb7, 0x50, 0x23, 0x86, 0x84, 0x12, 0xce, 0x09, 0x8b, 0xb8, 0x51, 0xb5, 0xaa, 0x9d,
-	0x35, 0x77, 0x1e, 0xea, 0xbd, 0x78, 0xa5, 0x66, 0x6a, 0x2f, 0xab, 0xb8, 0xd0, 0xab, 0x9c, 0xcc,
-	0x98, 0x9b, 0x6c, 0x81, 0xfd, 0xf5, 0xf2, 0x6c, 0x67, 0x83, 0x4a, 0x64, 0x36, 0x4c, 0xfb, 0x47,
-	0x0d, 0xbd, 0xaf, 0x44, 0x83, 0x04, 0x46, 0x10, 0x09, 0x82, 0xc7, 0xba, 0x89, 0x1a, 0xa5, 0x4c,
-	0x76, 0x2b, 0x77, 0xc3, 0x45, 0x0a, 0x7a, 0x5a, 0xf4, 0xfc, 0x00, 0xbd, 0x37, 0x82, 0x84, 0x9c,
-	0x60, 0x41, 0x58, 0x54, 0x5c, 0x23, 0x37, 0x56, 0xac, 0x6a, 0x67, 0xdd, 0xdd, 0xb8, 0x86, 0x1f,
-	0x43, 0xce, 0x7b, 0x1f, 0x15, 0x0d, 0xdd, 0x9f, 0x6b, 0xe8, 0x61, 0xc2, 0xd2, 0xb8, 0xec, 0xe7,
-	0xba, 0x62, 0xfb, 0xe7, 0x2a, 0xaa, 0x3f, 0xc3, 0x09, 0xa6, 0x5c, 0xb7, 0xd1, 0x6d, 0x8a, 0x33,
-	0x8f, 0x02, 0x65, 0x5e, 0x70, 0x84, 0x13, 0x1c, 0x08, 0x48, 0xd4, 0x82, 0xd6, 0xdc, 0x4d, 0x8a,
-	0xb3, 0x27, 0x40, 0xd9, 0xe0, 0x8a, 0xd0, 0x2d, 0xb4, 0x2e, 0x32, 0x8f, 0x93, 0xd0, 0x1b, 0x13,
-	0x4a, 0x84, 0xf4, 0xb6, 0xe6, 0x22, 0x91, 0x0d, 0x49, 0xf8, 0x4d, 0x81, 0xe8, 0x9f, 0xa2, 0x0f,
-	0xa4, 0xe2, 0x25, 0x78, 0x01, 0xe3, 0xc2, 0x8b, 0x21, 0xf1, 0xfc, 0x5c, 0x40, 0xb9, 0x61, 0x9b,
-	0x85, 0xf4, 0x25, 0x0c, 0x18, 0x17, 0xcf, 0x20, 0xe9, 0xe7, 0x02, 0xf4, 0x6f, 0xd1, 0x9d, 0x22,
-	0xe1, 0x09, 0x24, 0xe4, 0x30, 0x57, 0x41, 0x30, 0xea, 0xee, 0xef, 0xef, 0x7d, 0xa1, 0x96, 0xae,
-	0x6f, 0x4c, 0x27, 0x66, 0x73, 0x48, 0xc2, 0xe7, 0x52, 0x51, 0x84, 0x7e, 0xf5, 0xa5, 0xe4, 0xdd,
-	0x26, 0x5f, 0x40, 0x55, 0x94, 0xfe, 0x3d, 0xba, 0x7b, 0x33, 0x21, 0x87, 0x20, 0xee, 0xee, 0x7f,
-	0x7e, 0xbc, 0x67, 0xbc, 0x23, 0x53, 0x6e, 0x4f, 0x27, 0xe6, 0xd6, 0x42, 0xca, 0xe1, 0x4c, 0xe1,
-	0x6e, 0xf1, 0xa5, 0xb8, 0x7e, 0x0f, 0xa1, 0xc2, 0x2b, 0x91, 0x79, 0x21, 0xe6, 0x46, 0x5d, 0xfd,
-	0x1f, 0x28, 0xce, 0xbe, 0xcb, 0x1e, 0x62, 0xae, 0xdf, 0x41, 0xab, 0x22, 0xf3, 0x0e, 0x01, 0xb8,
-	0xb1, 0x2a, 0xaf, 0xb0, 0x2e, 0xb2, 0xaf, 0x01, 0x78, 0xef, 0xfe, 0x9b, 0x53, 0x53, 0xbb, 0xb9,
-	0x2a, 0x99, 0x7a, 0xaa, 0xd4, 0x2d, 0xf4, 0x07, 0x7f, 0x4c, 0x5b, 0xda, 0xf9, 0xb4, 0xa5, 0xfd,
-	0x33, 0x6d, 0x69, 0xbf, 0x5c, 0xb4, 0x2a, 0xe7, 0x17, 0xad, 0xca, 0xdf, 0x17, 0xad, 0xca, 0x8b,
-	0x8f, 0x43, 0x22, 0x8e, 0x52, 0xdf, 0x0e, 0x18, 0x2d, 0x9f, 0x23, 0xe7, 0xed, 0x2c, 0x22, 0x8f,
-	0x81, 0xfb, 0x75, 0xf9, 0x24, 0x7c, 0xf6, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x04, 0x83,
-	0xf2, 0x0c, 0x05, 0x00, 0x00,
+	// 843 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x31, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x2d, 0x55, 0xb2, 0x4f, 0x8e, 0x1b, 0x5f, 0x54, 0x87, 0x71, 0x03, 0x89, 0x11, 0xd0,
+	0x46, 0x35, 0x6a, 0xaa, 0x56, 0xe1, 0x02, 0xd5, 0x66, 0xaa, 0x4d, 0x10, 0xa4, 0x4e, 0x03, 0x3a,
+	0xc9, 0x90, 0x85, 0x38, 0x52, 0xcf, 0x34, 0x61, 0x1d, 0x8f, 0xe5, 0x1d, 0x0d, 0x32, 0x73, 0x87,
+	0xa0, 0x53, 0xd1, 0x5f, 0xe0, 0x76, 0xea, 0xe8, 0x21, 0x3f, 0x22, 0xe8, 0xe4, 0x76, 0xea, 0x24,
+	0x14, 0xf2, 0xe0, 0xa0, 0xe8, 0x8f, 0x28, 0x78, 0x47, 0xd9, 0xb2, 0xa3, 0x85, 0xb8, 0xfb, 0xbe,
+	0xef, 0xde, 0xfb, 0xde, 0xbb, 0xc7, 0x43, 0x4d, 0x8f, 0x71, 0xca, 0x78, 0x97, 0x24, 0xe2, 0xa0,
+	0x7b, 0xb4, 0xe5, 0x82, 0x20, 0x5b, 0x72, 0x63, 0x46, 0x31, 0x13, 0x0c, 0xdf, 0x52, 0xbc, 0x29,
+	0xa1, 0x82, 0x5f, 0x5f, 0x25, 0x34, 0x08, 0x59, 0x57, 0x7e, 0x95, 0x6e, 0xfd, 0x8e, 0xd2, 0x39,
+	0x72, 0xd7, 0x2d, 0x0e, 0x29, 0xaa, 0xe1, 0x33, 0x9f, 0x29, 0x3c, 0x5f, 0x4d, 0x0f, 0xf8, 0x8c,
+	0xf9, 0x23, 0xe8, 0xca, 0x9d, 0x9b, 0xec, 0x77, 0x49, 0x98, 0x29, 0xaa, 0xfd, 0xeb, 0x02, 0xaa,
+	0x5b, 0x84, 0xc3, 0x8e, 0xe7, 0xb1, 0x24, 0x14, 0xb8, 0x87, 0x6a, 0x64, 0x38, 0x8c, 0x81, 0x73,
+	0x5d, 0x33, 0xb4, 0xce, 0x92, 0xa5, 0xff, 0xf5, 0x66, 0xb3, 0x51, 0xe4, 0xd8, 0x51, 0xcc, 0x9e,
+	0x88, 0x83, 0xd0, 0xb7, 0xa7, 0x42, 0xfc, 0x02, 0xd5, 0xa2, 0xc4, 0x75, 0x0e, 0x21, 0xd3, 0x17,
+	0x0c, 0xad, 0x53, 0xef, 0x35, 0x4c, 0x95, 0xd0, 0x9c, 0x26, 0x34, 0x77, 0xc2, 0xcc, 0xba, 0xff,
+	0xef, 0xb8, 0xd5, 0x88, 0x12, 0x77, 0x14, 0x78, 0xb9, 0xf6, 0x73, 0x46, 0x03, 0x01, 0x34, 0x12,
+	0xd9, 0x6f, 0xe7, 0x27, 0x1b, 0xe8, 0x92, 0xb0, 0xab, 0x51, 0xe2, 0x3e, 0x86, 0x0c, 0x7f, 0x82,
+	0x56, 0x88, 0xb2, 0xe5, 0x84, 0x09, 0x75, 0x21, 0xd6, 0xcb, 0x86, 0xd6, 0xa9, 0xd8, 0x37, 0x0a,
+	0xf4, 0x89, 0x04, 0xf1, 0x3a, 0x5a, 0xe4, 0xf0, 0x43, 0x02, 0xa1, 0x07, 0x7a, 0x45, 0x0a, 0x2e,
+	0xf6, 0xfd, 0xc1, 0xeb, 0xe3, 0x56, 0xe9, 0xdd, 0x71, 0xab, 0xf4, 0xc7, 0x9b, 0xcd, 0xbb, 0x73,
+	0xda, 0x6b, 0x16, 0x75, 0x3f, 0xfa, 0xe9, 0xfc, 0x64, 0x63, 0x4d, 0x09, 0x36, 0xf9, 0xf0, 0xb0,
+	0x3b, 0xd3, 0x93, 0xf6, 0x7f, 0x1a, 0xba, 0xb1, 0xcb, 0x86, 0xc9, 0xe8, 0xa2, 0x4b, 0x8f, 0xd0,
+	0xb2, 0x4b, 0x38, 0x38, 0x85, 0x11, 0xd9, 0xaa, 0x7a, 0xcf, 0x30, 0xe7, 0x65, 0x98, 0x89, 0x64,
+	0x55, 0x4e, 0xc7, 0x2d, 0xcd, 0xae, 0xbb, 0x33, 0x0d, 0xc7, 0xa8, 0x12, 0x12, 0x0a, 0xb2, 0x73,
+	0x4b, 0xb6, 0x5c, 0x63, 0x03, 0xd5, 0x23, 0x88, 0x69, 0xc0, 0x79, 0xc0, 0x42, 0xae, 0x97, 0x8d,
+	0x72, 0x67, 0xc9, 0x9e, 0x85, 0xfa, 0x2f, 0x5f, 0xab, 0x9a, 0xda, 0xf3, 0x32, 0x5e, 0xf1, 0x2a,
+	0x2b, 0xd3, 0x67, 0x2a, 0xbb, 0xc2, 0xfe, 0x72, 0x7e, 0xb2, 0xb1, 0x42, 0x25, 0x32, 0x2d, 0xa6,
+	0xfd, 0xa3, 0x86, 0x6e, 0x2a, 0xd1, 0x20, 0x86, 0x21, 0x84, 0x22, 0x20, 0x23, 0xdc, 0x42, 0xf5,
+	0x42, 0x26, 0xdd, 0xca, 0xd9, 0xb0, 0x91, 0x82, 0x9e, 0xe4, 0x9e, 0xef, 0xa3, 0x0f, 0x87, 0x10,
+	0x07, 0x47, 0x44, 0x04, 0x2c, 0xcc, 0xaf, 0x91, 0xeb, 0x0b, 0x46, 0xb9, 0xb3, 0x6c, 0xaf, 0x5c,
+	0xc2, 0x8f, 0x21, 0xe3, 0xfd, 0x4f, 0x73, 0x43, 0xf7, 0x66, 0x0c, 0x3d, 0x8c, 0x59, 0x12, 0x15,
+	0x7e, 0x2e, 0x33, 0xb6, 0xff, 0x2c, 0xa3, 0xea, 0x53, 0x12, 0x13, 0xca, 0xb1, 0x89, 0x6e, 0x51,
+	0x92, 0x3a, 0x14, 0x28, 0x73, 0xbc, 0x03, 0x12, 0x13, 0x4f, 0x40, 0xac, 0x06, 0xb4, 0x62, 0xaf,
+	0x52, 0x92, 0xee, 0x02, 0x65, 0x83, 0x0b, 0x02, 0x1b, 0x68, 0x59, 0xa4, 0x0e, 0x0f, 0x7c, 0x67,
+	0x14, 0xd0, 0x40, 0xc8, 0xde, 0x56, 0x6c, 0x24, 0xd2, 0xbd, 0xc0, 0xff, 0x2e, 0x47, 0xf0, 0x17,
+	0xe8, 0x23, 0xa9, 0x78, 0x05, 0x8e, 0xc7, 0xb8, 0x70, 0x22, 0x88, 0x1d, 0x37, 0x13, 0x50, 0x4c,
+	0xd8, 0x6a, 0x2e, 0x7d, 0x05, 0x03, 0xc6, 0xc5, 0x53, 0x88, 0xad, 0x4c, 0x00, 0xfe, 0x1e, 0xdd,
+	0xce, 0x03, 0x1e, 0x41, 0x1c, 0xec, 0x67, 0xea, 0x10, 0x0c, 0x7b, 0xdb, 0xdb, 0x5b, 0x5f, 0xab,
+	0xa1, 0xb3, 0xf4, 0xc9, 0xb8, 0xd5, 0xd8, 0x0b, 0xfc, 0x17, 0x52, 0x91, 0x1f, 0xfd, 0xf6, 0x1b,
+	0xc9, 0xdb, 0x0d, 0x7e, 0x05, 0x55, 0xa7, 0xf0, 0x73, 0x74, 0xe7, 0x7a, 0x40, 0x0e, 0x5e, 0xd4,
+	0xdb, 0xfe, 0xea, 0x70, 0x4b, 0xff, 0x40, 0x86, 0x5c, 0x9f, 0x8c, 0x5b, 0x6b, 0x57, 0x42, 0xee,
+	0x4d, 0x15, 0xf6, 0x1a, 0x9f, 0x8b, 0xe3, 0xbb, 0x08, 0xe5, 0xbd, 0x12, 0xa9, 0xe3, 0x13, 0xae,
+	0x57, 0xd5, 0xff, 0x40, 0x49, 0xfa, 0x2c, 0x7d, 0x48, 0x38, 0xbe, 0x8d, 0x6a, 0x22, 0x75, 0xf6,
+	0x01, 0xb8, 0x5e, 0x93, 0x57, 0x58, 0x15, 0xe9, 0x03, 0x00, 0x8e, 0x77, 0xd0, 0x22, 0xe5, 0xbe,
+	0x62, 0x16, 0x8d, 0x72, 0xa7, 0xde, 0xfb, 0x78, 0xee, 0x34, 0xef, 0x72, 0xff, 0x01, 0x80, 0xb5,
+	0xf4, 0x76, 0xdc, 0x2a, 0xfd, 0x7e, 0x7e, 0xb2, 0xa1, 0xd9, 0x35, 0x2a, 0x21, 0xde, 0xbf, 0xf7,
+	0xee, 0xb8, 0xa5, 0x5d, 0x9f, 0xb6, 0x54, 0xbd, 0x76, 0xea, 0x22, 0xdb, 0x16, 0xaa, 0xaa, 0x00,
+	0xf9, 0x15, 0xe5, 0xf9, 0x44, 0x16, 0x81, 0x93, 0xc4, 0xa3, 0x8b, 0x81, 0xe2, 0xfe, 0xb3, 0x2c,
+	0x82, 0xe7, 0xf1, 0x08, 0xdf, 0x44, 0xe5, 0x7d, 0x98, 0xfe, 0x17, 0xf9, 0xb2, 0x5f, 0xc9, 0x13,
+	0x58, 0x83, 0xb7, 0x93, 0xa6, 0x76, 0x3a, 0x69, 0x6a, 0xff, 0x4c, 0x9a, 0xda, 0xcf, 0x67, 0xcd,
+	0xd2, 0xe9, 0x59, 0xb3, 0xf4, 0xf7, 0x59, 0xb3, 0xf4, 0xf2, 0x33, 0x3f, 0x10, 0x07, 0x89, 0x6b,
+	0x7a, 0x8c, 0x16, 0xaf, 0x62, 0xf7, 0x7d, 0x27, 0x79, 0x46, 0xee, 0x56, 0xe5, 0xcb, 0xf4, 0xe5,
+	0xff, 0x03, 0x00, 0x27, 0xe8, 0x37, 0x4f, 0x93, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -362,6 +433,41 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.TxFees != that1.TxFees {
 		return false
 	}
+	if len(this.MsgFees) != len(that1.MsgFees) {
+		return false
+	}
+	for i := range this.MsgFees {
+		if !this.MsgFees[i].Equal(&that1.MsgFees[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFee)
+	if !ok {
+		that2, ok := that.(MsgFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if this.Fee != that1.Fee {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -526,6 +632,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.TxFees) > 0 {
 		i -= len(m.TxFees)
 		copy(dAtA[i:], m.TxFees)
@@ -566,6 +686,43 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -670,6 +827,29 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuth(uint64(l))
 	}
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -1274,6 +1454,154 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.TxFees = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFees = append(m.MsgFees, MsgFee{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -9,7 +9,7 @@ import (
 // Default parameter values
 const (
 	DefaultMaxMemoCharacters      uint64 = 256
-	DefaultTxSigLimit             uint64 = 1
+	DefaultTxSigLimit             uint64 = 7
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
@@ -145,6 +145,31 @@ func validateTxFees(v string) error {
 	return nil
 }
 
+func validateMsgFees(msgFees []MsgFee) error {
+	seen := make(map[string]struct{}, len(msgFees))
+	for _, mf := range msgFees {
+		if !strings.HasPrefix(mf.MsgTypeUrl, "/") {
+			return fmt.Errorf("invalid msg fee type url: %q", mf.MsgTypeUrl)
+		}
+
+		if _, ok := seen[mf.MsgTypeUrl]; ok {
+			return fmt.Errorf("duplicate msg fee for type url: %s", mf.MsgTypeUrl)
+		}
+		seen[mf.MsgTypeUrl] = struct{}{}
+
+		fee, ok := big.NewInt(0).SetString(mf.Fee, 10)
+		if !ok {
+			return fmt.Errorf("invalid msg fee for %s: %s, should be valid big integer", mf.MsgTypeUrl, mf.Fee)
+		}
+
+		if fee.Sign() < 0 {
+			return fmt.Errorf("invalid msg fee for %s: %s, should not be negative", mf.MsgTypeUrl, mf.Fee)
+		}
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -168,6 +193,9 @@ func (p Params) Validate() error {
 	if err := validateTxFees(p.TxFees); err != nil {
 		return err
 	}
+	if err := validateMsgFees(p.MsgFees); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -48,6 +48,20 @@ func TestParams_Validate(t *testing.T) {
 		{"invalid tx fees", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultMaxTxGas, ""),
 			fmt.Errorf("invalid tx fees: ")},
+		{"valid msg fees", paramsWithMsgFees(
+			types.MsgFee{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Fee: "0"},
+			types.MsgFee{MsgTypeUrl: "/cosmos.gov.v1.MsgSubmitProposal", Fee: "5000000000000000"}),
+			nil},
+		{"invalid msg fee type url", paramsWithMsgFees(types.MsgFee{MsgTypeUrl: "cosmos.bank.v1beta1.MsgSend", Fee: "1"}),
+			fmt.Errorf("invalid msg fee type url: %q", "cosmos.bank.v1beta1.MsgSend")},
+		{"duplicate msg fee", paramsWithMsgFees(
+			types.MsgFee{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Fee: "1"},
+			types.MsgFee{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Fee: "2"}),
+			fmt.Errorf("duplicate msg fee for type url: /cosmos.bank.v1beta1.MsgSend")},
+		{"invalid msg fee amount", paramsWithMsgFees(types.MsgFee{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Fee: "1.5"}),
+			fmt.Errorf("invalid msg fee for /cosmos.bank.v1beta1.MsgSend: 1.5, should be valid big integer")},
+		{"negative msg fee", paramsWithMsgFees(types.MsgFee{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Fee: "-1"}),
+			fmt.Errorf("invalid msg fee for /cosmos.bank.v1beta1.MsgSend: -1, should not be negative")},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -63,3 +77,9 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func paramsWithMsgFees(msgFees ...types.MsgFee) types.Params {
+	p := types.DefaultParams()
+	p.MsgFees = msgFees
+	return p
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// GetQueryCmd returns vesting module's query commands.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the vesting module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		NewVestingAccountCmd(),
+	)
+
+	return queryCmd
+}
+
+// NewVestingAccountCmd returns a CLI command handler for querying the vesting
+// schedule of an account, along with the coins vested and still vesting.
+func NewVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account [address]",
+		Short:   "Query the vesting schedule of an account and the coins vested to date",
+		Example: fmt.Sprintf("%s query %s account [address]", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.VestingAccount(cmd.Context(), &types.QueryVestingAccountRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
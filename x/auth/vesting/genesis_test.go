@@ -0,0 +1,59 @@
+package vesting_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func (s *VestingTestSuite) TestVestingAccountsGenesis() {
+	startTime := s.ctx.BlockTime().Unix()
+	endTime := startTime + 100
+	coins := sdk.Coins{fooCoin}
+
+	continuousAcc, err := vestingtypes.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(to1Addr), coins, startTime, endTime)
+	s.Require().NoError(err)
+	delayedAcc, err := vestingtypes.NewDelayedVestingAccount(authtypes.NewBaseAccountWithAddress(to2Addr), coins, endTime)
+	s.Require().NoError(err)
+	periods := vestingtypes.Periods{
+		{Length: 50, Amount: sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 60))},
+		{Length: 50, Amount: sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 40))},
+	}
+	periodicAcc, err := vestingtypes.NewPeriodicVestingAccount(authtypes.NewBaseAccountWithAddress(to3Addr), coins, startTime, periods)
+	s.Require().NoError(err)
+	permanentAcc, err := vestingtypes.NewPermanentLockedAccount(authtypes.NewBaseAccountWithAddress(fromAddr), coins)
+	s.Require().NoError(err)
+
+	genAccs := authtypes.GenesisAccounts{continuousAcc, delayedAcc, periodicAcc, permanentAcc}
+	genState := authtypes.NewGenesisState(authtypes.DefaultParams(), genAccs)
+	s.Require().NoError(authtypes.ValidateGenesis(*genState))
+
+	// round trip the genesis state through its json encoding, as done on chain import
+	bz, err := s.encCfg.Codec.MarshalJSON(genState)
+	s.Require().NoError(err)
+	var imported authtypes.GenesisState
+	s.Require().NoError(s.encCfg.Codec.UnmarshalJSON(bz, &imported))
+
+	s.accountKeeper.InitGenesis(s.ctx, imported)
+	exported := s.accountKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(authtypes.ValidateGenesis(*exported))
+
+	exportedAccs, err := authtypes.UnpackAccounts(exported.Accounts)
+	s.Require().NoError(err)
+	s.Require().Len(exportedAccs, len(genAccs))
+
+	for _, genAcc := range genAccs {
+		acc := s.accountKeeper.GetAccount(s.ctx, genAcc.GetAddress())
+		s.Require().NotNil(acc)
+		s.Require().IsType(genAcc, acc)
+
+		// account numbers are assigned on import, so the rest of the account must match
+		s.Require().NoError(acc.SetAccountNumber(genAcc.GetAccountNumber()))
+		s.Require().Equal(genAcc, acc)
+	}
+
+	exportedPeriodic := s.accountKeeper.GetAccount(s.ctx, to3Addr).(*vestingtypes.PeriodicVestingAccount)
+	s.Require().Equal([]vestingtypes.Period(periods), exportedPeriodic.VestingPeriods)
+	s.Require().Equal(startTime, exportedPeriodic.StartTime)
+	s.Require().Equal(endTime, exportedPeriodic.EndTime)
+}
@@ -0,0 +1,66 @@
+package vesting
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+type queryServer struct {
+	keeper.AccountKeeper
+}
+
+// NewQueryServerImpl returns an implementation of the vesting QueryServer interface,
+// wrapping the corresponding AccountKeeper.
+func NewQueryServerImpl(k keeper.AccountKeeper) types.QueryServer {
+	return &queryServer{AccountKeeper: k}
+}
+
+var _ types.QueryServer = queryServer{}
+
+// VestingAccount returns the vesting schedule of an account, and the coins vested and vesting at the block time.
+func (s queryServer) VestingAccount(goCtx context.Context, req *types.QueryVestingAccountRequest) (*types.QueryVestingAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "Address cannot be empty")
+	}
+
+	addr, err := s.AccountKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	account := s.AccountKeeper.GetAccount(ctx, addr)
+	if account == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+
+	vacc, ok := account.(exported.VestingAccount)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "account %s is not a vesting account", req.Address)
+	}
+
+	a, err := codectypes.NewAnyWithValue(account)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err.Error())
+	}
+
+	return &types.QueryVestingAccountResponse{
+		Account:   a,
+		Vested:    vacc.GetVestedCoins(ctx.BlockTime()),
+		Vesting:   vacc.GetVestingCoins(ctx.BlockTime()),
+		StartTime: vacc.GetStartTime(),
+		EndTime:   vacc.GetEndTime(),
+	}, nil
+}
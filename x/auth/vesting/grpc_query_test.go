@@ -0,0 +1,113 @@
+package vesting_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func (s *VestingTestSuite) TestQueryVestingAccount() {
+	startTime := s.ctx.BlockTime().Unix()
+	endTime := s.ctx.BlockTime().Add(100 * time.Second).Unix()
+
+	testCases := map[string]struct {
+		preRun     func()
+		req        *vestingtypes.QueryVestingAccountRequest
+		expErr     bool
+		expErrMsg  string
+		blockTime  time.Time
+		expVested  sdk.Coins
+		expVesting sdk.Coins
+	}{
+		"nil request": {
+			req:       nil,
+			expErr:    true,
+			expErrMsg: "empty request",
+		},
+		"empty address": {
+			req:       &vestingtypes.QueryVestingAccountRequest{},
+			expErr:    true,
+			expErrMsg: "Address cannot be empty",
+		},
+		"invalid address": {
+			req:       &vestingtypes.QueryVestingAccountRequest{Address: "invalid"},
+			expErr:    true,
+			expErrMsg: "invalid address",
+		},
+		"account not found": {
+			req:       &vestingtypes.QueryVestingAccountRequest{Address: to2Addr.String()},
+			expErr:    true,
+			expErrMsg: "not found",
+		},
+		"not a vesting account": {
+			preRun: func() {
+				s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to3Addr))
+			},
+			req:       &vestingtypes.QueryVestingAccountRequest{Address: to3Addr.String()},
+			expErr:    true,
+			expErrMsg: "is not a vesting account",
+		},
+		"continuous vesting account at start": {
+			preRun:     func() { s.setContinuousVestingAccount(startTime, endTime) },
+			req:        &vestingtypes.QueryVestingAccountRequest{Address: to1Addr.String()},
+			blockTime:  time.Unix(startTime, 0),
+			expVested:  sdk.Coins{},
+			expVesting: sdk.Coins{fooCoin},
+		},
+		"continuous vesting account halfway": {
+			preRun:     func() { s.setContinuousVestingAccount(startTime, endTime) },
+			req:        &vestingtypes.QueryVestingAccountRequest{Address: to1Addr.String()},
+			blockTime:  time.Unix(startTime+50, 0),
+			expVested:  sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 50)),
+			expVesting: sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 50)),
+		},
+		"continuous vesting account fully vested": {
+			preRun:     func() { s.setContinuousVestingAccount(startTime, endTime) },
+			req:        &vestingtypes.QueryVestingAccountRequest{Address: to1Addr.String()},
+			blockTime:  time.Unix(endTime, 0),
+			expVested:  sdk.Coins{fooCoin},
+			expVesting: sdk.Coins{},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			s.SetupTest()
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			if !tc.blockTime.IsZero() {
+				s.ctx = s.ctx.WithBlockTime(tc.blockTime)
+			}
+
+			res, err := s.queryServer.VestingAccount(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(startTime, res.StartTime)
+			s.Require().Equal(endTime, res.EndTime)
+			s.Require().True(tc.expVested.Equal(res.Vested), "expected %s, got %s", tc.expVested, res.Vested)
+			s.Require().True(tc.expVesting.Equal(res.Vesting), "expected %s, got %s", tc.expVesting, res.Vesting)
+
+			var acc sdk.AccountI
+			s.Require().NoError(s.encCfg.InterfaceRegistry.UnpackAny(res.Account, &acc))
+			vacc, ok := acc.(*vestingtypes.ContinuousVestingAccount)
+			s.Require().True(ok)
+			s.Require().Equal(to1Addr, vacc.GetAddress())
+			s.Require().Equal(sdk.Coins{fooCoin}, vacc.GetOriginalVesting())
+		})
+	}
+}
+
+func (s *VestingTestSuite) setContinuousVestingAccount(startTime, endTime int64) {
+	baseAcc := authtypes.NewBaseAccountWithAddress(to1Addr)
+	vacc, err := vestingtypes.NewContinuousVestingAccount(baseAcc, sdk.Coins{fooCoin}, startTime, endTime)
+	s.Require().NoError(err)
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccount(s.ctx, vacc))
+}
@@ -235,5 +235,13 @@ func validateAmount(amount sdk.Coins) error {
 		return sdkerrors.ErrInvalidCoins.Wrap(amount.String())
 	}
 
+	// HV2: vesting schedules are only denominated in the heimdall fee token, see
+	// BaseVestingAccount.Validate
+	for _, coin := range amount {
+		if coin.Denom != authtypes.FeeToken {
+			return sdkerrors.ErrInvalidCoins.Wrapf("%s: only %s can be vested", amount, authtypes.FeeToken)
+		}
+	}
+
 	return nil
 }
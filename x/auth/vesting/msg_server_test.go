@@ -25,12 +25,13 @@ import (
 )
 
 var (
-	fromAddr   = sdk.AccAddress([]byte("from1________________"))
-	to1Addr    = sdk.AccAddress([]byte("to1__________________"))
-	to2Addr    = sdk.AccAddress([]byte("to2__________________"))
-	to3Addr    = sdk.AccAddress([]byte("to3__________________"))
-	fooCoin    = sdk.NewInt64Coin("foo", 100)
-	periodCoin = sdk.NewInt64Coin("foo", 20)
+	fromAddr   = sdk.AccAddress([]byte("from1_______________"))
+	to1Addr    = sdk.AccAddress([]byte("to1_________________"))
+	to2Addr    = sdk.AccAddress([]byte("to2_________________"))
+	to3Addr    = sdk.AccAddress([]byte("to3_________________"))
+	fooCoin    = sdk.NewInt64Coin(authtypes.FeeToken, 100)
+	periodCoin = sdk.NewInt64Coin(authtypes.FeeToken, 20)
+	otherCoin  = sdk.NewInt64Coin("foo", 100)
 )
 
 type VestingTestSuite struct {
@@ -40,6 +41,8 @@ type VestingTestSuite struct {
 	accountKeeper authkeeper.AccountKeeper
 	bankKeeper    *vestingtestutil.MockBankKeeper
 	msgServer     vestingtypes.MsgServer
+	queryServer   vestingtypes.QueryServer
+	encCfg        moduletestutil.TestEncodingConfig
 }
 
 func (s *VestingTestSuite) SetupTest() {
@@ -65,10 +68,11 @@ func (s *VestingTestSuite) SetupTest() {
 	vestingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper)
+	s.queryServer = vesting.NewQueryServerImpl(s.accountKeeper)
+	s.encCfg = encCfg
 }
 
 func (s *VestingTestSuite) TestCreateVestingAccount() {
-	s.T().Skip("skipping test for HV2 (vesting not relevant)")
 	testCases := map[string]struct {
 		preRun    func()
 		input     *vestingtypes.MsgCreateVestingAccount
@@ -108,6 +112,17 @@ func (s *VestingTestSuite) TestCreateVestingAccount() {
 			expErr:    true,
 			expErrMsg: "-1stake: invalid coins",
 		},
+		"non fee token coins": {
+			input: vestingtypes.NewMsgCreateVestingAccount(
+				fromAddr,
+				to1Addr,
+				sdk.Coins{otherCoin},
+				time.Now().Unix(),
+				true,
+			),
+			expErr:    true,
+			expErrMsg: "only pol can be vested",
+		},
 		"invalid end time": {
 			input: vestingtypes.NewMsgCreateVestingAccount(
 				fromAddr,
@@ -202,7 +217,6 @@ func (s *VestingTestSuite) TestCreateVestingAccount() {
 }
 
 func (s *VestingTestSuite) TestCreatePermanentLockedAccount() {
-	s.T().Skip("skipping test for HV2 (vesting not relevant)")
 	testCases := map[string]struct {
 		preRun    func()
 		input     *vestingtypes.MsgCreatePermanentLockedAccount
@@ -236,6 +250,15 @@ func (s *VestingTestSuite) TestCreatePermanentLockedAccount() {
 			expErr:    true,
 			expErrMsg: "-1stake: invalid coins",
 		},
+		"non fee token coins": {
+			input: vestingtypes.NewMsgCreatePermanentLockedAccount(
+				fromAddr,
+				to1Addr,
+				sdk.Coins{otherCoin},
+			),
+			expErr:    true,
+			expErrMsg: "only pol can be vested",
+		},
 		"create for existing account": {
 			preRun: func() {
 				toAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr)
@@ -301,7 +324,6 @@ func (s *VestingTestSuite) TestCreatePermanentLockedAccount() {
 }
 
 func (s *VestingTestSuite) TestCreatePeriodicVestingAccount() {
-	s.T().Skip("skipping test for HV2 (vesting not relevant)")
 	testCases := []struct {
 		name      string
 		preRun    func()
@@ -389,6 +411,26 @@ func (s *VestingTestSuite) TestCreatePeriodicVestingAccount() {
 			expErr:    true,
 			expErrMsg: "-1stake: invalid coins",
 		},
+		{
+			name: "non fee token coins",
+			input: vestingtypes.NewMsgCreatePeriodicVestingAccount(
+				fromAddr,
+				to1Addr,
+				time.Now().Unix(),
+				[]vestingtypes.Period{
+					{
+						Length: 1,
+						Amount: sdk.NewCoins(periodCoin),
+					},
+					{
+						Length: 1,
+						Amount: sdk.NewCoins(otherCoin),
+					},
+				},
+			),
+			expErr:    true,
+			expErrMsg: "only pol can be vested",
+		},
 		{
 			name: "create for existing account",
 			preRun: func() {
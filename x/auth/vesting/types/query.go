@@ -0,0 +1,13 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (m *QueryVestingAccountResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var account sdk.AccountI
+	return unpacker.UnpackAny(m.Account, &account)
+}
+
+var _ codectypes.UnpackInterfacesMessage = &QueryVestingAccountResponse{}
@@ -0,0 +1,811 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/vesting/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryVestingAccountRequest is the request type for the Query/VestingAccount RPC method.
+type QueryVestingAccountRequest struct {
+	// address defines the address of the vesting account to query for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryVestingAccountRequest) Reset()         { *m = QueryVestingAccountRequest{} }
+func (m *QueryVestingAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAccountRequest) ProtoMessage()    {}
+func (*QueryVestingAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94f6d251f3006c48, []int{0}
+}
+func (m *QueryVestingAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAccountRequest.Merge(m, src)
+}
+func (m *QueryVestingAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingAccountRequest proto.InternalMessageInfo
+
+// QueryVestingAccountResponse is the response type for the Query/VestingAccount RPC method.
+type QueryVestingAccountResponse struct {
+	// account defines the vesting account, which holds its vesting schedule.
+	Account *types.Any `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// vested defines the coins vested at the current block time.
+	Vested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	// vesting defines the coins still vesting, i.e. locked, at the current block time.
+	Vesting github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=vesting,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vesting"`
+	// start_time defines the time at which the vesting starts, as a unix timestamp.
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time defines the time at which the vesting ends, as a unix timestamp.
+	EndTime int64 `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *QueryVestingAccountResponse) Reset()         { *m = QueryVestingAccountResponse{} }
+func (m *QueryVestingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAccountResponse) ProtoMessage()    {}
+func (*QueryVestingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94f6d251f3006c48, []int{1}
+}
+func (m *QueryVestingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAccountResponse.Merge(m, src)
+}
+func (m *QueryVestingAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingAccountResponse proto.InternalMessageInfo
+
+func (m *QueryVestingAccountResponse) GetAccount() *types.Any {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *QueryVestingAccountResponse) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
+}
+
+func (m *QueryVestingAccountResponse) GetVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vesting
+	}
+	return nil
+}
+
+func (m *QueryVestingAccountResponse) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryVestingAccountResponse) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryVestingAccountRequest)(nil), "cosmos.vesting.v1beta1.QueryVestingAccountRequest")
+	proto.RegisterType((*QueryVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.QueryVestingAccountResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/vesting/v1beta1/query.proto", fileDescriptor_94f6d251f3006c48)
+}
+
+var fileDescriptor_94f6d251f3006c48 = []byte{
+	// 520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xde, 0x4d, 0x6c, 0xd3, 0x4e, 0x41, 0x70, 0x08, 0x92, 0x6c, 0xeb, 0x26, 0xe4, 0x14, 0x8a,
+	0x99, 0x21, 0x29, 0x22, 0x78, 0x4b, 0x3c, 0x89, 0x17, 0x8d, 0xe2, 0xa1, 0x97, 0x32, 0xc9, 0x8e,
+	0x9b, 0x51, 0x33, 0x93, 0xee, 0xcc, 0x06, 0x83, 0x78, 0xf1, 0xd4, 0xa3, 0xe0, 0x1f, 0xe8, 0x51,
+	0x3c, 0x89, 0xf4, 0xe8, 0x0f, 0x28, 0x9e, 0x8a, 0x5e, 0x3c, 0xa9, 0x24, 0x82, 0xfe, 0x0b, 0x65,
+	0x67, 0xde, 0x06, 0x0a, 0xad, 0xe0, 0xa1, 0x97, 0x64, 0x66, 0xbe, 0xf7, 0xbe, 0xf7, 0xde, 0xf7,
+	0xbe, 0x45, 0x8d, 0xa1, 0xd2, 0x63, 0xa5, 0xe9, 0x94, 0x6b, 0x23, 0x64, 0x4c, 0xa7, 0xed, 0x01,
+	0x37, 0xac, 0x4d, 0xf7, 0x53, 0x9e, 0xcc, 0xc8, 0x24, 0x51, 0x46, 0xe1, 0xab, 0x2e, 0x86, 0x40,
+	0x0c, 0x81, 0x98, 0xe0, 0x0a, 0x1b, 0x0b, 0xa9, 0xa8, 0xfd, 0x75, 0xa1, 0x41, 0x39, 0x56, 0xb1,
+	0xb2, 0x47, 0x9a, 0x9d, 0xe0, 0x75, 0x2b, 0x56, 0x2a, 0x7e, 0xc6, 0x29, 0x9b, 0x08, 0xca, 0xa4,
+	0x54, 0x86, 0x19, 0xa1, 0xa4, 0x06, 0xb4, 0x0a, 0xa8, 0xbd, 0x0d, 0xd2, 0xc7, 0x94, 0x49, 0xa8,
+	0x1c, 0x84, 0xd0, 0xdd, 0x80, 0x69, 0xbe, 0x6c, 0x6d, 0xa8, 0x84, 0x04, 0x7c, 0x13, 0x70, 0xdb,
+	0x2d, 0x9d, 0x9e, 0x6a, 0x3b, 0xa8, 0x3a, 0x70, 0xcf, 0xb5, 0x03, 0x33, 0xd8, 0x4b, 0x63, 0x17,
+	0x05, 0xf7, 0xb3, 0xc8, 0x47, 0x6e, 0xa2, 0xee, 0x70, 0xa8, 0x52, 0x69, 0xfa, 0x7c, 0x3f, 0xe5,
+	0xda, 0xe0, 0x0e, 0x2a, 0xb1, 0x28, 0x4a, 0xb8, 0xd6, 0x15, 0xbf, 0xee, 0x37, 0xd7, 0x7b, 0x95,
+	0xcf, 0x47, 0xad, 0x32, 0x10, 0x74, 0x1d, 0xf2, 0xc0, 0x24, 0x42, 0xc6, 0xfd, 0x3c, 0xf0, 0xd6,
+	0xda, 0xc1, 0x61, 0xcd, 0xfb, 0x7d, 0x58, 0xf3, 0x1a, 0x7f, 0x0a, 0x68, 0xf3, 0x4c, 0x72, 0x3d,
+	0x51, 0x52, 0x73, 0x7c, 0x0f, 0x95, 0x98, 0x7b, 0xb2, 0xec, 0x1b, 0x9d, 0x32, 0x71, 0x02, 0x90,
+	0x5c, 0x00, 0xd2, 0x95, 0xb3, 0x5e, 0xfd, 0xd3, 0x51, 0x6b, 0x0b, 0x6a, 0xb2, 0xd4, 0x8c, 0x72,
+	0xd5, 0x09, 0xf0, 0xdd, 0xe9, 0xe7, 0x34, 0x78, 0x84, 0x56, 0xb3, 0xd5, 0xf0, 0xa8, 0x52, 0xa8,
+	0x17, 0x9b, 0x1b, 0x9d, 0x2a, 0x81, 0xbc, 0x4c, 0xb6, 0x65, 0xde, 0x6d, 0x25, 0x64, 0xef, 0xc6,
+	0xf1, 0xb7, 0x9a, 0xf7, 0xee, 0x7b, 0xad, 0x19, 0x0b, 0x33, 0x4a, 0x07, 0x64, 0xa8, 0xc6, 0xa0,
+	0x0c, 0xfc, 0xb5, 0x74, 0xf4, 0x94, 0x9a, 0xd9, 0x84, 0x6b, 0x9b, 0xa0, 0xdf, 0xfe, 0x7a, 0xbf,
+	0xed, 0xf7, 0x81, 0x1f, 0x3f, 0x41, 0x25, 0x30, 0x41, 0xa5, 0x78, 0x41, 0xa5, 0xf2, 0x02, 0xf8,
+	0x1a, 0x42, 0xda, 0xb0, 0xc4, 0xec, 0x19, 0x31, 0xe6, 0x95, 0x4b, 0x75, 0xbf, 0x59, 0xec, 0xaf,
+	0xdb, 0x97, 0x87, 0x62, 0xcc, 0x71, 0x15, 0xad, 0x71, 0x19, 0x39, 0x70, 0xc5, 0x82, 0x25, 0x2e,
+	0xa3, 0x0c, 0xea, 0x7c, 0xf4, 0xd1, 0x8a, 0xdd, 0x00, 0xfe, 0xe0, 0xa3, 0xcb, 0xa7, 0xd7, 0x80,
+	0x3b, 0xe4, 0x6c, 0x37, 0x93, 0xf3, 0x0d, 0x11, 0xec, 0xfc, 0x57, 0x8e, 0xdb, 0x73, 0xe3, 0xe6,
+	0x41, 0x36, 0xcf, 0xab, 0x2f, 0x3f, 0xdf, 0x14, 0xae, 0xe3, 0x6d, 0x7a, 0xce, 0x77, 0x06, 0x3b,
+	0xd4, 0xf4, 0x05, 0x38, 0xe9, 0x65, 0xef, 0xee, 0xf1, 0x3c, 0xf4, 0x4f, 0xe6, 0xa1, 0xff, 0x63,
+	0x1e, 0xfa, 0xaf, 0x17, 0xa1, 0x77, 0xb2, 0x08, 0xbd, 0xaf, 0x8b, 0xd0, 0xdb, 0x6d, 0xff, 0x53,
+	0xca, 0xe7, 0x34, 0xf3, 0xc9, 0xb2, 0x82, 0x55, 0x76, 0xb0, 0x6a, 0x4d, 0xb5, 0xf3, 0x77, 0x00,
+	0x49, 0x84, 0x98, 0x07, 0xe8, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// VestingAccount returns the vesting schedule of an account, along with the
+	// coins vested and still vesting at the current block time.
+	VestingAccount(ctx context.Context, in *QueryVestingAccountRequest, opts ...grpc.CallOption) (*QueryVestingAccountResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) VestingAccount(ctx context.Context, in *QueryVestingAccountRequest, opts ...grpc.CallOption) (*QueryVestingAccountResponse, error) {
+	out := new(QueryVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Query/VestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// VestingAccount returns the vesting schedule of an account, along with the
+	// coins vested and still vesting at the current block time.
+	VestingAccount(context.Context, *QueryVestingAccountRequest) (*QueryVestingAccountResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) VestingAccount(ctx context.Context, req *QueryVestingAccountRequest) (*QueryVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingAccount not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_VestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Query/VestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingAccount(ctx, req.(*QueryVestingAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.vesting.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VestingAccount",
+			Handler:    _Query_VestingAccount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/vesting/v1beta1/query.proto",
+}
+
+func (m *QueryVestingAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Vesting) > 0 {
+		for iNdEx := len(m.Vesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVestingAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Vesting) > 0 {
+		for _, e := range m.Vesting {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryVestingAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &types.Any{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types1.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vesting = append(m.Vesting, types1.Coin{})
+			if err := m.Vesting[len(m.Vesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -155,6 +155,14 @@ func (bva BaseVestingAccount) Validate() error {
 		return fmt.Errorf("invalid coins: %s", bva.OriginalVesting.String())
 	}
 
+	// HV2: vesting schedules are only denominated in the heimdall fee token,
+	// including the ones of the genesis accounts
+	for _, coin := range bva.OriginalVesting {
+		if coin.Denom != authtypes.FeeToken {
+			return fmt.Errorf("invalid coins: %s, only %s can be vested", bva.OriginalVesting.String(), authtypes.FeeToken)
+		}
+	}
+
 	if !(bva.DelegatedVesting.IsAllLTE(bva.OriginalVesting)) {
 		return errors.New("delegated vesting amount cannot be greater than original vesting amount")
 	}
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 
@@ -251,18 +252,13 @@ func (k BaseKeeper) DenomOwners(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	denomOwners, pageRes, err := query.CollectionPaginate(
+	denomOwners, pageRes, err := query.CollectionIndexPaginate(
 		ctx,
-		k.Balances.Indexes.Denom,
+		indexes.NewQuery(k.Balances, k.Balances.Indexes.Denom).Prefix(req.Denom),
 		req.Pagination,
-		func(key collections.Pair[string, sdk.AccAddress], _ collections.NoValue) (*types.DenomOwner, error) {
-			amt, err := k.Balances.Get(ctx, collections.Join(key.K2(), req.Denom))
-			if err != nil {
-				return nil, err
-			}
-			return &types.DenomOwner{Address: key.K2().String(), Balance: sdk.NewCoin(req.Denom, amt)}, nil
+		func(key collections.Pair[sdk.AccAddress, string], amt math.Int) (*types.DenomOwner, error) {
+			return &types.DenomOwner{Address: key.K1().String(), Balance: sdk.NewCoin(req.Denom, amt)}, nil
 		},
-		query.WithCollectionPaginationPairPrefix[string, sdk.AccAddress](req.Denom),
 	)
 	if err != nil {
 		return nil, err
@@ -194,13 +194,13 @@ func (suite *KeeperTestSuite) TestSpendableBalances() {
 	suite.Require().NotNil(res)
 	suite.True(res.Balances.IsZero())
 
-	fooCoins := newFooCoin(50)
+	feeCoins := sdk.NewInt64Coin(authtypes.FeeToken, 50)
 	barCoins := newBarCoin(30)
 
-	origCoins := sdk.NewCoins(fooCoins, barCoins)
+	origCoins := sdk.NewCoins(feeCoins, barCoins)
 	vacc, err := vestingtypes.NewContinuousVestingAccount(
 		acc,
-		sdk.NewCoins(fooCoins),
+		sdk.NewCoins(feeCoins),
 		ctx.BlockTime().Unix(),
 		ctx.BlockTime().Add(time.Hour).Unix(),
 	)
@@ -233,7 +233,7 @@ func (suite *KeeperTestSuite) TestSpendableBalanceByDenom() {
 	_, err := queryClient.SpendableBalanceByDenom(ctx, &types.QuerySpendableBalanceByDenomRequest{})
 	suite.Require().Error(err)
 
-	req := types.NewQuerySpendableBalanceByDenomRequest(addr, fooDenom)
+	req := types.NewQuerySpendableBalanceByDenomRequest(addr, authtypes.FeeToken)
 	acc := authtypes.NewBaseAccountWithAddress(addr)
 
 	suite.mockSpendableCoins(ctx, acc)
@@ -242,13 +242,13 @@ func (suite *KeeperTestSuite) TestSpendableBalanceByDenom() {
 	suite.Require().NotNil(res)
 	suite.True(res.Balance.IsZero())
 
-	fooCoins := newFooCoin(100)
+	feeCoins := sdk.NewInt64Coin(authtypes.FeeToken, 100)
 	barCoins := newBarCoin(30)
 
-	origCoins := sdk.NewCoins(fooCoins, barCoins)
+	origCoins := sdk.NewCoins(feeCoins, barCoins)
 	vacc, err := vestingtypes.NewContinuousVestingAccount(
 		acc,
-		sdk.NewCoins(fooCoins),
+		sdk.NewCoins(feeCoins),
 		ctx.BlockTime().Unix(),
 		ctx.BlockTime().Add(time.Hour).Unix(),
 	)
@@ -261,7 +261,7 @@ func (suite *KeeperTestSuite) TestSpendableBalanceByDenom() {
 	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
 	queryClient = suite.mockQueryClient(ctx)
 
-	// check fooCoins first, it has some vested and some vesting
+	// check feeCoins first, it has some vested and some vesting
 	suite.mockSpendableCoins(ctx, vacc)
 	res, err = queryClient.SpendableBalanceByDenom(ctx, req)
 	suite.Require().NoError(err)
@@ -116,95 +116,85 @@ func (k BaseKeeper) WithMintCoinsRestriction(check types.MintingRestrictionFn) B
 	return k
 }
 
-// HV2: vesting accounts are not used in heimdall
-
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
 // address to a ModuleAccount address. If any of the delegation amounts are negative,
 // an error is returned.
-func (k BaseKeeper) DelegateCoins(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
-	return fmt.Errorf("DelegateCoins not supported in Heimdall since vesting and delegation are disabled")
-	/*
-		moduleAcc := k.ak.GetAccount(ctx, moduleAccAddr)
-		if moduleAcc == nil {
-			return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleAccAddr)
-		}
+func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	moduleAcc := k.ak.GetAccount(ctx, moduleAccAddr)
+	if moduleAcc == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleAccAddr)
+	}
 
-		if !amt.IsValid() {
-			return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
-		}
+	if !amt.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
 
-		balances := sdk.NewCoins()
-
-		for _, coin := range amt {
-			balance := k.GetBalance(ctx, delegatorAddr, coin.GetDenom())
-			if balance.IsLT(coin) {
-				return errorsmod.Wrapf(
-					sdkerrors.ErrInsufficientFunds, "failed to delegate; %s is smaller than %s", balance, amt,
-				)
-			}
-
-			balances = balances.Add(balance)
-			err := k.setBalance(ctx, delegatorAddr, balance.Sub(coin))
-			if err != nil {
-				return err
-			}
-		}
+	balances := sdk.NewCoins()
 
-		if err := k.trackDelegation(ctx, delegatorAddr, balances, amt); err != nil {
-			return errorsmod.Wrap(err, "failed to track delegation")
+	for _, coin := range amt {
+		balance := k.GetBalance(ctx, delegatorAddr, coin.GetDenom())
+		if balance.IsLT(coin) {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "failed to delegate; %s is smaller than %s", balance, amt,
+			)
 		}
-		// emit coin spent event
-		sdkCtx := sdk.UnwrapSDKContext(ctx)
-		sdkCtx.EventManager().EmitEvent(
-			types.NewCoinSpentEvent(delegatorAddr, amt),
-		)
 
-		err := k.addCoins(ctx, moduleAccAddr, amt)
+		balances = balances.Add(balance)
+		err := k.setBalance(ctx, delegatorAddr, balance.Sub(coin))
 		if err != nil {
 			return err
 		}
+	}
 
-		return nil
-	*/
-}
+	if err := k.trackDelegation(ctx, delegatorAddr, balances, amt); err != nil {
+		return errorsmod.Wrap(err, "failed to track delegation")
+	}
+	// emit coin spent event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		types.NewCoinSpentEvent(delegatorAddr, amt),
+	)
 
-// HV2: vesting accounts are not used in heimdall
+	err := k.addCoins(ctx, moduleAccAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
 
 // UndelegateCoins performs undelegation by crediting amt coins to an account with
 // address addr. For vesting accounts, undelegation amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from a ModuleAccount
 // address to the delegator address. If any of the undelegation amounts are
 // negative, an error is returned.
-func (k BaseKeeper) UndelegateCoins(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
-	return fmt.Errorf("UndelegateCoins not supported in Heimdall since vesting and delegation is disabled")
-	/*
-		moduleAcc := k.ak.GetAccount(ctx, moduleAccAddr)
-		if moduleAcc == nil {
-			return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleAccAddr)
-		}
+func (k BaseKeeper) UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	moduleAcc := k.ak.GetAccount(ctx, moduleAccAddr)
+	if moduleAcc == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleAccAddr)
+	}
 
-		if !amt.IsValid() {
-			return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
-		}
+	if !amt.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
 
-		err := k.subUnlockedCoins(ctx, moduleAccAddr, amt)
-		if err != nil {
-			return err
-		}
+	err := k.subUnlockedCoins(ctx, moduleAccAddr, amt)
+	if err != nil {
+		return err
+	}
 
-		if err := k.trackUndelegation(ctx, delegatorAddr, amt); err != nil {
-			return errorsmod.Wrap(err, "failed to track undelegation")
-		}
+	if err := k.trackUndelegation(ctx, delegatorAddr, amt); err != nil {
+		return errorsmod.Wrap(err, "failed to track undelegation")
+	}
 
-		err = k.addCoins(ctx, delegatorAddr, amt)
-		if err != nil {
-			return err
-		}
+	err = k.addCoins(ctx, delegatorAddr, amt)
+	if err != nil {
+		return err
+	}
 
-		return nil
-	*/
+	return nil
 }
 
 // GetSupply retrieves the Supply from store
@@ -312,50 +302,40 @@ func (k BaseKeeper) SendCoinsFromAccountToModule(
 	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
-// HV2: vesting accounts are not used in heimdall
-
 // DelegateCoinsFromAccountToModule delegates coins and transfers them from a
 // delegator account to a module account. It will panic if the module account
 // does not exist or is unauthorized.
 func (k BaseKeeper) DelegateCoinsFromAccountToModule(
-	_ context.Context, _ sdk.AccAddress, _ string, _ sdk.Coins,
+	ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
 ) error {
-	return fmt.Errorf("DelegateCoinsFromAccountToModule not supported in Heimdall since vesting and delegation are disabled")
-	/*
-		recipientAcc := k.ak.GetModuleAccount(ctx, recipientModule)
-		if recipientAcc == nil {
-			panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
-		}
+	recipientAcc := k.ak.GetModuleAccount(ctx, recipientModule)
+	if recipientAcc == nil {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
+	}
 
-		if !recipientAcc.HasPermission(authtypes.Staking) {
-			panic(errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to receive delegated coins", recipientModule))
-		}
+	if !recipientAcc.HasPermission(authtypes.Staking) {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to receive delegated coins", recipientModule))
+	}
 
-		return k.DelegateCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
-	*/
+	return k.DelegateCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
-// HV2: vesting accounts are not used in heimdall
-
 // UndelegateCoinsFromModuleToAccount undelegates the unbonding coins and transfers
 // them from a module account to the delegator account. It will panic if the
 // module account does not exist or is unauthorized.
 func (k BaseKeeper) UndelegateCoinsFromModuleToAccount(
-	_ context.Context, _ string, _ sdk.AccAddress, _ sdk.Coins,
+	ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
 ) error {
-	return fmt.Errorf("UndelegateCoinsFromModuleToAccount not supported in Heimdall since vesting and delegation are disabled")
-	/*
-		acc := k.ak.GetModuleAccount(ctx, senderModule)
-		if acc == nil {
-			panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
-		}
+	acc := k.ak.GetModuleAccount(ctx, senderModule)
+	if acc == nil {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
+	}
 
-		if !acc.HasPermission(authtypes.Staking) {
-			panic(errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to undelegate coins", senderModule))
-		}
+	if !acc.HasPermission(authtypes.Staking) {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to undelegate coins", senderModule))
+	}
 
-		return k.UndelegateCoins(ctx, acc.GetAddress(), recipientAddr, amt)
-	*/
+	return k.UndelegateCoins(ctx, acc.GetAddress(), recipientAddr, amt)
 }
 
 // MintCoins creates new coins from thin air and adds it to the module account.
@@ -398,8 +378,6 @@ func (k BaseKeeper) MintCoins(ctx context.Context, moduleName string, amounts sd
 	return nil
 }
 
-// HV2: not used in heimdall
-
 // BurnCoins burns coins deletes coins from the balance of the module account.
 // It will panic if the module account does not exist or is unauthorized.
 func (k BaseKeeper) BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error {
@@ -444,6 +422,43 @@ func (k BaseKeeper) setSupply(ctx context.Context, coin sdk.Coin) {
 	}
 }
 
+// trackDelegation tracks the delegation of the given account if it is a vesting
+// account
+func (k BaseKeeper) trackDelegation(ctx context.Context, addr sdk.AccAddress, balance, amt sdk.Coins) error {
+	acc := k.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	vacc, ok := acc.(types.VestingAccount)
+	if ok {
+		// TODO: return error on account.TrackDelegation
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		vacc.TrackDelegation(sdkCtx.BlockHeader().Time, balance, amt)
+		k.ak.SetAccount(ctx, acc)
+	}
+
+	return nil
+}
+
+// trackUndelegation trakcs undelegation of the given account if it is a vesting
+// account
+func (k BaseKeeper) trackUndelegation(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	acc := k.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	vacc, ok := acc.(types.VestingAccount)
+	if ok {
+		// TODO: return error on account.TrackUndelegation
+		vacc.TrackUndelegation(amt)
+		k.ak.SetAccount(ctx, acc)
+	}
+
+	return nil
+}
+
 // IterateTotalSupply iterates over the total supply calling the given cb (callback) function
 // with the balance of each coin.
 // The iteration stops if the callback returns true.
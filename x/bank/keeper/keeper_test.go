@@ -16,6 +16,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
@@ -389,7 +390,6 @@ func (suite *KeeperTestSuite) TestSendCoinsFromModuleToAccount_Blocklist() {
 }
 
 func (suite *KeeperTestSuite) TestSupply_DelegateUndelegateCoins() {
-	suite.T().Skip("We dont use delegate undelegate functionality in HV2")
 	ctx := suite.ctx
 	require := suite.Require()
 	authKeeper, keeper := suite.authKeeper, suite.bankKeeper
@@ -1219,8 +1219,8 @@ func (suite *KeeperTestSuite) TestSendCoins_Invalid_SendLockedCoins() {
 	now := cmttime.Now()
 	endTime := now.Add(24 * time.Hour)
 
-	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
-	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 100))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 50))
 
 	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
 	vacc, err := vesting.NewContinuousVestingAccount(acc0, origCoins, now.Unix(), endTime.Unix())
@@ -1234,7 +1234,7 @@ func (suite *KeeperTestSuite) TestSendCoins_Invalid_SendLockedCoins() {
 }
 
 func (suite *KeeperTestSuite) TestSendCoins_Invalid_NoSpendableCoins() {
-	coin := sdk.NewInt64Coin("stake", 10)
+	coin := sdk.NewInt64Coin(authtypes.FeeToken, 10)
 	coins := sdk.NewCoins(coin)
 	balances := coins
 
@@ -1253,8 +1253,8 @@ func (suite *KeeperTestSuite) TestSendCoins_Invalid_NoSpendableCoins() {
 	suite.authKeeper.EXPECT().GetAccount(suite.ctx, accAddrs[0]).Return(vacc)
 	e := errorsmod.Wrapf(
 		sdkerrors.ErrInsufficientFunds,
-		"spendable balance 0stake is smaller than %s",
-		coin,
+		"spendable balance 0%s is smaller than %s",
+		authtypes.FeeToken, coin,
 	)
 	suite.Require().EqualError(suite.bankKeeper.SendCoins(suite.ctx, accAddrs[0], accAddrs[1], sendCoins), e.Error())
 }
@@ -1269,7 +1269,7 @@ func (suite *KeeperTestSuite) TestValidateBalance() {
 	suite.authKeeper.EXPECT().GetAccount(suite.ctx, accAddrs[0]).Return(nil)
 	require.Error(suite.bankKeeper.ValidateBalance(ctx, accAddrs[0]))
 
-	balances := sdk.NewCoins(newFooCoin(100))
+	balances := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 100))
 	suite.mockFundAccount(accAddrs[0])
 	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
 
@@ -1481,8 +1481,8 @@ func (suite *KeeperTestSuite) TestSpendableCoins() {
 	now := cmttime.Now()
 	endTime := now.Add(24 * time.Hour)
 
-	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
-	lockedCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 100))
+	lockedCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 50))
 
 	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
 	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
@@ -1499,14 +1499,14 @@ func (suite *KeeperTestSuite) TestSpendableCoins() {
 	require.Equal(origCoins, suite.bankKeeper.SpendableCoins(ctx, accAddrs[1]))
 
 	suite.mockSpendableCoins(ctx, acc1)
-	require.Equal(origCoins[0], suite.bankKeeper.SpendableCoin(ctx, accAddrs[1], "stake"))
+	require.Equal(origCoins[0], suite.bankKeeper.SpendableCoin(ctx, accAddrs[1], authtypes.FeeToken))
 
 	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
 	suite.mockSpendableCoins(ctx, vacc)
 	require.Equal(origCoins.Sub(lockedCoins...), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
 
 	suite.mockSpendableCoins(ctx, vacc)
-	require.Equal(origCoins.Sub(lockedCoins...)[0], suite.bankKeeper.SpendableCoin(ctx, accAddrs[0], "stake"))
+	require.Equal(origCoins.Sub(lockedCoins...)[0], suite.bankKeeper.SpendableCoin(ctx, accAddrs[0], authtypes.FeeToken))
 }
 
 func (suite *KeeperTestSuite) TestVestingAccountSend() {
@@ -1515,8 +1515,8 @@ func (suite *KeeperTestSuite) TestVestingAccountSend() {
 	now := cmttime.Now()
 	endTime := now.Add(24 * time.Hour)
 
-	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
-	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 100))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 50))
 
 	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
 	vacc, err := vesting.NewContinuousVestingAccount(acc0, origCoins, now.Unix(), endTime.Unix())
@@ -1543,13 +1543,13 @@ func (suite *KeeperTestSuite) TestPeriodicVestingAccountSend() {
 	ctx := sdk.UnwrapSDKContext(suite.ctx)
 	require := suite.Require()
 	now := cmttime.Now()
-	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
-	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 100))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 50))
 
 	periods := vesting.Periods{
-		vesting.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin("stake", 50)}},
-		vesting.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin("stake", 25)}},
-		vesting.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin("stake", 25)}},
+		vesting.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(authtypes.FeeToken, 50)}},
+		vesting.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(authtypes.FeeToken, 25)}},
+		vesting.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(authtypes.FeeToken, 25)}},
 	}
 
 	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
@@ -1580,8 +1580,8 @@ func (suite *KeeperTestSuite) TestVestingAccountReceive() {
 	now := cmttime.Now()
 	endTime := now.Add(24 * time.Hour)
 
-	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
-	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 100))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 50))
 
 	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
 	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
@@ -1612,15 +1612,15 @@ func (suite *KeeperTestSuite) TestPeriodicVestingAccountReceive() {
 	require := suite.Require()
 	now := cmttime.Now()
 
-	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
-	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 100))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 50))
 
 	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
 	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
 	periods := vesting.Periods{
-		vesting.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin("stake", 50)}},
-		vesting.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin("stake", 25)}},
-		vesting.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin("stake", 25)}},
+		vesting.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(authtypes.FeeToken, 50)}},
+		vesting.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(authtypes.FeeToken, 25)}},
+		vesting.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(authtypes.FeeToken, 25)}},
 	}
 
 	vacc, err := vesting.NewPeriodicVestingAccount(acc0, origCoins, ctx.BlockHeader().Time.Unix(), periods)
@@ -1645,15 +1645,51 @@ func (suite *KeeperTestSuite) TestPeriodicVestingAccountReceive() {
 	require.Equal(balances.Sub(vacc.LockedCoins(now.Add(12*time.Hour))...), origCoins)
 }
 
+func (suite *KeeperTestSuite) TestVestingAccountPayFees() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	now := cmttime.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount(authtypes.FeeCollectorName)
+	fee, ok := math.NewIntFromString(authtypes.DefaultTxFees)
+	require.True(ok)
+	feeCoins := sdk.NewCoins(sdk.NewCoin(authtypes.FeeToken, fee))
+	origCoins := sdk.NewCoins(sdk.NewCoin(authtypes.FeeToken, fee.MulRaw(10)))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	vacc, err := vesting.NewContinuousVestingAccount(acc0, origCoins, now.Unix(), endTime.Unix())
+	require.NoError(err)
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], origCoins))
+
+	// require that the fixed fee cannot be paid with the locked coins at the beginning of the vesting schedule
+	ctx = ctx.WithBlockTime(now)
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, authtypes.FeeCollectorName).Return(feeCollectorAcc)
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(vacc)
+	err = suite.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddrs[0], authtypes.FeeCollectorName, feeCoins)
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	require.Equal(origCoins, suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// require that the fixed fee can be paid once enough coins are vested
+	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, authtypes.FeeCollectorName).Return(feeCollectorAcc)
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(vacc)
+	suite.authKeeper.EXPECT().HasAccount(ctx, feeCollectorAcc.GetAddress()).Return(true)
+	require.NoError(suite.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddrs[0], authtypes.FeeCollectorName, feeCoins))
+	require.Equal(origCoins.Sub(feeCoins...), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(feeCoins, suite.bankKeeper.GetAllBalances(ctx, feeCollectorAcc.GetAddress()))
+}
+
 func (suite *KeeperTestSuite) TestDelegateCoins() {
-	suite.T().Skip("We dont use delegate undelegate functionality in HV2")
 	ctx := sdk.UnwrapSDKContext(suite.ctx)
 	require := suite.Require()
 	now := cmttime.Now()
 	endTime := now.Add(24 * time.Hour)
 
-	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
-	delCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 100))
+	delCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 50))
 
 	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
 	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
@@ -1684,7 +1720,6 @@ func (suite *KeeperTestSuite) TestDelegateCoins() {
 }
 
 func (suite *KeeperTestSuite) TestDelegateCoins_Invalid() {
-	suite.T().Skip("We dont use delegate undelegate functionality in HV2")
 	ctx := suite.ctx
 	require := suite.Require()
 
@@ -1706,14 +1741,13 @@ func (suite *KeeperTestSuite) TestDelegateCoins_Invalid() {
 }
 
 func (suite *KeeperTestSuite) TestUndelegateCoins() {
-	suite.T().Skip("We dont use delegate undelegate functionality in HV2")
 	ctx := sdk.UnwrapSDKContext(suite.ctx)
 	require := suite.Require()
 	now := cmttime.Now()
 	endTime := now.Add(24 * time.Hour)
 
-	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
-	delCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 100))
+	delCoins := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 50))
 
 	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
 	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
@@ -1761,7 +1795,6 @@ func (suite *KeeperTestSuite) TestUndelegateCoins() {
 }
 
 func (suite *KeeperTestSuite) TestUndelegateCoins_Invalid() {
-	suite.T().Skip("We dont use delegate undelegate functionality in HV2")
 	ctx := suite.ctx
 	require := suite.Require()
 
@@ -2460,3 +2493,34 @@ func (suite *KeeperTestSuite) TestSetParams() {
 		}
 	})
 }
+
+func (suite *KeeperTestSuite) TestDepositMerger() {
+	require := suite.Require()
+
+	merger := keeper.NewDepositMerger(accAddrs[0])
+
+	key, err := collections.EncodeKeyWithPrefix(banktypes.BalancesPrefix, suite.bankKeeper.Balances.KeyCodec(), collections.Join(accAddrs[0], fooDenom))
+	require.NoError(err)
+	require.True(merger.Mergeable(key))
+
+	key, err = collections.EncodeKeyWithPrefix(banktypes.BalancesPrefix, suite.bankKeeper.Balances.KeyCodec(), collections.Join(accAddrs[1], fooDenom))
+	require.NoError(err)
+	require.False(merger.Mergeable(key))
+
+	balance := func(amount int64) []byte {
+		bz, err := banktypes.BalanceValueCodec.Encode(math.NewInt(amount))
+		require.NoError(err)
+		return bz
+	}
+
+	merged, err := merger.Merge(nil, balance(10), balance(5))
+	require.NoError(err)
+	require.Equal(balance(15), merged)
+
+	merged, err = merger.Merge(balance(10), balance(15), balance(20))
+	require.NoError(err)
+	require.Equal(balance(25), merged)
+
+	_, err = merger.Merge(balance(10), balance(5), balance(20))
+	require.Error(err)
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DepositMerger merges the deposits of the txs executed in parallel to the
+// balances of accounts which the txs only deposit to, such as the fee
+// collector, see baseapp.ParallelExecMerger.
+type DepositMerger struct {
+	prefixes [][]byte
+}
+
+// NewDepositMerger returns a DepositMerger of the balances of the given
+// accounts.
+func NewDepositMerger(addrs ...sdk.AccAddress) DepositMerger {
+	keyCodec := collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+
+	prefixes := make([][]byte, 0, len(addrs))
+	for _, addr := range addrs {
+		prefix, err := collections.EncodeKeyWithPrefix(types.BalancesPrefix, keyCodec, collections.PairPrefix[sdk.AccAddress, string](addr))
+		if err != nil {
+			panic(err)
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	return DepositMerger{prefixes: prefixes}
+}
+
+// Mergeable reports whether the key is a balance of one of the accounts.
+func (m DepositMerger) Mergeable(key []byte) bool {
+	for _, prefix := range m.prefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Merge adds the amount deposited by a tx to the committed balance.
+func (m DepositMerger) Merge(base, written, committed []byte) ([]byte, error) {
+	baseAmount, err := decodeBalance(base)
+	if err != nil {
+		return nil, err
+	}
+
+	writtenAmount, err := decodeBalance(written)
+	if err != nil {
+		return nil, err
+	}
+
+	committedAmount, err := decodeBalance(committed)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit := writtenAmount.Sub(baseAmount)
+	if deposit.IsNegative() {
+		return nil, errors.New("balance decreased")
+	}
+
+	return types.BalanceValueCodec.Encode(committedAmount.Add(deposit))
+}
+
+// decodeBalance decodes a balance, nil being a zero balance.
+func decodeBalance(bz []byte) (math.Int, error) {
+	if bz == nil {
+		return math.ZeroInt(), nil
+	}
+
+	return types.BalanceValueCodec.Decode(bz)
+}
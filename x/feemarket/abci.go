@@ -0,0 +1,103 @@
+package feemarket
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker burns the base fee collected in the block, when enabled, and adjusts
+// the base fee of the next block from the gas used by the block against the target.
+// The errors of the burn and of the gas target are logged, not returned, so that
+// they don't halt the chain.
+func EndBlocker(ctx context.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	collected, err := k.GetCollectedBaseFee(ctx)
+	if err != nil {
+		return err
+	}
+
+	burned := math.ZeroInt()
+	if params.BurnBaseFee {
+		if burned, err = burnBaseFee(ctx, k, collected); err != nil {
+			return err
+		}
+	} else if err := k.UnburnedBaseFee.Remove(ctx); err != nil {
+		// the base fee left from a failed burn stays in the fee collector with the rest of the fees
+		return err
+	}
+
+	if err := k.CollectedBaseFees.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	baseFee, err := k.BaseFee.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var gasUsed uint64
+	if sdkCtx.BlockGasMeter() != nil {
+		gasUsed = sdkCtx.BlockGasMeter().GasConsumedToLimit()
+	}
+
+	gasTarget, err := k.GasTarget(ctx, params)
+	if err != nil {
+		// the base fee is left unchanged
+		k.Logger(ctx).Error("failed to get the gas target", "error", err)
+	} else {
+		baseFee = params.NextBaseFee(baseFee, gasUsed, gasTarget)
+		if err := k.BaseFee.Set(ctx, baseFee); err != nil {
+			return err
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeMarket,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+			sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+		),
+	)
+
+	return nil
+}
+
+// burnBaseFee burns the base fee collected in the block, together with the base fee
+// left from a failed burn, and returns the burned amount. When the burn fails, e.g.
+// the module account is missing the burner permission, the amount is carried to the
+// next block.
+func burnBaseFee(ctx context.Context, k keeper.Keeper, collected math.Int) (math.Int, error) {
+	unburned, err := k.GetUnburnedBaseFee(ctx)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	amount := collected.Add(unburned)
+
+	// the burn is written only when it succeeds, so that a failure can't leave the
+	// coins in the module account
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if err := k.BurnCollectedBaseFee(cacheCtx, amount); err != nil {
+		k.Logger(ctx).Error("failed to burn the collected base fee", "amount", amount, "error", err)
+		return math.ZeroInt(), k.UnburnedBaseFee.Set(ctx, amount)
+	}
+	write()
+
+	return amount, k.UnburnedBaseFee.Remove(ctx)
+}
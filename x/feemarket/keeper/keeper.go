@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc              codec.BinaryCodec
+	storeService     storetypes.KVStoreService
+	bankKeeper       types.BankKeeper
+	consensusKeeper  types.ConsensusKeeper
+	feeCollectorName string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema  collections.Schema
+	Params  collections.Item[types.Params]
+	BaseFee collections.Item[math.Int]
+	// CollectedBaseFees are the base fee portions of the fees charged by the txs of the
+	// current block, keyed by tx hash. They are burned, when enabled, and cleared at the
+	// end of the block. Each tx writes its own key, so that the txs don't conflict when
+	// executed in parallel.
+	CollectedBaseFees collections.Map[[]byte, math.Int]
+	// UnburnedBaseFee is the collected base fee whose burn failed. It is burned together
+	// with the base fee collected in the next block.
+	UnburnedBaseFee collections.Item[math.Int]
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	ck types.ConsensusKeeper,
+	feeCollectorName string,
+	authority string,
+) Keeper {
+	// ensure feemarket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:               cdc,
+		storeService:      storeService,
+		bankKeeper:        bk,
+		consensusKeeper:   ck,
+		feeCollectorName:  feeCollectorName,
+		authority:         authority,
+		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BaseFee:           collections.NewItem(sb, types.BaseFeeKey, "base_fee", sdk.IntValue),
+		CollectedBaseFees: collections.NewMap(sb, types.CollectedBaseFeesKey, "collected_base_fees", collections.BytesKey, sdk.IntValue),
+		UnburnedBaseFee:   collections.NewItem(sb, types.UnburnedBaseFeeKey, "unburned_base_fee", sdk.IntValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetBaseFee returns the base fee, per unit of gas, charged in the current block.
+func (k Keeper) GetBaseFee(ctx context.Context) (math.Int, error) {
+	return k.BaseFee.Get(ctx)
+}
+
+// AddCollectedBaseFee records amount as the base fee portion of the fees charged by the tx
+// being executed in the current block.
+func (k Keeper) AddCollectedBaseFee(ctx context.Context, amount math.Int) error {
+	txHash := tmhash.Sum(sdk.UnwrapSDKContext(ctx).TxBytes())
+	return k.CollectedBaseFees.Set(ctx, txHash, amount)
+}
+
+// GetCollectedBaseFee returns the base fee collected in the current block, or zero if none was.
+func (k Keeper) GetCollectedBaseFee(ctx context.Context) (math.Int, error) {
+	collected := math.ZeroInt()
+	err := k.CollectedBaseFees.Walk(ctx, nil, func(_ []byte, amount math.Int) (bool, error) {
+		collected = collected.Add(amount)
+		return false, nil
+	})
+
+	return collected, err
+}
+
+// GetUnburnedBaseFee returns the collected base fee whose burn failed, or zero if none did.
+func (k Keeper) GetUnburnedBaseFee(ctx context.Context) (math.Int, error) {
+	unburned, err := k.UnburnedBaseFee.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+
+	return unburned, err
+}
+
+// GasTarget returns the amount of gas a block is expected to use, derived from the block
+// max gas of the x/consensus params. It returns zero when the block gas is unlimited.
+func (k Keeper) GasTarget(ctx context.Context, params types.Params) (uint64, error) {
+	res, err := k.consensusKeeper.Params(ctx, &consensustypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	if res.Params == nil || res.Params.Block == nil || res.Params.Block.MaxGas <= 0 {
+		return 0, nil
+	}
+
+	return uint64(res.Params.Block.MaxGas) / uint64(params.ElasticityMultiplier), nil
+}
+
+// BurnCollectedBaseFee burns the base fee collected in the current block from the fee collector.
+func (k Keeper) BurnCollectedBaseFee(ctx context.Context, amount math.Int) error {
+	if !amount.IsPositive() {
+		// skip as no coins need to be burned
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(authtypes.FeeToken, amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
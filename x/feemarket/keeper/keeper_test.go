@@ -0,0 +1,300 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettestutil "github.com/cosmos/cosmos-sdk/x/feemarket/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	feeMarketKeeper keeper.Keeper
+	ctx             sdk.Context
+	msgServer       types.MsgServer
+	queryServer     types.QueryServer
+	accountKeeper   *feemarkettestutil.MockAccountKeeper
+	bankKeeper      *feemarkettestutil.MockBankKeeper
+	consensusKeeper *feemarkettestutil.MockConsensusKeeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
+
+func (s *IntegrationTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feemarket.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	// gomock initializations
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := feemarkettestutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := feemarkettestutil.NewMockBankKeeper(ctrl)
+	consensusKeeper := feemarkettestutil.NewMockConsensusKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{})
+
+	s.feeMarketKeeper = keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		consensusKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	s.accountKeeper = accountKeeper
+	s.bankKeeper = bankKeeper
+	s.consensusKeeper = consensusKeeper
+
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(s.ctx, types.DefaultParams()))
+	s.Require().NoError(s.feeMarketKeeper.BaseFee.Set(s.ctx, types.DefaultBaseFee))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.feeMarketKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.feeMarketKeeper)
+}
+
+func (s *IntegrationTestSuite) setMaxGas(maxGas int64) {
+	s.consensusKeeper.EXPECT().Params(gomock.Any(), gomock.Any()).Return(&consensustypes.QueryParamsResponse{
+		Params: &cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: maxGas}},
+	}, nil).AnyTimes()
+}
+
+func (s *IntegrationTestSuite) TestCollectedBaseFee() {
+	collected, err := s.feeMarketKeeper.GetCollectedBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(collected.IsZero())
+
+	// the base fee is recorded per tx
+	tx1, tx2 := []byte("tx1"), []byte("tx2")
+	s.Require().NoError(s.feeMarketKeeper.AddCollectedBaseFee(s.ctx.WithTxBytes(tx1), math.NewInt(100)))
+	s.Require().NoError(s.feeMarketKeeper.AddCollectedBaseFee(s.ctx.WithTxBytes(tx2), math.NewInt(50)))
+
+	amount, err := s.feeMarketKeeper.CollectedBaseFees.Get(s.ctx, tmhash.Sum(tx1))
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(100), amount)
+	amount, err = s.feeMarketKeeper.CollectedBaseFees.Get(s.ctx, tmhash.Sum(tx2))
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(50), amount)
+
+	collected, err = s.feeMarketKeeper.GetCollectedBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(150), collected)
+}
+
+func (s *IntegrationTestSuite) TestGasTarget() {
+	params := types.DefaultParams()
+
+	s.setMaxGas(10_000_000)
+	target, err := s.feeMarketKeeper.GasTarget(s.ctx, params)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5_000_000), target)
+}
+
+func (s *IntegrationTestSuite) TestGasTargetUnlimitedBlockGas() {
+	s.setMaxGas(-1)
+	target, err := s.feeMarketKeeper.GasTarget(s.ctx, types.DefaultParams())
+	s.Require().NoError(err)
+	s.Require().Zero(target)
+}
+
+func (s *IntegrationTestSuite) TestEndBlocker() {
+	s.setMaxGas(10_000_000)
+	baseFee := math.NewInt(1_000_000_000_000)
+
+	testCases := []struct {
+		name     string
+		gasUsed  uint64
+		expected math.Int
+	}{
+		{"block at target", 5_000_000, baseFee},
+		{"full block", 10_000_000, math.NewInt(1_125_000_000_000)},
+		{"empty block", 0, math.NewInt(875_000_000_000)},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().NoError(s.feeMarketKeeper.BaseFee.Set(s.ctx, baseFee))
+
+			ctx := s.ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+			ctx.BlockGasMeter().ConsumeGas(tc.gasUsed, "test")
+
+			s.Require().NoError(feemarket.EndBlocker(ctx, s.feeMarketKeeper))
+
+			next, err := s.feeMarketKeeper.BaseFee.Get(ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, next)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestEndBlockerBurnsCollectedBaseFee() {
+	s.setMaxGas(10_000_000)
+
+	params := types.DefaultParams()
+	params.BurnBaseFee = true
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.feeMarketKeeper.AddCollectedBaseFee(s.ctx, math.NewInt(1000)))
+
+	burned := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 1000))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, types.ModuleName, burned).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, burned).Return(nil)
+
+	s.Require().NoError(feemarket.EndBlocker(s.ctx, s.feeMarketKeeper))
+
+	collected, err := s.feeMarketKeeper.GetCollectedBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(collected.IsZero())
+}
+
+func (s *IntegrationTestSuite) TestEndBlockerBurnWithoutBurnerPermission() {
+	s.setMaxGas(10_000_000)
+
+	params := types.DefaultParams()
+	params.BurnBaseFee = true
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.feeMarketKeeper.AddCollectedBaseFee(s.ctx.WithTxBytes([]byte("tx1")), math.NewInt(1000)))
+
+	// the module account is missing the burner permission, the burn fails without halting the chain
+	unburned := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 1000))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, types.ModuleName, unburned).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, unburned).
+		Return(sdkerrors.ErrUnauthorized.Wrapf("module account %s does not have permissions to burn tokens", types.ModuleName))
+
+	ctx := s.ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.BlockGasMeter().ConsumeGas(10_000_000, "test")
+	s.Require().NoError(feemarket.EndBlocker(ctx, s.feeMarketKeeper))
+
+	// the amount is carried to the next block and the base fee is still adjusted
+	amount, err := s.feeMarketKeeper.GetUnburnedBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(1000), amount)
+	baseFee, err := s.feeMarketKeeper.BaseFee.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(baseFee.GT(types.DefaultBaseFee))
+
+	// the next burn includes the amount carried over
+	s.Require().NoError(s.feeMarketKeeper.AddCollectedBaseFee(s.ctx.WithTxBytes([]byte("tx2")), math.NewInt(500)))
+	burned := sdk.NewCoins(sdk.NewInt64Coin(authtypes.FeeToken, 1500))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, types.ModuleName, burned).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, burned).Return(nil)
+	s.Require().NoError(feemarket.EndBlocker(s.ctx, s.feeMarketKeeper))
+
+	amount, err = s.feeMarketKeeper.GetUnburnedBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(amount.IsZero())
+}
+
+func (s *IntegrationTestSuite) TestEndBlockerGasTargetError() {
+	s.consensusKeeper.EXPECT().Params(gomock.Any(), gomock.Any()).Return(nil, errors.New("consensus params not found"))
+
+	// the base fee is left unchanged
+	ctx := s.ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.BlockGasMeter().ConsumeGas(10_000_000, "test")
+	s.Require().NoError(feemarket.EndBlocker(ctx, s.feeMarketKeeper))
+
+	baseFee, err := s.feeMarketKeeper.BaseFee.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultBaseFee, baseFee)
+}
+
+func (s *IntegrationTestSuite) TestEndBlockerKeepsCollectedBaseFee() {
+	s.setMaxGas(10_000_000)
+
+	// with burning disabled the base fee stays in the fee collector, no bank call is expected
+	s.Require().NoError(s.feeMarketKeeper.AddCollectedBaseFee(s.ctx, math.NewInt(1000)))
+	s.Require().NoError(feemarket.EndBlocker(s.ctx, s.feeMarketKeeper))
+
+	collected, err := s.feeMarketKeeper.GetCollectedBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(collected.IsZero())
+}
+
+func (s *IntegrationTestSuite) TestGenesis() {
+	genesis := types.NewGenesisState(types.NewParams(4, 3, math.NewInt(10), true), math.NewInt(20))
+	s.accountKeeper.EXPECT().GetModuleAccount(s.ctx, types.ModuleName)
+	s.feeMarketKeeper.InitGenesis(s.ctx, s.accountKeeper, genesis)
+
+	s.Require().Equal(genesis, s.feeMarketKeeper.ExportGenesis(s.ctx))
+}
+
+func (s *IntegrationTestSuite) TestQueries() {
+	params, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), params.Params)
+
+	baseFee, err := s.queryServer.BaseFee(s.ctx, &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultBaseFee, baseFee.BaseFee)
+}
+
+func (s *IntegrationTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name: "set invalid authority",
+			request: &types.MsgUpdateParams{
+				Authority: "foo",
+				Params:    types.DefaultParams(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params:    types.NewParams(0, 2, math.ZeroInt(), false),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set full valid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params:    types.NewParams(16, 4, math.NewInt(5), true),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			params, err := s.feeMarketKeeper.Params.Get(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.request.Params, params)
+		})
+	}
+}
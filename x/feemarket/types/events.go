@@ -0,0 +1,10 @@
+package types
+
+// feemarket module event types
+const (
+	EventTypeFeeMarket = ModuleName
+
+	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyGasUsed = "gas_used"
+	AttributeKeyBurned  = "burned"
+)
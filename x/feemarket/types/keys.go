@@ -0,0 +1,22 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// ParamsKey is the key to use for the params in the keeper store.
+	ParamsKey = collections.NewPrefix(0)
+	// BaseFeeKey is the key to use for the base fee in the keeper store.
+	BaseFeeKey = collections.NewPrefix(1)
+	// CollectedBaseFeesKey is the prefix to use for the base fee collected per tx in the current block.
+	CollectedBaseFeesKey = collections.NewPrefix(2)
+	// UnburnedBaseFeeKey is the key to use for the collected base fee whose burn failed.
+	UnburnedBaseFeeKey = collections.NewPrefix(3)
+)
+
+const (
+	// module name
+	ModuleName = "feemarket"
+
+	// StoreKey is the default store key for feemarket
+	StoreKey = ModuleName
+)
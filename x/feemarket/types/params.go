@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Default parameter values
+const (
+	DefaultBaseFeeChangeDenominator uint32 = 8
+	DefaultElasticityMultiplier     uint32 = 2
+	DefaultBurnBaseFee                     = false
+)
+
+var (
+	// DefaultMinBaseFee is the default lower bound of the base fee. It matches the per gas price
+	// of the default x/auth fixed fee, so that fees only grow above it under congestion.
+	DefaultMinBaseFee = math.NewInt(1000000000)
+
+	// DefaultBaseFee is the base fee set at genesis by default.
+	DefaultBaseFee = DefaultMinBaseFee
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(baseFeeChangeDenominator, elasticityMultiplier uint32, minBaseFee math.Int, burnBaseFee bool) Params {
+	return Params{
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		ElasticityMultiplier:     elasticityMultiplier,
+		MinBaseFee:               minBaseFee,
+		BurnBaseFee:              burnBaseFee,
+	}
+}
+
+// DefaultParams returns default x/feemarket module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultBaseFeeChangeDenominator, DefaultElasticityMultiplier, DefaultMinBaseFee, DefaultBurnBaseFee)
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.BaseFeeChangeDenominator == 0 {
+		return fmt.Errorf("base fee change denominator cannot be zero")
+	}
+	if p.ElasticityMultiplier == 0 {
+		return fmt.Errorf("elasticity multiplier cannot be zero")
+	}
+	if p.MinBaseFee.IsNil() {
+		return fmt.Errorf("min base fee cannot be nil")
+	}
+	if p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", p.MinBaseFee)
+	}
+
+	return nil
+}
+
+// NextBaseFee computes the base fee of the next block following EIP-1559: the base fee
+// grows when the block used more gas than the target and shrinks when it used less, by at
+// most 1/BaseFeeChangeDenominator of its value. The result is never lower than MinBaseFee.
+func (p Params) NextBaseFee(baseFee math.Int, gasUsed, gasTarget uint64) math.Int {
+	if gasTarget == 0 || gasUsed == gasTarget {
+		return math.MaxInt(baseFee, p.MinBaseFee)
+	}
+
+	target := math.NewIntFromUint64(gasTarget)
+	denominator := math.NewIntFromUint64(uint64(p.BaseFeeChangeDenominator))
+
+	var next math.Int
+	if gasUsed > gasTarget {
+		delta := baseFee.Mul(math.NewIntFromUint64(gasUsed - gasTarget)).Quo(target).Quo(denominator)
+		next = baseFee.Add(math.MaxInt(delta, math.OneInt()))
+	} else {
+		delta := baseFee.Mul(math.NewIntFromUint64(gasTarget - gasUsed)).Quo(target).Quo(denominator)
+		next = baseFee.Sub(delta)
+	}
+
+	return math.MaxInt(next, p.MinBaseFee)
+}
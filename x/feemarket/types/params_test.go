@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+	require.NoError(t, types.ValidateGenesis(*types.DefaultGenesisState()))
+
+	require.Error(t, types.NewParams(0, 2, math.ZeroInt(), false).Validate())
+	require.Error(t, types.NewParams(8, 0, math.ZeroInt(), false).Validate())
+	require.Error(t, types.NewParams(8, 2, math.NewInt(-1), false).Validate())
+	require.Error(t, types.NewParams(8, 2, math.Int{}, false).Validate())
+
+	// the base fee cannot be lower than the min base fee
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState(types.NewParams(8, 2, math.NewInt(10), false), math.NewInt(9))))
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.NewParams(8, 2, math.NewInt(100), false)
+
+	tests := []struct {
+		name      string
+		baseFee   math.Int
+		gasUsed   uint64
+		gasTarget uint64
+		expected  math.Int
+	}{
+		{"at target", math.NewInt(1000), 500, 500, math.NewInt(1000)},
+		{"full block", math.NewInt(1000), 1000, 500, math.NewInt(1125)},
+		{"half over target", math.NewInt(1000), 750, 500, math.NewInt(1062)},
+		{"empty block", math.NewInt(1000), 0, 500, math.NewInt(875)},
+		{"increase is at least one", math.NewInt(100), 501, 500, math.NewInt(101)},
+		{"bounded by min base fee", math.NewInt(110), 0, 500, math.NewInt(100)},
+		{"no target", math.NewInt(1000), 1000, 0, math.NewInt(1000)},
+		{"raised to min base fee", math.NewInt(50), 500, 500, math.NewInt(100)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, params.NextBaseFee(tt.baseFee, tt.gasUsed, tt.gasTarget))
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// GenesisSchemaCmd returns a command printing the JSON schema of the
+// collections genesis of the application modules. The application must expose
+// the collections schemas of its modules, keyed by the name of the module
+// store, with a CollectionsSchemas method.
+func GenesisSchemaCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema [module]",
+		Short: "Print the JSON schema of the collections genesis of the modules",
+		Long: `Print the JSON schema of the collections genesis of a module, or an object of the JSON schemas
+of all the modules keyed by module name. The schema describes the genesis exported by the module
+collections, with one property per collection.`,
+		Example: "schema bank",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// the application is only instantiated to read its schemas
+			home, err := os.MkdirTemp("", "genesis-schema")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(home)
+
+			vp := viper.New()
+			vp.Set(flags.FlagHome, home)
+			vp.Set(flags.FlagChainID, "genesis-schema")
+
+			app := appCreator(log.NewNopLogger(), dbm.NewMemDB(), nil, vp)
+			defer app.Close()
+
+			provider, ok := app.(interface {
+				CollectionsSchemas() map[string]collections.Schema
+			})
+			if !ok {
+				return fmt.Errorf("application %T does not expose its collections schemas", app)
+			}
+			schemas := provider.CollectionsSchemas()
+
+			if len(args) == 1 {
+				schema, ok := schemas[args[0]]
+				if !ok {
+					return fmt.Errorf("no collections schema for module %s", args[0])
+				}
+				bz, err := schema.JSONSchema()
+				if err != nil {
+					return err
+				}
+				return printJSON(cmd, bz)
+			}
+
+			// the modules are sorted by name when marshaled
+			moduleSchemas := make(map[string]json.RawMessage, len(schemas))
+			for module, schema := range schemas {
+				bz, err := schema.JSONSchema()
+				if err != nil {
+					return fmt.Errorf("module %s: %w", module, err)
+				}
+				moduleSchemas[module] = bz
+			}
+			bz, err := json.Marshal(moduleSchemas)
+			if err != nil {
+				return err
+			}
+			return printJSON(cmd, bz)
+		},
+	}
+}
+
+func printJSON(cmd *cobra.Command, bz []byte) error {
+	var out bytes.Buffer
+	if err := json.Indent(&out, bz, "", "  "); err != nil {
+		return err
+	}
+	cmd.Println(out.String())
+	return nil
+}
@@ -0,0 +1,92 @@
+package cli_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/log"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+)
+
+type closeApp struct {
+	servertypes.Application
+}
+
+func (closeApp) Close() error { return nil }
+
+type schemaApp struct {
+	closeApp
+	schemas map[string]collections.Schema
+}
+
+func (a schemaApp) CollectionsSchemas() map[string]collections.Schema { return a.schemas }
+
+func TestGenesisSchemaCmd(t *testing.T) {
+	storeService, _ := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(storeService)
+	collections.NewMap(sb, collections.NewPrefix(0), "balances", collections.StringKey, collections.Uint64Value)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	appCreator := func(app servertypes.Application) servertypes.AppCreator {
+		return func(log.Logger, dbm.DB, io.Writer, servertypes.AppOptions) servertypes.Application {
+			return app
+		}
+	}
+
+	// the application must expose its collections schemas
+	cmd := cli.GenesisSchemaCmd(appCreator(closeApp{}))
+	cmd.SetArgs([]string{})
+	cmd.SetOut(io.Discard)
+	require.ErrorContains(t, cmd.Execute(), "does not expose its collections schemas")
+
+	// the module must have a collections schema
+	cmd = cli.GenesisSchemaCmd(appCreator(schemaApp{schemas: map[string]collections.Schema{"bank": schema}}))
+	cmd.SetArgs([]string{"staking"})
+	cmd.SetOut(io.Discard)
+	require.ErrorContains(t, cmd.Execute(), "no collections schema for module staking")
+
+	balancesSchema := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"additionalProperties": false,
+		"properties": {
+			"balances": {
+				"type": "array",
+				"items": {
+					"type": "object",
+					"additionalProperties": false,
+					"required": ["key", "value"],
+					"properties": {
+						"key": {"type": "string"},
+						"value": {"type": "string", "pattern": "^[0-9]+$"}
+					}
+				}
+			}
+		}
+	}`
+
+	// the JSON schema of a module
+	var out bytes.Buffer
+	cmd = cli.GenesisSchemaCmd(appCreator(schemaApp{schemas: map[string]collections.Schema{"bank": schema}}))
+	cmd.SetArgs([]string{"bank"})
+	cmd.SetOut(&out)
+	require.NoError(t, cmd.Execute())
+	require.JSONEq(t, balancesSchema, out.String())
+
+	// the JSON schemas of all the modules
+	out.Reset()
+	cmd = cli.GenesisSchemaCmd(appCreator(schemaApp{schemas: map[string]collections.Schema{"bank": schema}}))
+	cmd.SetArgs([]string{})
+	cmd.SetOut(&out)
+	require.NoError(t, cmd.Execute())
+	require.JSONEq(t, `{"bank": `+balancesSchema+`}`, out.String())
+}
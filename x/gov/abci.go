@@ -46,31 +46,25 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 			return false, err
 		}
 
-		// Distribute the deposits if the proposal got removed without getting 100% of the proposal
-		err = keeper.DistributeAndDeleteDeposits(ctx, proposal.Id)
+		params, err := keeper.Params.Get(ctx)
 		if err != nil {
 			return false, err
 		}
 
-		if err = keeper.DeleteProposal(ctx, proposal.Id); err != nil {
-			return false, err
+		// HV2: heimdall distributes the deposits among the validators, unless they are set to be burned,
+		// if the proposal got removed without getting 100% of the proposal
+		if params.BurnProposalDepositPrevote {
+			err = deleteAndBurnDeposits(ctx, keeper, proposal.Id)
+		} else {
+			err = keeper.DistributeAndDeleteDeposits(ctx, proposal.Id)
 		}
-
-		params, err := keeper.Params.Get(ctx)
 		if err != nil {
 			return false, err
 		}
-		/* HV2: not present in heimdall
-		if !params.BurnProposalDepositPrevote {
-			err = keeper.RefundAndDeleteDeposits(ctx, proposal.Id) // refund deposit if proposal got removed without getting 100% of the proposal
-		} else {
-			err = keeper.DeleteAndBurnDeposits(ctx, proposal.Id) // burn the deposit if proposal got removed without getting 100% of the proposal
-		}
 
-		if err != nil {
+		if err = keeper.DeleteProposal(ctx, proposal.Id); err != nil {
 			return false, err
 		}
-		*/
 
 		// called when proposal become inactive
 		cacheCtx, writeCache := ctx.CacheContext()
@@ -130,43 +124,31 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 
 		var tagValue, logMsg string
 
-		passes, _, tallyResults, err := keeper.Tally(ctx, proposal)
+		passes, burnDeposits, tallyResults, err := keeper.Tally(ctx, proposal)
 		if err != nil {
 			return false, err
 		}
 
-		// HV2: heimdall distributes and deletes deposits in all cases of proposal failures, without caring about burnDeposits
-		if passes {
-			err = keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
-			if err != nil {
-				return false, err
+		// If an expedited proposal fails, we do not want to update
+		// the deposit at this point since the proposal is converted to regular.
+		// As a result, the deposits are either refunded, burned or distributed in all cases
+		// EXCEPT when an expedited proposal fails.
+		// HV2: heimdall distributes the deposits among the validators when a proposal fails
+		// and its deposits are not set to be burned.
+		if !(proposal.Expedited && !passes) {
+			switch {
+			case passes:
+				err = keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
+			case burnDeposits:
+				err = deleteAndBurnDeposits(ctx, keeper, proposal.Id)
+			default:
+				err = keeper.DistributeAndDeleteDeposits(ctx, proposal.Id)
 			}
-		} else {
-			err = keeper.DistributeAndDeleteDeposits(ctx, proposal.Id)
 			if err != nil {
 				return false, err
 			}
 		}
 
-		// If an expedited proposal fails, we do not want to update
-		// the deposit at this point since the proposal is converted to regular.
-		// As a result, the deposits are either deleted or refunded in all cases
-		// EXCEPT when an expedited proposal fails.
-
-		// HV2: this was removed in heimdall's gov/endblocker.go
-		/*
-			if !(proposal.Expedited && !passes) {
-				if burnDeposits {
-					err = keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
-				} else {
-					err = keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
-				}
-				if err != nil {
-					return false, err
-				}
-			}
-		*/
-
 		if err = keeper.ActiveProposalsQueue.Remove(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id)); err != nil {
 			return false, err
 		}
@@ -293,6 +275,19 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 	return nil
 }
 
+// deleteAndBurnDeposits burns the deposits of a proposal. HV2: when the gov module account
+// is missing the burner permission the error is logged and the deposits are refunded, so
+// that the chain does not halt.
+func deleteAndBurnDeposits(ctx sdk.Context, keeper *keeper.Keeper, proposalID uint64) error {
+	err := keeper.DeleteAndBurnDeposits(ctx, proposalID)
+	if !errors.Is(err, types.ErrMissingBurnerPermission) {
+		return err
+	}
+
+	keeper.Logger(ctx).Error("failed to burn the proposal deposits, refunding them", "proposal", proposalID, "error", err)
+	return keeper.RefundAndDeleteDeposits(ctx, proposalID)
+}
+
 // executes handle(msg) and recovers from panic.
 func safeExecuteHandler(ctx sdk.Context, msg sdk.Msg, handler baseapp.MsgServiceHandler,
 ) (res *sdk.Result, err error) {
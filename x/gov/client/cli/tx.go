@@ -71,6 +71,7 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 	govTxCmd.AddCommand(
 		NewCmdDeposit(),
 		NewCmdVote(),
+		NewCmdWeightedVote(),
 		NewCmdSubmitProposal(),
 		NewCmdDraftProposal(),
 		NewCmdCancelProposal(),
@@ -355,8 +356,6 @@ $ %s tx gov vote 1 yes --from mykey
 }
 
 // NewCmdWeightedVote implements creating a new weighted vote command.
-//
-//nolint:revive,govet
 func NewCmdWeightedVote() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "weighted-vote [proposal-id] [weighted-options]",
@@ -373,9 +372,6 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// HV2: disabled in heimdall as we do not support WeighedVoteOptions
-			return fmt.Errorf("weighted-vote are currently not supported in heimdall")
-
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
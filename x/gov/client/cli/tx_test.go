@@ -72,8 +72,7 @@ func (s *CLITestSuite) SetupSuite() {
 
 	// vote for proposal
 	_, err = govclitestutil.MsgVote(s.clientCtx, val[0].Address.String(), "1", "yes")
-	s.Require().Error(err)
-	s.Require().ErrorContains(err, "weighted-vote are currently not supported in heimdall")
+	s.Require().NoError(err)
 
 	// create a proposal without deposit
 	_, err = govclitestutil.MsgSubmitLegacyProposal(s.clientCtx, val[0].Address.String(),
@@ -88,8 +87,7 @@ func (s *CLITestSuite) SetupSuite() {
 
 	// vote for proposal3 as val
 	_, err = govclitestutil.MsgVote(s.clientCtx, val[0].Address.String(), "3", "yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05")
-	s.Require().Error(err)
-	s.Require().ErrorContains(err, "weighted-vote are currently not supported in heimdall")
+	s.Require().NoError(err)
 }
 
 func (s *CLITestSuite) TestNewCmdSubmitProposal() {
@@ -430,7 +428,7 @@ func (s *CLITestSuite) TestNewCmdWeightedVote() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("pol", sdkmath.NewInt(10))).String()),
 			},
-			"weighted-vote are currently not supported in heimdall",
+			"proposal-id abc not a valid int, please input a valid proposal-id",
 		},
 		{
 			"invalid vote",
@@ -442,7 +440,7 @@ func (s *CLITestSuite) TestNewCmdWeightedVote() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("pol", sdkmath.NewInt(10))).String()),
 			},
-			"weighted-vote are currently not supported in heimdall",
+			"'AYE' is not a valid vote option",
 		},
 		{
 			"valid vote",
@@ -454,7 +452,7 @@ func (s *CLITestSuite) TestNewCmdWeightedVote() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("pol", sdkmath.NewInt(10))).String()),
 			},
-			"weighted-vote are currently not supported in heimdall",
+			"",
 		},
 		{
 			"valid vote with metadata",
@@ -467,7 +465,7 @@ func (s *CLITestSuite) TestNewCmdWeightedVote() {
 				fmt.Sprintf("--metadata=%s", "AQ=="),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("pol", sdkmath.NewInt(10))).String()),
 			},
-			"weighted-vote are currently not supported in heimdall",
+			"",
 		},
 		{
 			"invalid valid split vote string",
@@ -479,7 +477,7 @@ func (s *CLITestSuite) TestNewCmdWeightedVote() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("pol", sdkmath.NewInt(10))).String()),
 			},
-			"weighted-vote are currently not supported in heimdall",
+			"'yes/0.6' is not a valid vote option",
 		},
 		{
 			"valid split vote",
@@ -491,7 +489,7 @@ func (s *CLITestSuite) TestNewCmdWeightedVote() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("pol", sdkmath.NewInt(10))).String()),
 			},
-			"weighted-vote are currently not supported in heimdall",
+			"",
 		},
 	}
 
@@ -28,12 +28,22 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		panic(err)
 	}
 
+	err = k.InitAllowedProposalMsgs(ctx, data.AllowedProposalMsgs)
+	if err != nil {
+		panic(err)
+	}
+
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
 	if moduleAcc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
+	// HV2: the deposits can only be burned when the gov module account has the burner permission
+	if err := k.ValidateBurnParams(ctx, *data.Params); err != nil {
+		panic(err)
+	}
+
 	var totalDeposits sdk.Coins
 	for _, deposit := range data.Deposits {
 		err := k.SetDeposit(ctx, *deposit)
@@ -111,6 +121,11 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) (*v1.GenesisState, error)
 		return nil, err
 	}
 
+	allowedProposalMsgs, err := k.GetAllowedProposalMsgs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var proposalsDeposits v1.Deposits
 	err = k.Deposits.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], value v1.Deposit) (stop bool, err error) {
 		proposalsDeposits = append(proposalsDeposits, &value)
@@ -131,11 +146,12 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) (*v1.GenesisState, error)
 	}
 
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:  startingProposalID,
+		Deposits:            proposalsDeposits,
+		Votes:               proposalsVotes,
+		Proposals:           proposals,
+		Params:              &params,
+		Constitution:        constitution,
+		AllowedProposalMsgs: allowedProposalMsgs,
 	}, nil
 }
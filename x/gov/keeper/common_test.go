@@ -102,7 +102,7 @@ func setupGovKeeper(t *testing.T) (
 
 	acctKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(govAcct).AnyTimes()
 	acctKeeper.EXPECT().GetModuleAddress(disttypes.ModuleName).Return(distAcct).AnyTimes()
-	acctKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(authtypes.NewEmptyModuleAccount(types.ModuleName)).AnyTimes()
+	acctKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Burner)).AnyTimes()
 	acctKeeper.EXPECT().AddressCodec().Return(address.NewHexCodec()).AnyTimes()
 
 	trackMockBalances(bankKeeper, distributionKeeper)
@@ -126,6 +126,8 @@ func setupGovKeeper(t *testing.T) (
 	require.NoError(t, err)
 	err = govKeeper.Constitution.Set(ctx, "constitution")
 	require.NoError(t, err)
+	err = govKeeper.InitAllowedProposalMsgs(ctx, nil)
+	require.NoError(t, err)
 
 	// Register all handlers for the MegServiceRouter.
 	msr.SetInterfaceRegistry(encCfg.InterfaceRegistry)
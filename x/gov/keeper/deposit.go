@@ -14,6 +14,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -38,13 +39,21 @@ func (keeper Keeper) GetDeposits(ctx context.Context, proposalID uint64) (deposi
 }
 
 // DeleteAndBurnDeposits deletes and burns all the deposits on a specific proposal.
-//
-//nolint:govet
+// HV2: the deposits are sent to the burn_deposits_dest param address when it is set.
 func (keeper Keeper) DeleteAndBurnDeposits(ctx context.Context, proposalID uint64) error {
-	// HV2: no support in heimdall for burn deposits
-	panic(errors.ErrPanic)
+	params, err := keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.BurnDepositsDest == "" {
+		if err := keeper.validateBurnerPermission(ctx); err != nil {
+			return err
+		}
+	}
+
 	coinsToBurn := sdk.NewCoins()
-	err := keeper.IterateDeposits(ctx, proposalID, func(key collections.Pair[uint64, sdk.AccAddress], deposit v1.Deposit) (stop bool, err error) {
+	err = keeper.IterateDeposits(ctx, proposalID, func(key collections.Pair[uint64, sdk.AccAddress], deposit v1.Deposit) (stop bool, err error) {
 		coinsToBurn = coinsToBurn.Add(deposit.Amount...)
 		return false, keeper.Deposits.Remove(ctx, key)
 	})
@@ -52,8 +61,26 @@ func (keeper Keeper) DeleteAndBurnDeposits(ctx context.Context, proposalID uint6
 		return err
 	}
 
-	// HV2: in heimdall we have no BurnCoins func, returning nil
-	// return keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, coinsToBurn)
+	return keeper.burnOrSendDeposits(ctx, params.BurnDepositsDest, coinsToBurn)
+}
+
+// ValidateBurnParams returns an error if the deposits are set to be burned by params while
+// the gov module account cannot burn them.
+// HV2: the burn params are enabled by default, so that they are checked at genesis too.
+func (keeper Keeper) ValidateBurnParams(ctx context.Context, params v1.Params) error {
+	burnDeposits := params.BurnProposalDepositPrevote || params.BurnVoteQuorum || params.BurnVoteVeto
+	if !burnDeposits || params.BurnDepositsDest != "" {
+		return nil
+	}
+
+	return keeper.validateBurnerPermission(ctx)
+}
+
+// validateBurnerPermission returns an error if the gov module account cannot burn the deposits.
+func (keeper Keeper) validateBurnerPermission(ctx context.Context) error {
+	if !keeper.GetGovernanceAccount(ctx).HasPermission(authtypes.Burner) {
+		return errors.Wrapf(types.ErrMissingBurnerPermission, "module account %s", types.ModuleName)
+	}
 	return nil
 }
 
@@ -192,7 +219,7 @@ func (keeper Keeper) AddDeposit(ctx context.Context, proposalID uint64, deposito
 }
 
 // ChargeDeposit will charge proposal cancellation fee (deposits * proposal_cancel_burn_rate)  and
-// send to a destAddress if defined or burn otherwise.
+// send to a destAddress if defined or keep it in the gov module account otherwise.
 // Remaining funds are send back to the depositor.
 func (keeper Keeper) ChargeDeposit(ctx context.Context, proposalID uint64, destAddress, proposalCancelRate string) error {
 	rate := sdkmath.LegacyMustNewDecFromStr(proposalCancelRate)
@@ -242,39 +269,37 @@ func (keeper Keeper) ChargeDeposit(ctx context.Context, proposalID uint64, destA
 		}
 	}
 
-	// burn the cancellation fee or sent the cancellation charges to destination address.
-	if !cancellationCharges.IsZero() {
-		// get the distribution module account address
-		distributionAddress := keeper.authKeeper.GetModuleAddress(disttypes.ModuleName)
-		switch {
-		case destAddress == "":
-			/* HV2: in heimdall we have no BurnCoins func
-			// burn the cancellation charges from deposits
-			err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, cancellationCharges)
-			if err != nil {
-				return err
-			}
-			*/
-		case distributionAddress.String() == destAddress:
-			err := keeper.distrKeeper.FundCommunityPool(ctx, cancellationCharges, keeper.ModuleAccountAddress())
-			if err != nil {
-				return err
-			}
-		default:
-			destAccAddress, err := keeper.authKeeper.AddressCodec().StringToBytes(destAddress)
-			if err != nil {
-				return err
-			}
-			err = keeper.bankKeeper.SendCoinsFromModuleToAccount(
-				ctx, types.ModuleName, destAccAddress, cancellationCharges,
-			)
-			if err != nil {
-				return err
-			}
-		}
+	// HV2: heimdall does not burn the cancellation charges, they are kept in the gov module account
+	// when no destination address is defined.
+	if destAddress == "" {
+		return nil
 	}
 
-	return nil
+	// sent the cancellation charges to destination address.
+	return keeper.burnOrSendDeposits(ctx, destAddress, cancellationCharges)
+}
+
+// burnOrSendDeposits burns the given deposits if destAddress is empty, funds the community pool
+// with them if destAddress is the distribution module account, or sends them to destAddress otherwise.
+func (keeper Keeper) burnOrSendDeposits(ctx context.Context, destAddress string, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	// get the distribution module account address
+	distributionAddress := keeper.authKeeper.GetModuleAddress(disttypes.ModuleName)
+	switch {
+	case destAddress == "":
+		return keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, amount)
+	case distributionAddress.String() == destAddress:
+		return keeper.distrKeeper.FundCommunityPool(ctx, amount, keeper.ModuleAccountAddress())
+	default:
+		destAccAddress, err := keeper.authKeeper.AddressCodec().StringToBytes(destAddress)
+		if err != nil {
+			return err
+		}
+		return keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, destAccAddress, amount)
+	}
 }
 
 // RefundAndDeleteDeposits refunds and deletes all the deposits on a specific proposal.
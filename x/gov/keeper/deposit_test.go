@@ -18,6 +18,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	stakeTypes "github.com/0xPolygon/heimdall-v2/x/stake/types"
@@ -156,11 +158,10 @@ func TestDeposits(t *testing.T) {
 			proposalID = proposal.Id
 			_, err = govKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
 			require.NoError(t, err)
-			require.Panics(t, func() {
-				_ = govKeeper.DeleteAndBurnDeposits(ctx, proposalID)
-			})
+			err = govKeeper.DeleteAndBurnDeposits(ctx, proposalID)
+			require.NoError(t, err)
 			deposits, _ = govKeeper.GetDeposits(ctx, proposalID)
-			require.Len(t, deposits, 1)
+			require.Len(t, deposits, 0)
 			require.Equal(t, addr0Initial.Sub(fourStake...), bankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 		})
 	}
@@ -407,7 +408,7 @@ func TestChargeDeposit(t *testing.T) {
 
 				switch i {
 				case 0:
-					// no dest address for cancel proposal, total cancellation charges will be burned
+					// no dest address for cancel proposal, total cancellation charges are kept in the gov module account
 					params.ProposalCancelDest = ""
 				case 1:
 					// normal account address for proposal cancel dest address
@@ -467,6 +468,90 @@ func TestChargeDeposit(t *testing.T) {
 	}
 }
 
+func TestDeleteAndBurnDeposits(t *testing.T) {
+	testCases := []struct {
+		name          string
+		toDestAddress bool
+		noBurner      bool
+		expErr        error
+	}{
+		{
+			name: "deposits are burned when no dest address is set",
+		},
+		{
+			name:          "deposits are sent to the dest address",
+			toDestAddress: true,
+		},
+		{
+			name:     "gov module account without burner permission",
+			noBurner: true,
+			expErr:   types.ErrMissingBurnerPermission,
+		},
+		{
+			name:          "deposits are sent to the dest address without burner permission",
+			toDestAddress: true,
+			noBurner:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			govKeeper, authKeeper, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+			authKeeper.EXPECT().AddressCodec().Return(address.NewHexCodec()).AnyTimes()
+
+			accAmt := sdkmath.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(10), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)))
+			TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, ctx, 3, accAmt)
+
+			params := v1.DefaultParams()
+			if tc.toDestAddress {
+				params.BurnDepositsDest = TestAddrs[2].String()
+			}
+			require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", TestAddrs[0], false)
+			require.NoError(t, err)
+
+			deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 3)))
+			_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], deposit)
+			require.NoError(t, err)
+			_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[1], deposit)
+			require.NoError(t, err)
+
+			if tc.noBurner {
+				govKeeper.GetGovernanceAccount(ctx).(*authtypes.ModuleAccount).Permissions = nil
+			}
+
+			destInitial := bankKeeper.GetAllBalances(ctx, TestAddrs[2])
+
+			err = govKeeper.DeleteAndBurnDeposits(ctx, proposal.Id)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+
+				// the deposits are left untouched
+				deposits, err := govKeeper.GetDeposits(ctx, proposal.Id)
+				require.NoError(t, err)
+				require.Len(t, deposits, 2)
+				return
+			}
+			require.NoError(t, err)
+
+			deposits, err := govKeeper.GetDeposits(ctx, proposal.Id)
+			require.NoError(t, err)
+			require.Empty(t, deposits)
+
+			// the depositors are not refunded
+			require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, accAmt)).Sub(deposit...), bankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+			require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, accAmt)).Sub(deposit...), bankKeeper.GetAllBalances(ctx, TestAddrs[1]))
+
+			expDestBalance := destInitial
+			if tc.toDestAddress {
+				expDestBalance = destInitial.Add(deposit...).Add(deposit...)
+			}
+			require.Equal(t, expDestBalance, bankKeeper.GetAllBalances(ctx, TestAddrs[2]))
+		})
+	}
+}
+
 func TestDistributeAndDeleteDeposits(t *testing.T) {
 	testcases := []struct {
 		name          string
@@ -604,3 +689,161 @@ func TestDistributeAndDeleteDeposits(t *testing.T) {
 		})
 	}
 }
+
+func TestEndBlockerDeposits(t *testing.T) {
+	testCases := []struct {
+		name      string
+		votes     map[int]v1.VoteOption
+		prevote   bool
+		burnParam func(*v1.Params)
+		noBurner  bool
+		expBurned bool
+		expRefund bool
+	}{
+		{
+			name:    "prevote deposits are distributed",
+			prevote: true,
+		},
+		{
+			name:      "prevote deposits are burned",
+			prevote:   true,
+			burnParam: func(p *v1.Params) { p.BurnProposalDepositPrevote = true },
+			expBurned: true,
+		},
+		{
+			name:      "prevote deposits are refunded without burner permission",
+			prevote:   true,
+			burnParam: func(p *v1.Params) { p.BurnProposalDepositPrevote = true },
+			noBurner:  true,
+			expRefund: true,
+		},
+		{
+			name:      "vetoed proposal deposits are distributed",
+			votes:     map[int]v1.VoteOption{2: v1.OptionNoWithVeto},
+			burnParam: func(p *v1.Params) { p.BurnVoteVeto = false },
+		},
+		{
+			name:      "vetoed proposal deposits are burned",
+			votes:     map[int]v1.VoteOption{2: v1.OptionNoWithVeto},
+			burnParam: func(p *v1.Params) { p.BurnVoteVeto = true },
+			expBurned: true,
+		},
+		{
+			name:      "vetoed proposal deposits are refunded without burner permission",
+			votes:     map[int]v1.VoteOption{2: v1.OptionNoWithVeto},
+			burnParam: func(p *v1.Params) { p.BurnVoteVeto = true },
+			noBurner:  true,
+			expRefund: true,
+		},
+		{
+			name:  "proposal without quorum deposits are distributed",
+			votes: map[int]v1.VoteOption{0: v1.OptionYes},
+		},
+		{
+			name:      "proposal without quorum deposits are burned",
+			votes:     map[int]v1.VoteOption{0: v1.OptionYes},
+			burnParam: func(p *v1.Params) { p.BurnVoteQuorum = true },
+			expBurned: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			govKeeper, authKeeper, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+			authKeeper.EXPECT().AddressCodec().Return(address.NewHexCodec()).AnyTimes()
+
+			accAmt := sdkmath.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(10), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)))
+			TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, ctx, 4, accAmt)
+
+			// the first three addresses are the validators, the last one is the depositor
+			powers := []int64{10, 20, 70}
+			mockValidators := make([]stakeTypes.Validator, len(powers))
+			for i, power := range powers {
+				mockValidators[i] = stakeTypes.Validator{ValId: uint64(i + 1), Signer: TestAddrs[i].String(), VotingPower: power}
+			}
+			stakingKeeper.EXPECT().IterateCurrentValidatorsAndApplyFn(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(stakeTypes.Validator) bool) error {
+					for _, validator := range mockValidators {
+						if stop := fn(validator); stop {
+							break
+						}
+					}
+					return nil
+				},
+			).AnyTimes()
+
+			params := v1.DefaultParams()
+			params.BurnVoteVeto = false
+			if tc.burnParam != nil {
+				tc.burnParam(&params)
+			}
+			require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+			if tc.noBurner {
+				govKeeper.GetGovernanceAccount(ctx).(*authtypes.ModuleAccount).Permissions = nil
+			}
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", TestAddrs[3], false)
+			require.NoError(t, err)
+
+			deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 3)))
+			_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[3], deposit)
+			require.NoError(t, err)
+
+			if !tc.prevote {
+				require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+				for i, option := range tc.votes {
+					require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, TestAddrs[i], v1.NewNonSplitVoteOption(option), ""))
+				}
+			}
+
+			initialBalances := make([]sdk.Coins, len(TestAddrs))
+			for i, addr := range TestAddrs {
+				initialBalances[i] = bankKeeper.GetAllBalances(ctx, addr)
+			}
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod))
+			// a missing burner permission does not halt the chain
+			require.NoError(t, gov.EndBlocker(ctx, govKeeper))
+
+			deposits, err := govKeeper.GetDeposits(ctx, proposal.Id)
+			require.NoError(t, err)
+			require.Empty(t, deposits)
+
+			// the depositor is only refunded when the deposits can't be burned
+			expBalance := initialBalances[3]
+			if tc.expRefund {
+				expBalance = expBalance.Add(deposit...)
+			}
+			require.Equal(t, expBalance, bankKeeper.GetAllBalances(ctx, TestAddrs[3]))
+
+			// the burned or refunded deposits are not distributed among the validators
+			share := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, deposit.AmountOf(sdk.DefaultBondDenom).QuoRaw(int64(len(powers)))))
+			for i := range powers {
+				expBalance := initialBalances[i]
+				if !tc.expBurned && !tc.expRefund {
+					expBalance = expBalance.Add(share...)
+				}
+				require.Equal(t, expBalance, bankKeeper.GetAllBalances(ctx, TestAddrs[i]))
+			}
+		})
+	}
+}
+
+func TestInitGenesisBurnerPermission(t *testing.T) {
+	govKeeper, authKeeper, bankKeeper, _, _, _, ctx := setupGovKeeper(t)
+	authKeeper.EXPECT().SetModuleAccount(gomock.Any(), gomock.Any()).AnyTimes()
+	govKeeper.GetGovernanceAccount(ctx).(*authtypes.ModuleAccount).Permissions = nil
+
+	// the vetoed proposals deposits cannot be burned, the chain does not start
+	genesis := v1.DefaultGenesisState()
+	require.PanicsWithError(t, types.ErrMissingBurnerPermission.Wrapf("module account %s", types.ModuleName).Error(), func() {
+		gov.InitGenesis(ctx, authKeeper, bankKeeper, govKeeper, genesis)
+	})
+
+	// the deposits are sent to the burned deposits destination
+	genesis.Params.BurnDepositsDest = govKeeper.GetGovernanceAccount(ctx).GetAddress().String()
+	require.NotPanics(t, func() {
+		gov.InitGenesis(ctx, authKeeper, bankKeeper, govKeeper, genesis)
+	})
+}
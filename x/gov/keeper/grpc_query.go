@@ -33,6 +33,15 @@ func (q queryServer) Constitution(ctx context.Context, _ *v1.QueryConstitutionRe
 	return &v1.QueryConstitutionResponse{Constitution: constitution}, nil
 }
 
+// AllowedProposalMsgs returns the type URLs of the messages and legacy contents allowed in proposals
+func (q queryServer) AllowedProposalMsgs(ctx context.Context, _ *v1.QueryAllowedProposalMsgsRequest) (*v1.QueryAllowedProposalMsgsResponse, error) {
+	typeURLs, err := q.k.GetAllowedProposalMsgs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryAllowedProposalMsgsResponse{TypeUrls: typeURLs}, nil
+}
+
 // Proposal returns proposal details based on ProposalID
 func (q queryServer) Proposal(ctx context.Context, req *v1.QueryProposalRequest) (*v1.QueryProposalResponse, error) {
 	if req == nil {
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/golang/mock/gomock"
@@ -14,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	v3 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v3"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -101,6 +103,18 @@ func (suite *KeeperTestSuite) TestGRPCQueryConstitution() {
 	suite.Require().Equal(expRes, constitution)
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryAllowedProposalMsgs() {
+	suite.reset()
+	queryClient := suite.queryClient
+
+	expRes := &v1.QueryAllowedProposalMsgsResponse{TypeUrls: keeper.DefaultAllowedProposalMsgs()}
+	sort.Strings(expRes.TypeUrls)
+
+	allowed, err := queryClient.AllowedProposalMsgs(gocontext.Background(), &v1.QueryAllowedProposalMsgsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expRes, allowed)
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposal() {
 	suite.reset()
 	ctx, queryClient, addrs := suite.ctx, suite.legacyQueryClient, suite.addrs
@@ -26,6 +26,9 @@ type Keeper struct {
 	// The reference to the DelegationSet and ValidatorSet to get information about validators and delegators
 	sk types.StakingKeeper
 
+	// The source of the voting power used to tally proposals
+	tallyPowerSource types.TallyPowerSource
+
 	// GovHooks
 	hooks types.GovHooks
 
@@ -49,6 +52,7 @@ type Keeper struct {
 
 	Schema                 collections.Schema
 	Constitution           collections.Item[string]
+	AllowedProposalMsgs    collections.KeySet[string]
 	Params                 collections.Item[v1.Params]
 	Deposits               collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.Deposit]
 	Votes                  collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.Vote]
@@ -90,11 +94,16 @@ func NewKeeper(
 		config.MaxMetadataLen = types.DefaultConfig().MaxMetadataLen
 	}
 
-	// Enforce MaxVoteOptionsLen to be 1.
-	if config.MaxVoteOptionsLen != 1 {
+	// If MaxVoteOptionsLen not set by app developer, set to default value.
+	if config.MaxVoteOptionsLen == 0 {
 		config.MaxVoteOptionsLen = types.DefaultConfig().MaxVoteOptionsLen
 	}
 
+	// If AllowedProposalMsgs not set by app developer, set to default value.
+	if config.AllowedProposalMsgs == nil {
+		config.AllowedProposalMsgs = DefaultAllowedProposalMsgs()
+	}
+
 	sb := collections.NewSchemaBuilder(storeService)
 	k := &Keeper{
 		storeService:           storeService,
@@ -102,11 +111,13 @@ func NewKeeper(
 		bankKeeper:             bankKeeper,
 		distrKeeper:            distrKeeper,
 		sk:                     sk,
+		tallyPowerSource:       NewValidatorTallyPowerSource(sk),
 		cdc:                    cdc,
 		router:                 router,
 		config:                 config,
 		authority:              authority,
 		Constitution:           collections.NewItem(sb, types.ConstitutionKey, "constitution", collections.StringValue),
+		AllowedProposalMsgs:    collections.NewKeySet(sb, types.AllowedProposalMsgsKey, "allowed_proposal_msgs", collections.StringKey),
 		Params:                 collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc)),
 		Deposits:               collections.NewMap(sb, types.DepositsKeyPrefix, "deposits", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Deposit](cdc)), // nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 		Votes:                  collections.NewMap(sb, types.VotesKeyPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Vote](cdc)),          // nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
@@ -145,6 +156,14 @@ func (k *Keeper) SetHooks(gh types.GovHooks) *Keeper {
 	return k
 }
 
+// SetTallyPowerSource sets the source of the voting power used to tally proposals,
+// replacing the default one where only the validators vote.
+func (k *Keeper) SetTallyPowerSource(source types.TallyPowerSource) *Keeper {
+	k.tallyPowerSource = source
+
+	return k
+}
+
 // SetLegacyRouter sets the legacy router for governance
 func (k *Keeper) SetLegacyRouter(router v1beta1.Router) {
 	// It is vital to seal the governance proposal router here as to not allow
@@ -193,12 +193,7 @@ func (k msgServer) Vote(goCtx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResp
 }
 
 // VoteWeighted implements the MsgServer.VoteWeighted method.
-//
-//nolint:revive,govet
 func (k msgServer) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted) (*v1.MsgVoteWeightedResponse, error) {
-	// HV2: disabled in heimdall as we do not support WeighedVoteOptions
-	return nil, errors.Wrap(govtypes.ErrWeightedVoteOptionsNotSupported, "not implemented")
-
 	accAddr, accErr := k.authKeeper.AddressCodec().StringToBytes(msg.Voter)
 	if accErr != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", accErr)
@@ -282,10 +277,20 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// HV2: the deposits can only be burned when the gov module account has the burner permission
+	if err := k.ValidateBurnParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
 	if err := k.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
 	}
 
+	if err := k.UpdateAllowedProposalMsgs(ctx, msg.AddAllowedProposalMsgs, msg.RemoveAllowedProposalMsgs); err != nil {
+		return nil, err
+	}
+
 	return &v1.MsgUpdateParamsResponse{}, nil
 }
 
@@ -352,11 +357,7 @@ func (k legacyMsgServer) Vote(goCtx context.Context, msg *v1beta1.MsgVote) (*v1b
 	return &v1beta1.MsgVoteResponse{}, nil
 }
 
-//nolint:revive,govet
 func (k legacyMsgServer) VoteWeighted(goCtx context.Context, msg *v1beta1.MsgVoteWeighted) (*v1beta1.MsgVoteWeightedResponse, error) {
-	// HV2: disabled in heimdall as we do not support WeighedVoteOptions
-	return nil, errors.Wrap(govtypes.ErrWeightedVoteOptionsNotSupported, "not implemented")
-
 	opts := make([]*v1.WeightedVoteOption, len(msg.Options))
 	for idx, opt := range msg.Options {
 		opts[idx] = &v1.WeightedVoteOption{
@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -217,7 +218,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 			expErr:    true,
 			expErrMsg: "invalid proposal message type",
 		},
-		// HV2: banktypes.NewMsgSend is not a valid proposal message type
+		// HV2: banktypes.NewMsgSend is not in the default proposal message allow-list
 		"msg send not supported": {
 			preRun: func() (*v1.MsgSubmitProposal, error) {
 				return v1.NewMsgSubmitProposal(
@@ -751,7 +752,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 			voter:     sdk.AccAddress{},
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "invalid voter address",
 		},
 		"weights sum > 1": {
 			preRun: func() uint64 {
@@ -764,7 +765,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "total weight overflow 1.00",
 		},
 		"duplicate vote options": {
 			preRun: func() uint64 {
@@ -777,7 +778,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "duplicated vote option",
 		},
 		"zero weight": {
 			preRun: func() uint64 {
@@ -789,7 +790,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "invalid vote option",
 		},
 		"negative weight": {
 			preRun: func() uint64 {
@@ -801,7 +802,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "invalid vote option",
 		},
 		"empty options": {
 			preRun: func() uint64 {
@@ -811,7 +812,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "invalid request",
 		},
 		"invalid vote option": {
 			preRun: func() uint64 {
@@ -821,7 +822,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "invalid vote option",
 		},
 		"weight sum < 1": {
 			preRun: func() uint64 {
@@ -833,7 +834,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "total weight lower than 1.00",
 		},
 		"vote on inactive proposal": {
 			preRun: func() uint64 {
@@ -857,7 +858,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "inactive proposal",
 		},
 		"metadata too long": {
 			preRun: func() uint64 {
@@ -867,7 +868,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 			voter:     proposer,
 			metadata:  strings.Repeat("a", 300),
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "metadata too long",
 		},
 		"voter error": {
 			preRun: func() uint64 {
@@ -877,7 +878,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 			voter:     sdk.AccAddress(strings.Repeat("a", 300)),
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "invalid address",
 		},
 		"all good": {
 			preRun: func() uint64 {
@@ -897,11 +898,10 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 				suite.Require().NotNil(res.ProposalId)
 				return res.ProposalId
 			},
-			option:    v1.NewNonSplitVoteOption(v1.VoteOption_VOTE_OPTION_YES),
-			voter:     proposer,
-			metadata:  "",
-			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			option:   v1.NewNonSplitVoteOption(v1.VoteOption_VOTE_OPTION_YES),
+			voter:    proposer,
+			metadata: "",
+			expErr:   false,
 		},
 		"all good with split votes": {
 			preRun: func() uint64 {
@@ -925,10 +925,9 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 				v1.NewWeightedVoteOption(v1.OptionYes, sdkmath.LegacyNewDecWithPrec(5, 1)),
 				v1.NewWeightedVoteOption(v1.OptionAbstain, sdkmath.LegacyNewDecWithPrec(5, 1)),
 			},
-			voter:     proposer,
-			metadata:  "",
-			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			voter:    proposer,
+			metadata: "",
+			expErr:   false,
 		},
 	}
 
@@ -1329,7 +1328,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 			voter:     sdk.AccAddress{},
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "invalid voter address",
 		},
 		"weights sum > 1": {
 			preRun: func() uint64 {
@@ -1348,7 +1347,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "total weight overflow 1.00",
 		},
 		"duplicate vote options": {
 			preRun: func() uint64 {
@@ -1367,7 +1366,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "duplicated vote option",
 		},
 		"zero weight": {
 			preRun: func() uint64 {
@@ -1382,7 +1381,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "invalid vote option",
 		},
 		"negative weight": {
 			preRun: func() uint64 {
@@ -1397,7 +1396,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "invalid vote option",
 		},
 		"empty options": {
 			preRun: func() uint64 {
@@ -1407,7 +1406,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "invalid request",
 		},
 		"invalid vote option": {
 			preRun: func() uint64 {
@@ -1422,7 +1421,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "invalid vote option",
 		},
 		"weight sum < 1": {
 			preRun: func() uint64 {
@@ -1437,7 +1436,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "total weight lower than 1.00",
 		},
 		"vote on inactive proposal": {
 			preRun: func() uint64 {
@@ -1466,7 +1465,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 			voter:     proposer,
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "inactive proposal",
 		},
 		"voter error": {
 			preRun: func() uint64 {
@@ -1481,7 +1480,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 			voter:     sdk.AccAddress(strings.Repeat("a", 300)),
 			metadata:  "",
 			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			expErrMsg: "invalid address",
 		},
 		"all good": {
 			preRun: func() uint64 {
@@ -1507,10 +1506,9 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 					Weight: sdkmath.LegacyNewDec(1),
 				},
 			},
-			voter:     proposer,
-			metadata:  "",
-			expErr:    true,
-			expErrMsg: "weighted vote options not supported",
+			voter:    proposer,
+			metadata: "",
+			expErr:   false,
 		},
 	}
 
@@ -1850,6 +1848,69 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 			expErr:    true,
 			expErrMsg: "voting period must be positive",
 		},
+		{
+			name: "invalid burn deposits dest",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.BurnDepositsDest = "invalid"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "burned deposits destination address is invalid",
+		},
+		{
+			name: "add and remove allowed proposal msgs",
+			input: func() *v1.MsgUpdateParams {
+				return &v1.MsgUpdateParams{
+					Authority:                 authority,
+					Params:                    params,
+					AddAllowedProposalMsgs:    []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+					RemoveAllowedProposalMsgs: []string{"/cosmos.params.v1beta1.ParamChange"},
+				}
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid allowed proposal msg",
+			input: func() *v1.MsgUpdateParams {
+				return &v1.MsgUpdateParams{
+					Authority:              authority,
+					Params:                 params,
+					AddAllowedProposalMsgs: []string{"cosmos.bank.v1beta1.MsgSend"},
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid type URL",
+		},
+		{
+			name: "allowed proposal msg both added and removed",
+			input: func() *v1.MsgUpdateParams {
+				return &v1.MsgUpdateParams{
+					Authority:                 authority,
+					Params:                    params,
+					AddAllowedProposalMsgs:    []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+					RemoveAllowedProposalMsgs: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+				}
+			},
+			expErr:    true,
+			expErrMsg: "is both added and removed",
+		},
+		{
+			name: "remove gov MsgUpdateParams from allowed proposal msgs",
+			input: func() *v1.MsgUpdateParams {
+				return &v1.MsgUpdateParams{
+					Authority:                 authority,
+					Params:                    params,
+					RemoveAllowedProposalMsgs: []string{sdk.MsgTypeURL(&v1.MsgUpdateParams{})},
+				}
+			},
+			expErr:    true,
+			expErrMsg: "cannot be removed",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1874,6 +1935,81 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgUpdateParamsBurnerPermission() {
+	suite.reset()
+	authority := suite.govKeeper.GetAuthority()
+	suite.govKeeper.GetGovernanceAccount(suite.ctx).(*authtypes.ModuleAccount).Permissions = nil
+
+	updateParams := func(params v1.Params) error {
+		_, err := suite.msgSrvr.UpdateParams(suite.ctx, &v1.MsgUpdateParams{Authority: authority, Params: params})
+		return err
+	}
+
+	// the vetoed proposals deposits cannot be burned
+	params := v1.DefaultParams()
+	suite.Require().ErrorIs(updateParams(params), types.ErrMissingBurnerPermission)
+
+	// the deposits are never burned
+	params.BurnVoteVeto = false
+	suite.Require().NoError(updateParams(params))
+
+	// the deposits are sent to the burned deposits destination
+	params.BurnVoteVeto = true
+	params.BurnDepositsDest = suite.addrs[0].String()
+	suite.Require().NoError(updateParams(params))
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateParamsAllowedProposalMsgs() {
+	suite.reset()
+	authority := suite.govKeeper.GetAuthority()
+	params, err := suite.govKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+
+	proposer := suite.addrs[0]
+	msgSendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	submitMsgSend := func() error {
+		msg, err := v1.NewMsgSubmitProposal(
+			[]sdk.Msg{banktypes.NewMsgSend(suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress(), proposer, sdk.NewCoins(sdk.NewCoin("pol", sdkmath.NewInt(1000))))},
+			params.MinDeposit,
+			proposer.String(),
+			"",
+			"Proposal",
+			"description of proposal",
+			false,
+		)
+		suite.Require().NoError(err)
+		_, err = suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+		return err
+	}
+
+	// MsgSend is not in the default allow-list
+	suite.Require().ErrorIs(submitMsgSend(), types.ErrInvalidProposalMsgType)
+
+	_, err = suite.msgSrvr.UpdateParams(suite.ctx, &v1.MsgUpdateParams{
+		Authority:              authority,
+		Params:                 params,
+		AddAllowedProposalMsgs: []string{msgSendTypeURL},
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(submitMsgSend())
+
+	res, err := suite.queryClient.AllowedProposalMsgs(suite.ctx, &v1.QueryAllowedProposalMsgsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Contains(res.TypeUrls, msgSendTypeURL)
+
+	_, err = suite.msgSrvr.UpdateParams(suite.ctx, &v1.MsgUpdateParams{
+		Authority:                 authority,
+		Params:                    params,
+		RemoveAllowedProposalMsgs: []string{msgSendTypeURL},
+	})
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(submitMsgSend(), types.ErrInvalidProposalMsgType)
+
+	res, err = suite.queryClient.AllowedProposalMsgs(suite.ctx, &v1.QueryAllowedProposalMsgsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().NotContains(res.TypeUrls, msgSendTypeURL)
+}
+
 func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 	const meetsDepositValue = baseDepositTestAmount * baseDepositTestPercent / 100
 	baseDepositRatioDec := sdkmath.LegacyNewDec(baseDepositTestPercent).Quo(sdkmath.LegacyNewDec(100))
@@ -1,21 +1,14 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/0xPolygon/heimdall-v2/api/heimdallv2/bor"
-	"github.com/0xPolygon/heimdall-v2/api/heimdallv2/chainmanager"
-	"github.com/0xPolygon/heimdall-v2/api/heimdallv2/checkpoint"
-	"github.com/0xPolygon/heimdall-v2/api/heimdallv2/milestone"
 	borTypes "github.com/0xPolygon/heimdall-v2/x/bor/types"
 	chainmanagertypes "github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
 	checkpointTypes "github.com/0xPolygon/heimdall-v2/x/checkpoint/types"
 	milestoneTypes "github.com/0xPolygon/heimdall-v2/x/milestone/types"
 
-	authv1beta1 "cosmossdk.io/api/cosmos/auth/v1beta1"
-	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
-	consensusv1 "cosmossdk.io/api/cosmos/consensus/v1"
-	govv1 "cosmossdk.io/api/cosmos/gov/v1"
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,35 +19,119 @@ import (
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
-// ValidateGovMsgType validates the type of the message submitted within a proposal.
-// It only accepts `MsgExecLegacyContent` and `MsgUpdateParams` for the heimdall-v2 enabled modules.
-func ValidateGovMsgType(msg sdk.Msg) error {
-	switch msg.(type) {
-	case *v1.MsgExecLegacyContent,
-		*v1.MsgUpdateParams, *govv1.MsgUpdateParams,
-		*authtypes.MsgUpdateParams, *authv1beta1.MsgUpdateParams,
-		*banktypes.MsgUpdateParams, *bankv1beta1.MsgUpdateParams,
-		*consensustypes.MsgUpdateParams, *consensusv1.MsgUpdateParams,
-		// HV2: list of MsgUpdateParams for heimdall-v2 custom modules, to be eventually extended
-		*chainmanagertypes.MsgUpdateParams, *chainmanager.MsgUpdateParams,
-		*borTypes.MsgUpdateParams, *bor.MsgUpdateParams,
-		*checkpointTypes.MsgUpdateParams, *checkpoint.MsgUpdateParams,
-		*milestoneTypes.MsgUpdateParams, *milestone.MsgUpdateParams:
-		return nil
-	default:
+// DefaultAllowedProposalMsgs returns the type URLs allowed in proposals when neither the app,
+// through the keeper config, nor the genesis set any.
+// It accepts `MsgExecLegacyContent` with `TextProposal` and `ParamChange` contents,
+// and `MsgUpdateParams` for the heimdall-v2 enabled modules.
+func DefaultAllowedProposalMsgs() []string {
+	return []string{
+		sdk.MsgTypeURL(&v1.MsgExecLegacyContent{}),
+		sdk.MsgTypeURL(&v1.MsgUpdateParams{}),
+		sdk.MsgTypeURL(&authtypes.MsgUpdateParams{}),
+		sdk.MsgTypeURL(&banktypes.MsgUpdateParams{}),
+		sdk.MsgTypeURL(&consensustypes.MsgUpdateParams{}),
+		// HV2: list of MsgUpdateParams for heimdall-v2 custom modules
+		sdk.MsgTypeURL(&chainmanagertypes.MsgUpdateParams{}),
+		sdk.MsgTypeURL(&borTypes.MsgUpdateParams{}),
+		sdk.MsgTypeURL(&checkpointTypes.MsgUpdateParams{}),
+		sdk.MsgTypeURL(&milestoneTypes.MsgUpdateParams{}),
+		// legacy contents
+		"/cosmos.gov.v1beta1.TextProposal",
+		"/cosmos.params.v1beta1.ParameterChangeProposal", "/cosmos.params.v1beta1.ParamChange",
+	}
+}
+
+// InitAllowedProposalMsgs stores the given type URLs as the proposal message allow-list.
+// If none is given, the ones set in the keeper config are stored.
+func (k Keeper) InitAllowedProposalMsgs(ctx context.Context, typeURLs []string) error {
+	if len(typeURLs) == 0 {
+		typeURLs = k.config.AllowedProposalMsgs
+	}
+
+	for _, typeURL := range typeURLs {
+		if err := k.AllowedProposalMsgs.Set(ctx, typeURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetAllowedProposalMsgs returns the type URLs of the messages and legacy contents allowed in proposals.
+func (k Keeper) GetAllowedProposalMsgs(ctx context.Context) ([]string, error) {
+	iter, err := k.AllowedProposalMsgs.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Keys()
+}
+
+// UpdateAllowedProposalMsgs removes and adds the given type URLs to the proposal message allow-list.
+// The gov MsgUpdateParams cannot be removed, otherwise the allow-list could never be updated again.
+func (k Keeper) UpdateAllowedProposalMsgs(ctx context.Context, add, remove []string) error {
+	if err := v1.ValidateAllowedProposalMsgs(add); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidAllowedProposalMsgs, err.Error())
+	}
+
+	if err := v1.ValidateAllowedProposalMsgs(remove); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidAllowedProposalMsgs, err.Error())
+	}
+
+	added := make(map[string]bool, len(add))
+	for _, typeURL := range add {
+		added[typeURL] = true
+	}
+
+	for _, typeURL := range remove {
+		if added[typeURL] {
+			return errorsmod.Wrapf(types.ErrInvalidAllowedProposalMsgs, "%s is both added and removed", typeURL)
+		}
+
+		if typeURL == sdk.MsgTypeURL(&v1.MsgUpdateParams{}) {
+			return errorsmod.Wrapf(types.ErrInvalidAllowedProposalMsgs, "%s cannot be removed", typeURL)
+		}
+
+		if err := k.AllowedProposalMsgs.Remove(ctx, typeURL); err != nil {
+			return err
+		}
+	}
+
+	for _, typeURL := range add {
+		if err := k.AllowedProposalMsgs.Set(ctx, typeURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateGovMsgType validates the type of the message submitted within a proposal
+// against the proposal message allow-list.
+func (k Keeper) ValidateGovMsgType(ctx context.Context, msg sdk.Msg) error {
+	allowed, err := k.AllowedProposalMsgs.Has(ctx, sdk.MsgTypeURL(msg))
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
 		return errorsmod.Wrap(types.ErrInvalidProposalMsgType, fmt.Sprintf("type not supported: %T", msg))
 	}
+
+	return nil
 }
 
-// ValidateGovMsgContentType validates the type of the msg content submitted within a proposal.
-// It only accepts `TextProposal` and `ParamChange` for the heimdall-v2 enabled modules.
-func ValidateGovMsgContentType(msg *v1.MsgExecLegacyContent) error {
-	switch msg.Content.TypeUrl {
-	// HV2: list of Proposals for heimdall-v2 custom modules, to be eventually extended
-	case "/cosmos.gov.v1beta1.TextProposal",
-		"/cosmos.params.v1beta1.ParameterChangeProposal", "/cosmos.params.v1beta1.ParamChange":
-		return nil
-	default:
+// ValidateGovMsgContentType validates the type of the msg content submitted within a proposal
+// against the proposal message allow-list.
+func (k Keeper) ValidateGovMsgContentType(ctx context.Context, msg *v1.MsgExecLegacyContent) error {
+	allowed, err := k.AllowedProposalMsgs.Has(ctx, msg.Content.TypeUrl)
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
 		return errorsmod.Wrap(types.ErrInvalidProposalContentType, fmt.Sprintf("type not supported: %T", msg.Content))
 	}
+
+	return nil
 }
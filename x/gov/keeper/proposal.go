@@ -43,8 +43,8 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 	for _, msg := range messages {
 		msgsStr += fmt.Sprintf(",%s", sdk.MsgTypeURL(msg))
 
-		// HV2: filter out the message types that are not supported by the gov module
-		err = ValidateGovMsgType(msg)
+		// HV2: filter out the message types that are not in the proposal message allow-list
+		err = keeper.ValidateGovMsgType(ctx, msg)
 		if err != nil {
 			keeper.Logger(ctx).Info("type not supported, proposal is considered not valid")
 			return v1.Proposal{}, err
@@ -83,8 +83,8 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 		// ref: https://github.com/cosmos/cosmos-sdk/pull/10868#discussion_r784872842
 		if msg, ok := msg.(*v1.MsgExecLegacyContent); ok {
 
-			// HV2: filter out the content types that are not supported by the gov module
-			err = ValidateGovMsgContentType(msg)
+			// HV2: filter out the content types that are not in the proposal message allow-list
+			err = keeper.ValidateGovMsgContentType(ctx, msg)
 			if err != nil {
 				keeper.Logger(ctx).Info("type not supported, proposal is considered not valid")
 				return v1.Proposal{}, err
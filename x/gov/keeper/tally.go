@@ -2,9 +2,6 @@ package keeper
 
 import (
 	"context"
-	"strings"
-
-	stakeTypes "github.com/0xPolygon/heimdall-v2/x/stake/types"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
@@ -28,16 +25,12 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 	totalVotingPower := math.LegacyZeroDec()
 	currValidators := make(map[string]v1.ValidatorGovInfo)
 
-	err = keeper.sk.IterateCurrentValidatorsAndApplyFn(ctx, func(validator stakeTypes.Validator) bool {
-		valBz, err := keeper.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
-		if err != nil {
-			return true
-		}
-		currValidators[strings.ToLower(validator.GetOperator())] = v1.NewValidatorGovInfo(
-			valBz,
-			// HV2: using validator.GetBondedTokens() as custom staking module will return the validator's VotingPower for it
-			validator.GetBondedTokens(),
-			math.LegacyZeroDec(),
+	// HV2: the voting power comes from the tally power source, which by default only reports the current validators
+	err = keeper.tallyPowerSource.IterateValidatorsPower(ctx, func(validator sdk.ValAddress, power math.Int) bool {
+		currValidators[validator.String()] = v1.NewValidatorGovInfo(
+			validator,
+			power,
+			math.LegacyNewDecFromInt(power),
 			math.LegacyZeroDec(),
 			v1.WeightedVoteOptions{},
 		)
@@ -56,30 +49,22 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 			return false, err
 		}
 
-		valAddrStr, err := keeper.sk.ValidatorAddressCodec().BytesToString(voter)
-		if err != nil {
-			return false, err
-		}
-		valAddrStr = strings.ToLower(valAddrStr)
+		valAddrStr := sdk.ValAddress(voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
 			val.Vote = vote.Options
 			currValidators[valAddrStr] = val
 		}
 
-		/* HV2: delegations not supported in heimdall
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr()
+		// iterate over all the power delegated by the voter, deduct it from any delegated-to validators
+		err = keeper.tallyPowerSource.IterateDelegatedPower(ctx, voter, func(validator sdk.ValAddress, votingPower math.LegacyDec) (stop bool) {
+			valAddrStr := validator.String()
 
 			if val, ok := currValidators[valAddrStr]; ok {
 				// There is no need to handle the special case that validator address equal to voter address.
 				// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(votingPower)
 				currValidators[valAddrStr] = val
 
-				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
 				for _, option := range vote.Options {
 					weight, _ := math.LegacyNewDecFromStr(option.Weight)
 					subPower := votingPower.Mul(weight)
@@ -93,7 +78,7 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 		if err != nil {
 			return false, err
 		}
-		*/
+
 		return false, keeper.Votes.Remove(ctx, collections.Join(vote.ProposalId, sdk.AccAddress(voter)))
 	})
 	if err != nil {
@@ -102,32 +87,17 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		// HV2: using val.BondedTokens as this is heimdall's val.VotingPower
-		votingPower := math.LegacyNewDec(val.BondedTokens.Int64())
-		totalBondedTokens = totalBondedTokens.Add(votingPower)
+		// HV2: the delegator shares of a validator are its whole voting power
+		totalBondedTokens = totalBondedTokens.Add(val.DelegatorShares)
 
 		if len(val.Vote) == 0 {
 			continue
 		}
 
-		/* HV2: removed the following lines as they are also deleted in heimdall
-		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
-		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-		*/
-
-		// HV2: check on the length of the options.
-		// This should never fail as all votes must have only 1 option, hence we panic if something goes wrong
-		err := keeper.assertVoteOptionsLength(val.Vote)
-		if err != nil {
-			panic(err)
-		}
+		votingPower := val.DelegatorShares.Sub(val.DelegatorDeductions)
 
+		// HV2: a weighted vote splits the validator's voting power across the options
 		for _, option := range val.Vote {
-			// HV2: check on the weight of the option.
-			// This should never fail as the only option should have weight=1, hence we panic if something goes wrong
-			if !v1.ValidWeightedVoteOption(*option) {
-				panic(err)
-			}
 			weight, _ := math.LegacyNewDecFromStr(option.Weight)
 			subPower := votingPower.Mul(weight)
 			results[option.Option] = results[option.Option].Add(subPower)
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	stakeTypes "github.com/0xPolygon/heimdall-v2/x/stake/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.TallyPowerSource = ValidatorTallyPowerSource{}
+
+// ValidatorTallyPowerSource is the default TallyPowerSource, where only the current validators have voting power.
+// HV2: heimdall does not support delegations, hence no voting power is ever deducted from the validators.
+type ValidatorTallyPowerSource struct {
+	sk types.StakingKeeper
+}
+
+// NewValidatorTallyPowerSource returns a TallyPowerSource reporting the voting power of the current validators.
+func NewValidatorTallyPowerSource(sk types.StakingKeeper) ValidatorTallyPowerSource {
+	return ValidatorTallyPowerSource{sk: sk}
+}
+
+// IterateValidatorsPower implements types.TallyPowerSource.
+func (s ValidatorTallyPowerSource) IterateValidatorsPower(ctx context.Context, fn func(validator sdk.ValAddress, power math.Int) (stop bool)) error {
+	var err error
+	iterErr := s.sk.IterateCurrentValidatorsAndApplyFn(ctx, func(validator stakeTypes.Validator) bool {
+		var valBz []byte
+		valBz, err = s.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			return true
+		}
+
+		// HV2: using validator.GetBondedTokens() as custom staking module will return the validator's VotingPower for it
+		return fn(valBz, validator.GetBondedTokens())
+	})
+	if iterErr != nil {
+		return iterErr
+	}
+
+	return err
+}
+
+// IterateDelegatedPower implements types.TallyPowerSource.
+func (s ValidatorTallyPowerSource) IterateDelegatedPower(_ context.Context, _ sdk.AccAddress, _ func(validator sdk.ValAddress, power math.LegacyDec) (stop bool)) error {
+	return nil
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	stakeTypes "github.com/0xPolygon/heimdall-v2/x/stake/types"
+)
+
+// delegationTallyPowerSource is a TallyPowerSource where some accounts delegated part of the validators' power
+type delegationTallyPowerSource struct {
+	validators  map[string]sdkmath.Int
+	delegations map[string]map[string]sdkmath.LegacyDec
+}
+
+func (s delegationTallyPowerSource) IterateValidatorsPower(_ context.Context, fn func(validator sdk.ValAddress, power sdkmath.Int) (stop bool)) error {
+	for addr, power := range s.validators {
+		if fn(sdk.ValAddress(addr), power) {
+			break
+		}
+	}
+	return nil
+}
+
+func (s delegationTallyPowerSource) IterateDelegatedPower(_ context.Context, voter sdk.AccAddress, fn func(validator sdk.ValAddress, power sdkmath.LegacyDec) (stop bool)) error {
+	for addr, power := range s.delegations[string(voter)] {
+		if fn(sdk.ValAddress(addr), power) {
+			break
+		}
+	}
+	return nil
+}
+
+func TestValidatorTallyPowerSource(t *testing.T) {
+	_, _, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, ctx, 3, sdkmath.NewInt(10000000))
+
+	powers := []int64{10, 20, 70}
+	stakingKeeper.EXPECT().IterateCurrentValidatorsAndApplyFn(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(stakeTypes.Validator) bool) error {
+			for i, addr := range addrs {
+				if stop := fn(stakeTypes.Validator{ValId: uint64(i + 1), Signer: addr.String(), VotingPower: powers[i]}); stop {
+					break
+				}
+			}
+			return nil
+		},
+	).AnyTimes()
+
+	source := keeper.NewValidatorTallyPowerSource(stakingKeeper)
+
+	var gotAddrs []sdk.ValAddress
+	var gotPowers []int64
+	err := source.IterateValidatorsPower(ctx, func(validator sdk.ValAddress, power sdkmath.Int) bool {
+		gotAddrs = append(gotAddrs, validator)
+		gotPowers = append(gotPowers, power.Int64())
+		return false
+	})
+	require.NoError(t, err)
+	require.Equal(t, powers, gotPowers)
+	for i, addr := range addrs {
+		require.Equal(t, sdk.ValAddress(addr), gotAddrs[i])
+	}
+
+	// the iteration stops when asked to
+	calls := 0
+	err = source.IterateValidatorsPower(ctx, func(sdk.ValAddress, sdkmath.Int) bool {
+		calls++
+		return true
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+
+	// no power is ever delegated
+	err = source.IterateDelegatedPower(ctx, addrs[0], func(sdk.ValAddress, sdkmath.LegacyDec) bool {
+		require.Fail(t, "unexpected delegation")
+		return false
+	})
+	require.NoError(t, err)
+}
+
+func TestTallyWithDelegatedPower(t *testing.T) {
+	testcases := []struct {
+		name     string
+		votes    map[int]v1.VoteOption
+		expPass  bool
+		expTally v1.TallyResult
+	}{
+		{
+			name:     "delegator inherits the validator vote when not voting",
+			votes:    map[int]v1.VoteOption{2: v1.OptionYes},
+			expPass:  true,
+			expTally: v1.TallyResult{YesCount: "70", AbstainCount: "0", NoCount: "0", NoWithVetoCount: "0"},
+		},
+		{
+			name:     "delegator overrides the validator vote",
+			votes:    map[int]v1.VoteOption{2: v1.OptionYes, 3: v1.OptionNo},
+			expPass:  true,
+			expTally: v1.TallyResult{YesCount: "40", AbstainCount: "0", NoCount: "30", NoWithVetoCount: "0"},
+		},
+		{
+			name:     "delegator votes without its validator",
+			votes:    map[int]v1.VoteOption{0: v1.OptionNo, 3: v1.OptionYes},
+			expPass:  true,
+			expTally: v1.TallyResult{YesCount: "30", AbstainCount: "0", NoCount: "10", NoWithVetoCount: "0"},
+		},
+		{
+			name:     "delegator alone misses quorum",
+			votes:    map[int]v1.VoteOption{3: v1.OptionYes},
+			expPass:  false,
+			expTally: v1.TallyResult{YesCount: "30", AbstainCount: "0", NoCount: "0", NoWithVetoCount: "0"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			govKeeper, _, bankKeeper, _, _, _, ctx := setupGovKeeper(t)
+			addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, ctx, 4, sdkmath.NewInt(10000000))
+
+			// addrs[3] delegated 30 of the 70 voting power of addrs[2]
+			govKeeper.SetTallyPowerSource(delegationTallyPowerSource{
+				validators: map[string]sdkmath.Int{
+					string(addrs[0]): sdkmath.NewInt(10),
+					string(addrs[1]): sdkmath.NewInt(20),
+					string(addrs[2]): sdkmath.NewInt(70),
+				},
+				delegations: map[string]map[string]sdkmath.LegacyDec{
+					string(addrs[3]): {string(addrs[2]): sdkmath.LegacyNewDec(30)},
+				},
+			})
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+			require.NoError(t, err)
+			require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+
+			for i, option := range tc.votes {
+				require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, addrs[i], v1.NewNonSplitVoteOption(option), ""))
+			}
+
+			passes, _, tally, err := govKeeper.Tally(ctx, proposal)
+			require.NoError(t, err)
+			require.Equal(t, tc.expPass, passes)
+			require.Equal(t, tc.expTally, tally)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	stakeTypes "github.com/0xPolygon/heimdall-v2/x/stake/types"
+)
+
+func TestTallyWeightedVotes(t *testing.T) {
+	splitVote := func(option1 v1.VoteOption, weight1 string, option2 v1.VoteOption, weight2 string) v1.WeightedVoteOptions {
+		return v1.WeightedVoteOptions{
+			v1.NewWeightedVoteOption(option1, sdkmath.LegacyMustNewDecFromStr(weight1)),
+			v1.NewWeightedVoteOption(option2, sdkmath.LegacyMustNewDecFromStr(weight2)),
+		}
+	}
+
+	testcases := []struct {
+		name         string
+		votes        map[int]v1.WeightedVoteOptions
+		expPass      bool
+		expTally     v1.TallyResult
+		expBurnParam func(v1.Params) bool
+	}{
+		{
+			name:     "no votes",
+			votes:    map[int]v1.WeightedVoteOptions{},
+			expPass:  false,
+			expTally: v1.EmptyTallyResult(),
+		},
+		{
+			name: "split vote of the largest validator passes",
+			votes: map[int]v1.WeightedVoteOptions{
+				2: splitVote(v1.OptionYes, "0.7", v1.OptionAbstain, "0.3"),
+			},
+			expPass:  true,
+			expTally: v1.TallyResult{YesCount: "49", AbstainCount: "21", NoCount: "0", NoWithVetoCount: "0"},
+		},
+		{
+			name: "split vote outweighs non split votes",
+			votes: map[int]v1.WeightedVoteOptions{
+				0: v1.NewNonSplitVoteOption(v1.OptionYes),
+				1: v1.NewNonSplitVoteOption(v1.OptionNo),
+				2: splitVote(v1.OptionYes, "0.3", v1.OptionNo, "0.7"),
+			},
+			expPass:  false,
+			expTally: v1.TallyResult{YesCount: "31", AbstainCount: "0", NoCount: "69", NoWithVetoCount: "0"},
+		},
+		{
+			name: "split vote reaches the veto threshold",
+			votes: map[int]v1.WeightedVoteOptions{
+				0: v1.NewNonSplitVoteOption(v1.OptionYes),
+				1: v1.NewNonSplitVoteOption(v1.OptionYes),
+				2: splitVote(v1.OptionYes, "0.5", v1.OptionNoWithVeto, "0.5"),
+			},
+			expPass:      false,
+			expTally:     v1.TallyResult{YesCount: "65", AbstainCount: "0", NoCount: "0", NoWithVetoCount: "35"},
+			expBurnParam: func(p v1.Params) bool { return p.BurnVoteVeto },
+		},
+		{
+			name: "split vote of a small validator misses quorum",
+			votes: map[int]v1.WeightedVoteOptions{
+				0: splitVote(v1.OptionYes, "0.5", v1.OptionNo, "0.5"),
+			},
+			expPass:      false,
+			expTally:     v1.TallyResult{YesCount: "5", AbstainCount: "0", NoCount: "5", NoWithVetoCount: "0"},
+			expBurnParam: func(p v1.Params) bool { return p.BurnVoteQuorum },
+		},
+		{
+			name: "everyone abstains",
+			votes: map[int]v1.WeightedVoteOptions{
+				0: v1.NewNonSplitVoteOption(v1.OptionAbstain),
+				2: v1.NewNonSplitVoteOption(v1.OptionAbstain),
+			},
+			expPass:  false,
+			expTally: v1.TallyResult{YesCount: "0", AbstainCount: "80", NoCount: "0", NoWithVetoCount: "0"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			govKeeper, authKeeper, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+			authKeeper.EXPECT().AddressCodec().Return(address.NewHexCodec()).AnyTimes()
+			addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, ctx, 3, sdkmath.NewInt(10000000))
+
+			powers := []int64{10, 20, 70}
+			mockValidators := make([]stakeTypes.Validator, len(addrs))
+			for i, addr := range addrs {
+				mockValidators[i] = stakeTypes.Validator{ValId: uint64(i + 1), Signer: addr.String(), VotingPower: powers[i]}
+			}
+			stakingKeeper.EXPECT().IterateCurrentValidatorsAndApplyFn(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(stakeTypes.Validator) bool) error {
+					for _, validator := range mockValidators {
+						if stop := fn(validator); stop {
+							break
+						}
+					}
+					return nil
+				},
+			).AnyTimes()
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+			require.NoError(t, err)
+			require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+
+			for i, options := range tc.votes {
+				require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, addrs[i], options, ""))
+			}
+
+			params, err := govKeeper.Params.Get(ctx)
+			require.NoError(t, err)
+
+			passes, burnDeposits, tally, err := govKeeper.Tally(ctx, proposal)
+			require.NoError(t, err)
+			require.Equal(t, tc.expPass, passes)
+			expBurn := false
+			if tc.expBurnParam != nil {
+				expBurn = tc.expBurnParam(params)
+			}
+			require.Equal(t, expBurn, burnDeposits)
+			require.Equal(t, tc.expTally, tally)
+
+			// votes are removed once tallied
+			votes, err := govKeeper.Votes.Iterate(ctx, nil)
+			require.NoError(t, err)
+			keys, err := votes.Keys()
+			require.NoError(t, err)
+			require.Empty(t, keys)
+		})
+	}
+}
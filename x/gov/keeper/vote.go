@@ -29,20 +29,15 @@ func (keeper Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr s
 		return err
 	}
 
-	// HV2: check on the length of the options. This should never fail as it is only used by `MsgServer.Vote`.
-	// There, `v1.NewNonSplitVoteOption` is used to enforce the constraints (only 1 option with weight=1)
-	// Hence, we panic if something goes wrong
 	err = keeper.assertVoteOptionsLength(options)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	// HV2: check on the weight of the option. This should never fail as it is only used by `MsgServer.Vote`.
-	// There, `v1.NewNonSplitVoteOption` is used to enforce the constraints (only 1 option with weight=1)
-	for _, option := range options {
-		if !v1.ValidWeightedVoteOption(*option) {
-			return errors.Wrap(types.ErrInvalidVote, option.String())
-		}
+	// HV2: a validator can split its voting power across several options,
+	// as long as every option is used once and the weights sum up to 1
+	if !v1.ValidWeightedVoteOptions(options) {
+		return errors.Wrap(types.ErrInvalidVote, options.String())
 	}
 
 	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
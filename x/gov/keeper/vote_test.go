@@ -56,17 +56,14 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, v1.OptionYes, vote.Options[0].Option)
 
 	// Test second vote
-	require.Panics(t, func() {
-		_ = govKeeper.AddVote(ctx, proposalID, addrs[1], v1.WeightedVoteOptions{
-			v1.NewWeightedVoteOption(v1.OptionYes, sdkmath.LegacyNewDecWithPrec(60, 2)),
-			v1.NewWeightedVoteOption(v1.OptionNo, sdkmath.LegacyNewDecWithPrec(30, 2)),
-			v1.NewWeightedVoteOption(v1.OptionAbstain, sdkmath.LegacyNewDecWithPrec(5, 2)),
-			v1.NewWeightedVoteOption(v1.OptionNoWithVeto, sdkmath.LegacyNewDecWithPrec(5, 2)),
-		}, "")
-	})
+	require.NoError(t, govKeeper.AddVote(ctx, proposalID, addrs[1], v1.WeightedVoteOptions{
+		v1.NewWeightedVoteOption(v1.OptionYes, sdkmath.LegacyNewDecWithPrec(60, 2)),
+		v1.NewWeightedVoteOption(v1.OptionNo, sdkmath.LegacyNewDecWithPrec(30, 2)),
+		v1.NewWeightedVoteOption(v1.OptionAbstain, sdkmath.LegacyNewDecWithPrec(5, 2)),
+		v1.NewWeightedVoteOption(v1.OptionNoWithVeto, sdkmath.LegacyNewDecWithPrec(5, 2)),
+	}, ""))
 	vote, err = govKeeper.Votes.Get(ctx, collections.Join(proposalID, addrs[1]))
-	require.NotNil(t, err)
-	/* HV2: WeightedVoteOptions is not supported, heimdall won't have the vote options here
+	require.Nil(t, err)
 	require.Equal(t, addrs[1].String(), vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalId)
 	require.True(t, len(vote.Options) == 4)
@@ -78,7 +75,22 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, vote.Options[1].Weight, sdkmath.LegacyNewDecWithPrec(30, 2).String())
 	require.Equal(t, vote.Options[2].Weight, sdkmath.LegacyNewDecWithPrec(5, 2).String())
 	require.Equal(t, vote.Options[3].Weight, sdkmath.LegacyNewDecWithPrec(5, 2).String())
-	*/
+
+	// Test invalid weighted votes
+	require.Error(t, govKeeper.AddVote(ctx, proposalID, addrs[1], v1.WeightedVoteOptions{
+		v1.NewWeightedVoteOption(v1.OptionYes, sdkmath.LegacyNewDecWithPrec(60, 2)),
+	}, ""), "weight sum lower than 1")
+	require.Error(t, govKeeper.AddVote(ctx, proposalID, addrs[1], v1.WeightedVoteOptions{
+		v1.NewWeightedVoteOption(v1.OptionYes, sdkmath.LegacyNewDecWithPrec(50, 2)),
+		v1.NewWeightedVoteOption(v1.OptionYes, sdkmath.LegacyNewDecWithPrec(50, 2)),
+	}, ""), "duplicated option")
+	require.Error(t, govKeeper.AddVote(ctx, proposalID, addrs[1], v1.WeightedVoteOptions{
+		v1.NewWeightedVoteOption(v1.OptionYes, sdkmath.LegacyNewDecWithPrec(20, 2)),
+		v1.NewWeightedVoteOption(v1.OptionNo, sdkmath.LegacyNewDecWithPrec(20, 2)),
+		v1.NewWeightedVoteOption(v1.OptionAbstain, sdkmath.LegacyNewDecWithPrec(20, 2)),
+		v1.NewWeightedVoteOption(v1.OptionNoWithVeto, sdkmath.LegacyNewDecWithPrec(20, 2)),
+		v1.NewWeightedVoteOption(v1.OptionEmpty, sdkmath.LegacyNewDecWithPrec(20, 2)),
+	}, ""), "too many options")
 
 	// Test vote iterator
 	// NOTE order of deposits is determined by the addresses
@@ -87,7 +99,7 @@ func TestVotes(t *testing.T) {
 		votes = append(votes, &value)
 		return false, nil
 	}))
-	require.Len(t, votes, 1)
+	require.Len(t, votes, 2)
 	var propVotes v1.Votes
 	require.NoError(t, govKeeper.Votes.Walk(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID), func(_ collections.Pair[uint64, sdk.AccAddress], value v1.Vote) (stop bool, err error) {
 		propVotes = append(propVotes, &value)
@@ -98,7 +110,6 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, proposalID, votes[0].ProposalId)
 	require.True(t, len(votes[0].Options) == 1)
 	require.Equal(t, v1.OptionYes, votes[0].Options[0].Option)
-	/* HV2: no additional votes here as second vote option hasn't been added
 	require.Equal(t, addrs[1].String(), votes[1].Voter)
 	require.Equal(t, proposalID, votes[1].ProposalId)
 	require.True(t, len(votes[1].Options) == 4)
@@ -106,7 +117,6 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, votes[1].Options[1].Weight, sdkmath.LegacyNewDecWithPrec(30, 2).String())
 	require.Equal(t, votes[1].Options[2].Weight, sdkmath.LegacyNewDecWithPrec(5, 2).String())
 	require.Equal(t, votes[1].Options[3].Weight, sdkmath.LegacyNewDecWithPrec(5, 2).String())
-	*/
 	// non existent vote
 	_, err = govKeeper.Votes.Get(ctx, collections.Join(proposalID+100, addrs[1]))
 	require.ErrorIs(t, err, collections.ErrNotFound)
@@ -75,6 +75,7 @@ func TestMigrateJSON(t *testing.T) {
 	// Make sure about:
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
+	"allowed_proposal_msgs": [],
 	"constitution": "",
 	"deposit_params": {
 		"max_deposit_period": "172800s",
@@ -57,10 +57,12 @@ func TestMigrateJSON(t *testing.T) {
 	// Make sure about:
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
+	"allowed_proposal_msgs": [],
 	"constitution": "",
 	"deposit_params": null,
 	"deposits": [],
 	"params": {
+		"burn_deposits_dest": "",
 		"burn_proposal_deposit_prevote": false,
 		"burn_vote_quorum": false,
 		"burn_vote_veto": true,
@@ -0,0 +1,22 @@
+package v6
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. The
+// migration includes:
+//
+// Addition of the proposal message allow-list, set to the type URLs registered by
+// the app in the gov keeper config, which replaces the list hardcoded in the gov module.
+func MigrateStore(ctx sdk.Context, allowedProposalMsgs collections.KeySet[string], typeURLs []string) error {
+	for _, typeURL := range typeURLs {
+		if err := allowedProposalMsgs.Set(ctx, typeURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
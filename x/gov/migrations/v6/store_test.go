@@ -0,0 +1,35 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	v6 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	govKey := storetypes.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, storetypes.NewTransientStoreKey("transient_test"))
+	storeService := runtime.NewKVStoreService(govKey)
+	sb := collections.NewSchemaBuilder(storeService)
+	allowedProposalMsgs := collections.NewKeySet(sb, types.AllowedProposalMsgsKey, "allowed_proposal_msgs", collections.StringKey)
+
+	typeURLs := []string{"/cosmos.gov.v1.MsgExecLegacyContent", "/cosmos.gov.v1.MsgUpdateParams", "/cosmos.gov.v1beta1.TextProposal"}
+
+	// Run migrations.
+	require.NoError(t, v6.MigrateStore(ctx, allowedProposalMsgs, typeURLs))
+
+	// Check allow-list
+	iter, err := allowedProposalMsgs.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, typeURLs, keys)
+}
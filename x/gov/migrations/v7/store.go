@@ -0,0 +1,26 @@
+package v7
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. The
+// migration includes:
+//
+// The deposit burn parameters are set to false, as the deposits of the failed
+// proposals were distributed among the validators regardless of their values.
+func MigrateStore(ctx sdk.Context, params collections.Item[govv1.Params]) error {
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.BurnProposalDepositPrevote = false
+	p.BurnVoteQuorum = false
+	p.BurnVoteVeto = false
+
+	return params.Set(ctx, p)
+}
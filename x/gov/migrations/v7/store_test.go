@@ -0,0 +1,50 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	v7 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v7"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(gov.AppModuleBasic{}).Codec
+	govKey := storetypes.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, storetypes.NewTransientStoreKey("transient_test"))
+	storeService := runtime.NewKVStoreService(govKey)
+	sb := collections.NewSchemaBuilder(storeService)
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc))
+
+	oldParams := v1.DefaultParams()
+	oldParams.BurnProposalDepositPrevote = true
+	oldParams.BurnVoteQuorum = true
+	oldParams.BurnVoteVeto = true
+	require.NoError(t, params.Set(ctx, oldParams))
+
+	// Run migrations.
+	require.NoError(t, v7.MigrateStore(ctx, params))
+
+	// Check params
+	newParams, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.False(t, newParams.BurnProposalDepositPrevote)
+	require.False(t, newParams.BurnVoteQuorum)
+	require.False(t, newParams.BurnVoteVeto)
+
+	// the other params are left untouched
+	oldParams.BurnProposalDepositPrevote = false
+	oldParams.BurnVoteQuorum = false
+	oldParams.BurnVoteVeto = false
+	require.Equal(t, oldParams, newParams)
+}
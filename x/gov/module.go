@@ -36,7 +36,7 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-const ConsensusVersion = 5
+const ConsensusVersion = 7
 
 var (
 	_ module.AppModuleBasic      = AppModuleBasic{}
@@ -174,6 +174,9 @@ type ModuleInputs struct {
 	StakingKeeper      govtypes.StakingKeeper
 	DistributionKeeper govtypes.DistributionKeeper
 
+	// TallyPowerSource replaces the default validator-only voting power source when provided
+	TallyPowerSource govtypes.TallyPowerSource `optional:"true"`
+
 	// LegacySubspace is used solely for migration of x/params managed parameters
 	LegacySubspace govtypes.ParamSubspace `optional:"true"`
 }
@@ -209,6 +212,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		defaultConfig,
 		authority.String(),
 	)
+	if in.TallyPowerSource != nil {
+		k.SetTallyPowerSource(in.TallyPowerSource)
+	}
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.LegacySubspace)
 	hr := v1beta1.HandlerRoute{Handler: v1beta1.ProposalHandler, RouteKey: govtypes.RouterKey}
 
@@ -292,6 +298,14 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(govtypes.ModuleName, 4, m.Migrate4to5); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/gov from version 4 to 5: %v", err))
 	}
+
+	if err := cfg.RegisterMigration(govtypes.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/gov from version 5 to 6: %v", err))
+	}
+
+	if err := cfg.RegisterMigration(govtypes.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/gov from version 6 to 7: %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the gov module. It returns
@@ -6,12 +6,15 @@ type Config struct {
 	MaxMetadataLen uint64
 	// MaxVoteOptionsLen defines the maximum number of options a proposal can have.
 	MaxVoteOptionsLen uint64
+	// AllowedProposalMsgs defines the type URLs of the messages and legacy contents
+	// allowed in proposals when the genesis does not set any.
+	AllowedProposalMsgs []string
 }
 
 // DefaultConfig returns the default config for gov.
 func DefaultConfig() Config {
 	return Config{
 		MaxMetadataLen:    255,
-		MaxVoteOptionsLen: 1,
+		MaxVoteOptionsLen: 4,
 	}
 }
@@ -29,4 +29,6 @@ var (
 	ErrWeightedVoteOptionsNotSupported = errors.Register(ModuleName, 25, "weighted vote options not supported")
 	ErrInvalidProposalMsgType          = errors.Register(ModuleName, 26, "invalid proposal message type")
 	ErrInvalidProposalContentType      = errors.Register(ModuleName, 27, "invalid proposal content type")
+	ErrInvalidAllowedProposalMsgs      = errors.Register(ModuleName, 28, "invalid allowed proposal messages")
+	ErrMissingBurnerPermission         = errors.Register(ModuleName, 29, "gov module account is missing the burner permission")
 )
@@ -6,6 +6,7 @@ import (
 	stakeTypes "github.com/0xPolygon/heimdall-v2/x/stake/types"
 
 	addresscodec "cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -37,6 +38,18 @@ type StakingKeeper interface {
 	GetValIdFromAddress(ctx context.Context, address string) (uint64, error)
 }
 
+// TallyPowerSource defines the expected interface reporting the voting power used to tally proposals (noalias)
+// HV2: it allows the staking module to account for delegators (or stake pools) overriding their validators' votes
+type TallyPowerSource interface {
+	// IterateValidatorsPower iterates over the validators whose voting power counts in the tally,
+	// calling fn with the validator address and its total voting power.
+	IterateValidatorsPower(ctx context.Context, fn func(validator sdk.ValAddress, power math.Int) (stop bool)) error
+
+	// IterateDelegatedPower iterates over the voting power the voter delegated to validators, calling fn with
+	// the validator address and the delegated power, which is deducted from the validator when the voter votes.
+	IterateDelegatedPower(ctx context.Context, voter sdk.AccAddress, fn func(validator sdk.ValAddress, power math.LegacyDec) (stop bool)) error
+}
+
 // DistributionKeeper defines the expected distribution keeper (noalias)
 type DistributionKeeper interface {
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
@@ -65,8 +78,7 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 
-	// HV2: this function was removed in heimdall's gox/expected_keepers.go (from SupplyKeeper, merged now with BankKeeper)
-	// BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 }
 
 // Event Hooks
@@ -3,6 +3,7 @@ package v1
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"golang.org/x/sync/errgroup"
 
@@ -102,9 +103,32 @@ func ValidateGenesis(data *GenesisState) error {
 		return data.Params.ValidateBasic()
 	})
 
+	// verify the proposal message allow-list
+	errGroup.Go(func() error {
+		return ValidateAllowedProposalMsgs(data.AllowedProposalMsgs)
+	})
+
 	return errGroup.Wait()
 }
 
+// ValidateAllowedProposalMsgs checks that the given type URLs are well formed and unique.
+func ValidateAllowedProposalMsgs(typeURLs []string) error {
+	seen := make(map[string]struct{}, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return fmt.Errorf("invalid type URL: %q", typeURL)
+		}
+
+		if _, ok := seen[typeURL]; ok {
+			return fmt.Errorf("duplicate type URL: %s", typeURL)
+		}
+
+		seen[typeURL] = struct{}{}
+	}
+
+	return nil
+}
+
 var _ types.UnpackInterfacesMessage = GenesisState{}
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
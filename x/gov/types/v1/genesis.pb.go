@@ -52,6 +52,10 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.50
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// allowed_proposal_msgs defines the type URLs of the messages and legacy contents
+	// which can be submitted within a proposal. When empty, the defaults registered
+	// by the app in the gov keeper config are used.
+	AllowedProposalMsgs []string `protobuf:"bytes,10,rep,name=allowed_proposal_msgs,json=allowedProposalMsgs,proto3" json:"allowed_proposal_msgs,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -153,6 +157,13 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetAllowedProposalMsgs() []string {
+	if m != nil {
+		return m.AllowedProposalMsgs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -160,31 +171,33 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x4e, 0xfa, 0x40,
-	0x14, 0xc5, 0x19, 0xbe, 0xfe, 0x30, 0x94, 0xff, 0x62, 0xfc, 0xa0, 0x01, 0xd3, 0x34, 0xac, 0x6a,
-	0x0c, 0xad, 0x60, 0x7c, 0x00, 0x09, 0x86, 0xb8, 0x23, 0xd5, 0xb8, 0x70, 0x43, 0x0a, 0x6d, 0x6a,
-	0x23, 0xf4, 0x36, 0x9d, 0x61, 0x22, 0x6f, 0xe1, 0x63, 0xb9, 0x64, 0xe9, 0xd2, 0xc0, 0x63, 0xb8,
-	0x31, 0xcc, 0xb4, 0x02, 0xd5, 0xd5, 0x24, 0xf7, 0xfc, 0xce, 0x99, 0x93, 0x9b, 0x8b, 0x5b, 0x53,
-	0xa0, 0x73, 0xa0, 0x96, 0x0f, 0xdc, 0xe2, 0x5d, 0xcb, 0xf7, 0x42, 0x8f, 0x06, 0xd4, 0x8c, 0x62,
-	0x60, 0x40, 0xea, 0x52, 0x34, 0x7d, 0xe0, 0x26, 0xef, 0x36, 0x1b, 0x19, 0x16, 0xb8, 0xe4, 0xda,
-	0x5f, 0x05, 0xac, 0x0c, 0xa5, 0xf3, 0x9e, 0x39, 0xcc, 0x23, 0x97, 0xf8, 0x98, 0x32, 0x27, 0x66,
-	0x41, 0xe8, 0x8f, 0xa3, 0x18, 0x22, 0xa0, 0xce, 0x6c, 0x1c, 0xb8, 0x2a, 0xd2, 0x91, 0x51, 0xb4,
-	0x49, 0xaa, 0x8d, 0x12, 0xe9, 0xce, 0x25, 0x3d, 0x5c, 0x71, 0xbd, 0x08, 0x68, 0xc0, 0xa8, 0x9a,
-	0xd7, 0x0b, 0x46, 0xad, 0x77, 0x6a, 0x1e, 0xfc, 0x6e, 0x0e, 0xa4, 0x6c, 0xff, 0x70, 0xe4, 0x1c,
-	0x97, 0x38, 0x30, 0x8f, 0xaa, 0x05, 0x61, 0x38, 0xca, 0x18, 0x1e, 0x81, 0x79, 0xb6, 0x24, 0xc8,
-	0x35, 0xae, 0xa6, 0x3d, 0xa8, 0x5a, 0x14, 0x78, 0x23, 0x83, 0xa7, 0x65, 0xec, 0x1d, 0x49, 0x86,
-	0xf8, 0x7f, 0xf2, 0xdb, 0x38, 0x72, 0x62, 0x67, 0x4e, 0xd5, 0x92, 0x8e, 0x8c, 0x5a, 0xef, 0xec,
-	0xef, 0x6e, 0x23, 0xc1, 0xf4, 0xf3, 0x2a, 0xb2, 0xeb, 0xee, 0xfe, 0x88, 0x0c, 0x70, 0x9d, 0x83,
-	0x5c, 0x87, 0xcc, 0x29, 0x8b, 0x9c, 0xd6, 0xef, 0xca, 0xdb, 0xb5, 0xec, 0x62, 0x14, 0xbe, 0x37,
-	0x21, 0x37, 0x58, 0x61, 0xce, 0x6c, 0xb6, 0x4c, 0x43, 0xfe, 0x89, 0x90, 0x66, 0x26, 0xe4, 0x61,
-	0x8b, 0xec, 0x65, 0xd4, 0xd8, 0x6e, 0x40, 0x3a, 0xb8, 0x9c, 0x98, 0x2b, 0xc2, 0x7c, 0x92, 0xdd,
-	0x82, 0x10, 0xed, 0x04, 0x22, 0x6d, 0xac, 0x4c, 0x21, 0xa4, 0x2c, 0x60, 0x0b, 0x16, 0x40, 0xa8,
-	0x56, 0x75, 0x64, 0x54, 0xed, 0x83, 0x59, 0xff, 0xf6, 0x7d, 0xad, 0xa1, 0xd5, 0x5a, 0x43, 0x9f,
-	0x6b, 0x0d, 0xbd, 0x6d, 0xb4, 0xdc, 0x6a, 0xa3, 0xe5, 0x3e, 0x36, 0x5a, 0xee, 0xe9, 0xc2, 0x0f,
-	0xd8, 0xf3, 0x62, 0x62, 0x4e, 0x61, 0x6e, 0x25, 0xb7, 0x23, 0x9f, 0x0e, 0x75, 0x5f, 0xac, 0x57,
-	0x71, 0x48, 0x6c, 0x19, 0x79, 0xd4, 0xe2, 0xdd, 0x49, 0x59, 0xdc, 0xd2, 0xd5, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x1b, 0x69, 0x07, 0x35, 0x92, 0x02, 0x00, 0x00,
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x33, 0x4d, 0x1a, 0xea, 0x89, 0xc3, 0x62, 0x4a, 0xe9, 0xa8, 0x45, 0x96, 0xd5, 0x95,
+	0x11, 0xaa, 0x4d, 0x8c, 0x38, 0x00, 0x55, 0x51, 0xc5, 0x02, 0xa9, 0x1a, 0x10, 0x0b, 0x36, 0x91,
+	0x1b, 0x8f, 0x8c, 0x85, 0xed, 0x67, 0xf9, 0x4d, 0x07, 0x7a, 0x0b, 0x6e, 0xc2, 0x35, 0x58, 0x76,
+	0xc9, 0x12, 0x25, 0x17, 0x41, 0x9d, 0xb1, 0x71, 0x6a, 0xba, 0xb2, 0xf4, 0xfe, 0xef, 0xff, 0xe7,
+	0xe9, 0xf7, 0xa3, 0xc7, 0x2b, 0xc0, 0x12, 0x30, 0xca, 0x40, 0x47, 0x7a, 0x11, 0x65, 0xb2, 0x92,
+	0x98, 0x63, 0x58, 0x37, 0xa0, 0x80, 0xcd, 0xad, 0x18, 0x66, 0xa0, 0x43, 0xbd, 0x38, 0x3a, 0x1c,
+	0xb0, 0xa0, 0x2d, 0x77, 0xf2, 0x73, 0x42, 0xdd, 0x0b, 0xeb, 0xfc, 0xa0, 0x12, 0x25, 0xd9, 0x4b,
+	0xfa, 0x04, 0x55, 0xd2, 0xa8, 0xbc, 0xca, 0x96, 0x75, 0x03, 0x35, 0x60, 0x52, 0x2c, 0xf3, 0x94,
+	0x13, 0x9f, 0x04, 0x13, 0xc1, 0x3a, 0xed, 0xb2, 0x95, 0xde, 0xa5, 0x2c, 0xa6, 0x7b, 0xa9, 0xac,
+	0x01, 0x73, 0x85, 0x7c, 0xc7, 0x1f, 0x07, 0xb3, 0xf8, 0x69, 0x78, 0xef, 0xf5, 0xf0, 0xdc, 0xca,
+	0xe2, 0x1f, 0xc7, 0x9e, 0xd3, 0x5d, 0x0d, 0x4a, 0x22, 0x1f, 0x1b, 0xc3, 0xfe, 0xc0, 0xf0, 0x09,
+	0x94, 0x14, 0x96, 0x60, 0xaf, 0xa9, 0xd3, 0xed, 0x81, 0x7c, 0x62, 0xf0, 0xc3, 0x01, 0xde, 0x2d,
+	0x23, 0x7a, 0x92, 0x5d, 0xd0, 0xc7, 0xed, 0x6b, 0xcb, 0x3a, 0x69, 0x92, 0x12, 0xf9, 0xae, 0x4f,
+	0x82, 0x59, 0xfc, 0xec, 0xe1, 0xdd, 0x2e, 0x0d, 0x73, 0xb6, 0xc3, 0x89, 0x98, 0xa7, 0xdb, 0x23,
+	0x76, 0x4e, 0xe7, 0x1a, 0x6c, 0x1d, 0x36, 0x67, 0x6a, 0x72, 0x8e, 0xff, 0x5f, 0xf9, 0xae, 0x96,
+	0x3e, 0xc6, 0xd5, 0x5b, 0x13, 0xf6, 0x86, 0xba, 0x2a, 0x29, 0x8a, 0x9b, 0x2e, 0xe4, 0x91, 0x09,
+	0x39, 0x1a, 0x84, 0x7c, 0xbc, 0x43, 0xb6, 0x32, 0x66, 0xaa, 0x1f, 0xb0, 0x53, 0x3a, 0x6d, 0xcd,
+	0x7b, 0xc6, 0x7c, 0x30, 0x6c, 0xc1, 0x88, 0xa2, 0x85, 0xd8, 0x09, 0x75, 0x57, 0x50, 0xa1, 0xca,
+	0xd5, 0xb5, 0xca, 0xa1, 0xe2, 0x8e, 0x4f, 0x02, 0x47, 0xdc, 0x9b, 0xb1, 0x98, 0x1e, 0x24, 0x45,
+	0x01, 0xdf, 0x64, 0xda, 0xff, 0xeb, 0x12, 0x33, 0xe4, 0xd4, 0x1f, 0x07, 0x8e, 0xd8, 0x6f, 0xc5,
+	0xae, 0xdf, 0xf7, 0x98, 0xe1, 0xd9, 0xdb, 0x5f, 0x6b, 0x8f, 0xdc, 0xae, 0x3d, 0xf2, 0x67, 0xed,
+	0x91, 0x1f, 0x1b, 0x6f, 0x74, 0xbb, 0xf1, 0x46, 0xbf, 0x37, 0xde, 0xe8, 0xf3, 0x8b, 0x2c, 0x57,
+	0x5f, 0xae, 0xaf, 0xc2, 0x15, 0x94, 0x51, 0x7b, 0x6f, 0xf6, 0x73, 0x8a, 0xe9, 0xd7, 0xe8, 0xbb,
+	0x39, 0x3e, 0x75, 0x53, 0x4b, 0x8c, 0xf4, 0xe2, 0x6a, 0x6a, 0xee, 0xef, 0xd5, 0xdf, 0x01, 0x00,
+	0x1a, 0xc8, 0xb6, 0x39, 0xc6, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +220,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedProposalMsgs) > 0 {
+		for iNdEx := len(m.AllowedProposalMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedProposalMsgs[iNdEx])
+			copy(dAtA[i:], m.AllowedProposalMsgs[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedProposalMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -370,6 +392,12 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.AllowedProposalMsgs) > 0 {
+		for _, s := range m.AllowedProposalMsgs {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -705,6 +733,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedProposalMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedProposalMsgs = append(m.AllowedProposalMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
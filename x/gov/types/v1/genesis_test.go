@@ -170,6 +170,35 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "valid allowed proposal msgs",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.AllowedProposalMsgs = []string{"/cosmos.gov.v1.MsgUpdateParams", "/cosmos.gov.v1beta1.TextProposal"}
+
+				return state
+			},
+		},
+		{
+			name: "invalid type URL in allowed proposal msgs",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.AllowedProposalMsgs = []string{"cosmos.gov.v1.MsgUpdateParams"}
+
+				return state
+			},
+			expErrMsg: "invalid type URL",
+		},
+		{
+			name: "duplicate type URL in allowed proposal msgs",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.AllowedProposalMsgs = []string{"/cosmos.gov.v1.MsgUpdateParams", "/cosmos.gov.v1.MsgUpdateParams"}
+
+				return state
+			},
+			expErrMsg: "duplicate type URL",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -760,15 +760,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	//
@@ -787,7 +787,7 @@ type Params struct {
 	//
 	// Since: cosmos-sdk 0.50
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -801,6 +801,10 @@ type Params struct {
 	//
 	// Since: cosmos-sdk 0.50
 	MinDepositRatio string `protobuf:"bytes,16,opt,name=min_deposit_ratio,json=minDepositRatio,proto3" json:"min_deposit_ratio,omitempty"`
+	// The address which will receive the deposits of a proposal when they are burned, as set by
+	// burn_vote_quorum, burn_proposal_deposit_prevote and burn_vote_veto.
+	// If empty, the deposits will be burned.
+	BurnDepositsDest string `protobuf:"bytes,17,opt,name=burn_deposits_dest,json=burnDepositsDest,proto3" json:"burn_deposits_dest,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -948,6 +952,13 @@ func (m *Params) GetMinDepositRatio() string {
 	return ""
 }
 
+func (m *Params) GetBurnDepositsDest() string {
+	if m != nil {
+		return m.BurnDepositsDest
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -965,97 +976,98 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
-	0x16, 0x36, 0x25, 0x59, 0x96, 0x8f, 0x1e, 0x56, 0xc6, 0x4e, 0x4c, 0x3b, 0xb1, 0xec, 0xe8, 0x06,
-	0x81, 0x6f, 0x1e, 0xd2, 0x75, 0x72, 0x73, 0x17, 0xc9, 0x05, 0x0a, 0xd9, 0x62, 0x1a, 0x1a, 0x89,
-	0xa5, 0x52, 0x8a, 0x9d, 0x74, 0x43, 0xd0, 0xe6, 0x44, 0x1e, 0x54, 0xe4, 0xa8, 0x9c, 0x91, 0x63,
-	0xed, 0xbb, 0xe9, 0xaa, 0x59, 0x76, 0x55, 0x74, 0xd9, 0x65, 0x17, 0x41, 0x7f, 0x43, 0x56, 0x45,
-	0x90, 0x4d, 0xbb, 0x69, 0x5a, 0x24, 0x8b, 0x02, 0xf9, 0x15, 0x05, 0x87, 0x43, 0x91, 0x96, 0xd5,
-	0xda, 0xce, 0xc6, 0x16, 0xcf, 0xf9, 0xbe, 0x6f, 0xce, 0x9c, 0xc7, 0x0c, 0x09, 0xf3, 0x7b, 0x94,
-	0x39, 0x94, 0x55, 0x3b, 0xf4, 0xa0, 0x7a, 0xb0, 0xe6, 0xff, 0xab, 0xf4, 0x3c, 0xca, 0x29, 0xca,
-	0x07, 0x8e, 0x8a, 0x6f, 0x39, 0x58, 0x5b, 0x2c, 0x49, 0xdc, 0xae, 0xc5, 0x70, 0xf5, 0x60, 0x6d,
-	0x17, 0x73, 0x6b, 0xad, 0xba, 0x47, 0x89, 0x1b, 0xc0, 0x17, 0xe7, 0x3a, 0xb4, 0x43, 0xc5, 0xcf,
-	0xaa, 0xff, 0x4b, 0x5a, 0x97, 0x3b, 0x94, 0x76, 0xba, 0xb8, 0x2a, 0x9e, 0x76, 0xfb, 0xcf, 0xaa,
-	0x9c, 0x38, 0x98, 0x71, 0xcb, 0xe9, 0x49, 0xc0, 0xc2, 0x28, 0xc0, 0x72, 0x07, 0xd2, 0x55, 0x1a,
-	0x75, 0xd9, 0x7d, 0xcf, 0xe2, 0x84, 0x86, 0x2b, 0x2e, 0x04, 0x11, 0x99, 0xc1, 0xa2, 0x32, 0xda,
-	0xc0, 0x75, 0xce, 0x72, 0x88, 0x4b, 0xab, 0xe2, 0x6f, 0x60, 0x2a, 0x53, 0x40, 0x3b, 0x98, 0x74,
-	0xf6, 0x39, 0xb6, 0xb7, 0x29, 0xc7, 0x8d, 0x9e, 0xaf, 0x84, 0xd6, 0x20, 0x4d, 0xc5, 0x2f, 0x55,
-	0x59, 0x51, 0x56, 0x0b, 0xb7, 0x16, 0x2a, 0x47, 0x76, 0x5d, 0x89, 0xa0, 0x86, 0x04, 0xa2, 0xab,
-	0x90, 0x7e, 0x2e, 0x84, 0xd4, 0xc4, 0x8a, 0xb2, 0x3a, 0xbd, 0x5e, 0x78, 0xf3, 0xf2, 0x26, 0x48,
-	0x56, 0x1d, 0xef, 0x19, 0xd2, 0x5b, 0xfe, 0x5e, 0x81, 0xa9, 0x3a, 0xee, 0x51, 0x46, 0x38, 0x5a,
-	0x86, 0x6c, 0xcf, 0xa3, 0x3d, 0xca, 0xac, 0xae, 0x49, 0x6c, 0xb1, 0x56, 0xca, 0x80, 0xd0, 0xa4,
-	0xdb, 0xe8, 0x7f, 0x30, 0x6d, 0x07, 0x58, 0xea, 0x49, 0x5d, 0xf5, 0xcd, 0xcb, 0x9b, 0x73, 0x52,
-	0xb7, 0x66, 0xdb, 0x1e, 0x66, 0xac, 0xc5, 0x3d, 0xe2, 0x76, 0x8c, 0x08, 0x8a, 0xfe, 0x0f, 0x69,
-	0xcb, 0xa1, 0x7d, 0x97, 0xab, 0xc9, 0x95, 0xe4, 0x6a, 0x36, 0x8a, 0xdf, 0x2f, 0x53, 0x45, 0x96,
-	0xa9, 0xb2, 0x41, 0x89, 0xbb, 0x3e, 0xfd, 0xea, 0xed, 0xf2, 0xc4, 0x0f, 0x7f, 0xfe, 0x78, 0x4d,
-	0x31, 0x24, 0xa7, 0xfc, 0x55, 0x1a, 0x32, 0x4d, 0x19, 0x04, 0x2a, 0x40, 0x62, 0x18, 0x5a, 0x82,
-	0xd8, 0xe8, 0x3f, 0x90, 0x71, 0x30, 0x63, 0x56, 0x07, 0x33, 0x35, 0x21, 0xc4, 0xe7, 0x2a, 0x41,
-	0x45, 0x2a, 0x61, 0x45, 0x2a, 0x35, 0x77, 0x60, 0x0c, 0x51, 0xe8, 0x0e, 0xa4, 0x19, 0xb7, 0x78,
-	0x9f, 0xa9, 0x49, 0x91, 0xcc, 0xa5, 0x91, 0x64, 0x86, 0x4b, 0xb5, 0x04, 0xc8, 0x90, 0x60, 0xf4,
-	0x00, 0xd0, 0x33, 0xe2, 0x5a, 0x5d, 0x93, 0x5b, 0xdd, 0xee, 0xc0, 0xf4, 0x30, 0xeb, 0x77, 0xb9,
-	0x9a, 0x5a, 0x51, 0x56, 0xb3, 0xb7, 0x16, 0x47, 0x24, 0xda, 0x3e, 0xc4, 0x10, 0x08, 0xa3, 0x28,
-	0x58, 0x31, 0x0b, 0xaa, 0x41, 0x96, 0xf5, 0x77, 0x1d, 0xc2, 0x4d, 0xbf, 0xcd, 0xd4, 0x49, 0x29,
-	0x31, 0x1a, 0x75, 0x3b, 0xec, 0xc1, 0xf5, 0xd4, 0x8b, 0xdf, 0x97, 0x15, 0x03, 0x02, 0x92, 0x6f,
-	0x46, 0x9b, 0x50, 0x94, 0xd9, 0x35, 0xb1, 0x6b, 0x07, 0x3a, 0xe9, 0x53, 0xea, 0x14, 0x24, 0x53,
-	0x73, 0x6d, 0xa1, 0xa5, 0x43, 0x9e, 0x53, 0x6e, 0x75, 0x4d, 0x69, 0x57, 0xa7, 0xce, 0x50, 0xa3,
-	0x9c, 0xa0, 0x86, 0x0d, 0xf4, 0x10, 0xce, 0x1d, 0x50, 0x4e, 0xdc, 0x8e, 0xc9, 0xb8, 0xe5, 0xc9,
-	0xfd, 0x65, 0x4e, 0x19, 0xd7, 0x4c, 0x40, 0x6d, 0xf9, 0x4c, 0x11, 0xd8, 0x03, 0x90, 0xa6, 0x68,
-	0x8f, 0xd3, 0xa7, 0xd4, 0xca, 0x07, 0xc4, 0x70, 0x8b, 0x8b, 0x7e, 0x93, 0x70, 0xcb, 0xb6, 0xb8,
-	0xa5, 0x82, 0xdf, 0xb6, 0xc6, 0xf0, 0x19, 0xcd, 0xc1, 0x24, 0x27, 0xbc, 0x8b, 0xd5, 0xac, 0x70,
-	0x04, 0x0f, 0x48, 0x85, 0x29, 0xd6, 0x77, 0x1c, 0xcb, 0x1b, 0xa8, 0x39, 0x61, 0x0f, 0x1f, 0xd1,
-	0x7f, 0x21, 0x13, 0x4c, 0x04, 0xf6, 0xd4, 0xfc, 0x09, 0x23, 0x30, 0x44, 0xa2, 0x4b, 0x30, 0x8d,
-	0x0f, 0x7b, 0xd8, 0x26, 0x1c, 0xdb, 0x6a, 0x61, 0x45, 0x59, 0xcd, 0x18, 0x91, 0x01, 0xfd, 0x0b,
-	0xf2, 0xcf, 0x2c, 0xd2, 0xc5, 0xb6, 0xe9, 0x61, 0x8b, 0x51, 0x57, 0x9d, 0x11, 0x6b, 0xe6, 0x02,
-	0xa3, 0x21, 0x6c, 0xe5, 0x5f, 0x14, 0xc8, 0xc6, 0xdb, 0xe8, 0x3a, 0x4c, 0x0f, 0x30, 0x33, 0xf7,
-	0xc4, 0x5c, 0x29, 0xc7, 0x86, 0x5c, 0x77, 0xb9, 0x91, 0x19, 0x60, 0xb6, 0xe1, 0xfb, 0xd1, 0x6d,
-	0xc8, 0x5b, 0xbb, 0x8c, 0x5b, 0xc4, 0x95, 0x84, 0xc4, 0x58, 0x42, 0x4e, 0x82, 0x02, 0xd2, 0xbf,
-	0x21, 0xe3, 0x52, 0x89, 0x4f, 0x8e, 0xc5, 0x4f, 0xb9, 0x34, 0x80, 0xde, 0x03, 0xe4, 0x52, 0xf3,
-	0x39, 0xe1, 0xfb, 0xe6, 0x01, 0xe6, 0x21, 0x29, 0x35, 0x96, 0x34, 0xe3, 0xd2, 0x1d, 0xc2, 0xf7,
-	0xb7, 0x31, 0x0f, 0xc8, 0xe5, 0x9f, 0x14, 0x48, 0xf9, 0x47, 0xd8, 0xc9, 0x07, 0x50, 0x05, 0x26,
-	0x0f, 0x28, 0xc7, 0x27, 0x1f, 0x3e, 0x01, 0x0c, 0xdd, 0x83, 0xa9, 0xe0, 0x3c, 0x64, 0x6a, 0x4a,
-	0x74, 0xf5, 0xe5, 0x91, 0x49, 0x3d, 0x7e, 0xd8, 0x1a, 0x21, 0xe3, 0x48, 0xd7, 0x4c, 0x1e, 0xed,
-	0x9a, 0xcd, 0x54, 0x26, 0x59, 0x4c, 0x95, 0x7f, 0x53, 0x20, 0x2f, 0x7b, 0xbf, 0x69, 0x79, 0x96,
-	0xc3, 0xd0, 0x53, 0xc8, 0x3a, 0xc4, 0x1d, 0x8e, 0x92, 0x72, 0xd2, 0x28, 0x2d, 0xf9, 0xa3, 0xf4,
-	0xe1, 0xed, 0xf2, 0xf9, 0x18, 0xeb, 0x06, 0x75, 0x08, 0xc7, 0x4e, 0x8f, 0x0f, 0x0c, 0x70, 0x88,
-	0x1b, 0x0e, 0x97, 0x03, 0xc8, 0xb1, 0x0e, 0x43, 0x90, 0xd9, 0xc3, 0x1e, 0xa1, 0xb6, 0x48, 0x84,
-	0xbf, 0xc2, 0xe8, 0x44, 0xd4, 0xe5, 0x2d, 0xb4, 0x7e, 0xe5, 0xc3, 0xdb, 0xe5, 0x4b, 0xc7, 0x89,
-	0xd1, 0x22, 0xdf, 0xfa, 0x03, 0x53, 0x74, 0xac, 0xc3, 0x70, 0x27, 0xc2, 0x7f, 0x37, 0xa1, 0x2a,
-	0xe5, 0x27, 0x90, 0xdb, 0x16, 0x83, 0x24, 0x77, 0x57, 0x07, 0x39, 0x58, 0xe1, 0xea, 0xca, 0x49,
-	0xab, 0xa7, 0x84, 0x7a, 0x2e, 0x60, 0xc5, 0x94, 0xbf, 0x0b, 0x9b, 0x59, 0x2a, 0x5f, 0x85, 0xf4,
-	0x97, 0x7d, 0xea, 0xf5, 0x9d, 0x31, 0x9d, 0x2c, 0xae, 0xab, 0xc0, 0x8b, 0x6e, 0xc0, 0x34, 0xdf,
-	0xf7, 0x30, 0xdb, 0xa7, 0x5d, 0xfb, 0x6f, 0x6e, 0xb6, 0x08, 0x80, 0xee, 0x40, 0x41, 0x74, 0x63,
-	0x44, 0x49, 0x8e, 0xa5, 0xe4, 0x7d, 0x54, 0x3b, 0x04, 0x89, 0x00, 0xbf, 0xc9, 0x40, 0x5a, 0xc6,
-	0xa6, 0x9d, 0xb1, 0xa6, 0xb1, 0xe3, 0x31, 0x5e, 0xbf, 0x47, 0x1f, 0x57, 0xbf, 0xd4, 0xf8, 0xfa,
-	0x1c, 0xaf, 0x45, 0xf2, 0x23, 0x6a, 0x11, 0xcb, 0x7b, 0xea, 0xf4, 0x79, 0x9f, 0x3c, 0x7b, 0xde,
-	0xd3, 0xa7, 0xc8, 0x3b, 0xd2, 0x61, 0xc1, 0x4f, 0x34, 0x71, 0x09, 0x27, 0xd1, 0x7d, 0x64, 0x8a,
-	0xf0, 0xd5, 0xa9, 0xb1, 0x0a, 0x17, 0x1c, 0xe2, 0xea, 0x01, 0x5e, 0xa6, 0xc7, 0xf0, 0xd1, 0x68,
-	0x1d, 0xce, 0x0f, 0x4f, 0x92, 0x3d, 0xcb, 0xdd, 0xc3, 0x5d, 0x29, 0x93, 0x19, 0x2b, 0x33, 0x1b,
-	0x82, 0x37, 0x04, 0x36, 0xd0, 0xd8, 0x84, 0xb9, 0x51, 0x0d, 0x1b, 0x33, 0x2e, 0x2e, 0xa1, 0x7f,
-	0x3a, 0x7b, 0xd0, 0x51, 0xb1, 0x3a, 0x66, 0x1c, 0xed, 0xc0, 0xfc, 0xf0, 0xb8, 0x37, 0x8f, 0xd6,
-	0x0d, 0x4e, 0x57, 0xb7, 0xf3, 0x43, 0xfe, 0x76, 0xbc, 0x80, 0x9f, 0xc0, 0x6c, 0x24, 0x1c, 0xe5,
-	0x3b, 0x3b, 0x76, 0x9b, 0x68, 0x08, 0x8d, 0x92, 0xfe, 0x04, 0x22, 0x65, 0x33, 0xde, 0xe7, 0xb9,
-	0x33, 0xf4, 0x79, 0x14, 0xc3, 0xa3, 0xa8, 0xe1, 0x57, 0xa1, 0xb8, 0xdb, 0xf7, 0x5c, 0x7f, 0xbb,
-	0xd8, 0x94, 0x5d, 0x96, 0x17, 0x57, 0x5f, 0xc1, 0xb7, 0xfb, 0x47, 0xee, 0x67, 0x41, 0x77, 0xd5,
-	0x60, 0x49, 0x20, 0x87, 0xe9, 0x1e, 0x0e, 0x89, 0x87, 0x7d, 0xb6, 0xbc, 0x31, 0x17, 0x7d, 0x50,
-	0xf8, 0x7a, 0x16, 0x4e, 0x43, 0x80, 0x40, 0x57, 0xa0, 0x10, 0x2d, 0xe6, 0xb7, 0x95, 0xb8, 0x43,
-	0x33, 0x46, 0x2e, 0x5c, 0xca, 0xbf, 0x6e, 0xd0, 0x5d, 0x38, 0x17, 0xdb, 0xa2, 0x6c, 0x89, 0xe2,
-	0xd8, 0x5c, 0xcd, 0x44, 0xa3, 0x2b, 0xda, 0xe1, 0xda, 0xd7, 0x0a, 0x40, 0xec, 0x9d, 0xfc, 0x22,
-	0xcc, 0x6f, 0x37, 0xda, 0x9a, 0xd9, 0x68, 0xb6, 0xf5, 0xc6, 0x96, 0xf9, 0x78, 0xab, 0xd5, 0xd4,
-	0x36, 0xf4, 0xfb, 0xba, 0x56, 0x2f, 0x4e, 0xa0, 0x59, 0x98, 0x89, 0x3b, 0x9f, 0x6a, 0xad, 0xa2,
-	0x82, 0xe6, 0x61, 0x36, 0x6e, 0xac, 0xad, 0xb7, 0xda, 0x35, 0x7d, 0xab, 0x98, 0x40, 0x08, 0x0a,
-	0x71, 0xc7, 0x56, 0xa3, 0x98, 0x44, 0x97, 0x40, 0x3d, 0x6a, 0x33, 0x77, 0xf4, 0xf6, 0x03, 0x73,
-	0x5b, 0x6b, 0x37, 0x8a, 0xa9, 0x6b, 0x3f, 0x2b, 0x50, 0x38, 0xfa, 0x9e, 0x8a, 0x96, 0xe1, 0x62,
-	0xd3, 0x68, 0x34, 0x1b, 0xad, 0xda, 0x43, 0xb3, 0xd5, 0xae, 0xb5, 0x1f, 0xb7, 0x46, 0x62, 0x2a,
-	0x43, 0x69, 0x14, 0x50, 0xd7, 0x9a, 0x8d, 0x96, 0xde, 0x36, 0x9b, 0x9a, 0xa1, 0x37, 0xea, 0x45,
-	0x05, 0x5d, 0x86, 0xa5, 0x51, 0xcc, 0x76, 0xa3, 0xad, 0x6f, 0x7d, 0x1a, 0x42, 0x12, 0x68, 0x11,
-	0x2e, 0x8c, 0x42, 0x9a, 0xb5, 0x56, 0x4b, 0xab, 0x07, 0x41, 0x8f, 0xfa, 0x0c, 0x6d, 0x53, 0xdb,
-	0x68, 0x6b, 0xf5, 0x62, 0x6a, 0x1c, 0xf3, 0x7e, 0x4d, 0x7f, 0xa8, 0xd5, 0x8b, 0x93, 0xeb, 0xda,
-	0xab, 0x77, 0x25, 0xe5, 0xf5, 0xbb, 0x92, 0xf2, 0xc7, 0xbb, 0x92, 0xf2, 0xe2, 0x7d, 0x69, 0xe2,
-	0xf5, 0xfb, 0xd2, 0xc4, 0xaf, 0xef, 0x4b, 0x13, 0x9f, 0x5f, 0xef, 0x10, 0xbe, 0xdf, 0xdf, 0xad,
-	0xec, 0x51, 0x47, 0x7e, 0x3d, 0xc9, 0x7f, 0x37, 0x99, 0xfd, 0x45, 0xf5, 0x50, 0x7c, 0x11, 0xf2,
-	0x41, 0x0f, 0x33, 0xff, 0x73, 0x2f, 0x2d, 0xa6, 0xe7, 0xf6, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x08, 0xe1, 0xbb, 0x1f, 0x2f, 0x0e, 0x00, 0x00,
+	// 1443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x73, 0xd3, 0x46,
+	0x14, 0x8e, 0x6c, 0xc7, 0xb1, 0x9f, 0x7f, 0xc4, 0xd9, 0x04, 0xa2, 0x04, 0xe2, 0x04, 0x97, 0x61,
+	0x52, 0x7e, 0xd8, 0x0d, 0x94, 0x1e, 0xa0, 0x33, 0x1d, 0x27, 0x16, 0xc5, 0x19, 0x88, 0x5d, 0xd9,
+	0x24, 0xd0, 0x8b, 0x46, 0x89, 0x16, 0x47, 0x53, 0x4b, 0xeb, 0x6a, 0xd7, 0x21, 0xbe, 0xf7, 0xd2,
+	0x1b, 0xc7, 0x9e, 0x3a, 0x3d, 0xf6, 0xd8, 0x03, 0xd3, 0xbf, 0x81, 0x53, 0x87, 0xe1, 0x42, 0x2f,
+	0xa5, 0x1d, 0x38, 0x74, 0x86, 0xbf, 0xa2, 0xa3, 0xdd, 0x95, 0xa5, 0x38, 0xee, 0x24, 0xe1, 0x92,
+	0x58, 0x6f, 0xbf, 0xef, 0xbd, 0xb7, 0xef, 0x7d, 0x6f, 0x57, 0x82, 0xf9, 0x3d, 0x42, 0x1d, 0x42,
+	0x2b, 0x1d, 0x72, 0x50, 0x39, 0x58, 0xf3, 0xff, 0x95, 0x7b, 0x1e, 0x61, 0x04, 0xe5, 0xc4, 0x42,
+	0xd9, 0xb7, 0x1c, 0xac, 0x2d, 0x16, 0x25, 0x6e, 0xd7, 0xa4, 0xb8, 0x72, 0xb0, 0xb6, 0x8b, 0x99,
+	0xb9, 0x56, 0xd9, 0x23, 0xb6, 0x2b, 0xe0, 0x8b, 0x73, 0x1d, 0xd2, 0x21, 0xfc, 0x67, 0xc5, 0xff,
+	0x25, 0xad, 0xcb, 0x1d, 0x42, 0x3a, 0x5d, 0x5c, 0xe1, 0x4f, 0xbb, 0xfd, 0xa7, 0x15, 0x66, 0x3b,
+	0x98, 0x32, 0xd3, 0xe9, 0x49, 0xc0, 0xc2, 0x28, 0xc0, 0x74, 0x07, 0x72, 0xa9, 0x38, 0xba, 0x64,
+	0xf5, 0x3d, 0x93, 0xd9, 0x24, 0x88, 0xb8, 0x20, 0x32, 0x32, 0x44, 0x50, 0x99, 0xad, 0x58, 0x9a,
+	0x31, 0x1d, 0xdb, 0x25, 0x15, 0xfe, 0x57, 0x98, 0x4a, 0x04, 0xd0, 0x0e, 0xb6, 0x3b, 0xfb, 0x0c,
+	0x5b, 0xdb, 0x84, 0xe1, 0x46, 0xcf, 0xf7, 0x84, 0xd6, 0x20, 0x49, 0xf8, 0x2f, 0x55, 0x59, 0x51,
+	0x56, 0xf3, 0x37, 0x17, 0xca, 0x47, 0x76, 0x5d, 0x0e, 0xa1, 0xba, 0x04, 0xa2, 0x2b, 0x90, 0x7c,
+	0xc6, 0x1d, 0xa9, 0xb1, 0x15, 0x65, 0x35, 0xbd, 0x9e, 0x7f, 0xfd, 0xe2, 0x06, 0x48, 0x56, 0x0d,
+	0xef, 0xe9, 0x72, 0xb5, 0xf4, 0x8b, 0x02, 0x53, 0x35, 0xdc, 0x23, 0xd4, 0x66, 0x68, 0x19, 0x32,
+	0x3d, 0x8f, 0xf4, 0x08, 0x35, 0xbb, 0x86, 0x6d, 0xf1, 0x58, 0x09, 0x1d, 0x02, 0x53, 0xdd, 0x42,
+	0x5f, 0x40, 0xda, 0x12, 0x58, 0xe2, 0x49, 0xbf, 0xea, 0xeb, 0x17, 0x37, 0xe6, 0xa4, 0xdf, 0xaa,
+	0x65, 0x79, 0x98, 0xd2, 0x16, 0xf3, 0x6c, 0xb7, 0xa3, 0x87, 0x50, 0xf4, 0x25, 0x24, 0x4d, 0x87,
+	0xf4, 0x5d, 0xa6, 0xc6, 0x57, 0xe2, 0xab, 0x99, 0x30, 0x7f, 0xbf, 0x4d, 0x65, 0xd9, 0xa6, 0xf2,
+	0x06, 0xb1, 0xdd, 0xf5, 0xf4, 0xcb, 0xb7, 0xcb, 0x13, 0xbf, 0xfe, 0xfb, 0xdb, 0x55, 0x45, 0x97,
+	0x9c, 0xd2, 0x0f, 0x49, 0x48, 0x35, 0x65, 0x12, 0x28, 0x0f, 0xb1, 0x61, 0x6a, 0x31, 0xdb, 0x42,
+	0x9f, 0x41, 0xca, 0xc1, 0x94, 0x9a, 0x1d, 0x4c, 0xd5, 0x18, 0x77, 0x3e, 0x57, 0x16, 0x1d, 0x29,
+	0x07, 0x1d, 0x29, 0x57, 0xdd, 0x81, 0x3e, 0x44, 0xa1, 0xdb, 0x90, 0xa4, 0xcc, 0x64, 0x7d, 0xaa,
+	0xc6, 0x79, 0x31, 0x97, 0x46, 0x8a, 0x19, 0x84, 0x6a, 0x71, 0x90, 0x2e, 0xc1, 0xe8, 0x3e, 0xa0,
+	0xa7, 0xb6, 0x6b, 0x76, 0x0d, 0x66, 0x76, 0xbb, 0x03, 0xc3, 0xc3, 0xb4, 0xdf, 0x65, 0x6a, 0x62,
+	0x45, 0x59, 0xcd, 0xdc, 0x5c, 0x1c, 0x71, 0xd1, 0xf6, 0x21, 0x3a, 0x47, 0xe8, 0x05, 0xce, 0x8a,
+	0x58, 0x50, 0x15, 0x32, 0xb4, 0xbf, 0xeb, 0xd8, 0xcc, 0xf0, 0x65, 0xa6, 0x4e, 0x4a, 0x17, 0xa3,
+	0x59, 0xb7, 0x03, 0x0d, 0xae, 0x27, 0x9e, 0xff, 0xbd, 0xac, 0xe8, 0x20, 0x48, 0xbe, 0x19, 0x6d,
+	0x42, 0x41, 0x56, 0xd7, 0xc0, 0xae, 0x25, 0xfc, 0x24, 0x4f, 0xe9, 0x27, 0x2f, 0x99, 0x9a, 0x6b,
+	0x71, 0x5f, 0x75, 0xc8, 0x31, 0xc2, 0xcc, 0xae, 0x21, 0xed, 0xea, 0xd4, 0x19, 0x7a, 0x94, 0xe5,
+	0xd4, 0x40, 0x40, 0x0f, 0x60, 0xe6, 0x80, 0x30, 0xdb, 0xed, 0x18, 0x94, 0x99, 0x9e, 0xdc, 0x5f,
+	0xea, 0x94, 0x79, 0x4d, 0x0b, 0x6a, 0xcb, 0x67, 0xf2, 0xc4, 0xee, 0x83, 0x34, 0x85, 0x7b, 0x4c,
+	0x9f, 0xd2, 0x57, 0x4e, 0x10, 0x83, 0x2d, 0x2e, 0xfa, 0x22, 0x61, 0xa6, 0x65, 0x32, 0x53, 0x05,
+	0x5f, 0xb6, 0xfa, 0xf0, 0x19, 0xcd, 0xc1, 0x24, 0xb3, 0x59, 0x17, 0xab, 0x19, 0xbe, 0x20, 0x1e,
+	0x90, 0x0a, 0x53, 0xb4, 0xef, 0x38, 0xa6, 0x37, 0x50, 0xb3, 0xdc, 0x1e, 0x3c, 0xa2, 0xcf, 0x21,
+	0x25, 0x26, 0x02, 0x7b, 0x6a, 0xee, 0x84, 0x11, 0x18, 0x22, 0xd1, 0x45, 0x48, 0xe3, 0xc3, 0x1e,
+	0xb6, 0x6c, 0x86, 0x2d, 0x35, 0xbf, 0xa2, 0xac, 0xa6, 0xf4, 0xd0, 0x80, 0x3e, 0x81, 0xdc, 0x53,
+	0xd3, 0xee, 0x62, 0xcb, 0xf0, 0xb0, 0x49, 0x89, 0xab, 0x4e, 0xf3, 0x98, 0x59, 0x61, 0xd4, 0xb9,
+	0xad, 0xf4, 0x46, 0x81, 0x4c, 0x54, 0x46, 0xd7, 0x20, 0x3d, 0xc0, 0xd4, 0xd8, 0xe3, 0x73, 0xa5,
+	0x1c, 0x1b, 0xf2, 0xba, 0xcb, 0xf4, 0xd4, 0x00, 0xd3, 0x0d, 0x7f, 0x1d, 0xdd, 0x82, 0x9c, 0xb9,
+	0x4b, 0x99, 0x69, 0xbb, 0x92, 0x10, 0x1b, 0x4b, 0xc8, 0x4a, 0x90, 0x20, 0x7d, 0x0a, 0x29, 0x97,
+	0x48, 0x7c, 0x7c, 0x2c, 0x7e, 0xca, 0x25, 0x02, 0x7a, 0x17, 0x90, 0x4b, 0x8c, 0x67, 0x36, 0xdb,
+	0x37, 0x0e, 0x30, 0x0b, 0x48, 0x89, 0xb1, 0xa4, 0x69, 0x97, 0xec, 0xd8, 0x6c, 0x7f, 0x1b, 0x33,
+	0x41, 0x2e, 0xfd, 0xae, 0x40, 0xc2, 0x3f, 0xc2, 0x4e, 0x3e, 0x80, 0xca, 0x30, 0x79, 0x40, 0x18,
+	0x3e, 0xf9, 0xf0, 0x11, 0x30, 0x74, 0x17, 0xa6, 0xc4, 0x79, 0x48, 0xd5, 0x04, 0x57, 0xf5, 0xa5,
+	0x91, 0x49, 0x3d, 0x7e, 0xd8, 0xea, 0x01, 0xe3, 0x88, 0x6a, 0x26, 0x8f, 0xaa, 0x66, 0x33, 0x91,
+	0x8a, 0x17, 0x12, 0xa5, 0xbf, 0x14, 0xc8, 0x49, 0xed, 0x37, 0x4d, 0xcf, 0x74, 0x28, 0x7a, 0x02,
+	0x19, 0xc7, 0x76, 0x87, 0xa3, 0xa4, 0x9c, 0x34, 0x4a, 0x4b, 0xfe, 0x28, 0x7d, 0x78, 0xbb, 0x7c,
+	0x2e, 0xc2, 0xba, 0x4e, 0x1c, 0x9b, 0x61, 0xa7, 0xc7, 0x06, 0x3a, 0x38, 0xb6, 0x1b, 0x0c, 0x97,
+	0x03, 0xc8, 0x31, 0x0f, 0x03, 0x90, 0xd1, 0xc3, 0x9e, 0x4d, 0x2c, 0x5e, 0x08, 0x3f, 0xc2, 0xe8,
+	0x44, 0xd4, 0xe4, 0x2d, 0xb4, 0x7e, 0xf9, 0xc3, 0xdb, 0xe5, 0x8b, 0xc7, 0x89, 0x61, 0x90, 0x9f,
+	0xfc, 0x81, 0x29, 0x38, 0xe6, 0x61, 0xb0, 0x13, 0xbe, 0x7e, 0x27, 0xa6, 0x2a, 0xa5, 0xc7, 0x90,
+	0xdd, 0xe6, 0x83, 0x24, 0x77, 0x57, 0x03, 0x39, 0x58, 0x41, 0x74, 0xe5, 0xa4, 0xe8, 0x09, 0xee,
+	0x3d, 0x2b, 0x58, 0x11, 0xcf, 0x3f, 0x07, 0x62, 0x96, 0x9e, 0xaf, 0x40, 0xf2, 0xfb, 0x3e, 0xf1,
+	0xfa, 0x8e, 0xaa, 0x8c, 0xbf, 0xae, 0xc4, 0x2a, 0xba, 0x0e, 0x69, 0xb6, 0xef, 0x61, 0xba, 0x4f,
+	0xba, 0xd6, 0xff, 0xdc, 0x6c, 0x21, 0x00, 0xdd, 0x86, 0x3c, 0x57, 0x63, 0x48, 0x89, 0x8f, 0xa5,
+	0xe4, 0x7c, 0x54, 0x3b, 0x00, 0xf1, 0x04, 0xdf, 0xa4, 0x20, 0x29, 0x73, 0xd3, 0xce, 0xd8, 0xd3,
+	0xc8, 0xf1, 0x18, 0xed, 0xdf, 0xc3, 0x8f, 0xeb, 0x5f, 0x62, 0x7c, 0x7f, 0x8e, 0xf7, 0x22, 0xfe,
+	0x11, 0xbd, 0x88, 0xd4, 0x3d, 0x71, 0xfa, 0xba, 0x4f, 0x9e, 0xbd, 0xee, 0xc9, 0x53, 0xd4, 0x1d,
+	0xd5, 0x61, 0xc1, 0x2f, 0xb4, 0xed, 0xda, 0xcc, 0x0e, 0xef, 0x23, 0x83, 0xa7, 0xaf, 0x4e, 0x8d,
+	0xf5, 0x70, 0xde, 0xb1, 0xdd, 0xba, 0xc0, 0xcb, 0xf2, 0xe8, 0x3e, 0x1a, 0xad, 0xc3, 0xb9, 0xe1,
+	0x49, 0xb2, 0x67, 0xba, 0x7b, 0xb8, 0x2b, 0xdd, 0xa4, 0xc6, 0xba, 0x99, 0x0d, 0xc0, 0x1b, 0x1c,
+	0x2b, 0x7c, 0x6c, 0xc2, 0xdc, 0xa8, 0x0f, 0x0b, 0x53, 0xa6, 0xa6, 0x4f, 0x38, 0x7b, 0xd0, 0x51,
+	0x67, 0x35, 0x4c, 0x19, 0xda, 0x81, 0xf9, 0xe1, 0x71, 0x6f, 0x1c, 0xed, 0x1b, 0x9c, 0xae, 0x6f,
+	0xe7, 0x86, 0xfc, 0xed, 0x68, 0x03, 0xbf, 0x82, 0xd9, 0xd0, 0x71, 0x58, 0xef, 0xcc, 0xd8, 0x6d,
+	0xa2, 0x21, 0x34, 0x2c, 0xfa, 0x63, 0x08, 0x3d, 0x1b, 0x51, 0x9d, 0x67, 0xcf, 0xa0, 0xf3, 0x30,
+	0x87, 0x87, 0xa1, 0xe0, 0x57, 0xa1, 0xb0, 0xdb, 0xf7, 0x5c, 0x7f, 0xbb, 0xd8, 0x90, 0x2a, 0xcb,
+	0xf1, 0xab, 0x2f, 0xef, 0xdb, 0xfd, 0x23, 0xf7, 0x1b, 0xa1, 0xae, 0x2a, 0x2c, 0x71, 0xe4, 0xb0,
+	0xdc, 0xc3, 0x21, 0xf1, 0xb0, 0xcf, 0x96, 0x37, 0xe6, 0xa2, 0x0f, 0x0a, 0x5e, 0xcf, 0x82, 0x69,
+	0x10, 0x08, 0x74, 0x19, 0xf2, 0x61, 0x30, 0x5f, 0x56, 0xfc, 0x0e, 0x4d, 0xe9, 0xd9, 0x20, 0x94,
+	0x7f, 0xdd, 0xa0, 0x3b, 0x30, 0x13, 0xd9, 0xa2, 0x94, 0x44, 0x61, 0x6c, 0xad, 0xa6, 0xc3, 0xd1,
+	0x15, 0x72, 0xb8, 0x07, 0x88, 0x47, 0x90, 0x64, 0x2a, 0xc4, 0x30, 0x73, 0x82, 0x18, 0x78, 0x09,
+	0xa4, 0x1f, 0xea, 0x4b, 0xe1, 0xea, 0x8f, 0x0a, 0x40, 0xe4, 0xdd, 0xfe, 0x02, 0xcc, 0x6f, 0x37,
+	0xda, 0x9a, 0xd1, 0x68, 0xb6, 0xeb, 0x8d, 0x2d, 0xe3, 0xd1, 0x56, 0xab, 0xa9, 0x6d, 0xd4, 0xef,
+	0xd5, 0xb5, 0x5a, 0x61, 0x02, 0xcd, 0xc2, 0x74, 0x74, 0xf1, 0x89, 0xd6, 0x2a, 0x28, 0x68, 0x1e,
+	0x66, 0xa3, 0xc6, 0xea, 0x7a, 0xab, 0x5d, 0xad, 0x6f, 0x15, 0x62, 0x08, 0x41, 0x3e, 0xba, 0xb0,
+	0xd5, 0x28, 0xc4, 0xd1, 0x45, 0x50, 0x8f, 0xda, 0x8c, 0x9d, 0x7a, 0xfb, 0xbe, 0xb1, 0xad, 0xb5,
+	0x1b, 0x85, 0xc4, 0xd5, 0x3f, 0x14, 0xc8, 0x1f, 0x7d, 0xdf, 0x45, 0xcb, 0x70, 0xa1, 0xa9, 0x37,
+	0x9a, 0x8d, 0x56, 0xf5, 0x81, 0xd1, 0x6a, 0x57, 0xdb, 0x8f, 0x5a, 0x23, 0x39, 0x95, 0xa0, 0x38,
+	0x0a, 0xa8, 0x69, 0xcd, 0x46, 0xab, 0xde, 0x36, 0x9a, 0x9a, 0x5e, 0x6f, 0xd4, 0x0a, 0x0a, 0xba,
+	0x04, 0x4b, 0xa3, 0x98, 0xed, 0x46, 0xbb, 0xbe, 0xf5, 0x75, 0x00, 0x89, 0xa1, 0x45, 0x38, 0x3f,
+	0x0a, 0x69, 0x56, 0x5b, 0x2d, 0xad, 0x26, 0x92, 0x1e, 0x5d, 0xd3, 0xb5, 0x4d, 0x6d, 0xa3, 0xad,
+	0xd5, 0x0a, 0x89, 0x71, 0xcc, 0x7b, 0xd5, 0xfa, 0x03, 0xad, 0x56, 0x98, 0x5c, 0xd7, 0x5e, 0xbe,
+	0x2b, 0x2a, 0xaf, 0xde, 0x15, 0x95, 0x7f, 0xde, 0x15, 0x95, 0xe7, 0xef, 0x8b, 0x13, 0xaf, 0xde,
+	0x17, 0x27, 0xfe, 0x7c, 0x5f, 0x9c, 0xf8, 0xf6, 0x5a, 0xc7, 0x66, 0xfb, 0xfd, 0xdd, 0xf2, 0x1e,
+	0x71, 0xe4, 0x57, 0x98, 0xfc, 0x77, 0x83, 0x5a, 0xdf, 0x55, 0x0e, 0xf9, 0x97, 0x25, 0x1b, 0xf4,
+	0x30, 0xf5, 0x3f, 0x1b, 0x93, 0x7c, 0x0a, 0x6f, 0xfd, 0x37, 0x00, 0x4e, 0xd0, 0xe0, 0xc6, 0x77,
+	0x0e, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1551,6 +1563,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BurnDepositsDest) > 0 {
+		i -= len(m.BurnDepositsDest)
+		copy(dAtA[i:], m.BurnDepositsDest)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.BurnDepositsDest)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if len(m.MinDepositRatio) > 0 {
 		i -= len(m.MinDepositRatio)
 		copy(dAtA[i:], m.MinDepositRatio)
@@ -1994,6 +2015,10 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
 	}
+	l = len(m.BurnDepositsDest)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -3973,6 +3998,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.MinDepositRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDepositsDest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnDepositsDest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -34,6 +34,7 @@ var (
 	DefaultBurnVoteQuorom            = false // set to false to  replicate behavior of when this change was made (0.47)
 	DefaultBurnVoteVeto              = true  // set to true to replicate behavior of when this change was made (0.47)
 	DefaultMinDepositRatio           = sdkmath.LegacyMustNewDecFromStr("0.01")
+	DefaultBurnDepositsDestAddress   = ""
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -88,7 +89,7 @@ func NewParams(
 
 // DefaultParams returns the default governance params
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		sdk.NewCoins(sdk.NewCoin(authtypes.FeeToken, DefaultMinDepositTokens)), // HV2: defined in heimdall
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
 		DefaultPeriod,
@@ -106,6 +107,9 @@ func DefaultParams() Params {
 		DefaultBurnVoteVeto,
 		DefaultMinDepositRatio.String(),
 	)
+	params.BurnDepositsDest = DefaultBurnDepositsDestAddress
+
+	return params
 }
 
 // ValidateBasic performs basic validation on governance parameters.
@@ -222,5 +226,12 @@ func (p Params) ValidateBasic() error {
 		}
 	}
 
+	if len(p.BurnDepositsDest) != 0 {
+		_, err := sdk.AccAddressFromHex(p.BurnDepositsDest)
+		if err != nil {
+			return fmt.Errorf("burned deposits destination address is invalid: %s", p.BurnDepositsDest)
+		}
+	}
+
 	return nil
 }
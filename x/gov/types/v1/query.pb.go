@@ -672,6 +672,89 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryAllowedProposalMsgsRequest is the request type for the Query/AllowedProposalMsgs RPC method.
+type QueryAllowedProposalMsgsRequest struct {
+}
+
+func (m *QueryAllowedProposalMsgsRequest) Reset()         { *m = QueryAllowedProposalMsgsRequest{} }
+func (m *QueryAllowedProposalMsgsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedProposalMsgsRequest) ProtoMessage()    {}
+func (*QueryAllowedProposalMsgsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{12}
+}
+func (m *QueryAllowedProposalMsgsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedProposalMsgsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedProposalMsgsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedProposalMsgsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedProposalMsgsRequest.Merge(m, src)
+}
+func (m *QueryAllowedProposalMsgsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedProposalMsgsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedProposalMsgsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedProposalMsgsRequest proto.InternalMessageInfo
+
+// QueryAllowedProposalMsgsResponse is the response type for the Query/AllowedProposalMsgs RPC method.
+type QueryAllowedProposalMsgsResponse struct {
+	// type_urls defines the type URLs of the messages and legacy contents allowed in a proposal.
+	TypeUrls []string `protobuf:"bytes,1,rep,name=type_urls,json=typeUrls,proto3" json:"type_urls,omitempty"`
+}
+
+func (m *QueryAllowedProposalMsgsResponse) Reset()         { *m = QueryAllowedProposalMsgsResponse{} }
+func (m *QueryAllowedProposalMsgsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedProposalMsgsResponse) ProtoMessage()    {}
+func (*QueryAllowedProposalMsgsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{13}
+}
+func (m *QueryAllowedProposalMsgsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedProposalMsgsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedProposalMsgsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedProposalMsgsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedProposalMsgsResponse.Merge(m, src)
+}
+func (m *QueryAllowedProposalMsgsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedProposalMsgsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedProposalMsgsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedProposalMsgsResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedProposalMsgsResponse) GetTypeUrls() []string {
+	if m != nil {
+		return m.TypeUrls
+	}
+	return nil
+}
+
 // QueryDepositRequest is the request type for the Query/Deposit RPC method.
 type QueryDepositRequest struct {
 	// proposal_id defines the unique id of the proposal.
@@ -684,7 +767,7 @@ func (m *QueryDepositRequest) Reset()         { *m = QueryDepositRequest{} }
 func (m *QueryDepositRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositRequest) ProtoMessage()    {}
 func (*QueryDepositRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{12}
+	return fileDescriptor_46a436d1109b50d0, []int{14}
 }
 func (m *QueryDepositRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,7 +820,7 @@ func (m *QueryDepositResponse) Reset()         { *m = QueryDepositResponse{} }
 func (m *QueryDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositResponse) ProtoMessage()    {}
 func (*QueryDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{13}
+	return fileDescriptor_46a436d1109b50d0, []int{15}
 }
 func (m *QueryDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,7 +868,7 @@ func (m *QueryDepositsRequest) Reset()         { *m = QueryDepositsRequest{} }
 func (m *QueryDepositsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsRequest) ProtoMessage()    {}
 func (*QueryDepositsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{14}
+	return fileDescriptor_46a436d1109b50d0, []int{16}
 }
 func (m *QueryDepositsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,7 +923,7 @@ func (m *QueryDepositsResponse) Reset()         { *m = QueryDepositsResponse{} }
 func (m *QueryDepositsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsResponse) ProtoMessage()    {}
 func (*QueryDepositsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{15}
+	return fileDescriptor_46a436d1109b50d0, []int{17}
 }
 func (m *QueryDepositsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -893,7 +976,7 @@ func (m *QueryTallyResultRequest) Reset()         { *m = QueryTallyResultRequest
 func (m *QueryTallyResultRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyResultRequest) ProtoMessage()    {}
 func (*QueryTallyResultRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{16}
+	return fileDescriptor_46a436d1109b50d0, []int{18}
 }
 func (m *QueryTallyResultRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -939,7 +1022,7 @@ func (m *QueryTallyResultResponse) Reset()         { *m = QueryTallyResultRespon
 func (m *QueryTallyResultResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyResultResponse) ProtoMessage()    {}
 func (*QueryTallyResultResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{17}
+	return fileDescriptor_46a436d1109b50d0, []int{19}
 }
 func (m *QueryTallyResultResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -988,6 +1071,8 @@ func init() {
 	proto.RegisterType((*QueryVotesResponse)(nil), "cosmos.gov.v1.QueryVotesResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.gov.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.gov.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAllowedProposalMsgsRequest)(nil), "cosmos.gov.v1.QueryAllowedProposalMsgsRequest")
+	proto.RegisterType((*QueryAllowedProposalMsgsResponse)(nil), "cosmos.gov.v1.QueryAllowedProposalMsgsResponse")
 	proto.RegisterType((*QueryDepositRequest)(nil), "cosmos.gov.v1.QueryDepositRequest")
 	proto.RegisterType((*QueryDepositResponse)(nil), "cosmos.gov.v1.QueryDepositResponse")
 	proto.RegisterType((*QueryDepositsRequest)(nil), "cosmos.gov.v1.QueryDepositsRequest")
@@ -999,71 +1084,76 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 1023 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5b, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0x37, 0x97, 0x66, 0x4f, 0x2e, 0xc0, 0xe9, 0x25, 0xae, 0xdb, 0x6e, 0x83, 0x43, 0x93,
-	0x40, 0x89, 0xcd, 0xa6, 0x37, 0x09, 0x8a, 0x50, 0xd3, 0x92, 0x82, 0xc4, 0x43, 0x70, 0x2b, 0x1e,
-	0x78, 0x89, 0x9c, 0xac, 0x65, 0x2c, 0x36, 0x1e, 0x77, 0x67, 0x76, 0x45, 0x48, 0x23, 0xa4, 0x4a,
-	0x5c, 0x9e, 0x00, 0x89, 0x0a, 0xf8, 0x21, 0xfc, 0x08, 0x1e, 0x2b, 0x78, 0xe1, 0x11, 0x25, 0xfc,
-	0x10, 0xe4, 0x99, 0x63, 0xaf, 0xed, 0x78, 0x6f, 0x55, 0xd5, 0xa7, 0x95, 0x67, 0xbe, 0xf3, 0x9d,
-	0xef, 0x9c, 0x39, 0x73, 0xce, 0x2c, 0x9c, 0xdf, 0x65, 0x7c, 0x8f, 0x71, 0xdb, 0x67, 0x1d, 0xbb,
-	0x53, 0xb7, 0x1f, 0xb5, 0xbd, 0xd6, 0xbe, 0x15, 0xb5, 0x98, 0x60, 0x38, 0xa7, 0xb6, 0x2c, 0x9f,
-	0x75, 0xac, 0x4e, 0xdd, 0x78, 0x8b, 0x90, 0x3b, 0x2e, 0xf7, 0x14, 0xce, 0xee, 0xd4, 0x77, 0x3c,
-	0xe1, 0xd6, 0xed, 0xc8, 0xf5, 0x83, 0xd0, 0x15, 0x01, 0x0b, 0x95, 0xa9, 0x71, 0xd1, 0x67, 0xcc,
-	0x6f, 0x7a, 0xb6, 0x1b, 0x05, 0xb6, 0x1b, 0x86, 0x4c, 0xc8, 0x4d, 0x4e, 0xbb, 0x0b, 0x79, 0x9f,
-	0x31, 0xbf, 0xda, 0x20, 0x31, 0xdb, 0xf2, 0xcb, 0x26, 0xf7, 0xf2, 0xc3, 0x34, 0x40, 0xff, 0x34,
-	0xf6, 0x79, 0x97, 0x85, 0x5c, 0x04, 0xa2, 0x1d, 0xf3, 0x39, 0xde, 0xa3, 0xb6, 0xc7, 0x85, 0xf9,
-	0x01, 0x9c, 0x2f, 0xd9, 0xe3, 0x11, 0x0b, 0xb9, 0x87, 0x26, 0xcc, 0xee, 0x66, 0xd6, 0x75, 0x6d,
-	0x51, 0x5b, 0xad, 0x3a, 0xb9, 0x35, 0xf3, 0x16, 0x9c, 0x91, 0x04, 0x5b, 0x2d, 0x16, 0x31, 0xee,
-	0x36, 0x89, 0x18, 0x2f, 0xc3, 0x4c, 0x44, 0x4b, 0xdb, 0x41, 0x43, 0x9a, 0x4e, 0x38, 0x90, 0x2c,
-	0x7d, 0xdc, 0x30, 0x3f, 0x81, 0xb3, 0x05, 0x43, 0xf2, 0x7a, 0x0d, 0xa6, 0x13, 0x98, 0x34, 0x9b,
-	0x59, 0x5f, 0xb0, 0x72, 0xe9, 0xb4, 0x52, 0x93, 0x14, 0x68, 0xfe, 0x54, 0x29, 0xd0, 0xf1, 0x44,
-	0xc8, 0x26, 0xbc, 0x92, 0x0a, 0xe1, 0xc2, 0x15, 0x6d, 0x2e, 0x59, 0xe7, 0xd7, 0x2f, 0xf5, 0x60,
-	0x7d, 0x20, 0x41, 0xce, 0x7c, 0x94, 0xfb, 0x46, 0x0b, 0x26, 0x3b, 0x4c, 0x78, 0x2d, 0xbd, 0x12,
-	0x67, 0x61, 0x43, 0xff, 0xeb, 0x8f, 0xb5, 0x33, 0x44, 0x70, 0xa7, 0xd1, 0x68, 0x79, 0x9c, 0x3f,
-	0x10, 0xad, 0x20, 0xf4, 0x1d, 0x05, 0xc3, 0x9b, 0x50, 0x6d, 0x78, 0x11, 0xe3, 0x81, 0x60, 0x2d,
-	0x7d, 0x7c, 0x80, 0x4d, 0x17, 0x8a, 0x9b, 0x00, 0xdd, 0x9a, 0xd0, 0x27, 0x64, 0x02, 0x96, 0x13,
-	0xa9, 0x71, 0x01, 0x59, 0xaa, 0xd0, 0xa8, 0x80, 0xac, 0x2d, 0xd7, 0xf7, 0x28, 0x56, 0x27, 0x63,
-	0x69, 0xfe, 0xae, 0xc1, 0xb9, 0x62, 0x46, 0x28, 0xc3, 0x37, 0xa0, 0x9a, 0x04, 0x17, 0x27, 0x63,
-	0xbc, 0x5f, 0x8a, 0xbb, 0x48, 0xbc, 0x9f, 0x53, 0x56, 0x91, 0xca, 0x56, 0x06, 0x2a, 0x53, 0x3e,
-	0x73, 0xd2, 0x76, 0xe1, 0x55, 0xa9, 0xec, 0x33, 0x26, 0xbc, 0x61, 0xeb, 0x65, 0xd4, 0xfc, 0x9b,
-	0xb7, 0xe1, 0xb5, 0x8c, 0x13, 0x8a, 0x7c, 0x05, 0x26, 0xe2, 0x5d, 0xaa, 0xab, 0xd3, 0x85, 0xa0,
-	0x25, 0x54, 0x02, 0xcc, 0xc7, 0x19, 0x6b, 0x3e, 0xb4, 0xc6, 0xcd, 0x92, 0x0c, 0x3d, 0xcf, 0xd9,
-	0xfd, 0xa0, 0x01, 0x66, 0xdd, 0x93, 0xfa, 0x37, 0x55, 0x0a, 0x92, 0x33, 0x2b, 0x95, 0xaf, 0x10,
-	0x2f, 0xee, 0xac, 0x6e, 0x90, 0x92, 0x2d, 0xb7, 0xe5, 0xee, 0xe5, 0x32, 0x21, 0x17, 0xb6, 0xc5,
-	0x7e, 0xe4, 0x51, 0x63, 0x00, 0xb5, 0xf4, 0x70, 0x3f, 0xf2, 0xcc, 0x5f, 0x2b, 0x70, 0x3a, 0x67,
-	0x47, 0x21, 0xdc, 0x83, 0xb9, 0x0e, 0x13, 0x41, 0xe8, 0x6f, 0x2b, 0x30, 0x9d, 0xc4, 0x85, 0x93,
-	0xa1, 0x04, 0xa1, 0xaf, 0x6c, 0x37, 0x2a, 0xba, 0xe6, 0xcc, 0x76, 0x32, 0x2b, 0x78, 0x1f, 0xe6,
-	0xe9, 0xc2, 0x24, 0x34, 0x2a, 0xc2, 0x8b, 0x05, 0x9a, 0x7b, 0x0a, 0x94, 0xe1, 0x99, 0x6b, 0x64,
-	0x97, 0xf0, 0x0e, 0xcc, 0x0a, 0xb7, 0xd9, 0xdc, 0x4f, 0x68, 0xc6, 0x25, 0x8d, 0x51, 0xa0, 0x79,
-	0x18, 0x43, 0x32, 0x24, 0x33, 0xa2, 0xbb, 0x80, 0x6b, 0x30, 0x45, 0xc6, 0xea, 0xae, 0x9e, 0x2d,
-	0xde, 0x24, 0x95, 0x00, 0x02, 0x99, 0x21, 0xe5, 0x85, 0xa4, 0x0d, 0x5d, 0x5a, 0xb9, 0x76, 0x52,
-	0x19, 0xba, 0x9d, 0x98, 0x1f, 0x51, 0x7f, 0x4e, 0xfd, 0xd1, 0x41, 0xbc, 0x03, 0xa7, 0x08, 0x44,
-	0x47, 0x70, 0xae, 0x3c, 0x77, 0x4e, 0x02, 0x33, 0xbf, 0xc9, 0x33, 0xbd, 0xfc, 0x5b, 0xf1, 0x54,
-	0xa3, 0x1e, 0xdf, 0x55, 0x40, 0xc1, 0xac, 0xc3, 0x34, 0xa9, 0x4c, 0xee, 0x46, 0xaf, 0x68, 0x52,
-	0xdc, 0x8b, 0xbb, 0x21, 0xef, 0xc2, 0x82, 0x54, 0x25, 0xab, 0xc4, 0xf1, 0x78, 0xbb, 0x29, 0x46,
-	0x18, 0x82, 0xfa, 0x49, 0xdb, 0xf4, 0x84, 0x26, 0x65, 0x9d, 0xd1, 0xf9, 0x94, 0x16, 0x25, 0x99,
-	0x28, 0xe0, 0xfa, 0x71, 0x15, 0x26, 0x25, 0x1d, 0x7e, 0xa7, 0xc1, 0x6c, 0x76, 0xa4, 0xe3, 0x4a,
-	0xc1, 0xba, 0xd7, 0x83, 0xc0, 0x58, 0x1d, 0x0c, 0x54, 0xfa, 0xcc, 0xa5, 0x27, 0x7f, 0xff, 0xf7,
-	0x4b, 0xe5, 0x12, 0x5e, 0xb0, 0xf3, 0x6f, 0x92, 0xec, 0xf3, 0x00, 0xbf, 0xd5, 0x60, 0x3a, 0x99,
-	0x25, 0xb8, 0x54, 0xc6, 0x5d, 0x78, 0x38, 0x18, 0x6f, 0xf4, 0x07, 0x91, 0x73, 0x4b, 0x3a, 0x5f,
-	0xc5, 0xe5, 0x82, 0xf3, 0x74, 0x5a, 0xd9, 0x07, 0x99, 0xcc, 0x1f, 0xe2, 0xd7, 0x50, 0x4d, 0xe7,
-	0x20, 0xf6, 0x75, 0x91, 0xd4, 0xb5, 0x71, 0x65, 0x00, 0x8a, 0x94, 0x2c, 0x4a, 0x25, 0x06, 0xea,
-	0xbd, 0x94, 0xe0, 0xf7, 0x1a, 0x4c, 0xc4, 0xbd, 0x19, 0x2f, 0x97, 0x31, 0x66, 0x86, 0xa0, 0xb1,
-	0xd8, 0x1b, 0x40, 0xde, 0x6e, 0x4b, 0x6f, 0x37, 0xf1, 0xfa, 0x70, 0x71, 0xdb, 0x72, 0x1a, 0xd8,
-	0x07, 0x72, 0x24, 0x1e, 0xe2, 0x13, 0x0d, 0x26, 0xe5, 0x48, 0xc1, 0x9e, 0x9e, 0xd2, 0xf0, 0x5f,
-	0xef, 0x83, 0x20, 0x31, 0xd7, 0xa5, 0x18, 0x0b, 0xdf, 0x1e, 0x45, 0x0c, 0x3e, 0x86, 0x29, 0x6a,
-	0x9d, 0xa5, 0x2e, 0x72, 0x83, 0xc6, 0x30, 0xfb, 0x41, 0x48, 0xc6, 0x55, 0x29, 0xe3, 0x0a, 0x2e,
-	0x15, 0x65, 0x48, 0x98, 0x7d, 0x90, 0x99, 0x54, 0x87, 0xf8, 0x9b, 0x06, 0xa7, 0xa8, 0x19, 0x60,
-	0x29, 0x79, 0xbe, 0x31, 0x1b, 0x4b, 0x7d, 0x31, 0xa4, 0xe0, 0xae, 0x54, 0xf0, 0x3e, 0xbe, 0x37,
-	0x64, 0x22, 0x92, 0x26, 0x64, 0x1f, 0xa4, 0x8d, 0xfa, 0x10, 0x7f, 0xd4, 0x60, 0x3a, 0xe9, 0x6c,
-	0xd8, 0xcf, 0x2d, 0xef, 0x7b, 0x55, 0x8a, 0xcd, 0xd1, 0xbc, 0x25, 0xc5, 0xd5, 0xd1, 0x1e, 0x51,
-	0x1c, 0x3e, 0xd5, 0x60, 0x26, 0xd3, 0x65, 0x70, 0xb9, 0xcc, 0xdd, 0xc9, 0xae, 0x67, 0xac, 0x0c,
-	0xc4, 0x3d, 0x67, 0xfd, 0xc8, 0x2e, 0xb7, 0xf1, 0xe1, 0x9f, 0x47, 0x35, 0xed, 0xd9, 0x51, 0x4d,
-	0xfb, 0xf7, 0xa8, 0xa6, 0xfd, 0x7c, 0x5c, 0x1b, 0x7b, 0x76, 0x5c, 0x1b, 0xfb, 0xe7, 0xb8, 0x36,
-	0xf6, 0xf9, 0x55, 0x3f, 0x10, 0x5f, 0xb4, 0x77, 0xac, 0x5d, 0xb6, 0x97, 0x30, 0xaa, 0x9f, 0x35,
-	0xde, 0xf8, 0xd2, 0xfe, 0x4a, 0xd2, 0xc7, 0x55, 0xc0, 0xe3, 0x7f, 0x5f, 0x53, 0xf2, 0xcf, 0xd1,
-	0xb5, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x34, 0xfb, 0x7f, 0x51, 0xc6, 0x0d, 0x00, 0x00,
+	// 1098 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5b, 0x6f, 0xdc, 0x54,
+	0x10, 0x8e, 0x37, 0x97, 0x66, 0x27, 0x17, 0x60, 0x92, 0x34, 0xae, 0xd3, 0x6e, 0x52, 0xa7, 0x4d,
+	0x02, 0x6d, 0x6c, 0x92, 0xde, 0x24, 0x28, 0x42, 0x49, 0x4b, 0x0a, 0x12, 0x48, 0xc1, 0x2d, 0x3c,
+	0xf0, 0xb2, 0x72, 0xb2, 0x96, 0xb1, 0xd8, 0xf8, 0xb8, 0x3e, 0xde, 0x85, 0x90, 0x46, 0x48, 0x95,
+	0xb8, 0x88, 0x07, 0x40, 0xa2, 0x02, 0x7e, 0x00, 0x3f, 0x81, 0x1f, 0xc1, 0x63, 0x05, 0x2f, 0x3c,
+	0xa2, 0x84, 0x1f, 0x82, 0x7c, 0xce, 0x78, 0xd7, 0x76, 0xbc, 0x97, 0x54, 0x55, 0x9f, 0x56, 0x3e,
+	0xe7, 0x9b, 0x6f, 0xbe, 0x99, 0x33, 0x33, 0xe7, 0x2c, 0x9c, 0xdb, 0x65, 0x7c, 0x8f, 0x71, 0xd3,
+	0x65, 0x4d, 0xb3, 0xb9, 0x66, 0x3e, 0x6c, 0x38, 0xe1, 0xbe, 0x11, 0x84, 0x2c, 0x62, 0x38, 0x21,
+	0xb7, 0x0c, 0x97, 0x35, 0x8d, 0xe6, 0x9a, 0xf6, 0x1a, 0x21, 0x77, 0x6c, 0xee, 0x48, 0x9c, 0xd9,
+	0x5c, 0xdb, 0x71, 0x22, 0x7b, 0xcd, 0x0c, 0x6c, 0xd7, 0xf3, 0xed, 0xc8, 0x63, 0xbe, 0x34, 0xd5,
+	0xce, 0xbb, 0x8c, 0xb9, 0x75, 0xc7, 0xb4, 0x03, 0xcf, 0xb4, 0x7d, 0x9f, 0x45, 0x62, 0x93, 0xd3,
+	0xee, 0x6c, 0xd6, 0x67, 0xcc, 0x2f, 0x37, 0x48, 0x4c, 0x55, 0x7c, 0x99, 0xe4, 0x5e, 0x7c, 0xe8,
+	0x1a, 0xa8, 0x1f, 0xc6, 0x3e, 0xef, 0x30, 0x9f, 0x47, 0x5e, 0xd4, 0x88, 0xf9, 0x2c, 0xe7, 0x61,
+	0xc3, 0xe1, 0x91, 0xfe, 0x36, 0x9c, 0x2b, 0xd8, 0xe3, 0x01, 0xf3, 0xb9, 0x83, 0x3a, 0x8c, 0xef,
+	0xa6, 0xd6, 0x55, 0x65, 0x41, 0x59, 0x29, 0x5b, 0x99, 0x35, 0xfd, 0x16, 0x4c, 0x0b, 0x82, 0xed,
+	0x90, 0x05, 0x8c, 0xdb, 0x75, 0x22, 0xc6, 0x79, 0x18, 0x0b, 0x68, 0xa9, 0xea, 0xd5, 0x84, 0xe9,
+	0x90, 0x05, 0xc9, 0xd2, 0x7b, 0x35, 0xfd, 0x7d, 0x98, 0xc9, 0x19, 0x92, 0xd7, 0x6b, 0x30, 0x9a,
+	0xc0, 0x84, 0xd9, 0xd8, 0xfa, 0xac, 0x91, 0x49, 0xa7, 0xd1, 0x32, 0x69, 0x01, 0xf5, 0x1f, 0x4b,
+	0x39, 0x3a, 0x9e, 0x08, 0xd9, 0x82, 0x97, 0x5a, 0x42, 0x78, 0x64, 0x47, 0x0d, 0x2e, 0x58, 0x27,
+	0xd7, 0x2f, 0x74, 0x60, 0xbd, 0x2f, 0x40, 0xd6, 0x64, 0x90, 0xf9, 0x46, 0x03, 0x86, 0x9b, 0x2c,
+	0x72, 0x42, 0xb5, 0x14, 0x67, 0x61, 0x53, 0xfd, 0xeb, 0x8f, 0xd5, 0x69, 0x22, 0xd8, 0xa8, 0xd5,
+	0x42, 0x87, 0xf3, 0xfb, 0x51, 0xe8, 0xf9, 0xae, 0x25, 0x61, 0x78, 0x13, 0xca, 0x35, 0x27, 0x60,
+	0xdc, 0x8b, 0x58, 0xa8, 0x0e, 0xf6, 0xb0, 0x69, 0x43, 0x71, 0x0b, 0xa0, 0x5d, 0x13, 0xea, 0x90,
+	0x48, 0xc0, 0x52, 0x22, 0x35, 0x2e, 0x20, 0x43, 0x16, 0x1a, 0x15, 0x90, 0xb1, 0x6d, 0xbb, 0x0e,
+	0xc5, 0x6a, 0xa5, 0x2c, 0xf5, 0xdf, 0x14, 0x38, 0x9b, 0xcf, 0x08, 0x65, 0xf8, 0x06, 0x94, 0x93,
+	0xe0, 0xe2, 0x64, 0x0c, 0x76, 0x4b, 0x71, 0x1b, 0x89, 0xf7, 0x32, 0xca, 0x4a, 0x42, 0xd9, 0x72,
+	0x4f, 0x65, 0xd2, 0x67, 0x46, 0xda, 0x2e, 0xbc, 0x2c, 0x94, 0x7d, 0xcc, 0x22, 0xa7, 0xdf, 0x7a,
+	0x39, 0x6d, 0xfe, 0xf5, 0xdb, 0xf0, 0x4a, 0xca, 0x09, 0x45, 0xbe, 0x0c, 0x43, 0xf1, 0x2e, 0xd5,
+	0xd5, 0x54, 0x2e, 0x68, 0x01, 0x15, 0x00, 0xfd, 0x51, 0xca, 0x9a, 0xf7, 0xad, 0x71, 0xab, 0x20,
+	0x43, 0xcf, 0x72, 0x76, 0xdf, 0x29, 0x80, 0x69, 0xf7, 0xa4, 0xfe, 0x55, 0x99, 0x82, 0xe4, 0xcc,
+	0x0a, 0xe5, 0x4b, 0xc4, 0xf3, 0x3b, 0xab, 0x1b, 0xa4, 0x64, 0xdb, 0x0e, 0xed, 0xbd, 0x4c, 0x26,
+	0xc4, 0x42, 0x35, 0xda, 0x0f, 0x1c, 0x1a, 0x0c, 0x20, 0x97, 0x1e, 0xec, 0x07, 0x8e, 0xfe, 0x4b,
+	0x09, 0xa6, 0x32, 0x76, 0x14, 0xc2, 0x5d, 0x98, 0x68, 0xb2, 0xc8, 0xf3, 0xdd, 0xaa, 0x04, 0xd3,
+	0x49, 0xcc, 0x9d, 0x0c, 0xc5, 0xf3, 0x5d, 0x69, 0xbb, 0x59, 0x52, 0x15, 0x6b, 0xbc, 0x99, 0x5a,
+	0xc1, 0x7b, 0x30, 0x49, 0x0d, 0x93, 0xd0, 0xc8, 0x08, 0xcf, 0xe7, 0x68, 0xee, 0x4a, 0x50, 0x8a,
+	0x67, 0xa2, 0x96, 0x5e, 0xc2, 0x0d, 0x18, 0x8f, 0xec, 0x7a, 0x7d, 0x3f, 0xa1, 0x19, 0x14, 0x34,
+	0x5a, 0x8e, 0xe6, 0x41, 0x0c, 0x49, 0x91, 0x8c, 0x45, 0xed, 0x05, 0x5c, 0x85, 0x11, 0x32, 0x96,
+	0xbd, 0x3a, 0x93, 0xef, 0x24, 0x99, 0x00, 0x02, 0xe9, 0x17, 0x61, 0x5e, 0xe4, 0x65, 0xa3, 0x5e,
+	0x67, 0x9f, 0x3b, 0xb5, 0xa4, 0xcf, 0x3e, 0xe0, 0x2e, 0x6f, 0xcf, 0xe4, 0x85, 0xce, 0x10, 0xca,
+	0xe3, 0x1c, 0x94, 0xe3, 0xcc, 0x57, 0x1b, 0x21, 0xb5, 0x70, 0xd9, 0x1a, 0x8d, 0x17, 0x3e, 0x0a,
+	0xeb, 0x5c, 0xf7, 0x29, 0xf7, 0x14, 0x7e, 0xdf, 0xe5, 0x9b, 0x19, 0x59, 0xa5, 0xbe, 0x47, 0x96,
+	0xfe, 0x2e, 0x4c, 0x67, 0xfd, 0x91, 0xc8, 0xd7, 0xe1, 0x0c, 0x81, 0xe8, 0x98, 0xcf, 0x16, 0x9f,
+	0x8f, 0x95, 0xc0, 0xf4, 0xaf, 0xb2, 0x4c, 0x2f, 0xbe, 0xf3, 0x9e, 0x28, 0x30, 0x93, 0x53, 0x40,
+	0xc1, 0xac, 0xc3, 0x28, 0xa9, 0x4c, 0xfa, 0xaf, 0x53, 0x34, 0x2d, 0xdc, 0xf3, 0xeb, 0xc2, 0x37,
+	0x60, 0x56, 0xa8, 0x12, 0x95, 0x68, 0x39, 0xbc, 0x51, 0x8f, 0x4e, 0x71, 0xd1, 0xaa, 0x27, 0x6d,
+	0x5b, 0x27, 0x34, 0x2c, 0x6a, 0x59, 0x55, 0x3a, 0x17, 0x3e, 0x99, 0x48, 0xe0, 0xfa, 0xf7, 0x63,
+	0x30, 0x2c, 0xe8, 0xf0, 0x1b, 0x05, 0xc6, 0xd3, 0xcf, 0x06, 0x5c, 0xce, 0x59, 0x77, 0x7a, 0x74,
+	0x68, 0x2b, 0xbd, 0x81, 0x52, 0x9f, 0xbe, 0xf8, 0xf8, 0xef, 0xff, 0x7e, 0x2e, 0x5d, 0xc0, 0x39,
+	0x33, 0xfb, 0xee, 0x49, 0x3f, 0x41, 0xf0, 0x6b, 0x05, 0x46, 0x93, 0x26, 0xc1, 0xc5, 0x22, 0xee,
+	0xdc, 0xe3, 0x44, 0xbb, 0xd4, 0x1d, 0x44, 0xce, 0x0d, 0xe1, 0x7c, 0x05, 0x97, 0x72, 0xce, 0x5b,
+	0x37, 0xa2, 0x79, 0x90, 0xca, 0xfc, 0x21, 0x7e, 0x09, 0xe5, 0x84, 0x83, 0x63, 0x57, 0x17, 0x49,
+	0x5d, 0x6b, 0x97, 0x7b, 0xa0, 0x48, 0xc9, 0x82, 0x50, 0xa2, 0xa1, 0xda, 0x49, 0x09, 0x7e, 0xab,
+	0xc0, 0x50, 0x3c, 0xff, 0x71, 0xbe, 0x88, 0x31, 0x75, 0xd1, 0x6a, 0x0b, 0x9d, 0x01, 0xe4, 0xed,
+	0xb6, 0xf0, 0x76, 0x13, 0xaf, 0xf7, 0x17, 0xb7, 0x29, 0x6e, 0x1c, 0xf3, 0x20, 0xfe, 0x09, 0x0f,
+	0xf1, 0xb1, 0x02, 0xc3, 0x31, 0x1d, 0xc7, 0x8e, 0x9e, 0x5a, 0xe1, 0x5f, 0xec, 0x82, 0x20, 0x31,
+	0xd7, 0x85, 0x18, 0x03, 0xaf, 0x9e, 0x46, 0x0c, 0x3e, 0x82, 0x11, 0x1a, 0xcf, 0x85, 0x2e, 0x32,
+	0x97, 0x99, 0xa6, 0x77, 0x83, 0x90, 0x8c, 0x2b, 0x42, 0xc6, 0x65, 0x5c, 0xcc, 0xcb, 0x10, 0x30,
+	0xf3, 0x20, 0x75, 0x1b, 0x1e, 0xe2, 0xef, 0x0a, 0x4c, 0x15, 0x0c, 0x6f, 0x34, 0x8a, 0x1c, 0x75,
+	0xbe, 0x08, 0x34, 0xb3, 0x6f, 0x3c, 0xa9, 0xbc, 0x2a, 0x54, 0x2e, 0xe1, 0xa5, 0x9c, 0x4a, 0x5b,
+	0xda, 0x54, 0x5b, 0x99, 0xda, 0x8b, 0xe5, 0xfc, 0xaa, 0xc0, 0x19, 0x9a, 0x59, 0x58, 0x98, 0x83,
+	0xec, 0xfd, 0xa1, 0x2d, 0x76, 0xc5, 0x90, 0x84, 0x3b, 0x42, 0xc2, 0x5b, 0xf8, 0x66, 0x9f, 0xe7,
+	0x95, 0xcc, 0x4a, 0xf3, 0xa0, 0x75, 0x9f, 0x1c, 0xe2, 0x0f, 0x0a, 0x8c, 0x12, 0x31, 0xc7, 0x6e,
+	0x6e, 0x79, 0xd7, 0x8e, 0xce, 0xcf, 0x70, 0xfd, 0x96, 0x10, 0xb7, 0x86, 0xe6, 0x29, 0xc5, 0xe1,
+	0x13, 0x05, 0xc6, 0x52, 0xc3, 0x10, 0x97, 0x8a, 0xdc, 0x9d, 0x1c, 0xce, 0xda, 0x72, 0x4f, 0xdc,
+	0x33, 0x96, 0xb9, 0x18, 0xc6, 0x9b, 0xef, 0xfc, 0x79, 0x54, 0x51, 0x9e, 0x1e, 0x55, 0x94, 0x7f,
+	0x8f, 0x2a, 0xca, 0x4f, 0xc7, 0x95, 0x81, 0xa7, 0xc7, 0x95, 0x81, 0x7f, 0x8e, 0x2b, 0x03, 0x9f,
+	0x5c, 0x71, 0xbd, 0xe8, 0xd3, 0xc6, 0x8e, 0xb1, 0xcb, 0xf6, 0x12, 0x46, 0xf9, 0xb3, 0xca, 0x6b,
+	0x9f, 0x99, 0x5f, 0x08, 0xfa, 0xb8, 0x58, 0x79, 0xfc, 0x47, 0x74, 0x44, 0xfc, 0x4f, 0xbc, 0xf6,
+	0xff, 0x00, 0x68, 0xce, 0xeb, 0x44, 0xd1, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1090,6 +1180,9 @@ type QueryClient interface {
 	Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
 	// Params queries all parameters of the gov module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AllowedProposalMsgs queries the type URLs of the messages and legacy contents
+	// which can be submitted within a proposal.
+	AllowedProposalMsgs(ctx context.Context, in *QueryAllowedProposalMsgsRequest, opts ...grpc.CallOption) (*QueryAllowedProposalMsgsResponse, error)
 	// Deposit queries single deposit information based on proposalID, depositAddr.
 	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
 	// Deposits queries all deposits of a single proposal.
@@ -1160,6 +1253,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) AllowedProposalMsgs(ctx context.Context, in *QueryAllowedProposalMsgsRequest, opts ...grpc.CallOption) (*QueryAllowedProposalMsgsResponse, error) {
+	out := new(QueryAllowedProposalMsgsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/AllowedProposalMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
 	out := new(QueryDepositResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/Deposit", in, out, opts...)
@@ -1201,6 +1303,9 @@ type QueryServer interface {
 	Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
 	// Params queries all parameters of the gov module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AllowedProposalMsgs queries the type URLs of the messages and legacy contents
+	// which can be submitted within a proposal.
+	AllowedProposalMsgs(context.Context, *QueryAllowedProposalMsgsRequest) (*QueryAllowedProposalMsgsResponse, error)
 	// Deposit queries single deposit information based on proposalID, depositAddr.
 	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
 	// Deposits queries all deposits of a single proposal.
@@ -1231,6 +1336,9 @@ func (*UnimplementedQueryServer) Votes(ctx context.Context, req *QueryVotesReque
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) AllowedProposalMsgs(ctx context.Context, req *QueryAllowedProposalMsgsRequest) (*QueryAllowedProposalMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedProposalMsgs not implemented")
+}
 func (*UnimplementedQueryServer) Deposit(ctx context.Context, req *QueryDepositRequest) (*QueryDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -1353,6 +1461,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllowedProposalMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedProposalMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedProposalMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/AllowedProposalMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedProposalMsgs(ctx, req.(*QueryAllowedProposalMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDepositRequest)
 	if err := dec(in); err != nil {
@@ -1407,6 +1533,7 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1435,6 +1562,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "AllowedProposalMsgs",
+			Handler:    _Query_AllowedProposalMsgs_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _Query_Deposit_Handler,
@@ -1931,6 +2062,61 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAllowedProposalMsgsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedProposalMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedProposalMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedProposalMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedProposalMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedProposalMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TypeUrls) > 0 {
+		for iNdEx := len(m.TypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeUrls[iNdEx])
+			copy(dAtA[i:], m.TypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryDepositRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2356,6 +2542,30 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAllowedProposalMsgsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllowedProposalMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TypeUrls) > 0 {
+		for _, s := range m.TypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryDepositRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3715,6 +3925,138 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAllowedProposalMsgsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedProposalMsgsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedProposalMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedProposalMsgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedProposalMsgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedProposalMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrls = append(m.TypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -615,6 +615,10 @@ type MsgUpdateParams struct {
 	//
 	// NOTE: All parameters must be supplied.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// add_allowed_proposal_msgs defines the type URLs to add to the proposal message allow-list.
+	AddAllowedProposalMsgs []string `protobuf:"bytes,3,rep,name=add_allowed_proposal_msgs,json=addAllowedProposalMsgs,proto3" json:"add_allowed_proposal_msgs,omitempty"`
+	// remove_allowed_proposal_msgs defines the type URLs to remove from the proposal message allow-list.
+	RemoveAllowedProposalMsgs []string `protobuf:"bytes,4,rep,name=remove_allowed_proposal_msgs,json=removeAllowedProposalMsgs,proto3" json:"remove_allowed_proposal_msgs,omitempty"`
 }
 
 func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
@@ -664,6 +668,20 @@ func (m *MsgUpdateParams) GetParams() Params {
 	return Params{}
 }
 
+func (m *MsgUpdateParams) GetAddAllowedProposalMsgs() []string {
+	if m != nil {
+		return m.AddAllowedProposalMsgs
+	}
+	return nil
+}
+
+func (m *MsgUpdateParams) GetRemoveAllowedProposalMsgs() []string {
+	if m != nil {
+		return m.RemoveAllowedProposalMsgs
+	}
+	return nil
+}
+
 // MsgUpdateParamsResponse defines the response structure for executing a
 // MsgUpdateParams message.
 //
@@ -848,75 +866,78 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1076 bytes of a gzipped FileDescriptorProto
+	// 1130 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xe6, 0xc3, 0x4e, 0x26, 0x5f, 0xca, 0xca, 0x6d, 0xd7, 0xab, 0xb2, 0x76, 0xb7, 0x08,
-	0xac, 0x84, 0xec, 0xe2, 0x40, 0x2b, 0x64, 0x2a, 0xa4, 0x3a, 0x14, 0xa8, 0x84, 0xa1, 0xda, 0x42,
-	0x91, 0x10, 0x92, 0xb5, 0xf6, 0x0e, 0x9b, 0x15, 0xde, 0x9d, 0x95, 0x67, 0x6c, 0xc5, 0x37, 0xc4,
-	0x31, 0xa7, 0x9e, 0xb9, 0x23, 0x21, 0x4e, 0x39, 0xf4, 0xd6, 0x13, 0xb7, 0x8a, 0x53, 0xc5, 0x89,
-	0x53, 0x8b, 0x12, 0x41, 0x10, 0xff, 0x04, 0x68, 0x3e, 0x76, 0xbd, 0x5f, 0x71, 0x0a, 0x87, 0x5e,
-	0x12, 0xcf, 0xfb, 0x9a, 0xf7, 0xfb, 0xbd, 0x79, 0xef, 0x2d, 0xb8, 0xdc, 0x47, 0xd8, 0x47, 0xd8,
-	0x74, 0xd1, 0xd8, 0x1c, 0x37, 0x4d, 0x72, 0x68, 0x84, 0x43, 0x44, 0x90, 0xbc, 0xce, 0xe5, 0x86,
-	0x8b, 0xc6, 0xc6, 0xb8, 0xa9, 0x6a, 0xc2, 0xac, 0x67, 0x63, 0x68, 0x8e, 0x9b, 0x3d, 0x48, 0xec,
-	0xa6, 0xd9, 0x47, 0x5e, 0xc0, 0xcd, 0xd5, 0x2b, 0xe9, 0x30, 0xd4, 0x8b, 0x2b, 0x2a, 0x2e, 0x72,
-	0x11, 0xfb, 0x69, 0xd2, 0x5f, 0x42, 0x5a, 0xe5, 0xe6, 0x5d, 0xae, 0x10, 0x57, 0x09, 0x95, 0x8b,
-	0x90, 0x3b, 0x80, 0x26, 0x3b, 0xf5, 0x46, 0x5f, 0x9b, 0x76, 0x30, 0xc9, 0x5c, 0xe2, 0x63, 0x97,
-	0x5e, 0xe2, 0x63, 0x57, 0x28, 0xb6, 0x6c, 0xdf, 0x0b, 0x90, 0xc9, 0xfe, 0x0a, 0x51, 0x2d, 0x1b,
-	0x86, 0x78, 0x3e, 0xc4, 0xc4, 0xf6, 0x43, 0x6e, 0xa0, 0xff, 0xb0, 0x00, 0xb6, 0x3a, 0xd8, 0xbd,
-	0x3f, 0xea, 0xf9, 0x1e, 0xb9, 0x37, 0x44, 0x21, 0xc2, 0xf6, 0x40, 0x7e, 0x13, 0x2c, 0xfb, 0x10,
-	0x63, 0xdb, 0x85, 0x58, 0x91, 0xea, 0x0b, 0x8d, 0xd5, 0xbd, 0x8a, 0xc1, 0x23, 0x19, 0x51, 0x24,
-	0xe3, 0x76, 0x30, 0xb1, 0x62, 0x2b, 0xf9, 0x48, 0x02, 0x9b, 0x5e, 0xe0, 0x11, 0xcf, 0x1e, 0x74,
-	0x1d, 0x18, 0x22, 0xec, 0x11, 0x65, 0x9e, 0x79, 0x56, 0x0d, 0x01, 0x8c, 0x92, 0x66, 0x08, 0xd2,
-	0x8c, 0x7d, 0xe4, 0x05, 0xed, 0x0f, 0x9e, 0x3c, 0xab, 0xcd, 0xfd, 0xf4, 0xbc, 0xd6, 0x70, 0x3d,
-	0x72, 0x30, 0xea, 0x19, 0x7d, 0xe4, 0x0b, 0x16, 0xc4, 0xbf, 0x5d, 0xec, 0x7c, 0x63, 0x92, 0x49,
-	0x08, 0x31, 0x73, 0xc0, 0xdf, 0x9f, 0x1d, 0x6f, 0xaf, 0x0d, 0xa0, 0x6b, 0xf7, 0x27, 0x5d, 0x4a,
-	0x3b, 0xfe, 0xf1, 0xec, 0x78, 0x5b, 0xb2, 0x36, 0xc4, 0xcd, 0xef, 0xf3, 0x8b, 0xe5, 0xb7, 0xc1,
-	0x72, 0xc8, 0xa0, 0xc0, 0xa1, 0xb2, 0x50, 0x97, 0x1a, 0x2b, 0x6d, 0xe5, 0xd7, 0x47, 0xbb, 0x15,
-	0x91, 0xc7, 0x6d, 0xc7, 0x19, 0x42, 0x8c, 0xef, 0x93, 0xa1, 0x17, 0xb8, 0x56, 0x6c, 0x29, 0xab,
-	0x14, 0x34, 0xb1, 0x1d, 0x9b, 0xd8, 0xca, 0x22, 0xf5, 0xb2, 0xe2, 0xb3, 0x5c, 0x01, 0x4b, 0xc4,
-	0x23, 0x03, 0xa8, 0x2c, 0x31, 0x05, 0x3f, 0xc8, 0x0a, 0x28, 0xe3, 0x91, 0xef, 0xdb, 0xc3, 0x89,
-	0x52, 0x62, 0xf2, 0xe8, 0x28, 0x5f, 0x05, 0x2b, 0xf0, 0x30, 0x84, 0x8e, 0x47, 0xa0, 0xa3, 0x94,
-	0xeb, 0x52, 0x63, 0xd9, 0x9a, 0x0a, 0x5a, 0xcd, 0xef, 0xce, 0x8e, 0xb7, 0xe3, 0x8b, 0x8f, 0xce,
-	0x8e, 0xb7, 0x6b, 0x09, 0xbc, 0xe3, 0xa6, 0x99, 0xab, 0x88, 0x7e, 0x0b, 0x54, 0x73, 0x42, 0x0b,
-	0xe2, 0x10, 0x05, 0x18, 0xca, 0x35, 0xb0, 0x1a, 0x0a, 0x59, 0xd7, 0x73, 0x14, 0xa9, 0x2e, 0x35,
-	0x16, 0x2d, 0x10, 0x89, 0xee, 0x3a, 0xfa, 0x63, 0x09, 0x54, 0x3a, 0xd8, 0xbd, 0x73, 0x08, 0xfb,
-	0x1f, 0x33, 0xf6, 0xf6, 0x51, 0x40, 0x60, 0x40, 0xe4, 0x4f, 0x40, 0xb9, 0xcf, 0x7f, 0x32, 0xaf,
-	0x73, 0xea, 0xdc, 0xd6, 0x7e, 0x79, 0xb4, 0xab, 0xa6, 0x5a, 0x21, 0xaa, 0x22, 0xf3, 0xb5, 0xa2,
-	0x20, 0x14, 0xb7, 0x3d, 0x22, 0x07, 0x68, 0xe8, 0x91, 0x89, 0x32, 0xcf, 0x38, 0x99, 0x0a, 0x5a,
-	0x37, 0x28, 0xee, 0xe9, 0x99, 0x02, 0xd7, 0x73, 0xc0, 0x73, 0x49, 0xea, 0x1a, 0xb8, 0x5a, 0x24,
-	0x8f, 0xe0, 0xeb, 0x7f, 0x48, 0xa0, 0xdc, 0xc1, 0xee, 0x03, 0x44, 0xa0, 0x7c, 0xa3, 0x80, 0x8a,
-	0x76, 0xe5, 0xef, 0x67, 0xb5, 0xa4, 0x98, 0xbf, 0x9a, 0x04, 0x41, 0xb2, 0x01, 0x96, 0xc6, 0x88,
-	0xc0, 0x21, 0xcf, 0x79, 0xc6, 0x73, 0xe1, 0x66, 0x72, 0x13, 0x94, 0x50, 0x48, 0x3c, 0x14, 0xb0,
-	0xf7, 0xb5, 0x31, 0x7d, 0xe4, 0x9c, 0x1d, 0x83, 0xe6, 0xf2, 0x29, 0x33, 0xb0, 0x84, 0xe1, 0xac,
-	0xe7, 0xd5, 0x7a, 0x95, 0x12, 0xc3, 0x43, 0x53, 0x52, 0x2e, 0xe5, 0x48, 0xa1, 0xf1, 0xf4, 0x2d,
-	0xb0, 0x29, 0x7e, 0xc6, 0xd0, 0xff, 0x91, 0x62, 0xd9, 0x17, 0xd0, 0x73, 0x0f, 0x08, 0x74, 0x5e,
-	0x16, 0x05, 0xef, 0x82, 0x32, 0x47, 0x86, 0x95, 0x05, 0xd6, 0xe8, 0xd7, 0x32, 0x1c, 0x44, 0x09,
-	0x25, 0xb8, 0x88, 0x3c, 0x66, 0x92, 0xf1, 0x46, 0x9a, 0x8c, 0x57, 0x0a, 0xc9, 0x88, 0x82, 0xeb,
-	0x55, 0x70, 0x25, 0x23, 0x8a, 0xc9, 0xf9, 0x53, 0x02, 0xa0, 0x83, 0xdd, 0x68, 0x2a, 0xfc, 0x4f,
-	0x5e, 0x6e, 0x82, 0x15, 0x31, 0xd0, 0xd0, 0xc5, 0xdc, 0x4c, 0x4d, 0xe5, 0x5b, 0xa0, 0x64, 0xfb,
-	0x68, 0x14, 0x10, 0x41, 0xcf, 0x8c, 0x39, 0xb8, 0x42, 0xe7, 0x20, 0xbf, 0x59, 0xf8, 0xb4, 0x76,
-	0x58, 0xab, 0xc4, 0xd1, 0x28, 0x11, 0x4a, 0x8e, 0x08, 0x81, 0x4c, 0xaf, 0x00, 0x79, 0x7a, 0x8a,
-	0xe1, 0x3f, 0xe6, 0x6f, 0xe3, 0xf3, 0xd0, 0xb1, 0x09, 0xbc, 0x67, 0x0f, 0x6d, 0x1f, 0x53, 0x30,
-	0xd3, 0xfe, 0x94, 0x2e, 0x02, 0x13, 0x9b, 0xca, 0xef, 0x80, 0x52, 0xc8, 0x22, 0x30, 0x06, 0x56,
-	0xf7, 0x2e, 0x65, 0x6a, 0xcd, 0xc3, 0xa7, 0x80, 0x70, 0xfb, 0xd6, 0xcd, 0x7c, 0xcf, 0x5f, 0x4f,
-	0x00, 0x39, 0x8c, 0x76, 0x65, 0x26, 0x53, 0x51, 0xd7, 0xa4, 0x28, 0x06, 0x76, 0x24, 0xb1, 0x9d,
-	0xb5, 0x6f, 0x07, 0x7d, 0x38, 0x48, 0xec, 0xac, 0x82, 0xf2, 0x6e, 0x66, 0xca, 0x9b, 0xaa, 0x6c,
-	0x72, 0x4d, 0xcc, 0xbf, 0xe8, 0x9a, 0x68, 0xad, 0xa7, 0x86, 0xb7, 0xfe, 0xb3, 0xc4, 0x26, 0x73,
-	0x3a, 0x99, 0x78, 0x32, 0xff, 0xf7, 0xa4, 0xee, 0x82, 0xf5, 0x3e, 0x8b, 0x05, 0x9d, 0x2e, 0x5d,
-	0xd6, 0x82, 0x70, 0x35, 0x37, 0x97, 0x3f, 0x8b, 0x36, 0x79, 0x7b, 0x99, 0xb2, 0xfe, 0xf0, 0x79,
-	0x4d, 0xb2, 0xd6, 0x22, 0x57, 0xaa, 0x94, 0x5f, 0x07, 0x9b, 0x71, 0xa8, 0x03, 0xd6, 0x1c, 0x6c,
-	0x5a, 0x2d, 0x5a, 0x1b, 0x91, 0xf8, 0x23, 0x26, 0xdd, 0xfb, 0x6b, 0x11, 0x2c, 0x74, 0xb0, 0x2b,
-	0x7f, 0x05, 0x36, 0x32, 0x1f, 0x02, 0xf5, 0x4c, 0x9d, 0x73, 0x3b, 0x48, 0x6d, 0x5c, 0x64, 0x11,
-	0x73, 0x01, 0xc1, 0x56, 0x7e, 0x01, 0x5d, 0xcf, 0xbb, 0xe7, 0x8c, 0xd4, 0x9d, 0x17, 0x30, 0x8a,
-	0xaf, 0x79, 0x0f, 0x2c, 0xb2, 0x4d, 0x70, 0x39, 0xef, 0x44, 0xe5, 0xaa, 0x56, 0x2c, 0x8f, 0xfd,
-	0x1f, 0x80, 0xb5, 0xd4, 0x38, 0x3d, 0xc7, 0x3e, 0xd2, 0xab, 0xaf, 0xcd, 0xd6, 0xc7, 0x71, 0x3f,
-	0x04, 0xe5, 0x68, 0x12, 0x55, 0xf3, 0x2e, 0x42, 0xa5, 0x5e, 0x3b, 0x57, 0x95, 0x4c, 0x30, 0xd5,
-	0xd3, 0x05, 0x09, 0x26, 0xf5, 0x45, 0x09, 0x16, 0xb5, 0x15, 0xad, 0x7e, 0xa6, 0xa5, 0x0a, 0xaa,
-	0x9f, 0xb6, 0x28, 0xaa, 0x7e, 0x71, 0x27, 0xa8, 0x4b, 0xdf, 0xd2, 0xb1, 0xd0, 0xbe, 0xf3, 0xe4,
-	0x44, 0x93, 0x9e, 0x9e, 0x68, 0xd2, 0xef, 0x27, 0x9a, 0xf4, 0xf0, 0x54, 0x9b, 0x7b, 0x7a, 0xaa,
-	0xcd, 0xfd, 0x76, 0xaa, 0xcd, 0x7d, 0xb9, 0x33, 0xf3, 0x23, 0x90, 0xcf, 0x09, 0xf6, 0x29, 0x48,
-	0x3f, 0xbc, 0x4b, 0xac, 0x0d, 0xde, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x6f, 0x84, 0xd5,
-	0xb8, 0x0b, 0x00, 0x00,
+	0x14, 0xcf, 0x26, 0x4e, 0x9c, 0x4c, 0xf3, 0xa1, 0x8c, 0xdc, 0x74, 0xbd, 0x0a, 0x76, 0xea, 0xa2,
+	0x62, 0x25, 0x64, 0x17, 0x07, 0x5a, 0x81, 0xa9, 0x40, 0x71, 0x28, 0x50, 0x09, 0x43, 0xb5, 0x85,
+	0x22, 0xa1, 0x4a, 0xd6, 0xc4, 0x3b, 0x4c, 0x56, 0x78, 0x77, 0x56, 0x9e, 0xb1, 0x89, 0x6f, 0x88,
+	0x63, 0x4e, 0x3d, 0x73, 0x47, 0x42, 0x9c, 0x72, 0xa8, 0xc4, 0x81, 0x13, 0xb7, 0x8a, 0x53, 0xc5,
+	0x89, 0x53, 0x8b, 0x12, 0x41, 0x10, 0xff, 0x04, 0x68, 0x3e, 0x76, 0xfd, 0xb1, 0x8e, 0x53, 0x38,
+	0x70, 0xb1, 0x77, 0xde, 0xfb, 0xbd, 0x37, 0xef, 0xfd, 0xe6, 0xcd, 0x7b, 0x03, 0xd6, 0x9a, 0x94,
+	0x05, 0x94, 0x39, 0x84, 0x76, 0x9d, 0x6e, 0xc5, 0xe1, 0x87, 0x76, 0xd4, 0xa6, 0x9c, 0xc2, 0x25,
+	0x25, 0xb7, 0x09, 0xed, 0xda, 0xdd, 0x8a, 0x55, 0xd0, 0xb0, 0x7d, 0xc4, 0xb0, 0xd3, 0xad, 0xec,
+	0x63, 0x8e, 0x2a, 0x4e, 0x93, 0xfa, 0xa1, 0x82, 0x5b, 0x57, 0x86, 0xdd, 0x08, 0x2b, 0xa5, 0xc8,
+	0x11, 0x4a, 0xa8, 0xfc, 0x74, 0xc4, 0x97, 0x96, 0xe6, 0x15, 0xbc, 0xa1, 0x14, 0x7a, 0x2b, 0xad,
+	0x22, 0x94, 0x92, 0x16, 0x76, 0xe4, 0x6a, 0xbf, 0xf3, 0xb9, 0x83, 0xc2, 0xde, 0xc8, 0x26, 0x01,
+	0x23, 0x62, 0x93, 0x80, 0x11, 0xad, 0x58, 0x45, 0x81, 0x1f, 0x52, 0x47, 0xfe, 0x6a, 0x51, 0x71,
+	0xd4, 0x0d, 0xf7, 0x03, 0xcc, 0x38, 0x0a, 0x22, 0x05, 0x28, 0x7d, 0x3b, 0x03, 0x56, 0xeb, 0x8c,
+	0xdc, 0xeb, 0xec, 0x07, 0x3e, 0xbf, 0xdb, 0xa6, 0x11, 0x65, 0xa8, 0x05, 0x5f, 0x01, 0xf3, 0x01,
+	0x66, 0x0c, 0x11, 0xcc, 0x4c, 0x63, 0x63, 0xa6, 0x7c, 0x69, 0x27, 0x67, 0x2b, 0x4f, 0x76, 0xec,
+	0xc9, 0xde, 0x0d, 0x7b, 0x6e, 0x82, 0x82, 0x47, 0x06, 0x58, 0xf1, 0x43, 0x9f, 0xfb, 0xa8, 0xd5,
+	0xf0, 0x70, 0x44, 0x99, 0xcf, 0xcd, 0x69, 0x69, 0x99, 0xb7, 0x75, 0x62, 0x82, 0x34, 0x5b, 0x93,
+	0x66, 0xef, 0x51, 0x3f, 0xac, 0xbd, 0xfb, 0xf8, 0x69, 0x71, 0xea, 0xfb, 0x67, 0xc5, 0x32, 0xf1,
+	0xf9, 0x41, 0x67, 0xdf, 0x6e, 0xd2, 0x40, 0xb3, 0xa0, 0xff, 0xb6, 0x99, 0xf7, 0x85, 0xc3, 0x7b,
+	0x11, 0x66, 0xd2, 0x80, 0x7d, 0x73, 0x76, 0xbc, 0xb9, 0xd8, 0xc2, 0x04, 0x35, 0x7b, 0x0d, 0x41,
+	0x3b, 0xfb, 0xee, 0xec, 0x78, 0xd3, 0x70, 0x97, 0xf5, 0xce, 0xef, 0xa8, 0x8d, 0xe1, 0x6b, 0x60,
+	0x3e, 0x92, 0xa9, 0xe0, 0xb6, 0x39, 0xb3, 0x61, 0x94, 0x17, 0x6a, 0xe6, 0x2f, 0x8f, 0xb6, 0x73,
+	0x3a, 0x8e, 0x5d, 0xcf, 0x6b, 0x63, 0xc6, 0xee, 0xf1, 0xb6, 0x1f, 0x12, 0x37, 0x41, 0x42, 0x4b,
+	0x24, 0xcd, 0x91, 0x87, 0x38, 0x32, 0x33, 0xc2, 0xca, 0x4d, 0xd6, 0x30, 0x07, 0x66, 0xb9, 0xcf,
+	0x5b, 0xd8, 0x9c, 0x95, 0x0a, 0xb5, 0x80, 0x26, 0xc8, 0xb2, 0x4e, 0x10, 0xa0, 0x76, 0xcf, 0x9c,
+	0x93, 0xf2, 0x78, 0x09, 0xd7, 0xc1, 0x02, 0x3e, 0x8c, 0xb0, 0xe7, 0x73, 0xec, 0x99, 0xd9, 0x0d,
+	0xa3, 0x3c, 0xef, 0xf6, 0x05, 0xd5, 0xca, 0xd7, 0x67, 0xc7, 0x9b, 0xc9, 0xc6, 0x47, 0x67, 0xc7,
+	0x9b, 0xc5, 0x81, 0x7c, 0xbb, 0x15, 0x27, 0x75, 0x22, 0xa5, 0x5b, 0x20, 0x9f, 0x12, 0xba, 0x98,
+	0x45, 0x34, 0x64, 0x18, 0x16, 0xc1, 0xa5, 0x48, 0xcb, 0x1a, 0xbe, 0x67, 0x1a, 0x1b, 0x46, 0x39,
+	0xe3, 0x82, 0x58, 0x74, 0xc7, 0x2b, 0xfd, 0x68, 0x80, 0x5c, 0x9d, 0x91, 0xdb, 0x87, 0xb8, 0xf9,
+	0x81, 0x64, 0x6f, 0x8f, 0x86, 0x1c, 0x87, 0x1c, 0x7e, 0x08, 0xb2, 0x4d, 0xf5, 0x29, 0xad, 0xce,
+	0x39, 0xe7, 0x5a, 0xe1, 0xe7, 0x47, 0xdb, 0xd6, 0xd0, 0x55, 0x88, 0x4f, 0x51, 0xda, 0xba, 0xb1,
+	0x13, 0x91, 0x37, 0xea, 0xf0, 0x03, 0xda, 0xf6, 0x79, 0xcf, 0x9c, 0x96, 0x9c, 0xf4, 0x05, 0xd5,
+	0x1b, 0x22, 0xef, 0xfe, 0x5a, 0x24, 0x5e, 0x4a, 0x25, 0x9e, 0x0a, 0xb2, 0x54, 0x00, 0xeb, 0xe3,
+	0xe4, 0x71, 0xfa, 0xa5, 0xdf, 0x0d, 0x90, 0xad, 0x33, 0x72, 0x9f, 0x72, 0x0c, 0x6f, 0x8c, 0xa1,
+	0xa2, 0x96, 0xfb, 0xeb, 0x69, 0x71, 0x50, 0xac, 0xaa, 0x66, 0x80, 0x20, 0x68, 0x83, 0xd9, 0x2e,
+	0xe5, 0xb8, 0x6d, 0x4e, 0x5f, 0x50, 0x2e, 0x0a, 0x06, 0x2b, 0x60, 0x8e, 0x46, 0xdc, 0xa7, 0xa1,
+	0xac, 0xaf, 0xe5, 0x7e, 0x91, 0x2b, 0x76, 0x6c, 0x11, 0xcb, 0x47, 0x12, 0xe0, 0x6a, 0xe0, 0xa4,
+	0xf2, 0xaa, 0xbe, 0x28, 0x88, 0x51, 0xae, 0x05, 0x29, 0x97, 0x53, 0xa4, 0x08, 0x7f, 0xa5, 0x55,
+	0xb0, 0xa2, 0x3f, 0x93, 0xd4, 0xff, 0x36, 0x12, 0xd9, 0xa7, 0xd8, 0x27, 0x07, 0x1c, 0x7b, 0xff,
+	0x17, 0x05, 0x6f, 0x82, 0xac, 0xca, 0x8c, 0x99, 0x33, 0xf2, 0xa2, 0x5f, 0x1d, 0xe1, 0x20, 0x0e,
+	0x68, 0x80, 0x8b, 0xd8, 0x62, 0x22, 0x19, 0x2f, 0x0f, 0x93, 0xf1, 0xc2, 0x58, 0x32, 0x62, 0xe7,
+	0xa5, 0x3c, 0xb8, 0x32, 0x22, 0x4a, 0xc8, 0xf9, 0xc3, 0x00, 0xa0, 0xce, 0x48, 0xdc, 0x15, 0xfe,
+	0x23, 0x2f, 0x37, 0xc1, 0x82, 0x6e, 0x68, 0xf4, 0x62, 0x6e, 0xfa, 0x50, 0x78, 0x0b, 0xcc, 0xa1,
+	0x80, 0x76, 0x42, 0xae, 0xe9, 0x99, 0xd0, 0x07, 0x17, 0x44, 0x1f, 0x54, 0x3b, 0x6b, 0x9b, 0xea,
+	0x96, 0xbc, 0x2a, 0x89, 0x37, 0x41, 0x84, 0x99, 0x22, 0x42, 0x67, 0x56, 0xca, 0x01, 0xd8, 0x5f,
+	0x25, 0xe9, 0xff, 0x30, 0x2d, 0x6b, 0xe3, 0x93, 0xc8, 0x43, 0x1c, 0xdf, 0x45, 0x6d, 0x14, 0x30,
+	0x91, 0x4c, 0xff, 0x7e, 0x1a, 0x17, 0x25, 0x93, 0x40, 0xe1, 0xeb, 0x60, 0x2e, 0x92, 0x1e, 0x24,
+	0x03, 0x97, 0x76, 0x2e, 0x8f, 0x9c, 0xb5, 0x72, 0x3f, 0x94, 0x88, 0xc2, 0xc3, 0x37, 0x40, 0x1e,
+	0x79, 0x5e, 0x03, 0xb5, 0x5a, 0xf4, 0x4b, 0xec, 0x35, 0x12, 0xaa, 0x03, 0x46, 0x54, 0xe1, 0x2c,
+	0xb8, 0x6b, 0xc8, 0xf3, 0x76, 0x95, 0x3e, 0x6e, 0x6d, 0x75, 0x46, 0x18, 0x7c, 0x1b, 0xac, 0xb7,
+	0x71, 0x40, 0xbb, 0xf8, 0x1c, 0xeb, 0x8c, 0xb4, 0xce, 0x2b, 0xcc, 0x18, 0x07, 0xd5, 0x9b, 0xe9,
+	0x7e, 0x73, 0x6d, 0x80, 0xc4, 0xc3, 0x78, 0x4e, 0x8f, 0xb0, 0xa4, 0x6b, 0x6a, 0x50, 0x94, 0x90,
+	0x7a, 0x64, 0xc8, 0x79, 0xb9, 0x87, 0xc2, 0x26, 0x6e, 0x0d, 0xcc, 0xcb, 0x31, 0xa5, 0xb5, 0x32,
+	0x52, 0x5a, 0x43, 0x55, 0x35, 0x38, 0xa2, 0xa6, 0x9f, 0x77, 0x44, 0x55, 0x97, 0x86, 0x06, 0x47,
+	0xe9, 0x27, 0x03, 0xe4, 0x53, 0xc1, 0x24, 0x53, 0xe1, 0xdf, 0x07, 0x75, 0x07, 0x2c, 0x35, 0xa5,
+	0x2f, 0xec, 0x35, 0xc4, 0x43, 0x41, 0x1f, 0xb6, 0x95, 0x9a, 0x09, 0x1f, 0xc7, 0xaf, 0x88, 0xda,
+	0xbc, 0x38, 0xf1, 0x87, 0xcf, 0x8a, 0x86, 0xbb, 0x18, 0x9b, 0x0a, 0x25, 0x7c, 0x09, 0xac, 0x24,
+	0xae, 0x0e, 0xe4, 0xc5, 0x94, 0x9d, 0x32, 0xe3, 0x2e, 0xc7, 0xe2, 0xf7, 0xa5, 0x74, 0xe7, 0xcf,
+	0x0c, 0x98, 0xa9, 0x33, 0x02, 0x1f, 0x80, 0xe5, 0x91, 0x47, 0xc8, 0xc6, 0x48, 0x8d, 0xa5, 0xe6,
+	0x9f, 0x55, 0xbe, 0x08, 0x91, 0x70, 0x81, 0xc1, 0x6a, 0x7a, 0xf8, 0x5d, 0x4b, 0x9b, 0xa7, 0x40,
+	0xd6, 0xd6, 0x73, 0x80, 0x92, 0x6d, 0xde, 0x02, 0x19, 0x39, 0x85, 0xd6, 0xd2, 0x46, 0x42, 0x6e,
+	0x15, 0xc6, 0xcb, 0x13, 0xfb, 0xfb, 0x60, 0x71, 0xa8, 0x95, 0x9f, 0x83, 0x8f, 0xf5, 0xd6, 0xf5,
+	0xc9, 0xfa, 0xc4, 0xef, 0x7b, 0x20, 0x1b, 0x77, 0xc1, 0x7c, 0xda, 0x44, 0xab, 0xac, 0xab, 0xe7,
+	0xaa, 0x06, 0x03, 0x1c, 0xea, 0x27, 0x63, 0x02, 0x1c, 0xd4, 0x5b, 0xd7, 0x27, 0xeb, 0x13, 0xbf,
+	0x0f, 0xc0, 0xf2, 0xc8, 0x95, 0x1a, 0x73, 0xfa, 0xc3, 0x08, 0xab, 0x7c, 0x11, 0x22, 0xf6, 0x6e,
+	0xcd, 0x7e, 0x25, 0x5a, 0x52, 0xed, 0xf6, 0xe3, 0x93, 0x82, 0xf1, 0xe4, 0xa4, 0x60, 0xfc, 0x76,
+	0x52, 0x30, 0x1e, 0x9e, 0x16, 0xa6, 0x9e, 0x9c, 0x16, 0xa6, 0x7e, 0x3d, 0x2d, 0x4c, 0x7d, 0xb6,
+	0x35, 0xf1, 0x01, 0xaa, 0xfa, 0x84, 0x7c, 0x86, 0x8a, 0x47, 0xff, 0x9c, 0xbc, 0x06, 0xaf, 0xfe,
+	0x33, 0x00, 0x8d, 0xd8, 0xaf, 0x75, 0x34, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1204,6 +1225,7 @@ func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1664,6 +1686,24 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemoveAllowedProposalMsgs) > 0 {
+		for iNdEx := len(m.RemoveAllowedProposalMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveAllowedProposalMsgs[iNdEx])
+			copy(dAtA[i:], m.RemoveAllowedProposalMsgs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveAllowedProposalMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddAllowedProposalMsgs) > 0 {
+		for iNdEx := len(m.AddAllowedProposalMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddAllowedProposalMsgs[iNdEx])
+			copy(dAtA[i:], m.AddAllowedProposalMsgs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddAllowedProposalMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1982,6 +2022,18 @@ func (m *MsgUpdateParams) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.AddAllowedProposalMsgs) > 0 {
+		for _, s := range m.AddAllowedProposalMsgs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveAllowedProposalMsgs) > 0 {
+		for _, s := range m.RemoveAllowedProposalMsgs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3234,6 +3286,70 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddAllowedProposalMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddAllowedProposalMsgs = append(m.AddAllowedProposalMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAllowedProposalMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAllowedProposalMsgs = append(m.RemoveAllowedProposalMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -84,13 +84,34 @@ func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
 // ValidWeightedVoteOption returns true if the sub vote is valid and false otherwise.
 func ValidWeightedVoteOption(option WeightedVoteOption) bool {
 	weight, err := math.LegacyNewDecFromStr(option.Weight)
-	// HV2: added check to make sure weight is 1
-	if err != nil || !weight.IsPositive() || !weight.Equal(math.LegacyNewDec(1)) {
+	if err != nil || !weight.IsPositive() || weight.GT(math.LegacyNewDec(1)) {
 		return false
 	}
 	return ValidVoteOption(option.Option)
 }
 
+// ValidWeightedVoteOptions returns true if every sub vote is valid, no option is
+// used twice and the weights sum up to exactly 1, false otherwise.
+func ValidWeightedVoteOptions(options WeightedVoteOptions) bool {
+	if len(options) == 0 {
+		return false
+	}
+
+	totalWeight := math.LegacyZeroDec()
+	usedOptions := make(map[VoteOption]bool)
+	for _, option := range options {
+		if option == nil || !ValidWeightedVoteOption(*option) || usedOptions[option.Option] {
+			return false
+		}
+		usedOptions[option.Option] = true
+
+		weight, _ := math.LegacyNewDecFromStr(option.Weight)
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	return totalWeight.Equal(math.LegacyOneDec())
+}
+
 // WeightedVoteOptions describes array of WeightedVoteOptions
 type WeightedVoteOptions []*WeightedVoteOption
 